@@ -2,14 +2,32 @@ package main
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"reflect"
 	"testing"
 	"time"
 
 	"github.com/fern-api/fern-go/internal/cmd/cmdtest"
 	builtin "github.com/fern-api/fern-go/internal/testdata/model/builtin/fixtures"
+	builtincore "github.com/fern-api/fern-go/internal/testdata/model/builtin/fixtures/core"
+	clone "github.com/fern-api/fern-go/internal/testdata/model/clone/fixtures"
+	clonecore "github.com/fern-api/fern-go/internal/testdata/model/clone/fixtures/core"
 	custom "github.com/fern-api/fern-go/internal/testdata/model/custom/fixtures"
 	enum "github.com/fern-api/fern-go/internal/testdata/model/enum/fixtures"
+	forwardcompatible "github.com/fern-api/fern-go/internal/testdata/model/forward-compatible/fixtures"
+	jsonschemas "github.com/fern-api/fern-go/internal/testdata/model/json-schema/fixtures/schemas"
+	optionalfastjson "github.com/fern-api/fern-go/internal/testdata/model/optional-fast-json/fixtures"
+	optionaltypes "github.com/fern-api/fern-go/internal/testdata/model/optional-types/fixtures"
+	patch "github.com/fern-api/fern-go/internal/testdata/model/patch/fixtures"
+	set "github.com/fern-api/fern-go/internal/testdata/model/set/fixtures"
+	sql "github.com/fern-api/fern-go/internal/testdata/model/sql/fixtures"
+	structtags "github.com/fern-api/fern-go/internal/testdata/model/struct-tags/fixtures"
+	undiscriminatedstrict "github.com/fern-api/fern-go/internal/testdata/model/undiscriminated-strict/fixtures"
+	undiscriminatedstrictcore "github.com/fern-api/fern-go/internal/testdata/model/undiscriminated-strict/fixtures/core"
 	undiscriminated "github.com/fern-api/fern-go/internal/testdata/model/undiscriminated/fixtures"
+	unioninterface "github.com/fern-api/fern-go/internal/testdata/model/union-interface/fixtures"
 	union "github.com/fern-api/fern-go/internal/testdata/model/union/fixtures"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -45,7 +63,7 @@ func TestRoundTrip(t *testing.T) {
 				Four:      true,
 				Five:      42,
 				Six:       time.Now(),
-				Seven:     time.Now(),
+				Seven:     builtincore.NewDate(time.Now()),
 				Eight:     newUUID(t),
 				Nine:      []byte("abc"),
 				Ten:       []int{3, 1, 4},
@@ -94,32 +112,44 @@ func TestRoundTrip(t *testing.T) {
 	}
 	for _, test := range tests {
 		t.Run(test.desc, func(t *testing.T) {
-			expectedBytes, err := json.Marshal(test.value)
-			require.NoError(t, err)
+			expected := marshalJSON(t, test.value)
 
 			value := test.constructor()
-			require.NoError(t, json.Unmarshal(expectedBytes, &value))
-
-			actualBytes, err := json.Marshal(value)
-			require.NoError(t, err)
+			unmarshalJSON(t, expected, &value)
 
-			assert.Equal(t, expectedBytes, actualBytes)
+			assert.Equal(t, expected, marshalJSON(t, value))
 		})
 	}
 }
 
+// TestDate verifies that date primitives are [de]serialized
+// without a time component.
+func TestDate(t *testing.T) {
+	value := &builtin.Type{
+		Seven: builtincore.Date{Year: 2023, Month: time.September, Day: 4},
+	}
+	bytes := marshalJSON(t, value)
+
+	object := make(map[string]any)
+	unmarshalJSON(t, bytes, &object)
+	assert.Equal(t, "2023-09-04", object["seven"])
+
+	decoded := new(builtin.Type)
+	unmarshalJSON(t, bytes, decoded)
+	assert.Equal(t, value.Seven, decoded.Seven)
+	assert.Equal(t, time.Date(2023, time.September, 4, 0, 0, 0, 0, time.UTC), decoded.Seven.Time())
+}
+
 // TestEnum verifies that enums are [de]serialized and
 // represented appropriately.
 func TestEnum(t *testing.T) {
 	var one enum.Enum
-	require.NoError(t, json.Unmarshal([]byte(`"ONE"`), &one))
+	unmarshalJSON(t, `"ONE"`, &one)
 
 	assert.Equal(t, enum.EnumOne, one)
 	assert.Equal(t, "ONE", string(one))
 
-	bytes, err := json.Marshal(one)
-	require.NoError(t, err)
-	assert.Equal(t, []byte(`"ONE"`), bytes)
+	assert.Equal(t, `"ONE"`, marshalJSON(t, one))
 
 	two, err := enum.NewEnumFromString("TWO")
 	require.NoError(t, err)
@@ -130,41 +160,324 @@ func TestEnum(t *testing.T) {
 	assert.EqualError(t, err, "FOUR is not a valid api.Enum")
 }
 
+// TestForwardCompatibility verifies that enums and unions
+// tolerate values that were added to the API after the types
+// were generated.
+func TestForwardCompatibility(t *testing.T) {
+	t.Run("enum", func(t *testing.T) {
+		var one forwardcompatible.Enum
+		unmarshalJSON(t, `"ONE"`, &one)
+		assert.True(t, one.IsKnown())
+
+		var four forwardcompatible.Enum
+		unmarshalJSON(t, `"FOUR"`, &four)
+		assert.False(t, four.IsKnown())
+
+		assert.Equal(t, `"FOUR"`, marshalJSON(t, four))
+
+		assert.Equal(
+			t,
+			[]forwardcompatible.Enum{
+				forwardcompatible.EnumOne,
+				forwardcompatible.EnumTwo,
+				forwardcompatible.EnumThree,
+			},
+			four.Values(),
+		)
+	})
+
+	t.Run("union", func(t *testing.T) {
+		value := new(forwardcompatible.Union)
+		unmarshalJSON(t, `{"type": "baz", "baz": {"name": "fern"}}`, &value)
+		assert.Equal(t, "baz", value.Type)
+		assert.Nil(t, value.Foo)
+		assert.Nil(t, value.Bar)
+
+		assert.JSONEq(t, `{"type": "baz", "baz": {"name": "fern"}}`, marshalJSON(t, value))
+
+		visitor := new(unknownUnionVisitor)
+		require.NoError(t, value.Accept(visitor))
+		assert.Equal(t, "baz", visitor.typeName)
+		assert.JSONEq(t, `{"type": "baz", "baz": {"name": "fern"}}`, string(visitor.data))
+
+		known := new(forwardcompatible.Union)
+		unmarshalJSON(t, `{"type": "foo", "foo": {"name": "fern"}}`, &known)
+		assert.Nil(t, known.Unknown)
+		assert.Equal(t, "fern", known.Foo.Name)
+	})
+
+	t.Run("union with unknown variant", func(t *testing.T) {
+		value := new(forwardcompatible.UnionWithUnknown)
+		unmarshalJSON(t, `{"type": "baz"}`, &value)
+		assert.Nil(t, value.Unknown)
+		assert.JSONEq(t, `{"type": "baz"}`, string(value.UnknownVariant))
+	})
+}
+
+func TestOptionalTypes(t *testing.T) {
+	data := `{
+		"id": "abc",
+		"description": null,
+		"name": "fern",
+		"nickname": "",
+		"age": null,
+		"enum": "TWO",
+		"foo": {"name": "foo"},
+		"union": {"type": "foo", "foo": {"name": "union"}},
+		"tags": [],
+		"metadata": null
+	}`
+
+	t.Run("reflection", func(t *testing.T) {
+		var profile optionaltypes.Profile
+		unmarshalJSON(t, data, &profile)
+		assert.True(t, profile.Description.IsNull())
+		assert.True(t, profile.Nickname.IsSet())
+		assert.False(t, profile.Nickname.IsNull())
+		assert.Equal(t, "", profile.Nickname.Value)
+		assert.True(t, profile.Age.IsNull())
+		assert.Equal(t, optionaltypes.EnumTwo, profile.Enum.Value)
+		assert.Equal(t, "foo", profile.Foo.Value.Name)
+		assert.Equal(t, "union", profile.Union.Value.Foo.Name)
+		assert.Equal(t, []string{}, profile.Tags.Value)
+		assert.True(t, profile.Metadata.IsNull())
+		assert.Equal(t, "profile", profile.Kind())
+
+		var omitted optionaltypes.Profile
+		unmarshalJSON(t, `{"id": "abc", "name": "fern"}`, &omitted)
+		assert.False(t, omitted.Description.IsSet())
+		assert.Nil(t, omitted.Nickname)
+		assert.Nil(t, omitted.Foo)
+
+		// Explicit nulls are preserved, whereas omitted values are not.
+		bytes := marshalJSON(t, &profile)
+		assert.JSONEq(
+			t,
+			`{
+				"id": "abc",
+				"description": null,
+				"name": "fern",
+				"nickname": "",
+				"age": null,
+				"enum": "TWO",
+				"foo": {"name": "foo"},
+				"union": {"type": "foo", "foo": {"name": "union"}},
+				"tags": [],
+				"metadata": null,
+				"kind": "profile"
+			}`,
+			bytes,
+		)
+
+		bytes = marshalJSON(t, &optionaltypes.Profile{
+			Id:       "abc",
+			Name:     "fern",
+			Nickname: optionaltypes.Optional("nick"),
+			Age:      optionaltypes.Null[int](),
+		})
+		assert.Equal(t, `{"id":"abc","name":"fern","nickname":"nick","age":null,"kind":"profile"}`, bytes)
+
+		assert.Error(t, json.Unmarshal([]byte(`{"age": "one"}`), new(optionaltypes.Profile)))
+	})
+
+	t.Run("fast", func(t *testing.T) {
+		var profile optionalfastjson.Profile
+		unmarshalJSON(t, data, &profile)
+		assert.True(t, profile.Description.IsNull())
+		assert.True(t, profile.Nickname.IsSet())
+		assert.False(t, profile.Nickname.IsNull())
+		assert.True(t, profile.Age.IsNull())
+		assert.Equal(t, optionalfastjson.EnumTwo, profile.Enum.Value)
+		assert.Equal(t, "foo", profile.Foo.Value.Name)
+		assert.Equal(t, "union", profile.Union.Value.Foo.Name)
+		assert.Equal(t, []string{}, profile.Tags.Value)
+		assert.True(t, profile.Metadata.IsNull())
+
+		var omitted optionalfastjson.Profile
+		unmarshalJSON(t, `{"id": "abc", "name": "fern"}`, &omitted)
+		assert.False(t, omitted.Description.IsSet())
+		assert.Nil(t, omitted.Nickname)
+		assert.Nil(t, omitted.Foo)
+
+		// The output matches the reflection-based marshalers.
+		var reflection optionaltypes.Profile
+		unmarshalJSON(t, data, &reflection)
+		assert.Equal(t, marshalJSON(t, &reflection), marshalJSON(t, &profile))
+		assert.Equal(
+			t,
+			marshalJSON(t, &optionaltypes.Profile{Tags: optionaltypes.Optional[[]string](nil)}),
+			marshalJSON(t, &optionalfastjson.Profile{Tags: optionalfastjson.Optional[[]string](nil)}),
+		)
+
+		assert.Error(t, json.Unmarshal([]byte(`{"age": "one"}`), new(optionalfastjson.Profile)))
+	})
+}
+
+func TestSet(t *testing.T) {
+	var user set.User
+	require.NoError(
+		t,
+		json.Unmarshal(
+			[]byte(`{
+				"name": "fern",
+				"tags": ["b", "a", "b"],
+				"roles": ["ADMIN", "MEMBER", "ADMIN"],
+				"nicknames": [],
+				"scores": [[1, 1, 2], null],
+				"friends": [{"name": "one"}, {"name": "one"}]
+			}`),
+			&user,
+		),
+	)
+	assert.Equal(t, []string{"b", "a"}, user.Tags.Values())
+	assert.Equal(t, []set.Role{set.RoleAdmin, set.RoleMember}, user.Roles.Values())
+	assert.Equal(t, 0, user.Nicknames.Len())
+	assert.True(t, user.Scores[0].Has(2))
+	assert.Nil(t, user.Scores[1])
+	assert.Len(t, user.Friends, 2)
+
+	bytes := marshalJSON(t, &user)
+	assert.Equal(
+		t,
+		`{"name":"fern","tags":["b","a"],"roles":["ADMIN","MEMBER"],"nicknames":[],"scores":[[1,2],null],"friends":[{"name":"one"},{"name":"one"}]}`,
+		bytes,
+	)
+
+	bytes = marshalJSON(t, &set.User{Name: "fern"})
+	assert.Equal(t, `{"name":"fern"}`, bytes)
+}
+
+type unknownUnionVisitor struct {
+	typeName string
+	data     json.RawMessage
+}
+
+func (u *unknownUnionVisitor) VisitFoo(*forwardcompatible.Foo) error {
+	return nil
+}
+
+func (u *unknownUnionVisitor) VisitBar(*forwardcompatible.Bar) error {
+	return nil
+}
+
+func (u *unknownUnionVisitor) VisitUnknown(typeName string, data json.RawMessage) error {
+	u.typeName = typeName
+	u.data = data
+	return nil
+}
+
+// TestUnionInterface verifies that unions represented with a sealed
+// interface are deserialized into the correct variant wherever
+// they're used, and serialize their discriminant.
+func TestUnionInterface(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		data := `{
+			"name": "fern",
+			"shape": {"type": "circle", "id": "one", "version": "v1", "radius": 1.5},
+			"shapes": [
+				{"type": "square", "id": "two", "version": "v1", "length": 2},
+				{"type": "nested", "id": "three", "version": "v1", "value": {"type": "foo", "foo": {"name": "foo"}}},
+				{"type": "fixed", "id": "four", "version": "v1", "value": "fixed"},
+				{"type": "empty", "id": "five", "label": "empty", "version": "v1"}
+			],
+			"shapesByName": {"six": [{"type": "empty", "id": "six", "version": "v1"}]},
+			"alias": {"type": "square", "id": "seven", "version": "v1", "length": 7},
+			"value": [{"type": "empty", "id": "eight", "version": "v1"}],
+			"values": ["nine", {"name": "ten"}]
+		}`
+		var drawing unioninterface.Drawing
+		unmarshalJSON(t, data, &drawing)
+
+		circle, ok := drawing.Shape.(*unioninterface.ShapeCircle)
+		require.True(t, ok)
+		assert.Equal(t, "one", circle.Id)
+		assert.Equal(t, 1.5, circle.Value.Radius)
+		assert.Nil(t, drawing.OptionalShape)
+
+		require.Len(t, drawing.Shapes, 4)
+		assert.Equal(t, &unioninterface.ShapeSquare{Id: "two", Value: 2}, drawing.Shapes[0])
+		nested, ok := drawing.Shapes[1].(*unioninterface.ShapeNested)
+		require.True(t, ok)
+		assert.Equal(t, &unioninterface.UnionFoo{Value: &unioninterface.Foo{Name: "foo"}}, nested.Value)
+		assert.IsType(t, (*unioninterface.ShapeFixed)(nil), drawing.Shapes[2])
+		label := "empty"
+		assert.Equal(t, &unioninterface.ShapeEmpty{Id: "five", Label: &label}, drawing.Shapes[3])
+		assert.IsType(t, (*unioninterface.ShapeEmpty)(nil), drawing.ShapesByName["six"][0])
+		assert.IsType(t, (*unioninterface.ShapeSquare)(nil), drawing.Alias)
+
+		value, ok := drawing.Value.(*unioninterface.ValueShapeList)
+		require.True(t, ok)
+		assert.IsType(t, (*unioninterface.ShapeEmpty)(nil), value.Value[0])
+		assert.Equal(t, []unioninterface.Value{&unioninterface.ValueString{Value: "nine"}, &unioninterface.ValueFoo{Value: &unioninterface.Foo{Name: "ten"}}}, drawing.Values)
+
+		assert.JSONEq(t, data, marshalJSON(t, drawing))
+	})
+
+	t.Run("type switch", func(t *testing.T) {
+		describe := func(shape unioninterface.Shape) string {
+			switch shape := shape.(type) {
+			case *unioninterface.ShapeCircle:
+				return fmt.Sprintf("circle %v", shape.Value.Radius)
+			case *unioninterface.ShapeSquare:
+				return fmt.Sprintf("square %v", shape.Value)
+			case *unioninterface.ShapeUnknown:
+				return "unknown " + shape.Type
+			}
+			return "other"
+		}
+		assert.Equal(t, "circle 2", describe(&unioninterface.ShapeCircle{Value: &unioninterface.Circle{Radius: 2}}))
+		assert.Equal(t, "square 3", describe(&unioninterface.ShapeSquare{Value: 3}))
+
+		shape, err := unioninterface.UnmarshalShape([]byte(`{"type": "triangle", "id": "new"}`))
+		require.NoError(t, err)
+		assert.Equal(t, "unknown triangle", describe(shape))
+
+		assert.JSONEq(t, `{"type": "triangle", "id": "new"}`, marshalJSON(t, shape))
+	})
+
+	t.Run("null", func(t *testing.T) {
+		shape, err := unioninterface.UnmarshalShape([]byte("null"))
+		require.NoError(t, err)
+		assert.Nil(t, shape)
+
+		value, err := unioninterface.UnmarshalValue([]byte("null"))
+		require.NoError(t, err)
+		assert.Nil(t, value)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		_, err := unioninterface.UnmarshalValue([]byte(`true`))
+		assert.EqualError(t, err, "true cannot be deserialized as a Value")
+
+		var drawing unioninterface.Drawing
+		assert.Error(t, json.Unmarshal([]byte(`{"shape": {"type": "circle", "radius": "big"}}`), &drawing))
+	})
+}
+
 // TestLiteral verifies that any type with a literal has
 // the constant value serialized, regardless, of what's
 // found on the wire.
 func TestLiteral(t *testing.T) {
 	t.Run("object", func(t *testing.T) {
 		value := new(builtin.Type)
-		require.NoError(t, json.Unmarshal([]byte(`{"eighteen": "something"}`), &value))
+		unmarshalJSON(t, `{"eighteen": "something"}`, &value)
 		assert.Equal(t, "fern", value.Eighteen())
 
-		bytes, err := json.Marshal(value)
-		require.NoError(t, err)
-
 		object := make(map[string]any)
-		require.NoError(t, json.Unmarshal(bytes, &object))
-
+		unmarshalJSON(t, marshalJSON(t, value), &object)
 		assert.Equal(t, "fern", object["eighteen"])
 	})
 
 	t.Run("union", func(t *testing.T) {
 		value := new(union.UnionWithLiteral)
-		require.NoError(t, json.Unmarshal([]byte(`{"type": "fern", "value": "fern"}`), &value))
+		unmarshalJSON(t, `{"type": "fern", "value": "fern"}`, &value)
 		assert.Equal(t, "extended", value.Extended())
 		assert.Equal(t, "base", value.Base())
 		assert.Equal(t, "fern", value.Fern())
 
-		bytes, err := json.Marshal(value)
-		require.NoError(t, err)
-
 		object := make(map[string]any)
-		require.NoError(t, json.Unmarshal(bytes, &object))
-
-		actualBytes, err := json.Marshal(object)
-		require.NoError(t, err)
-
-		assert.Equal(t, []byte(`{"base":"base","extended":"extended","type":"fern","value":"fern"}`), actualBytes)
+		unmarshalJSON(t, marshalJSON(t, value), &object)
+		assert.Equal(t, `{"base":"base","extended":"extended","type":"fern","value":"fern"}`, marshalJSON(t, object))
 	})
 }
 
@@ -174,13 +487,13 @@ func TestUndiscriminatedUnion(t *testing.T) {
 	}
 
 	request := new(body)
-	require.NoError(t, json.Unmarshal([]byte(`{"body": "something"}`), request))
+	unmarshalJSON(t, `{"body": "something"}`, request)
 
 	assert.Equal(t, "something", request.Body.String)
 	assert.Empty(t, request.Body.StringLiteral())
 
 	unionLiteral := new(undiscriminated.Union)
-	require.NoError(t, json.Unmarshal([]byte(`"fern"`), unionLiteral))
+	unmarshalJSON(t, `"fern"`, unionLiteral)
 
 	// Test that the string takes precedence over the literal because
 	// they aren't specified in the correct order.
@@ -188,7 +501,7 @@ func TestUndiscriminatedUnion(t *testing.T) {
 	assert.Equal(t, "fern", unionLiteral.String)
 
 	unionWithLiteral := new(undiscriminated.UnionWithLiteral)
-	require.NoError(t, json.Unmarshal([]byte(`"fern"`), unionWithLiteral))
+	unmarshalJSON(t, `"fern"`, unionWithLiteral)
 
 	// Test that the literal is used as long as it's actually observed
 	// on the wire.
@@ -196,6 +509,247 @@ func TestUndiscriminatedUnion(t *testing.T) {
 	assert.Empty(t, unionWithLiteral.String)
 }
 
+// TestStrictUndiscriminatedUnion verifies that strictly decoded undiscriminated
+// unions only select a member when the JSON matches it exactly, and explain
+// why every member was rejected otherwise.
+func TestStrictUndiscriminatedUnion(t *testing.T) {
+	t.Run("objects", func(t *testing.T) {
+		// Unknown fields are ignored by default, so the first object matches.
+		lenient := new(undiscriminated.Union)
+		unmarshalJSON(t, `{"id": "one"}`, lenient)
+		assert.Equal(t, &undiscriminated.Foo{}, lenient.Foo)
+
+		strict := new(undiscriminatedstrict.Union)
+		unmarshalJSON(t, `{"id": "one"}`, strict)
+		assert.Nil(t, strict.Foo)
+		assert.Equal(t, &undiscriminatedstrict.Baz{Id: "one"}, strict.Baz)
+
+		// Missing required fields are rejected, so the map matches instead.
+		unmarshalJSON(t, `{"enabled": true}`, strict)
+		assert.Nil(t, strict.Foo)
+		assert.Equal(t, map[string]bool{"enabled": true}, strict.StringBooleanMap)
+	})
+
+	t.Run("literals", func(t *testing.T) {
+		pet := new(undiscriminatedstrict.Pet)
+		unmarshalJSON(t, `{"kind": "cat", "name": "tom"}`, pet)
+		assert.Nil(t, pet.Dog)
+		require.NotNil(t, pet.Cat)
+		assert.Equal(t, "tom", pet.Cat.Name)
+
+		pet = new(undiscriminatedstrict.Pet)
+		unmarshalJSON(t, `"none"`, pet)
+		assert.Equal(t, "none", pet.StringLiteral())
+		assert.Empty(t, pet.String)
+	})
+
+	t.Run("enums", func(t *testing.T) {
+		pet := new(undiscriminatedstrict.Pet)
+		unmarshalJSON(t, `"RED"`, pet)
+		assert.Equal(t, undiscriminatedstrict.ColorRed, pet.Color)
+
+		pet = new(undiscriminatedstrict.Pet)
+		unmarshalJSON(t, `"BLUE"`, pet)
+		assert.Empty(t, pet.Color)
+		assert.Equal(t, "BLUE", pet.String)
+	})
+
+	t.Run("lists", func(t *testing.T) {
+		pet := new(undiscriminatedstrict.Pet)
+		unmarshalJSON(t, `[{"kind": "dog", "name": "rex"}]`, pet)
+		require.Len(t, pet.DogAliasList, 1)
+		assert.Equal(t, "rex", pet.DogAliasList[0].Name)
+
+		assert.JSONEq(t, `[{"kind": "dog", "name": "rex"}]`, marshalJSON(t, pet))
+	})
+
+	t.Run("error", func(t *testing.T) {
+		pet := new(undiscriminatedstrict.Pet)
+		err := json.Unmarshal([]byte(`{"kind": "dog", "name": "rex", "age": 3}`), pet)
+		require.Error(t, err)
+
+		var unionError *undiscriminatedstrictcore.UnionError
+		require.True(t, errors.As(err, &unionError))
+		assert.Equal(t, "Pet", unionError.Type)
+
+		reasons := make(map[string]string)
+		for _, member := range unionError.Members {
+			reasons[member.Member] = member.Err.Error()
+		}
+		assert.Equal(
+			t,
+			map[string]string{
+				"dog":           `unknown field "age"`,
+				"cat":           `unknown field "age"`,
+				"color":         "json: cannot unmarshal object into Go value of type api.Color",
+				"stringLiteral": `expected literal "none", got {"kind": "dog", "name": "rex", "age": 3}`,
+				"string":        "json: cannot unmarshal object into Go value of type string",
+				"dogAliasList":  "json: cannot unmarshal object into Go value of type []*api.Dog",
+			},
+			reasons,
+		)
+		assert.Contains(t, err.Error(), `cannot be deserialized as a Pet (dog: unknown field "age"; cat: unknown field "age"; `)
+	})
+}
+
+func TestGetters(t *testing.T) {
+	t.Run("nil", func(t *testing.T) {
+		var profile *optionaltypes.Profile
+		assert.Empty(t, profile.GetId())
+		assert.Empty(t, profile.GetNickname())
+		assert.Zero(t, profile.GetAge())
+		assert.Nil(t, profile.GetTags())
+		assert.Empty(t, profile.GetFoo().GetName())
+		assert.Empty(t, profile.GetUnion().GetFoo().GetName())
+	})
+
+	t.Run("optional types", func(t *testing.T) {
+		profile := &optionaltypes.Profile{
+			Id:       "id",
+			Nickname: optionaltypes.Optional("nick"),
+			Age:      optionaltypes.Null[int](),
+			Foo:      optionaltypes.Optional(optionaltypes.Foo{Name: "foo"}),
+		}
+		assert.Equal(t, "id", profile.GetId())
+		assert.Equal(t, "nick", profile.GetNickname())
+		assert.Zero(t, profile.GetAge())
+		assert.Empty(t, profile.GetDescription())
+		assert.Equal(t, "foo", profile.GetFoo().GetName())
+
+		// Objects held by value are returned by reference.
+		profile.GetFoo().Name = "bar"
+		assert.Equal(t, "bar", profile.Foo.Value.Name)
+	})
+
+	t.Run("unions", func(t *testing.T) {
+		value := union.NewUnionFromFoo(&union.Foo{Name: "foo"})
+		assert.Equal(t, "foo", value.GetType())
+		assert.Equal(t, "foo", value.GetFoo().GetName())
+		assert.Nil(t, value.GetBar())
+		assert.Empty(t, value.GetBar().GetName())
+
+		age := 42
+		undiscriminatedValue := undiscriminated.NewUnionFromIntegerOptional(&age)
+		assert.Equal(t, 42, undiscriminatedValue.GetIntegerOptional())
+		assert.Empty(t, undiscriminatedValue.GetString())
+		assert.Zero(t, undiscriminated.NewUnionFromIntegerOptional(nil).GetIntegerOptional())
+	})
+}
+
+func TestCloneAndEqual(t *testing.T) {
+	id := newUUID(t)
+	newAccount := func() *clone.Account {
+		return &clone.Account{
+			Id:        id,
+			Name:      "fern",
+			CreatedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+			Birthday:  clone.Optional(clonecore.Date{Year: 2020, Month: time.March, Day: 4}),
+			Avatar:    clone.Optional([]byte("avatar")),
+			Nickname:  clone.Null[string](),
+			Tags:      clone.Tags{"one", "two"},
+			Labels:    clonecore.NewSet("a", "b"),
+			Scores:    map[string]float64{"math": 1.5},
+			Groups:    map[string][]string{"admins": {"fern"}},
+			Address:   clone.Optional(clone.Address{Street: "Main St"}),
+			Addresses: []*clone.Address{{Street: "Elm St"}},
+			History: []*clone.Event{
+				clone.NewEventFromCreated(clone.Tags{"new"}),
+				clone.NewEventFromMoved(&clone.Address{Street: "Oak St"}),
+			},
+			Union:    clone.NewUnionFromStringListList([][]string{{"a"}, {"b", "c"}}),
+			Metadata: map[string]interface{}{"key": "value"},
+		}
+	}
+
+	t.Run("clone", func(t *testing.T) {
+		account := newAccount()
+		cloned := account.Clone()
+		require.True(t, account.Equal(cloned))
+		assert.Equal(t, account, cloned)
+
+		// Mutating the clone must not affect the original.
+		cloned.Avatar.Value[0] = 'A'
+		cloned.Tags[0] = "changed"
+		cloned.Labels.Add("c")
+		cloned.Scores["math"] = 2
+		cloned.Groups["admins"][0] = "changed"
+		cloned.Address.Value.Street = "changed"
+		cloned.Addresses[0].Street = "changed"
+		cloned.History[0].Created[0] = "changed"
+		cloned.History[1].Moved.Street = "changed"
+		cloned.Union.StringListList[1][0] = "changed"
+		assert.Equal(t, newAccount(), account)
+		assert.False(t, account.Equal(cloned))
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		var account *clone.Account
+		assert.Nil(t, account.Clone())
+		assert.True(t, account.Equal(nil))
+		assert.False(t, account.Equal(newAccount()))
+		assert.False(t, newAccount().Equal(nil))
+	})
+
+	t.Run("time", func(t *testing.T) {
+		account := newAccount()
+		other := newAccount()
+		other.CreatedAt = account.CreatedAt.In(time.FixedZone("PST", -8*60*60))
+		assert.NotEqual(t, account, other)
+		assert.True(t, account.Equal(other))
+
+		other.CreatedAt = other.CreatedAt.Add(time.Second)
+		assert.False(t, account.Equal(other))
+	})
+
+	t.Run("optional", func(t *testing.T) {
+		account := newAccount()
+		other := newAccount()
+		other.Nickname = nil
+		assert.False(t, account.Equal(other))
+
+		other.Nickname = clone.Optional("")
+		assert.False(t, account.Equal(other))
+
+		other.Nickname = clone.Null[string]()
+		assert.True(t, account.Equal(other))
+	})
+
+	t.Run("raw json", func(t *testing.T) {
+		account := new(clone.Account)
+		unmarshalJSON(t, `{"id": "3e1f4b8c-2b4f-4f5a-9a3e-0f6b1c2d3e4f", "name": "fern", "createdAt": "2024-01-02T03:04:05Z"}`, account)
+
+		other := &clone.Account{
+			Id:        account.Id,
+			Name:      "fern",
+			CreatedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+		}
+		assert.True(t, account.Equal(other))
+		assert.Equal(t, account.String(), account.Clone().String())
+	})
+
+	t.Run("unions", func(t *testing.T) {
+		created := clone.NewEventFromCreated(clone.Tags{"new"})
+		assert.True(t, created.Equal(created.Clone()))
+		assert.False(t, created.Equal(clone.NewEventFromCreated(clone.Tags{"old"})))
+		assert.False(t, created.Equal(clone.NewEventFromMoved(&clone.Address{})))
+
+		union := clone.NewUnionFromDoubleSet(clonecore.NewSet(1.0, 2.0))
+		assert.True(t, union.Equal(clone.NewUnionFromDoubleSet(clonecore.NewSet(2.0, 1.0))))
+		assert.False(t, union.Equal(clone.NewUnionFromDoubleSet(clonecore.NewSet(1.0))))
+		assert.True(t, clone.NewUnionWithStringLiteral().Equal(clone.NewUnionWithStringLiteral()))
+	})
+
+	t.Run("aliases", func(t *testing.T) {
+		tags := clone.Tags{"one"}
+		cloned := clone.CloneTags(tags)
+		assert.True(t, clone.EqualTags(tags, cloned))
+
+		cloned[0] = "changed"
+		assert.Equal(t, clone.Tags{"one"}, tags)
+		assert.False(t, clone.EqualTags(tags, cloned))
+	})
+}
+
 func newUUID(t *testing.T) uuid.UUID {
 	u, err := uuid.NewRandom()
 	require.NoError(t, err)
@@ -209,3 +763,266 @@ func uuidPtr(v uuid.UUID) *uuid.UUID {
 func int64Ptr(v int64) *int64 {
 	return &v
 }
+
+func TestDatabaseSQL(t *testing.T) {
+	t.Run("enum", func(t *testing.T) {
+		value, err := sql.StatusActive.Value()
+		require.NoError(t, err)
+		assert.Equal(t, "ACTIVE", value)
+
+		var status sql.Status
+		require.NoError(t, status.Scan([]byte("INACTIVE")))
+		assert.Equal(t, sql.StatusInactive, status)
+		require.NoError(t, status.Scan(nil))
+		assert.Equal(t, sql.Status(""), status)
+		assert.EqualError(t, status.Scan("UNKNOWN"), "UNKNOWN is not a valid api.Status")
+		assert.EqualError(t, status.Scan(42), "cannot scan int into *api.Status")
+	})
+
+	t.Run("object", func(t *testing.T) {
+		account := &sql.Account{
+			Name:    "fern",
+			Status:  sql.StatusActive,
+			Tags:    sql.Tags{"one"},
+			Home:    sql.Optional[sql.Home](&sql.Address{Street: "Main St"}),
+			History: []*sql.Event{sql.NewEventFromCreated(sql.Tags{"new"})},
+			Union:   sql.NewUnionFromString("union"),
+		}
+		value, err := account.Value()
+		require.NoError(t, err)
+		assert.Equal(t, marshalJSON(t, account), value)
+
+		scanned := &sql.Account{Name: "stale"}
+		require.NoError(t, scanned.Scan([]byte(value.(string))))
+		assert.Equal(t, "account", scanned.Kind())
+		assert.Equal(t, account.Name, scanned.Name)
+		assert.Equal(t, account.Home.Value.Street, scanned.Home.Value.Street)
+		assert.Equal(t, "union", scanned.Union.String)
+
+		require.NoError(t, scanned.Scan(nil))
+		assert.Equal(t, &sql.Account{}, scanned)
+
+		var nilAccount *sql.Account
+		value, err = nilAccount.Value()
+		require.NoError(t, err)
+		assert.Nil(t, value)
+	})
+
+	t.Run("union", func(t *testing.T) {
+		event := sql.NewEventFromMoved(&sql.Address{Street: "Main St"})
+		value, err := event.Value()
+		require.NoError(t, err)
+
+		scanned := sql.NewEventFromCreated(sql.Tags{"stale"})
+		require.NoError(t, scanned.Scan(value))
+		assert.Equal(t, "moved", scanned.Type)
+		assert.Nil(t, scanned.Created)
+		assert.Equal(t, "Main St", scanned.Moved.Street)
+	})
+
+	t.Run("conflict", func(t *testing.T) {
+		_, ok := interface{}(new(sql.Setting)).(interface{ Scan(interface{}) error })
+		assert.False(t, ok)
+	})
+}
+
+func TestStructTags(t *testing.T) {
+	tests := []struct {
+		desc  string
+		value interface{}
+		field string
+		want  string
+	}{
+		{
+			desc:  "required property",
+			value: structtags.Account{},
+			field: "FirstName",
+			want:  `json:"firstName" yaml:"firstName" bson:"first_name"`,
+		},
+		{
+			desc:  "optional property",
+			value: structtags.Account{},
+			field: "LastSeen",
+			want:  `json:"lastSeen,omitempty" yaml:"lastSeen,omitempty" bson:"last_seen,omitempty"`,
+		},
+		{
+			desc:  "union base property",
+			value: structtags.Event{},
+			field: "OccurredAt",
+			want:  `yaml:"occurredAt" bson:"occurred_at"`,
+		},
+		{
+			desc:  "union variant",
+			value: structtags.Event{},
+			field: "Moved",
+			want:  ``,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			field, ok := reflect.TypeOf(test.value).FieldByName(test.field)
+			require.True(t, ok)
+			assert.Equal(t, test.want, string(field.Tag))
+		})
+	}
+}
+
+func TestJSONSchema(t *testing.T) {
+	t.Run("schema", func(t *testing.T) {
+		data, err := jsonschemas.Schema("Status")
+		require.NoError(t, err)
+
+		var schema map[string]interface{}
+		require.NoError(t, json.Unmarshal(data, &schema))
+		assert.Equal(t, "https://json-schema.org/draft/2020-12/schema", schema["$schema"])
+		assert.Equal(t, []interface{}{"ACTIVE", "INACTIVE"}, schema["enum"])
+
+		_, err = jsonschemas.Schema("Unknown")
+		assert.Error(t, err)
+	})
+
+	t.Run("refs", func(t *testing.T) {
+		entries, err := fs.ReadDir(jsonschemas.FS, ".")
+		require.NoError(t, err)
+		require.NotEmpty(t, entries)
+
+		var refs []string
+		var collect func(value interface{})
+		collect = func(value interface{}) {
+			switch value := value.(type) {
+			case map[string]interface{}:
+				for key, nested := range value {
+					if ref, ok := nested.(string); ok && key == "$ref" {
+						refs = append(refs, ref)
+						continue
+					}
+					collect(nested)
+				}
+			case []interface{}:
+				for _, nested := range value {
+					collect(nested)
+				}
+			}
+		}
+		for _, entry := range entries {
+			data, err := fs.ReadFile(jsonschemas.FS, entry.Name())
+			require.NoError(t, err)
+			var schema interface{}
+			require.NoError(t, json.Unmarshal(data, &schema), entry.Name())
+			collect(schema)
+		}
+		require.NotEmpty(t, refs)
+		for _, ref := range refs {
+			_, err := fs.Stat(jsonschemas.FS, ref)
+			assert.NoError(t, err, ref)
+		}
+	})
+}
+
+func TestPatch(t *testing.T) {
+	newUser := func() *patch.User {
+		nickname := "georgie"
+		return &patch.User{
+			Id:       "user-1",
+			Name:     "George",
+			Nickname: &nickname,
+			Status:   patch.StatusActive,
+			Tags:     patch.Tags{"one"},
+			Address:  &patch.Address{Street: "Main St"},
+			Metadata: "metadata",
+		}
+	}
+
+	t.Run("apply", func(t *testing.T) {
+		user := newUser()
+		(&patch.UserPatch{
+			Name:            patch.Optional("Georgina"),
+			Nickname:        patch.Null[string](),
+			Age:             patch.Optional(42),
+			Status:          patch.Null[patch.Status](),
+			Address:         patch.Optional(patch.AddressPatch{City: patch.Optional("Springfield")}),
+			PreviousAddress: patch.Optional(patch.AddressPatch{Street: patch.Optional("Side St")}),
+			Metadata:        patch.Null[interface{}](),
+		}).Apply(user)
+
+		assert.Equal(t, "user-1", user.Id)
+		assert.Equal(t, "Georgina", user.Name)
+		assert.Nil(t, user.Nickname)
+		assert.Equal(t, 42, *user.Age)
+		assert.Equal(t, patch.Status(""), user.Status)
+		assert.Equal(t, patch.Tags{"one"}, user.Tags)
+		// Nested objects are merged rather than replaced.
+		assert.Equal(t, "Main St", user.Address.Street)
+		assert.Equal(t, "Springfield", *user.Address.City)
+		assert.Equal(t, "Side St", user.PreviousAddress.Street)
+		assert.Nil(t, user.PreviousAddress.City)
+		assert.Nil(t, user.Metadata)
+
+		// A nil patch or target is a no-op.
+		var nilPatch *patch.UserPatch
+		nilPatch.Apply(user)
+		(&patch.UserPatch{Name: patch.Optional("ignored")}).Apply(nil)
+		assert.Equal(t, "Georgina", user.Name)
+	})
+
+	t.Run("marshal", func(t *testing.T) {
+		data := marshalJSON(t, &patch.UserPatch{
+			Name:     patch.Optional("Georgina"),
+			Nickname: patch.Null[string](),
+			Address:  patch.Optional(patch.AddressPatch{Street: patch.Optional("Side St"), City: patch.Null[string]()}),
+		})
+		assert.JSONEq(t, `{"name":"Georgina","nickname":null,"address":{"street":"Side St","city":null}}`, data)
+
+		assert.Equal(t, `{}`, marshalJSON(t, &patch.UserPatch{}))
+	})
+
+	t.Run("unmarshal", func(t *testing.T) {
+		var userPatch patch.UserPatch
+		unmarshalJSON(t, `{"name":"Georgina","nickname":null,"labels":["admin"],"lastEvent":{"type":"created","at":"2024-01-01T00:00:00Z","tags":["new"]}}`, &userPatch)
+		assert.Equal(t, "Georgina", userPatch.Name.Value)
+		assert.True(t, userPatch.Nickname.IsNull())
+		assert.False(t, userPatch.Age.IsSet())
+		assert.True(t, userPatch.Labels.Value.Has("admin"))
+		assert.Equal(t, patch.Tags{"new"}, userPatch.LastEvent.Value.Created)
+
+		user := newUser()
+		userPatch.Apply(user)
+		assert.Equal(t, "Georgina", user.Name)
+		assert.Nil(t, user.Nickname)
+		assert.Equal(t, "created", user.LastEvent.Type)
+
+		// Nested objects are merged like a JSON Merge Patch (RFC 7386).
+		unmarshalJSON(t, `{"address":{"city":"Springfield"},"previousAddress":{"street":"Side St","city":null}}`, &userPatch)
+		user = newUser()
+		userPatch.Apply(user)
+		city := "Springfield"
+		assert.Equal(t, &patch.Address{Street: "Main St", City: &city}, user.Address)
+		assert.Equal(t, &patch.Address{Street: "Side St"}, user.PreviousAddress)
+
+		// Unmarshaling replaces the existing patch.
+		unmarshalJSON(t, `{"age":null}`, &userPatch)
+		assert.Nil(t, userPatch.Name)
+		assert.True(t, userPatch.Age.IsNull())
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		data := `{"id":"user-2","tags":null,"scores":{"a":1.5}}`
+		var userPatch patch.UserPatch
+		unmarshalJSON(t, data, &userPatch)
+		assert.JSONEq(t, data, marshalJSON(t, &userPatch))
+	})
+}
+
+// unmarshalJSON unmarshals the given JSON into the value.
+func unmarshalJSON(t *testing.T, data string, value any) {
+	t.Helper()
+	require.NoError(t, json.Unmarshal([]byte(data), value))
+}
+
+// marshalJSON returns the JSON encoding of the given value.
+func marshalJSON(t *testing.T, value any) string {
+	t.Helper()
+	bytes, err := json.Marshal(value)
+	require.NoError(t, err)
+	return string(bytes)
+}
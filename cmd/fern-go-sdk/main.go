@@ -4,38 +4,25 @@ import (
 	"github.com/fern-api/fern-go/internal/cmd"
 	"github.com/fern-api/fern-go/internal/coordinator"
 	"github.com/fern-api/fern-go/internal/generator"
-	"github.com/fern-api/fern-go/internal/writer"
 )
 
 const usage = `Generate Go clients from your Fern API definition.
 
 Usage:
   fern-go-sdk <config_file_path>
+  fern-go-sdk --ir <ir_file_path> --out <output_path> [generator flags]
 
 Flags:
   -h, --help     Print this help and exit.
-  -v, --version  Print the version and exit.`
+  -v, --version  Print the version and exit.
+` + cmd.FlagsUsage
 
 func main() {
 	cmd.Run(usage, run)
 }
 
 func run(config *cmd.Config, coordinator *coordinator.Client) ([]*generator.File, error) {
-	_, includeReadme := config.Writer.Mode.(*writer.GithubConfig)
-	generatorConfig, err := generator.NewConfig(
-		config.DryRun,
-		config.EnableExplicitNull,
-		includeReadme,
-		config.Organization,
-		config.Version,
-		config.IrFilepath,
-		config.ImportPath,
-		config.Module,
-	)
-	if err != nil {
-		return nil, err
-	}
-	g, err := generator.New(generatorConfig, coordinator)
+	g, err := generator.New(config.GeneratorConfig(), coordinator)
 	if err != nil {
 		return nil, err
 	}
@@ -1,11 +1,28 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"testing"
+	"time"
 
 	"github.com/fern-api/fern-go/internal/cmd/cmdtest"
+	builders "github.com/fern-api/fern-go/internal/testdata/sdk/builders/fixtures"
+	buildersclient "github.com/fern-api/fern-go/internal/testdata/sdk/builders/fixtures/client"
+	encoding "github.com/fern-api/fern-go/internal/testdata/sdk/encoding/fixtures"
+	encodingclient "github.com/fern-api/fern-go/internal/testdata/sdk/encoding/fixtures/client"
+	encodingcore "github.com/fern-api/fern-go/internal/testdata/sdk/encoding/fixtures/core"
 	packages "github.com/fern-api/fern-go/internal/testdata/sdk/packages/fixtures"
+	set "github.com/fern-api/fern-go/internal/testdata/sdk/set/fixtures"
+	setclient "github.com/fern-api/fern-go/internal/testdata/sdk/set/fixtures/client"
+	setcore "github.com/fern-api/fern-go/internal/testdata/sdk/set/fixtures/core"
+	unioninterface "github.com/fern-api/fern-go/internal/testdata/sdk/union-interface/fixtures"
+	unioninterfaceclient "github.com/fern-api/fern-go/internal/testdata/sdk/union-interface/fixtures/client"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -45,3 +62,256 @@ func TestStringifyJSON(t *testing.T) {
 		valueWithoutUnrecognized.String(),
 	)
 }
+
+// TestEncoding verifies that primitives configured with a custom
+// encoding are [de]serialized consistently in JSON bodies and
+// query parameters.
+func TestEncoding(t *testing.T) {
+	deadline := time.UnixMilli(1136214245123)
+	var query url.Values
+	server := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				query = r.URL.Query()
+				_, _ = w.Write([]byte(`{"name":"fern","id":"9007199254740993","score":3.14159265358979323846,"createdAt":1136214245123}`))
+			},
+		),
+	)
+	defer server.Close()
+
+	client := encodingclient.NewClient(encodingclient.WithBaseURL(server.URL))
+	user, err := client.User.GetUsername(
+		context.Background(),
+		&encoding.GetUsersRequest{
+			Id:               encodingcore.Int64String(9007199254740993),
+			Score:            json.Number("2.5"),
+			Deadline:         encodingcore.NewUnixMillis(deadline),
+			OptionalDeadline: encodingcore.NewUnixMillis(deadline).Ptr(),
+		},
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, "9007199254740993", query.Get("id"))
+	assert.Equal(t, "2.5", query.Get("score"))
+	assert.Equal(t, "1136214245123", query.Get("deadline"))
+	assert.Equal(t, "1136214245123", query.Get("optionalDeadline"))
+	assert.False(t, query.Has("optionalId"))
+
+	assert.Equal(t, encodingcore.Int64String(9007199254740993), user.Id)
+	assert.Equal(t, json.Number("3.14159265358979323846"), user.Score)
+	assert.True(t, deadline.Equal(user.CreatedAt.Time))
+	assert.Nil(t, user.UpdatedAt)
+
+	bytes, err := json.Marshal(user)
+	require.NoError(t, err)
+	assert.JSONEq(
+		t,
+		`{"name":"fern","id":"9007199254740993","score":3.14159265358979323846,"createdAt":1136214245123}`,
+		string(bytes),
+	)
+}
+
+// TestSet verifies that sets configured with core.Set collapse duplicates
+// and are formatted in query parameters just like slices.
+func TestGetters(t *testing.T) {
+	var request *encoding.GetUsersRequest
+	assert.Zero(t, request.GetId())
+	assert.Empty(t, request.GetOptionalScore())
+	assert.True(t, request.GetOptionalDeadline().IsZero())
+
+	optionalID := encodingcore.Int64String(42)
+	request = &encoding.GetUsersRequest{
+		Id:         7,
+		OptionalId: &optionalID,
+	}
+	assert.Equal(t, encodingcore.Int64String(7), request.GetId())
+	assert.Equal(t, encodingcore.Int64String(42), request.GetOptionalId())
+	assert.Empty(t, request.GetOptionalScore())
+}
+
+func TestBuilders(t *testing.T) {
+	t.Run("object", func(t *testing.T) {
+		filter, err := builders.NewFilterBuilder().WithTag("admin").WithLimit(10).Build()
+		require.NoError(t, err)
+		assert.Equal(t, "admin", filter.Tag)
+		assert.Equal(t, 10, *filter.Limit)
+		assert.Equal(t, "filter", filter.Kind())
+
+		_, err = builders.NewFilterBuilder().WithLimit(10).Build()
+		assert.EqualError(t, err, `api.Filter is missing required properties ["tag"]`)
+	})
+
+	t.Run("request", func(t *testing.T) {
+		var (
+			query   url.Values
+			header  http.Header
+			payload []byte
+		)
+		server := httptest.NewServer(
+			http.HandlerFunc(
+				func(w http.ResponseWriter, r *http.Request) {
+					query = r.URL.Query()
+					header = r.Header
+					payload, _ = io.ReadAll(r.Body)
+					_, _ = w.Write([]byte(`"ok"`))
+				},
+			),
+		)
+		defer server.Close()
+
+		filter, err := builders.NewFilterBuilder().WithTag("admin").Build()
+		require.NoError(t, err)
+		request, err := builders.NewUpdateRequestBuilder().
+			WithTag("one").
+			WithExtra("extra").
+			WithLabels([]string{"a", "b"}).
+			WithXTraceId("trace").
+			WithUnion(builders.NewUnionFromFoo(&builders.Foo{Id: "foo"})).
+			WithFilter(filter).
+			WithOptionalFilter(builders.Filter{Tag: "optional"}).
+			WithOptionalUnionNull().
+			Build()
+		require.NoError(t, err)
+
+		client := buildersclient.NewClient(buildersclient.WithBaseURL(server.URL))
+		response, err := client.User.Update(context.Background(), "fern", request)
+		require.NoError(t, err)
+		assert.Equal(t, "ok", response)
+		assert.Equal(t, "one", query.Get("tag"))
+		assert.Equal(t, "extra", query.Get("extra"))
+		assert.Equal(t, []string{"a", "b"}, query["labels"])
+		assert.Equal(t, "2024-01-01", header.Get("X-Version"))
+		assert.Equal(t, "trace", header.Get("X-Trace-Id"))
+		var body map[string]json.RawMessage
+		require.NoError(t, json.Unmarshal(payload, &body))
+		assert.JSONEq(t, `{"type": "foo", "id": "foo"}`, string(body["union"]))
+		assert.JSONEq(t, `{"tag": "admin", "kind": "filter"}`, string(body["filter"]))
+		assert.JSONEq(t, `{"tag": "optional", "kind": "filter"}`, string(body["optionalFilter"]))
+		assert.Equal(t, "null", string(body["optionalUnion"]))
+		assert.NotContains(t, body, "optionalTags")
+
+		_, err = builders.NewUpdateRequestBuilder().WithUnion(builders.NewUnionFromFoo(&builders.Foo{Id: "foo"})).Build()
+		assert.EqualError(t, err, `api.UpdateRequest is missing required properties ["tag" "filter"]`)
+	})
+
+	t.Run("literal body", func(t *testing.T) {
+		request, err := builders.NewSetNameRequestV5Builder().WithXEndpointHeader("header").Build()
+		require.NoError(t, err)
+		assert.Equal(t, "fern", request.Body)
+	})
+}
+
+func TestSet(t *testing.T) {
+	var query url.Values
+	server := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				query = r.URL.Query()
+				_, _ = w.Write([]byte(`{
+					"name": "fern",
+					"tags": ["b", "a", "b"],
+					"roles": ["ADMIN", "ADMIN"],
+					"scores": [[1, 1, 2], []],
+					"friends": [{"name": "one"}, {"name": "one"}]
+				}`))
+			},
+		),
+	)
+	defer server.Close()
+
+	client := setclient.NewClient(setclient.WithBaseURL(server.URL))
+	user, err := client.User.GetUsername(
+		context.Background(),
+		&set.GetUsersRequest{
+			Tags: setcore.NewSet("b", "a", "b"),
+		},
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, "[b a]", query.Get("tags"))
+	assert.False(t, query.Has("ids"))
+
+	assert.Equal(t, []string{"b", "a"}, user.Tags.Values())
+	assert.True(t, user.Roles.Has(set.RoleAdmin))
+	assert.Equal(t, 1, user.Roles.Len())
+	assert.Nil(t, user.Nicknames)
+	assert.Equal(t, []int{1, 2}, user.Scores[0].Values())
+	assert.Equal(t, 0, user.Scores[1].Len())
+
+	// Sets of objects aren't comparable, so they use a plain slice.
+	assert.Len(t, user.Friends, 2)
+
+	bytes, err := json.Marshal(user)
+	require.NoError(t, err)
+	assert.JSONEq(
+		t,
+		`{
+			"name": "fern",
+			"tags": ["b", "a"],
+			"roles": ["ADMIN"],
+			"scores": [[1, 2], []],
+			"friends": [{"name": "one"}, {"name": "one"}]
+		}`,
+		string(bytes),
+	)
+}
+
+// TestUnionInterface verifies that unions represented with a sealed
+// interface are used consistently in requests, responses, and errors.
+func TestUnionInterface(t *testing.T) {
+	var requestBody []byte
+	server := httptest.NewServer(
+		http.HandlerFunc(
+			func(w http.ResponseWriter, r *http.Request) {
+				requestBody, _ = io.ReadAll(r.Body)
+				if r.Header.Get("X-Endpoint-Header") == "conflict" {
+					w.WriteHeader(http.StatusConflict)
+					_, _ = w.Write([]byte(`{"type": "bar", "id": "conflict"}`))
+					return
+				}
+				_, _ = w.Write([]byte(`[{"type": "foo", "id": "one"}, {"type": "bar", "id": "two"}]`))
+			},
+		),
+	)
+	defer server.Close()
+
+	client := unioninterfaceclient.NewClient(unioninterfaceclient.WithBaseURL(server.URL))
+	response, err := client.User.SetNameV4(
+		context.Background(),
+		"fern",
+		&unioninterface.SetNameRequestV4{
+			XEndpointHeader: "ok",
+			Body:            &unioninterface.UnionFoo{Value: &unioninterface.Foo{Id: "request"}},
+		},
+	)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"type": "foo", "id": "request"}`, string(requestBody))
+
+	require.Len(t, response, 2)
+	foo, ok := response[0].(*unioninterface.UnionFoo)
+	require.True(t, ok)
+	assert.Equal(t, "one", foo.Value.Id)
+	bar, ok := response[1].(*unioninterface.UnionBar)
+	require.True(t, ok)
+	assert.Equal(t, "two", bar.Value.Id)
+
+	_, err = client.User.SetNameV4(
+		context.Background(),
+		"fern",
+		&unioninterface.SetNameRequestV4{
+			XEndpointHeader: "conflict",
+			Body:            &unioninterface.UnionBar{Value: &unioninterface.Bar{Id: "request"}},
+		},
+	)
+	var conflictError *unioninterface.ConflictError
+	require.True(t, errors.As(err, &conflictError))
+	assert.Equal(t, http.StatusConflict, conflictError.StatusCode)
+	bar, ok = conflictError.Body.(*unioninterface.UnionBar)
+	require.True(t, ok)
+	assert.Equal(t, "conflict", bar.Value.Id)
+
+	var request unioninterface.UpdateRequest
+	require.NoError(t, json.Unmarshal([]byte(`{"union": {"type": "bar", "id": "three"}}`), &request))
+	assert.IsType(t, (*unioninterface.UnionBar)(nil), request.Union)
+	assert.Nil(t, request.OptionalUnion)
+}
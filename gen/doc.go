@@ -0,0 +1,15 @@
+// Package gen generates Go models, clients, and Fiber server types from an
+// in-memory IR, so that the generator can be embedded in other programs
+// without a generator configuration file, coordinator, or Docker image.
+//
+// For example,
+//
+//	files, err := gen.Generate(
+//		ctx,
+//		ir,
+//		gen.Options{
+//			Mode:       gen.ModeClient,
+//			ImportPath: "github.com/acme/sdk",
+//		},
+//	)
+package gen
@@ -0,0 +1,371 @@
+package gen
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/fern-api/fern-go/internal/generator"
+	"github.com/fern-api/fern-go/ir"
+	generatorexec "github.com/fern-api/generator-exec-go"
+)
+
+// Mode selects the kind of code to generate.
+type Mode uint8
+
+// Enumerates the supported modes.
+const (
+	// ModeModel generates the types defined in the API (like fern-go-model).
+	ModeModel Mode = iota + 1
+
+	// ModeClient generates the types and the client (like fern-go-sdk).
+	ModeClient
+
+	// ModeFiber generates the types and the request types used by a
+	// Fiber server (like fern-go-fiber).
+	ModeFiber
+)
+
+// LogLevel is the severity of a message sent to the Logger.
+type LogLevel string
+
+// Enumerates the supported log levels.
+const (
+	LogLevelDebug LogLevel = "DEBUG"
+	LogLevelInfo  LogLevel = "INFO"
+	LogLevelWarn  LogLevel = "WARN"
+	LogLevelError LogLevel = "ERROR"
+)
+
+// Logger receives the messages logged during generation (e.g. a warning
+// for every type that's skipped). Files are generated concurrently, but
+// Log is never called concurrently.
+type Logger interface {
+	Log(level LogLevel, message string)
+}
+
+// LongEncoding is the JSON encoding used for long primitives.
+type LongEncoding string
+
+const (
+	// LongEncodingNumber encodes longs as JSON numbers (e.g. 42) with int64.
+	LongEncodingNumber LongEncoding = "number"
+
+	// LongEncodingString encodes longs as JSON strings (e.g. "42") with core.Int64String.
+	LongEncodingString LongEncoding = "string"
+)
+
+// DoubleEncoding is the JSON encoding used for double primitives.
+type DoubleEncoding string
+
+const (
+	// DoubleEncodingNumber decodes doubles with float64.
+	DoubleEncodingNumber DoubleEncoding = "number"
+
+	// DoubleEncodingJSONNumber decodes doubles with json.Number so that
+	// they're never subject to floating point error.
+	DoubleEncodingJSONNumber DoubleEncoding = "jsonNumber"
+)
+
+// DateTimeEncoding is the JSON encoding used for datetime primitives.
+type DateTimeEncoding string
+
+const (
+	// DateTimeEncodingRFC3339 encodes datetimes as RFC 3339 strings with time.Time.
+	DateTimeEncodingRFC3339 DateTimeEncoding = "rfc3339"
+
+	// DateTimeEncodingUnixSeconds encodes datetimes as the number of seconds
+	// since the Unix epoch with core.UnixSeconds.
+	DateTimeEncodingUnixSeconds DateTimeEncoding = "unixSeconds"
+
+	// DateTimeEncodingUnixMillis encodes datetimes as the number of milliseconds
+	// since the Unix epoch with core.UnixMillis.
+	DateTimeEncodingUnixMillis DateTimeEncoding = "unixMillis"
+)
+
+// DateEncoding is the JSON encoding used for date primitives.
+type DateEncoding string
+
+const (
+	// DateEncodingTime represents dates with time.Time, so they're encoded as
+	// RFC 3339 datetimes (e.g. "2023-09-04T00:00:00Z").
+	DateEncodingTime DateEncoding = "time"
+
+	// DateEncodingDate represents dates with core.Date, so they're encoded as
+	// RFC 3339 full-dates (e.g. "2023-09-04").
+	DateEncodingDate DateEncoding = "date"
+)
+
+// SetEncoding is the encoding used for set containers.
+type SetEncoding string
+
+const (
+	// SetEncodingList represents sets with a plain slice, just like lists.
+	SetEncodingList SetEncoding = "list"
+
+	// SetEncodingSet represents sets of comparable elements with core.Set,
+	// which collapses any duplicates.
+	SetEncodingSet SetEncoding = "set"
+)
+
+// UnionEncoding is the encoding used for discriminated and
+// undiscriminated unions.
+type UnionEncoding string
+
+const (
+	// UnionEncodingStruct represents unions with a struct that has a field
+	// for every variant, along with a Visitor interface.
+	UnionEncodingStruct UnionEncoding = "struct"
+
+	// UnionEncodingInterface represents unions with a sealed interface that's
+	// implemented by a separate type for every variant.
+	UnionEncodingInterface UnionEncoding = "interface"
+)
+
+// StructTagNaming is the naming strategy used for the property names
+// in a StructTag.
+type StructTagNaming string
+
+const (
+	// StructTagNamingWire uses the property's wire value, i.e. the same
+	// name used in its JSON tag.
+	StructTagNamingWire StructTagNaming = "wire"
+
+	// StructTagNamingOriginal uses the property's name as it's written
+	// in the API definition.
+	StructTagNamingOriginal StructTagNaming = "original"
+
+	// StructTagNamingSnakeCase uses the snake_case property name.
+	StructTagNamingSnakeCase StructTagNaming = "snake_case"
+
+	// StructTagNamingCamelCase uses the camelCase property name.
+	StructTagNamingCamelCase StructTagNaming = "camelCase"
+)
+
+// Options configures the generated code. The Enable* options are the same
+// as the generator configuration options of the same name.
+type Options struct {
+	// Mode is the kind of code to generate, and defaults to ModeModel.
+	Mode Mode
+
+	// ImportPath is the import path of the generated packages, including
+	// any major version suffix (e.g. github.com/acme/sdk/v2). It defaults
+	// to the module path, if any.
+	ImportPath string
+
+	// Module generates a go.mod with the given configuration. Note that
+	// 'go mod tidy' isn't run, so a go.sum isn't generated.
+	Module *Module
+
+	// Version and Organization are used in the client's platform headers.
+	Version      string
+	Organization string
+
+	EnableExplicitNull                bool
+	EnableForwardCompatibility        bool
+	EnableFastJSON                    bool
+	EnableOptionalTypes               bool
+	EnableStrictUndiscriminatedUnions bool
+	EnableCloneAndEqual               bool
+	EnableDatabaseSQL                 bool
+	EnableJSONSchema                  bool
+	EnablePatchTypes                  bool
+	EnableBuilders                    bool
+
+	// Encoding selects the JSON encoding of primitives, sets, and unions.
+	// If not specified, every type uses its default encoding.
+	Encoding *Encoding
+
+	// StructTags are generated alongside the JSON tag of every property.
+	StructTags []StructTag
+
+	// Logger receives the messages logged during generation. If not
+	// specified, the messages are discarded.
+	Logger Logger
+}
+
+// Module configures the generated go.mod.
+type Module struct {
+	Path string
+
+	// GoVersion is the version used in the go directive. If not specified,
+	// the minimum version required by the generated code is used.
+	GoVersion string
+
+	// Imports maps every required import path to its version.
+	Imports map[string]string
+}
+
+// Encoding selects the JSON encoding of the types that are commonly
+// represented in more than one way. The zero value of each field uses
+// the type's default encoding.
+type Encoding struct {
+	Long     LongEncoding
+	Double   DoubleEncoding
+	DateTime DateTimeEncoding
+	Date     DateEncoding
+	Set      SetEncoding
+	Union    UnionEncoding
+}
+
+// StructTag is an additional struct tag (e.g. yaml) generated for every
+// property of objects, unions, and request types.
+type StructTag struct {
+	Key    string
+	Naming StructTagNaming
+}
+
+// File is a generated file.
+type File struct {
+	// Path is the slash-separated path of the file, relative to the
+	// root of the generated module.
+	Path    string
+	Content []byte
+}
+
+// Generate generates the files for the given IR, which isn't modified.
+// The files are returned in order of their path.
+func Generate(ctx context.Context, ir *ir.IntermediateRepresentation, opts Options) ([]File, error) {
+	if ir == nil {
+		return nil, errors.New("an IR is required")
+	}
+	mode, err := generatorMode(opts.Mode)
+	if err != nil {
+		return nil, err
+	}
+	config, err := generatorConfig(opts)
+	if err != nil {
+		return nil, err
+	}
+	g, err := generator.New(config, &coordinator{logger: opts.Logger})
+	if err != nil {
+		return nil, err
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	// The generator modifies the IR in place, so it generates from a copy.
+	ir, err = copyIR(ir)
+	if err != nil {
+		return nil, err
+	}
+	generatedFiles, err := g.GenerateIR(ctx, ir, mode)
+	if err != nil {
+		return nil, err
+	}
+	files := make([]File, 0, len(generatedFiles))
+	for _, file := range generatedFiles {
+		files = append(files, File{Path: file.Path, Content: file.Content})
+	}
+	return files, nil
+}
+
+// generatorMode returns the generator.Mode equivalent to the given Mode.
+func generatorMode(mode Mode) (generator.Mode, error) {
+	switch mode {
+	case 0, ModeModel:
+		return generator.ModeModel, nil
+	case ModeClient:
+		return generator.ModeClient, nil
+	case ModeFiber:
+		return generator.ModeFiber, nil
+	}
+	return 0, fmt.Errorf("unrecognized mode %d", mode)
+}
+
+// generatorConfig returns the *generator.Config equivalent to the given Options,
+// which are validated just like the generator configuration.
+func generatorConfig(opts Options) (*generator.Config, error) {
+	importPath := opts.ImportPath
+	var moduleConfig *generator.ModuleConfig
+	if opts.Module != nil {
+		if opts.Module.Path == "" {
+			return nil, errors.New("the module path is required")
+		}
+		if importPath == "" {
+			importPath = opts.Module.Path
+		}
+		if importPath != opts.Module.Path {
+			return nil, fmt.Errorf(
+				"both module path (%q) and import path (%q) are specified, but not equal; please remove import path",
+				opts.Module.Path,
+				importPath,
+			)
+		}
+		moduleConfig = &generator.ModuleConfig{
+			Path:    opts.Module.Path,
+			Version: opts.Module.GoVersion,
+			Imports: opts.Module.Imports,
+		}
+	}
+	var encodingConfig *generator.EncodingConfig
+	if opts.Encoding != nil {
+		encodingConfig = &generator.EncodingConfig{
+			Long:     generator.LongEncoding(opts.Encoding.Long),
+			Double:   generator.DoubleEncoding(opts.Encoding.Double),
+			DateTime: generator.DateTimeEncoding(opts.Encoding.DateTime),
+			Date:     generator.DateEncoding(opts.Encoding.Date),
+			Set:      generator.SetEncoding(opts.Encoding.Set),
+			Union:    generator.UnionEncoding(opts.Encoding.Union),
+		}
+	}
+	var structTags []*generator.StructTag
+	for _, tag := range opts.StructTags {
+		structTags = append(
+			structTags,
+			&generator.StructTag{
+				Key:    tag.Key,
+				Naming: generator.StructTagNaming(tag.Naming),
+			},
+		)
+	}
+	return &generator.Config{
+		EnableExplicitNull:                opts.EnableExplicitNull,
+		EnableForwardCompatibility:        opts.EnableForwardCompatibility,
+		EnableFastJSON:                    opts.EnableFastJSON,
+		EnableOptionalTypes:               opts.EnableOptionalTypes,
+		EnableStrictUndiscriminatedUnions: opts.EnableStrictUndiscriminatedUnions,
+		EnableCloneAndEqual:               opts.EnableCloneAndEqual,
+		EnableDatabaseSQL:                 opts.EnableDatabaseSQL,
+		EnableJSONSchema:                  opts.EnableJSONSchema,
+		EnablePatchTypes:                  opts.EnablePatchTypes,
+		EnableBuilders:                    opts.EnableBuilders,
+		Organization:                      opts.Organization,
+		Version:                           opts.Version,
+		ImportPath:                        importPath,
+		ModuleConfig:                      moduleConfig,
+		EncodingConfig:                    encodingConfig,
+		StructTags:                        structTags,
+	}, nil
+}
+
+// copyIR returns a deep copy of the given IR.
+func copyIR(original *ir.IntermediateRepresentation) (*ir.IntermediateRepresentation, error) {
+	bytes, err := json.Marshal(original)
+	if err != nil {
+		return nil, fmt.Errorf("failed to copy the IR: %v", err)
+	}
+	var copied *ir.IntermediateRepresentation
+	if err := json.Unmarshal(bytes, &copied); err != nil {
+		return nil, fmt.Errorf("failed to copy the IR: %v", err)
+	}
+	return copied, nil
+}
+
+// coordinator adapts the Logger to the generator.Coordinator. The README.md
+// is never generated, since it's only included in GitHub repositories.
+type coordinator struct {
+	logger Logger
+}
+
+func (c *coordinator) Log(level generatorexec.LogLevel, message string) error {
+	if c.logger != nil {
+		c.logger.Log(LogLevel(level), message)
+	}
+	return nil
+}
+
+func (c *coordinator) GenerateReadme(*generatorexec.GenerateReadmeRequest) error {
+	return nil
+}
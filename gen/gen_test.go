@@ -0,0 +1,205 @@
+package gen
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/fern-api/fern-go/internal/generator"
+	"github.com/fern-api/fern-go/ir"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testdataPath = "../internal/testdata"
+
+func TestGenerate(t *testing.T) {
+	tests := []struct {
+		mode    Mode
+		fixture string
+	}{
+		{mode: ModeModel, fixture: "model/packages"},
+		{mode: ModeClient, fixture: "sdk/path-params"},
+		{mode: ModeFiber, fixture: "fiber/query-params"},
+	}
+	for _, test := range tests {
+		t.Run(test.fixture, func(t *testing.T) {
+			var (
+				dir         = filepath.Join(testdataPath, test.fixture)
+				fixturesDir = filepath.Join(dir, "fixtures")
+				ir          = readIR(t, dir)
+			)
+			original, err := json.Marshal(ir)
+			require.NoError(t, err)
+
+			files, err := Generate(
+				context.Background(),
+				ir,
+				Options{
+					Mode:         test.mode,
+					ImportPath:   "github.com/fern-api/fern-go/internal/testdata/" + test.fixture + "/fixtures",
+					Organization: "fernbot",
+				},
+			)
+			require.NoError(t, err)
+			require.NotEmpty(t, files)
+
+			// The files are equivalent to the fixtures generated by the command.
+			for i, file := range files {
+				if i > 0 {
+					assert.Less(t, files[i-1].Path, file.Path)
+				}
+				expected, err := os.ReadFile(filepath.Join(fixturesDir, filepath.FromSlash(file.Path)))
+				require.NoError(t, err)
+				assert.Equal(t, string(expected), string(file.Content), file.Path)
+			}
+
+			// The given IR isn't modified.
+			generated, err := json.Marshal(ir)
+			require.NoError(t, err)
+			assert.JSONEq(t, string(original), string(generated))
+		})
+	}
+}
+
+func TestGenerateModule(t *testing.T) {
+	ir := readIR(t, filepath.Join(testdataPath, "model/packages"))
+	files, err := Generate(
+		context.Background(),
+		ir,
+		Options{
+			Module: &Module{
+				Path:      "github.com/acme/sdk",
+				GoVersion: "1.19",
+				Imports: map[string]string{
+					"github.com/google/uuid": "v1.4.0",
+					"gopkg.in/yaml.v3":       "v3.0.1",
+				},
+			},
+		},
+	)
+	require.NoError(t, err)
+	var goMod *File
+	for i := range files {
+		if files[i].Path == "go.mod" {
+			goMod = &files[i]
+		}
+	}
+	require.NotNil(t, goMod)
+	assert.Equal(
+		t,
+		"module github.com/acme/sdk\n\ngo 1.19\n\nrequire (\n\tgithub.com/google/uuid v1.4.0\n\tgopkg.in/yaml.v3 v3.0.1\n)\n\n",
+		string(goMod.Content),
+	)
+}
+
+func TestGenerateLogger(t *testing.T) {
+	var (
+		ir     = readIR(t, filepath.Join(testdataPath, "model/packages"))
+		logger = new(testLogger)
+	)
+	files, err := Generate(
+		context.Background(),
+		ir,
+		Options{
+			ImportPath: "github.com/acme/sdk",
+			Logger:     logger,
+		},
+	)
+	require.NoError(t, err)
+	for _, file := range files {
+		assert.Contains(t, logger.messages[LogLevelDebug], "Generated "+file.Path)
+	}
+}
+
+func TestGeneratorConfig(t *testing.T) {
+	config, err := generatorConfig(
+		Options{
+			ImportPath: "github.com/acme/sdk",
+			Encoding: &Encoding{
+				Long:     LongEncodingString,
+				Double:   DoubleEncodingJSONNumber,
+				DateTime: DateTimeEncodingUnixMillis,
+				Date:     DateEncodingDate,
+				Set:      SetEncodingSet,
+				Union:    UnionEncodingInterface,
+			},
+			StructTags: []StructTag{
+				{Key: "yaml", Naming: StructTagNamingSnakeCase},
+				{Key: "bson", Naming: StructTagNamingOriginal},
+			},
+		},
+	)
+	require.NoError(t, err)
+	assert.Equal(
+		t,
+		&generator.EncodingConfig{
+			Long:     generator.LongEncodingString,
+			Double:   generator.DoubleEncodingJSONNumber,
+			DateTime: generator.DateTimeEncodingUnixMillis,
+			Date:     generator.DateEncodingDate,
+			Set:      generator.SetEncodingSet,
+			Union:    generator.UnionEncodingInterface,
+		},
+		config.EncodingConfig,
+	)
+	assert.Equal(
+		t,
+		[]*generator.StructTag{
+			{Key: "yaml", Naming: generator.StructTagNamingSnakeCase},
+			{Key: "bson", Naming: generator.StructTagNamingOriginal},
+		},
+		config.StructTags,
+	)
+	assert.NoError(t, config.Validate())
+}
+
+func TestGenerateErrors(t *testing.T) {
+	ir := readIR(t, filepath.Join(testdataPath, "model/packages"))
+
+	_, err := Generate(context.Background(), nil, Options{})
+	assert.EqualError(t, err, "an IR is required")
+
+	_, err = Generate(context.Background(), ir, Options{Mode: 42})
+	assert.EqualError(t, err, "unrecognized mode 42")
+
+	_, err = Generate(context.Background(), ir, Options{ImportPath: "github.com/acme/sdk", Module: &Module{Path: "github.com/acme/api"}})
+	assert.EqualError(t, err, `both module path ("github.com/acme/api") and import path ("github.com/acme/sdk") are specified, but not equal; please remove import path`)
+
+	_, err = Generate(context.Background(), ir, Options{Encoding: &Encoding{Long: "hex"}})
+	assert.EqualError(t, err, `unrecognized long encoding "hex"; expected one of "number" or "string"`)
+
+	_, err = Generate(context.Background(), ir, Options{EnableFastJSON: true, Encoding: &Encoding{Union: UnionEncodingInterface}})
+	assert.EqualError(t, err, `the "interface" union encoding is not supported with enableFastJSON`)
+
+	_, err = Generate(context.Background(), ir, Options{StructTags: []StructTag{{Key: "json"}}})
+	assert.EqualError(t, err, `the "json" struct tag is always generated and cannot be configured`)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err = Generate(ctx, ir, Options{ImportPath: "github.com/acme/sdk"})
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+// testLogger records the messages logged at every level.
+type testLogger struct {
+	messages map[LogLevel][]string
+}
+
+func (t *testLogger) Log(level LogLevel, message string) {
+	if t.messages == nil {
+		t.messages = make(map[LogLevel][]string)
+	}
+	t.messages[level] = append(t.messages[level], message)
+}
+
+// readIR reads the ir.json in the given directory.
+func readIR(t *testing.T, dir string) *ir.IntermediateRepresentation {
+	bytes, err := os.ReadFile(filepath.Join(dir, "ir.json"))
+	require.NoError(t, err)
+	ir := new(ir.IntermediateRepresentation)
+	require.NoError(t, json.Unmarshal(bytes, ir))
+	return ir
+}
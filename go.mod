@@ -9,7 +9,6 @@ require (
 	github.com/stretchr/testify v1.8.4
 	go.uber.org/multierr v1.11.0
 	golang.org/x/mod v0.13.0
-	golang.org/x/tools v0.14.0
 )
 
 require (
@@ -3,13 +3,17 @@ package cmd
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io/fs"
 	"os"
 	"path"
+	"path/filepath"
 	"strings"
 
 	"github.com/fern-api/fern-go"
 	"github.com/fern-api/fern-go/internal/coordinator"
+	"github.com/fern-api/fern-go/internal/fernignore"
 	"github.com/fern-api/fern-go/internal/generator"
 	"github.com/fern-api/fern-go/internal/goexec"
 	"github.com/fern-api/fern-go/internal/writer"
@@ -28,6 +32,11 @@ const (
 	// defaultModulePath is used as the default go.mod path used in the generated
 	// SDK.
 	defaultModulePath = "sdk"
+
+	// goModFilename and goSumFilename are the names of the files
+	// written by 'go mod tidy'.
+	goModFilename = "go.mod"
+	goSumFilename = "go.sum"
 )
 
 var (
@@ -51,21 +60,60 @@ var (
 // Config represents the common configuration required from all of
 // the commands (e.g. fern-go-{client,model}).
 type Config struct {
-	DryRun             bool
-	EnableExplicitNull bool
-	Organization       string
-	CoordinatorURL     string
-	CoordinatorTaskID  string
-	Version            string
-	IrFilepath         string
-	ImportPath         string
-	Module             *generator.ModuleConfig
-	Writer             *writer.Config
+	DryRun                            bool
+	Verify                            bool
+	EnableExplicitNull                bool
+	EnableForwardCompatibility        bool
+	EnableFastJSON                    bool
+	EnableOptionalTypes               bool
+	EnableStrictUndiscriminatedUnions bool
+	EnableCloneAndEqual               bool
+	EnableDatabaseSQL                 bool
+	EnableJSONSchema                  bool
+	EnablePatchTypes                  bool
+	EnableBuilders                    bool
+	Organization                      string
+	CoordinatorURL                    string
+	CoordinatorTaskID                 string
+	Version                           string
+	IrFilepath                        string
+	ImportPath                        string
+	Module                            *generator.ModuleConfig
+	Encoding                          *generator.EncodingConfig
+	StructTags                        []*generator.StructTag
+	Writer                            *writer.Config
 }
 
 // GeneratorFunc is a function that generates files.
 type GeneratorFunc func(*Config, *coordinator.Client) ([]*generator.File, error)
 
+// GeneratorConfig returns the generator configuration shared by all of
+// the commands.
+func (c *Config) GeneratorConfig() *generator.Config {
+	_, includeReadme := c.Writer.Mode.(*writer.GithubConfig)
+	return &generator.Config{
+		DryRun:                            c.DryRun,
+		EnableExplicitNull:                c.EnableExplicitNull,
+		EnableForwardCompatibility:        c.EnableForwardCompatibility,
+		EnableFastJSON:                    c.EnableFastJSON,
+		EnableOptionalTypes:               c.EnableOptionalTypes,
+		EnableStrictUndiscriminatedUnions: c.EnableStrictUndiscriminatedUnions,
+		EnableCloneAndEqual:               c.EnableCloneAndEqual,
+		EnableDatabaseSQL:                 c.EnableDatabaseSQL,
+		EnableJSONSchema:                  c.EnableJSONSchema,
+		EnablePatchTypes:                  c.EnablePatchTypes,
+		EnableBuilders:                    c.EnableBuilders,
+		IncludeReadme:                     includeReadme,
+		Organization:                      c.Organization,
+		Version:                           c.Version,
+		IRFilepath:                        c.IrFilepath,
+		ImportPath:                        c.ImportPath,
+		ModuleConfig:                      c.Module,
+		EncodingConfig:                    c.Encoding,
+		StructTags:                        c.StructTags,
+	}
+}
+
 // Run runs the given command that produces generated files.
 func Run(usage string, fn GeneratorFunc) {
 	if len(os.Args) == 2 && (os.Args[1] == "--version" || os.Args[1] == "-v") {
@@ -76,21 +124,22 @@ func Run(usage string, fn GeneratorFunc) {
 		fmt.Fprintln(os.Stdout, usage)
 		os.Exit(0)
 	}
-	if len(os.Args) != 2 {
+	config, err := newConfigFromArgs(os.Args[1:])
+	if errors.Is(err, errUsage) {
 		fmt.Fprintln(os.Stderr, usage)
 		os.Exit(1)
 	}
-	if err := run(fn); err != nil {
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		os.Exit(1)
+	}
+	if err := run(config, fn); err != nil {
 		fmt.Fprintln(os.Stderr, err.Error())
 		os.Exit(1)
 	}
 }
 
-func run(fn GeneratorFunc) (retErr error) {
-	config, err := newConfig(os.Args[1])
-	if err != nil {
-		return err
-	}
+func run(config *Config, fn GeneratorFunc) (retErr error) {
 	coordinator := coordinator.NewClient(config.CoordinatorURL, config.CoordinatorTaskID)
 	if err := coordinator.Init(); err != nil {
 		return err
@@ -130,7 +179,9 @@ func run(fn GeneratorFunc) (retErr error) {
 		//
 		// For details, see https://github.com/golang/go/issues/35732
 		config.ImportPath = maybeAppendVersionSuffix(config.ImportPath, suffix)
-		config.Module.Path = maybeAppendVersionSuffix(config.Module.Path, suffix)
+		if config.Module != nil {
+			config.Module.Path = maybeAppendVersionSuffix(config.Module.Path, suffix)
+		}
 	}
 	defer func() {
 		exitStatusUpdate := generatorexec.NewExitStatusUpdateFromSuccessful(new(generatorexec.SuccessfulStatusUpdate))
@@ -148,6 +199,16 @@ func run(fn GeneratorFunc) (retErr error) {
 	if err != nil {
 		return err
 	}
+	if config.Verify {
+		// The generated code is verified before anything is written (or published).
+		if err := verifyFiles(coordinator, config.Writer, config.ImportPath, config.Module, files); err != nil {
+			return err
+		}
+	}
+	if config.DryRun {
+		// A dry run only reports the changes, so nothing is written.
+		return diffFiles(coordinator, config.Writer, config.Module, files)
+	}
 	if err := writeFiles(coordinator, config.Writer, config.Module, files); err != nil {
 		return err
 	}
@@ -160,11 +221,16 @@ func newConfig(configFilename string) (*Config, error) {
 	if err != nil {
 		return nil, err
 	}
+	return newConfigFromGeneratorConfig(config)
+}
+
+// newConfigFromGeneratorConfig returns the *Config for the given generator configuration.
+func newConfigFromGeneratorConfig(config *generatorexec.GeneratorConfig) (*Config, error) {
 	customConfig, err := customConfigFromConfig(config)
 	if err != nil {
 		return nil, err
 	}
-	outputMode, err := outputModeFromConfig(config)
+	outputMode, err := outputModeFromConfig(config, customConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -176,6 +242,34 @@ func newConfig(configFilename string) (*Config, error) {
 	if err != nil {
 		return nil, err
 	}
+	version := outputVersionFromGeneratorConfig(config)
+	if publishConfig, ok := outputMode.(*writer.PublishConfig); ok {
+		// The default module path (i.e. "sdk") can't be published, so the
+		// module path must be configured explicitly.
+		if moduleConfig == nil || moduleConfig.Path == "" {
+			return nil, errors.New("the publish output mode requires a module path; please specify the module configuration's path")
+		}
+		// The publish configuration's version always has the 'v' prefix, so the
+		// major version suffix is appended for versions like 2.0.0.
+		version = publishConfig.Version
+	}
+	var (
+		encodingConfig = encodingConfigFromCustomConfig(customConfig)
+		structTags     = structTagsFromCustomConfig(customConfig)
+	)
+	// The options are validated up front so that an invalid configuration is
+	// reported before the generator starts.
+	options := &generator.Config{
+		EnableFastJSON:      customConfig.EnableFastJSON,
+		EnableCloneAndEqual: customConfig.EnableCloneAndEqual,
+		EnableDatabaseSQL:   customConfig.EnableDatabaseSQL,
+		EnablePatchTypes:    customConfig.EnablePatchTypes,
+		EncodingConfig:      encodingConfig,
+		StructTags:          structTags,
+	}
+	if err := options.Validate(); err != nil {
+		return nil, err
+	}
 	var (
 		coordinatorURL    string
 		coordinatorTaskID string
@@ -185,23 +279,110 @@ func newConfig(configFilename string) (*Config, error) {
 		coordinatorTaskID = config.Environment.Remote.Id
 	}
 	return &Config{
-		DryRun:             config.DryRun,
-		EnableExplicitNull: customConfig.EnableExplicitNull,
-		Organization:       config.Organization,
-		CoordinatorURL:     coordinatorURL,
-		CoordinatorTaskID:  coordinatorTaskID,
-		Version:            outputVersionFromGeneratorConfig(config),
-		IrFilepath:         config.IrFilepath,
-		ImportPath:         customConfig.ImportPath,
-		Module:             moduleConfig,
-		Writer:             writerConfig,
+		DryRun:                            config.DryRun,
+		Verify:                            customConfig.Verify,
+		EnableExplicitNull:                customConfig.EnableExplicitNull,
+		EnableForwardCompatibility:        customConfig.EnableForwardCompatibility,
+		EnableFastJSON:                    customConfig.EnableFastJSON,
+		EnableOptionalTypes:               customConfig.EnableOptionalTypes,
+		EnableStrictUndiscriminatedUnions: customConfig.EnableStrictUndiscriminatedUnions,
+		EnableCloneAndEqual:               customConfig.EnableCloneAndEqual,
+		EnableDatabaseSQL:                 customConfig.EnableDatabaseSQL,
+		EnableJSONSchema:                  customConfig.EnableJSONSchema,
+		EnablePatchTypes:                  customConfig.EnablePatchTypes,
+		EnableBuilders:                    customConfig.EnableBuilders,
+		Organization:                      config.Organization,
+		CoordinatorURL:                    coordinatorURL,
+		CoordinatorTaskID:                 coordinatorTaskID,
+		Version:                           version,
+		IrFilepath:                        config.IrFilepath,
+		ImportPath:                        customConfig.ImportPath,
+		Module:                            moduleConfig,
+		Encoding:                          encodingConfig,
+		StructTags:                        structTags,
+		Writer:                            writerConfig,
 	}, nil
 }
 
-// writeFiles writes the given files according to the configuration.
-func writeFiles(
+// diffFiles reports the unified diff between the given files and the files in the
+// configured output location through the coordinator and stdout. An error is returned
+// if there are any differences, so that a dry run can verify the output is up to date.
+func diffFiles(
+	coordinator *coordinator.Client,
+	writerConfig *writer.Config,
+	moduleConfig *generator.ModuleConfig,
+	files []*generator.File,
+) error {
+	writer, err := writer.New(coordinator, writerConfig)
+	if err != nil {
+		return err
+	}
+	if moduleConfig != nil {
+		// The go.mod and go.sum are compared after 'go mod tidy', just
+		// like they're written.
+		files, err = tidyFiles(moduleRoot(writerConfig, writer.Root()), files)
+		if err != nil {
+			return err
+		}
+	}
+	diff, err := writer.Diff(files)
+	if err != nil {
+		return err
+	}
+	if diff == "" {
+		return coordinator.Log(
+			generatorexec.LogLevelInfo,
+			fmt.Sprintf("The generated files in %s are up to date.", writer.Root()),
+		)
+	}
+	fmt.Fprint(os.Stdout, diff)
+	if err := coordinator.Log(generatorexec.LogLevelInfo, diff); err != nil {
+		return err
+	}
+	return fmt.Errorf("the generated files differ from the files in %s", writer.Root())
+}
+
+// tidyFiles returns the given files with the go.mod and go.sum that are written by
+// 'go mod tidy', which is run in a temporary copy of the root directory with the
+// generated files (see writeTempFiles).
+func tidyFiles(root string, files []*generator.File) ([]*generator.File, error) {
+	dir, err := writeTempFiles(root, files)
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	if err := goexec.RunTidy(dir); err != nil {
+		return nil, err
+	}
+
+	tidied := make([]*generator.File, 0, len(files)+1)
+	for _, file := range files {
+		if file.Path != goModFilename {
+			tidied = append(tidied, file)
+		}
+	}
+	for _, filename := range []string{goModFilename, goSumFilename} {
+		content, err := os.ReadFile(filepath.Join(dir, filename))
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		tidied = append(tidied, &generator.File{Path: filename, Content: content})
+	}
+	return tidied, nil
+}
+
+// verifyFiles runs 'go build' and 'go vet' on a temporary copy of the root directory with
+// the given files (see writeTempFiles), and reports every diagnostic through the coordinator
+// and stderr. An error is returned if the generated code doesn't compile or fails 'go vet',
+// so that nothing is written.
+func verifyFiles(
 	coordinator *coordinator.Client,
 	writerConfig *writer.Config,
+	importPath string,
 	moduleConfig *generator.ModuleConfig,
 	files []*generator.File,
 ) error {
@@ -209,14 +390,158 @@ func writeFiles(
 	if err != nil {
 		return err
 	}
-	if err := writer.WriteFiles(files); err != nil {
+	dir, err := writeTempFiles(moduleRoot(writerConfig, writer.Root()), files)
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	if _, err := os.Stat(filepath.Join(dir, goModFilename)); errors.Is(err, fs.ErrNotExist) && moduleConfig == nil {
+		// Neither the generated files nor the root directory include a go.mod, so
+		// they're verified in a temporary module with the configured import path.
+		if err := goexec.RunModInit(dir, importPath); err != nil {
+			return err
+		}
+	}
+	if err := goexec.RunTidy(dir); err != nil {
+		return err
+	}
+	diagnostics, err := goexec.RunBuildAndVet(dir)
+	if err != nil {
+		return err
+	}
+	if len(diagnostics) == 0 {
+		return coordinator.Log(generatorexec.LogLevelInfo, "The generated code compiles and passes go vet.")
+	}
+	for _, diagnostic := range diagnostics {
+		fmt.Fprintln(os.Stderr, diagnostic)
+		if err := coordinator.Log(generatorexec.LogLevelError, diagnostic.String()); err != nil {
+			return err
+		}
+	}
+	return fmt.Errorf(
+		"the generated code failed verification with %d issue(s), starting with %s",
+		len(diagnostics),
+		diagnostics[0],
+	)
+}
+
+// moduleRoot returns the given root directory if the files are written to the
+// root of the module, or an empty string otherwise (i.e. the publish output mode
+// writes a module zip to a directory laid out like a GOPROXY).
+func moduleRoot(writerConfig *writer.Config, root string) string {
+	if _, ok := writerConfig.Mode.(*writer.PublishConfig); ok {
+		return ""
+	}
+	return root
+}
+
+// writeTempFiles writes the given files to a new temporary directory, which
+// should be removed by the caller. The files in the root directory that are kept
+// as-is when the files are written are copied first (i.e. the go.sum and every
+// file that matches a pattern in its .fernignore file), so the directory matches
+// what the root directory looks like once the files are written. If the root
+// directory is empty, only the given files are written.
+func writeTempFiles(root string, files []*generator.File) (string, error) {
+	var ignore *fernignore.Matcher
+	if root != "" {
+		var err error
+		ignore, err = fernignore.Read(root)
+		if err != nil {
+			return "", err
+		}
+	}
+	dir, err := os.MkdirTemp("", "fern-go")
+	if err != nil {
+		return "", err
+	}
+	if root != "" {
+		if err := copyPreservedFiles(root, dir, ignore); err != nil {
+			os.RemoveAll(dir)
+			return "", err
+		}
+	}
+	for _, file := range files {
+		if ignore.Match(filepath.ToSlash(file.Path)) {
+			continue
+		}
+		if err := writeFile(filepath.Join(dir, file.Path), file.Content); err != nil {
+			os.RemoveAll(dir)
+			return "", err
+		}
+	}
+	return dir, nil
+}
+
+// copyPreservedFiles copies the go.sum and the files ignored by the given matcher
+// from the root directory to the given directory.
+func copyPreservedFiles(root string, dir string, ignore *fernignore.Matcher) error {
+	if err := copyFile(filepath.Join(root, goSumFilename), filepath.Join(dir, goSumFilename)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	if _, err := os.Stat(filepath.Join(root, fernignore.Filename)); err != nil {
+		// Nothing is ignored without a .fernignore file.
+		return nil
+	}
+	return filepath.WalkDir(root, func(filename string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			if entry.Name() == ".git" {
+				return fs.SkipDir
+			}
+			return nil
+		}
+		path, err := filepath.Rel(root, filename)
+		if err != nil {
+			return err
+		}
+		if !entry.Type().IsRegular() || !ignore.Match(filepath.ToSlash(path)) {
+			return nil
+		}
+		return copyFile(filename, filepath.Join(dir, path))
+	})
+}
+
+// copyFile copies the content of the source file to the destination.
+func copyFile(src string, dst string) error {
+	content, err := os.ReadFile(src)
+	if err != nil {
+		return err
+	}
+	return writeFile(dst, content)
+}
+
+// writeFile writes the given content to the file, creating its parent
+// directories if necessary.
+func writeFile(filename string, content []byte) error {
+	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(filename, content, 0644)
+}
+
+// writeFiles writes the given files according to the configuration.
+func writeFiles(
+	coordinator *coordinator.Client,
+	writerConfig *writer.Config,
+	moduleConfig *generator.ModuleConfig,
+	files []*generator.File,
+) error {
+	writer, err := writer.New(coordinator, writerConfig)
+	if err != nil {
 		return err
 	}
-	// If a module file was written, we still need to generate the go.sum.
 	if moduleConfig != nil {
-		return goexec.RunTidy(writer.Root())
+		// The go.mod and go.sum are tidied before they're written, so that
+		// they're unchanged by the next run.
+		files, err = tidyFiles(moduleRoot(writerConfig, writer.Root()), files)
+		if err != nil {
+			return err
+		}
 	}
-	return nil
+	return writer.WriteFiles(files)
 }
 
 // readConfig returns the generator configuration from the given filename.
@@ -233,9 +558,22 @@ func readConfig(configFilename string) (*generatorexec.GeneratorConfig, error) {
 }
 
 type customConfig struct {
-	EnableExplicitNull bool          `json:"enableExplicitNull,omitempty"`
-	ImportPath         string        `json:"importPath,omitempty"`
-	Module             *moduleConfig `json:"module,omitempty"`
+	EnableExplicitNull                bool            `json:"enableExplicitNull,omitempty"`
+	EnableForwardCompatibility        bool            `json:"enableForwardCompatibility,omitempty"`
+	EnableFastJSON                    bool            `json:"enableFastJSON,omitempty"`
+	EnableOptionalTypes               bool            `json:"enableOptionalTypes,omitempty"`
+	EnableStrictUndiscriminatedUnions bool            `json:"enableStrictUndiscriminatedUnions,omitempty"`
+	EnableCloneAndEqual               bool            `json:"enableCloneAndEqual,omitempty"`
+	EnableDatabaseSQL                 bool            `json:"enableDatabaseSQL,omitempty"`
+	EnableJSONSchema                  bool            `json:"enableJSONSchema,omitempty"`
+	EnablePatchTypes                  bool            `json:"enablePatchTypes,omitempty"`
+	EnableBuilders                    bool            `json:"enableBuilders,omitempty"`
+	Verify                            bool            `json:"verify,omitempty"`
+	OverwritePublishedVersion         bool            `json:"overwritePublishedVersion,omitempty"`
+	ImportPath                        string          `json:"importPath,omitempty"`
+	Module                            *moduleConfig   `json:"module,omitempty"`
+	Encoding                          *encodingConfig `json:"encoding,omitempty"`
+	StructTags                        []*structTag    `json:"structTags,omitempty"`
 }
 
 type moduleConfig struct {
@@ -244,6 +582,20 @@ type moduleConfig struct {
 	Imports map[string]string `json:"imports,omitempty"`
 }
 
+type structTag struct {
+	Key    string `json:"key,omitempty"`
+	Naming string `json:"naming,omitempty"`
+}
+
+type encodingConfig struct {
+	Long     string `json:"long,omitempty"`
+	Double   string `json:"double,omitempty"`
+	DateTime string `json:"datetime,omitempty"`
+	Date     string `json:"date,omitempty"`
+	Set      string `json:"set,omitempty"`
+	Union    string `json:"union,omitempty"`
+}
+
 func customConfigFromConfig(c *generatorexec.GeneratorConfig) (*customConfig, error) {
 	if c.CustomConfig == nil {
 		return &customConfig{}, nil
@@ -296,12 +648,41 @@ func moduleConfigFromCustomConfig(customConfig *customConfig, outputMode writer.
 	}, nil
 }
 
-func outputModeFromConfig(c *generatorexec.GeneratorConfig) (writer.OutputMode, error) {
+func encodingConfigFromCustomConfig(customConfig *customConfig) *generator.EncodingConfig {
+	if customConfig.Encoding == nil {
+		return nil
+	}
+	return &generator.EncodingConfig{
+		Long:     generator.LongEncoding(customConfig.Encoding.Long),
+		Double:   generator.DoubleEncoding(customConfig.Encoding.Double),
+		DateTime: generator.DateTimeEncoding(customConfig.Encoding.DateTime),
+		Date:     generator.DateEncoding(customConfig.Encoding.Date),
+		Set:      generator.SetEncoding(customConfig.Encoding.Set),
+		Union:    generator.UnionEncoding(customConfig.Encoding.Union),
+	}
+}
+
+func structTagsFromCustomConfig(customConfig *customConfig) []*generator.StructTag {
+	var structTags []*generator.StructTag
+	for _, tag := range customConfig.StructTags {
+		if tag == nil {
+			// The nil tag is reported by the generator's validation.
+			structTags = append(structTags, nil)
+			continue
+		}
+		structTags = append(structTags, &generator.StructTag{Key: tag.Key, Naming: generator.StructTagNaming(tag.Naming)})
+	}
+	return structTags
+}
+
+func outputModeFromConfig(c *generatorexec.GeneratorConfig, customConfig *customConfig) (writer.OutputMode, error) {
 	switch outputConfigMode := c.Output.Mode; outputConfigMode.Type {
 	case "github":
 		return writer.NewGithubConfig(c.Output.Path, outputConfigMode.Github.RepoUrl)
 	case "downloadFiles":
 		return writer.NewLocalConfig(c.Output.Path)
+	case "publish":
+		return writer.NewPublishConfig(c.Output.Path, outputConfigMode.Publish.Version, customConfig.OverwritePublishedVersion)
 	default:
 		return nil, fmt.Errorf("unrecognized output configuration mode: %T", outputConfigMode)
 	}
@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/fern-api/fern-go/internal/generator"
+	"github.com/fern-api/fern-go/internal/writer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteTempFiles(t *testing.T) {
+	root := t.TempDir()
+	for path, content := range map[string]string{
+		".fernignore":      "custom.go\nuser/client.go\n",
+		"go.sum":           "example.io/dep v1.0.0 h1:abc=\n",
+		"custom.go":        "package api // custom",
+		"user/client.go":   "package user // edited",
+		"user/user.go":     "package user // stale",
+		".git/custom.go":   "package git",
+		"types.go":         "package api // stale",
+		"user/service.go":  "package user // stale",
+		"internal/util.go": "package internal",
+	} {
+		filename := filepath.Join(root, path)
+		require.NoError(t, os.MkdirAll(filepath.Dir(filename), 0755))
+		require.NoError(t, os.WriteFile(filename, []byte(content), 0644))
+	}
+
+	dir, err := writeTempFiles(
+		root,
+		[]*generator.File{
+			{Path: "types.go", Content: []byte("package api")},
+			{Path: "user/client.go", Content: []byte("package user")},
+		},
+	)
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	// The go.sum and the ignored files are copied from the root directory,
+	// and the ignored files take precedence over the generated files.
+	assertFiles(
+		t,
+		dir,
+		map[string]string{
+			"go.sum":         "example.io/dep v1.0.0 h1:abc=\n",
+			"custom.go":      "package api // custom",
+			"user/client.go": "package user // edited",
+			"types.go":       "package api",
+		},
+	)
+
+	// Nothing is copied without a module root (e.g. in the publish output mode).
+	publishConfig, err := writer.NewPublishConfig(root, "1.2.3", false)
+	require.NoError(t, err)
+	dir, err = writeTempFiles(
+		moduleRoot(&writer.Config{Mode: publishConfig}, root),
+		[]*generator.File{
+			{Path: "types.go", Content: []byte("package api")},
+			{Path: "user/client.go", Content: []byte("package user")},
+		},
+	)
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	assertFiles(
+		t,
+		dir,
+		map[string]string{
+			"types.go":       "package api",
+			"user/client.go": "package user",
+		},
+	)
+}
+
+func assertFiles(t *testing.T, dir string, want map[string]string) {
+	got := make(map[string]string)
+	require.NoError(
+		t,
+		filepath.WalkDir(dir, func(filename string, entry os.DirEntry, err error) error {
+			if err != nil || entry.IsDir() {
+				return err
+			}
+			content, err := os.ReadFile(filename)
+			if err != nil {
+				return err
+			}
+			path, err := filepath.Rel(dir, filename)
+			if err != nil {
+				return err
+			}
+			got[filepath.ToSlash(path)] = string(content)
+			return nil
+		}),
+	)
+	assert.Equal(t, want, got)
+}
@@ -0,0 +1,179 @@
+package cmd
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	generatorexec "github.com/fern-api/generator-exec-go"
+)
+
+// FlagsUsage describes the flags accepted in place of a generator configuration
+// file, which is included in the usage of every command.
+const FlagsUsage = `
+Generator flags (instead of a config file):
+  --ir <path>                              The IR file to generate from (required).
+  --out <path>                             The directory to write the generated files to (required).
+  --import-path <path>                     The import path of the generated packages.
+  --module-path <path>                     Generate a go.mod with the given module path.
+  --go-version <version>                   The Go version used in the generated go.mod (e.g. 1.19).
+  --version <version>                      The version of the generated module (e.g. v1.2.3).
+  --organization <name>                    The name of the organization that owns the API.
+  --publish                                Write a versioned module zip laid out like a GOPROXY.
+  --overwrite-published-version            Replace the module zip if the version was already published.
+  --dry-run                                Print a diff of the changes instead of writing them.
+  --verify                                 Build and vet the generated code before writing it.
+  --enable-explicit-null                   Same as the enableExplicitNull option.
+  --enable-forward-compatibility           Same as the enableForwardCompatibility option.
+  --enable-fast-json                       Same as the enableFastJSON option.
+  --enable-optional-types                  Same as the enableOptionalTypes option.
+  --enable-strict-undiscriminated-unions   Same as the enableStrictUndiscriminatedUnions option.
+  --enable-clone-and-equal                 Same as the enableCloneAndEqual option.
+  --enable-database-sql                    Same as the enableDatabaseSQL option.
+  --enable-json-schema                     Same as the enableJSONSchema option.
+  --enable-patch-types                     Same as the enablePatchTypes option.
+  --enable-builders                        Same as the enableBuilders option.
+  --long-encoding <encoding>               The encoding used for long values (number or string).
+  --double-encoding <encoding>             The encoding used for double values (number or jsonNumber).
+  --datetime-encoding <encoding>           The encoding used for datetime values (rfc3339, unixSeconds, or unixMillis).
+  --date-encoding <encoding>               The encoding used for date values (time or date).
+  --set-encoding <encoding>                The encoding used for sets (list or set).
+  --union-encoding <encoding>              The encoding used for unions (struct or interface).
+  --struct-tag <key>[:<naming>]            Add a struct tag to every field (repeatable), e.g. yaml:snake_case.`
+
+// errUsage is returned when the command is run without any arguments.
+var errUsage = errors.New("usage")
+
+// newConfigFromArgs returns the *Config specified by the given command line arguments,
+// which are either the path to a generator configuration file, or the flags described
+// in FlagsUsage.
+func newConfigFromArgs(args []string) (*Config, error) {
+	if len(args) == 0 {
+		return nil, errUsage
+	}
+	if len(args) == 1 && !strings.HasPrefix(args[0], "-") {
+		return newConfig(args[0])
+	}
+	generatorConfig, version, err := generatorConfigFromFlags(args)
+	if err != nil {
+		return nil, err
+	}
+	config, err := newConfigFromGeneratorConfig(generatorConfig)
+	if err != nil {
+		return nil, err
+	}
+	if config.Version == "" {
+		// The local output mode doesn't include a version, so it's set explicitly.
+		config.Version = version
+	}
+	return config, nil
+}
+
+// generatorConfigFromFlags returns the generator configuration equivalent to the given
+// flags, along with the configured version (if any). The configuration doesn't specify
+// an environment, so the generator runs without a coordinator.
+func generatorConfigFromFlags(args []string) (*generatorexec.GeneratorConfig, string, error) {
+	var (
+		flagSet      = flag.NewFlagSet("fern-go", flag.ContinueOnError)
+		customConfig = new(customConfig)
+		encoding     = new(encodingConfig)
+		module       = new(moduleConfig)
+		structTags   structTagsFlag
+
+		irFilepath   string
+		outputPath   string
+		version      string
+		organization string
+		publish      bool
+		dryRun       bool
+	)
+	// The flags are documented in FlagsUsage, so the default output is discarded.
+	flagSet.SetOutput(io.Discard)
+	flagSet.StringVar(&irFilepath, "ir", "", "")
+	flagSet.StringVar(&outputPath, "out", "", "")
+	flagSet.StringVar(&customConfig.ImportPath, "import-path", "", "")
+	flagSet.StringVar(&module.Path, "module-path", "", "")
+	flagSet.StringVar(&module.Version, "go-version", "", "")
+	flagSet.StringVar(&version, "version", "", "")
+	flagSet.StringVar(&organization, "organization", "", "")
+	flagSet.BoolVar(&publish, "publish", false, "")
+	flagSet.BoolVar(&customConfig.OverwritePublishedVersion, "overwrite-published-version", false, "")
+	flagSet.BoolVar(&dryRun, "dry-run", false, "")
+	flagSet.BoolVar(&customConfig.Verify, "verify", false, "")
+	flagSet.BoolVar(&customConfig.EnableExplicitNull, "enable-explicit-null", false, "")
+	flagSet.BoolVar(&customConfig.EnableForwardCompatibility, "enable-forward-compatibility", false, "")
+	flagSet.BoolVar(&customConfig.EnableFastJSON, "enable-fast-json", false, "")
+	flagSet.BoolVar(&customConfig.EnableOptionalTypes, "enable-optional-types", false, "")
+	flagSet.BoolVar(&customConfig.EnableStrictUndiscriminatedUnions, "enable-strict-undiscriminated-unions", false, "")
+	flagSet.BoolVar(&customConfig.EnableCloneAndEqual, "enable-clone-and-equal", false, "")
+	flagSet.BoolVar(&customConfig.EnableDatabaseSQL, "enable-database-sql", false, "")
+	flagSet.BoolVar(&customConfig.EnableJSONSchema, "enable-json-schema", false, "")
+	flagSet.BoolVar(&customConfig.EnablePatchTypes, "enable-patch-types", false, "")
+	flagSet.BoolVar(&customConfig.EnableBuilders, "enable-builders", false, "")
+	flagSet.StringVar(&encoding.Long, "long-encoding", "", "")
+	flagSet.StringVar(&encoding.Double, "double-encoding", "", "")
+	flagSet.StringVar(&encoding.DateTime, "datetime-encoding", "", "")
+	flagSet.StringVar(&encoding.Date, "date-encoding", "", "")
+	flagSet.StringVar(&encoding.Set, "set-encoding", "", "")
+	flagSet.StringVar(&encoding.Union, "union-encoding", "", "")
+	flagSet.Var(&structTags, "struct-tag", "")
+	if err := flagSet.Parse(args); err != nil {
+		return nil, "", fmt.Errorf("%v; run with --help for usage", err)
+	}
+	if flagSet.NArg() > 0 {
+		return nil, "", fmt.Errorf("unexpected arguments %q; run with --help for usage", flagSet.Args())
+	}
+	if irFilepath == "" {
+		return nil, "", errors.New("the --ir flag is required")
+	}
+	if outputPath == "" {
+		return nil, "", errors.New("the --out flag is required")
+	}
+
+	if module.Path != "" || module.Version != "" {
+		customConfig.Module = module
+	}
+	if *encoding != (encodingConfig{}) {
+		customConfig.Encoding = encoding
+	}
+	customConfig.StructTags = structTags
+
+	outputMode := &generatorexec.OutputMode{Type: "downloadFiles"}
+	if publish {
+		outputMode = &generatorexec.OutputMode{
+			Type: "publish",
+			Publish: &generatorexec.GeneratorPublishConfig{
+				Version: version,
+			},
+		}
+	}
+	return &generatorexec.GeneratorConfig{
+		DryRun:       dryRun,
+		IrFilepath:   irFilepath,
+		Organization: organization,
+		CustomConfig: customConfig,
+		Output: &generatorexec.GeneratorOutputConfig{
+			Path: outputPath,
+			Mode: outputMode,
+		},
+	}, version, nil
+}
+
+// structTagsFlag is a repeatable flag that adds a struct tag, e.g. yaml:snake_case.
+type structTagsFlag []*structTag
+
+func (s *structTagsFlag) String() string {
+	tags := make([]string, 0, len(*s))
+	for _, tag := range *s {
+		tags = append(tags, tag.Key+":"+tag.Naming)
+	}
+	return strings.Join(tags, ",")
+}
+
+func (s *structTagsFlag) Set(value string) error {
+	key, naming, _ := strings.Cut(value, ":")
+	*s = append(*s, &structTag{Key: key, Naming: naming})
+	return nil
+}
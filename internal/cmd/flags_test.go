@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/fern-api/fern-go/internal/generator"
+	"github.com/fern-api/fern-go/internal/writer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewConfigFromArgs(t *testing.T) {
+	t.Run("flags", func(t *testing.T) {
+		config, err := newConfigFromArgs(
+			[]string{
+				"--ir", "ir.json",
+				"--out", "generated",
+				"--module-path", "github.com/acme/sdk",
+				"--go-version", "1.19",
+				"--version", "v1.2.3",
+				"--organization", "acme",
+				"--enable-explicit-null",
+				"--enable-builders",
+				"--verify",
+				"--long-encoding", "string",
+				"--date-encoding", "date",
+				"--struct-tag", "yaml:snake_case",
+				"--struct-tag", "bson",
+			},
+		)
+		require.NoError(t, err)
+		assert.Equal(t, "ir.json", config.IrFilepath)
+		assert.Equal(t, "v1.2.3", config.Version)
+		assert.Equal(t, "acme", config.Organization)
+		assert.True(t, config.EnableExplicitNull)
+		assert.True(t, config.EnableBuilders)
+		assert.False(t, config.EnableFastJSON)
+		assert.False(t, config.DryRun)
+		assert.True(t, config.Verify)
+		assert.Empty(t, config.CoordinatorURL)
+		assert.Equal(t, &writer.LocalConfig{Path: "generated"}, config.Writer.Mode)
+		assert.Equal(t, "github.com/acme/sdk", config.Module.Path)
+		assert.Equal(t, "1.19", config.Module.Version)
+		assert.Equal(t, defaultImports, config.Module.Imports)
+		assert.Equal(t, generator.LongEncodingString, config.Encoding.Long)
+		assert.Equal(t, generator.DateEncodingDate, config.Encoding.Date)
+		assert.Equal(
+			t,
+			[]*generator.StructTag{
+				{Key: "yaml", Naming: generator.StructTagNamingSnakeCase},
+				{Key: "bson"},
+			},
+			config.StructTags,
+		)
+	})
+	t.Run("publish", func(t *testing.T) {
+		config, err := newConfigFromArgs(
+			[]string{
+				"--ir", "ir.json",
+				"--out", "proxy",
+				"--module-path", "github.com/acme/sdk",
+				"--version", "2.0.0",
+				"--publish",
+				"--overwrite-published-version",
+				"--dry-run",
+			},
+		)
+		require.NoError(t, err)
+		assert.Equal(t, &writer.PublishConfig{Path: "proxy", Version: "v2.0.0", Overwrite: true}, config.Writer.Mode)
+		assert.Equal(t, "v2.0.0", config.Version)
+		assert.True(t, config.DryRun)
+		assert.False(t, config.Verify)
+		assert.Equal(t, "github.com/acme/sdk", config.Module.Path)
+		assert.Nil(t, config.Encoding)
+
+		// The major version suffix is appended to the normalized version.
+		suffix, ok := parseMajorVersion(config.Version)
+		assert.True(t, ok)
+		assert.Equal(t, "v2", suffix)
+	})
+	t.Run("config file", func(t *testing.T) {
+		_, err := newConfigFromArgs([]string{"missing.json"})
+		assert.EqualError(t, err, "failed to read generator configuration: open missing.json: no such file or directory")
+	})
+	t.Run("errors", func(t *testing.T) {
+		tests := []struct {
+			args []string
+			err  string
+		}{
+			{args: nil, err: "usage"},
+			{args: []string{"--out", "generated"}, err: "the --ir flag is required"},
+			{args: []string{"--ir", "ir.json"}, err: "the --out flag is required"},
+			{args: []string{"--ir", "ir.json", "--out", "generated", "extra"}, err: `unexpected arguments ["extra"]; run with --help for usage`},
+			{args: []string{"--ir", "ir.json", "--unknown"}, err: "flag provided but not defined: -unknown; run with --help for usage"},
+			{args: []string{"--ir", "ir.json", "--out", "generated", "--long-encoding", "hex"}, err: `unrecognized long encoding "hex"; expected one of "number" or "string"`},
+			{args: []string{"--ir", "ir.json", "--out", "generated", "--date-encoding", "iso8601"}, err: `unrecognized date encoding "iso8601"; expected one of "time" or "date"`},
+			{args: []string{"--ir", "ir.json", "--out", "generated", "--publish"}, err: "the publish output mode requires a version"},
+			{args: []string{"--ir", "ir.json", "--out", "generated", "--version", "1.2.3", "--publish"}, err: "the publish output mode requires a module path; please specify the module configuration's path"},
+		}
+		for _, test := range tests {
+			_, err := newConfigFromArgs(test.args)
+			assert.EqualError(t, err, test.err)
+		}
+	})
+}
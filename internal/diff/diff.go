@@ -0,0 +1,271 @@
+package diff
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// contextLines is the number of unchanged lines included
+	// before and after every change.
+	contextLines = 3
+
+	// maxEditDistance is the maximum number of insertions and deletions
+	// considered by the diff algorithm. Beyond that, the changed lines are
+	// reported as a single replacement so that the memory used to compute
+	// the diff is bounded.
+	maxEditDistance = 1000
+
+	// devNull is the filename used for a file that doesn't exist.
+	devNull = "/dev/null"
+)
+
+// opKind is the kind of an edit operation.
+type opKind byte
+
+const (
+	opEqual  opKind = ' '
+	opDelete opKind = '-'
+	opInsert opKind = '+'
+)
+
+// op is a single line of an edit script.
+type op struct {
+	kind opKind
+	line string
+}
+
+// Unified returns the unified diff between the old and new content, which are
+// labeled with the given names. A nil content represents a file that doesn't
+// exist (e.g. a new file), which is labeled as /dev/null. An empty string is
+// returned if the contents are equal.
+func Unified(oldName string, newName string, oldContent []byte, newContent []byte) string {
+	if string(oldContent) == string(newContent) && (oldContent == nil) == (newContent == nil) {
+		return ""
+	}
+	if oldContent == nil {
+		oldName = devNull
+	}
+	if newContent == nil {
+		newName = devNull
+	}
+	ops := editScript(splitLines(oldContent), splitLines(newContent))
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "--- %s\n", oldName)
+	fmt.Fprintf(&b, "+++ %s\n", newName)
+	writeHunks(&b, ops)
+	return b.String()
+}
+
+// writeHunks writes the hunks of the given edit script, where every hunk includes
+// the surrounding context lines. Changes that are close enough for their context
+// to overlap are merged into the same hunk.
+func writeHunks(b *strings.Builder, ops []op) {
+	// The (zero-based) line of the old and new content at each op.
+	var (
+		oldLines = make([]int, len(ops)+1)
+		newLines = make([]int, len(ops)+1)
+	)
+	for i, op := range ops {
+		oldLines[i+1], newLines[i+1] = oldLines[i], newLines[i]
+		if op.kind != opInsert {
+			oldLines[i+1]++
+		}
+		if op.kind != opDelete {
+			newLines[i+1]++
+		}
+	}
+
+	for i := 0; i < len(ops); {
+		for i < len(ops) && ops[i].kind == opEqual {
+			i++
+		}
+		if i == len(ops) {
+			break
+		}
+		start := i - contextLines
+		if start < 0 {
+			start = 0
+		}
+		end := i
+		for end < len(ops) {
+			for end < len(ops) && ops[end].kind != opEqual {
+				end++
+			}
+			equal := 0
+			for end+equal < len(ops) && ops[end+equal].kind == opEqual {
+				equal++
+			}
+			if end+equal == len(ops) || equal > 2*contextLines {
+				if equal > contextLines {
+					equal = contextLines
+				}
+				end += equal
+				break
+			}
+			end += equal
+		}
+
+		fmt.Fprintf(
+			b,
+			"@@ -%s +%s @@\n",
+			formatRange(oldLines[start], oldLines[end]-oldLines[start]),
+			formatRange(newLines[start], newLines[end]-newLines[start]),
+		)
+		for _, op := range ops[start:end] {
+			b.WriteByte(byte(op.kind))
+			b.WriteString(op.line)
+			if !strings.HasSuffix(op.line, "\n") {
+				b.WriteString("\n\\ No newline at end of file\n")
+			}
+		}
+		i = end
+	}
+}
+
+// formatRange formats the range of a hunk, given its zero-based start line.
+func formatRange(start int, count int) string {
+	if count == 0 {
+		// An empty range refers to the line before the change.
+		return fmt.Sprintf("%d,0", start)
+	}
+	if count == 1 {
+		return fmt.Sprintf("%d", start+1)
+	}
+	return fmt.Sprintf("%d,%d", start+1, count)
+}
+
+// splitLines splits the given content into lines, each of which includes
+// its trailing newline (if any).
+func splitLines(content []byte) []string {
+	if len(content) == 0 {
+		return nil
+	}
+	lines := strings.SplitAfter(string(content), "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// editScript returns the shortest edit script that transforms a into b. The
+// common prefix and suffix are trimmed before the rest is diffed.
+func editScript(a []string, b []string) []op {
+	var prefix, suffix int
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	ops := make([]op, 0, len(a)+len(b))
+	for _, line := range a[:prefix] {
+		ops = append(ops, op{kind: opEqual, line: line})
+	}
+	ops = append(ops, myers(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])...)
+	for _, line := range a[len(a)-suffix:] {
+		ops = append(ops, op{kind: opEqual, line: line})
+	}
+	return ops
+}
+
+// myers returns the shortest edit script that transforms a into b with
+// the algorithm described in "An O(ND) Difference Algorithm and Its
+// Variations" (Myers, 1986).
+func myers(a []string, b []string) []op {
+	var (
+		n      = len(a)
+		m      = len(b)
+		max    = n + m
+		offset = max + 1
+		v      = make([]int, 2*max+3)
+	)
+	// trace records the furthest reaching x of every diagonal k before
+	// each round d, i.e. trace[d][k+d] for k in [-d, d].
+	var trace [][]int
+	for d := 0; d <= max; d++ {
+		if d > maxEditDistance {
+			return replace(a, b)
+		}
+		snapshot := make([]int, 2*d+1)
+		copy(snapshot, v[offset-d:offset+d+1])
+		trace = append(trace, snapshot)
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				return backtrack(a, b, trace)
+			}
+		}
+	}
+	return replace(a, b)
+}
+
+// backtrack returns the edit script found by myers, given its trace.
+func backtrack(a []string, b []string, trace [][]int) []op {
+	var (
+		x   = len(a)
+		y   = len(b)
+		ops []op
+	)
+	for d := len(trace) - 1; d > 0; d-- {
+		var (
+			v     = trace[d]
+			k     = x - y
+			prevK int
+		)
+		if k == -d || (k != d && v[k-1+d] < v[k+1+d]) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := v[prevK+d]
+		prevY := prevX - prevK
+		for x > prevX && y > prevY {
+			ops = append(ops, op{kind: opEqual, line: a[x-1]})
+			x--
+			y--
+		}
+		if x == prevX {
+			ops = append(ops, op{kind: opInsert, line: b[y-1]})
+			y--
+		} else {
+			ops = append(ops, op{kind: opDelete, line: a[x-1]})
+			x--
+		}
+	}
+	for x > 0 && y > 0 {
+		ops = append(ops, op{kind: opEqual, line: a[x-1]})
+		x--
+		y--
+	}
+	for i, j := 0, len(ops)-1; i < j; i, j = i+1, j-1 {
+		ops[i], ops[j] = ops[j], ops[i]
+	}
+	return ops
+}
+
+// replace returns an edit script that deletes every line of a,
+// and then inserts every line of b.
+func replace(a []string, b []string) []op {
+	ops := make([]op, 0, len(a)+len(b))
+	for _, line := range a {
+		ops = append(ops, op{kind: opDelete, line: line})
+	}
+	for _, line := range b {
+		ops = append(ops, op{kind: opInsert, line: line})
+	}
+	return ops
+}
@@ -0,0 +1,145 @@
+package diff
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnified(t *testing.T) {
+	tests := []struct {
+		description string
+		old         []byte
+		new         []byte
+		want        string
+	}{
+		{
+			description: "equal",
+			old:         []byte("a\nb\n"),
+			new:         []byte("a\nb\n"),
+		},
+		{
+			description: "added",
+			new:         []byte("a\nb\n"),
+			want: `--- /dev/null
++++ b/file.go
+@@ -0,0 +1,2 @@
++a
++b
+`,
+		},
+		{
+			description: "deleted",
+			old:         []byte("a\n"),
+			want: `--- a/file.go
++++ /dev/null
+@@ -1 +0,0 @@
+-a
+`,
+		},
+		{
+			description: "modified",
+			old:         []byte("a\nb\nc\nd\ne\nf\ng\nh\n"),
+			new:         []byte("a\nb\nc\nd\nE\nf\ng\nh\n"),
+			want: `--- a/file.go
++++ b/file.go
+@@ -2,7 +2,7 @@
+ b
+ c
+ d
+-e
++E
+ f
+ g
+ h
+`,
+		},
+		{
+			description: "separate hunks",
+			old:         []byte(numberedLines(1, 20)),
+			new:         []byte(strings.Replace(strings.Replace(numberedLines(1, 20), "2\n", "two\n", 1), "19\n", "", 1)),
+			want: `--- a/file.go
++++ b/file.go
+@@ -1,5 +1,5 @@
+ 1
+-2
++two
+ 3
+ 4
+ 5
+@@ -16,5 +16,4 @@
+ 16
+ 17
+ 18
+-19
+ 20
+`,
+		},
+		{
+			description: "merged hunks",
+			old:         []byte(numberedLines(1, 10)),
+			new:         []byte(strings.Replace(strings.Replace(numberedLines(1, 10), "2\n", "two\n", 1), "8\n", "eight\n", 1)),
+			want: `--- a/file.go
++++ b/file.go
+@@ -1,10 +1,10 @@
+ 1
+-2
++two
+ 3
+ 4
+ 5
+ 6
+ 7
+-8
++eight
+ 9
+ 10
+`,
+		},
+		{
+			description: "no newline at end of file",
+			old:         []byte("a\nb"),
+			new:         []byte("a\nb\n"),
+			want: `--- a/file.go
++++ b/file.go
+@@ -1,2 +1,2 @@
+ a
+-b
+\ No newline at end of file
++b
+`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			assert.Equal(t, test.want, Unified("a/file.go", "b/file.go", test.old, test.new))
+		})
+	}
+}
+
+func TestEditScript(t *testing.T) {
+	// The edit script is always the shortest one.
+	ops := editScript(
+		splitLines([]byte("a\nb\nc\na\nb\nb\na\n")),
+		splitLines([]byte("c\nb\na\nb\na\nc\n")),
+	)
+	var edits int
+	for _, op := range ops {
+		if op.kind != opEqual {
+			edits++
+		}
+	}
+	assert.Equal(t, 5, edits)
+}
+
+// numberedLines returns the numbers from start to end (inclusive),
+// each on their own line.
+func numberedLines(start int, end int) string {
+	var b strings.Builder
+	for i := start; i <= end; i++ {
+		b.WriteString(strconv.Itoa(i) + "\n")
+	}
+	return b.String()
+}
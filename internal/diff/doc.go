@@ -0,0 +1,3 @@
+// Package diff computes line-based unified diffs, which are used to
+// report the changes a dry run would make to the output directory.
+package diff
@@ -0,0 +1,4 @@
+// Package fernignore matches paths against the gitignore-style patterns
+// listed in a .fernignore file, which protects hand-edited files in the
+// output directory from being overwritten by the generator.
+package fernignore
@@ -0,0 +1,220 @@
+package fernignore
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// Filename is the name of the file that lists the ignored paths, which
+// is read from the root of the output directory.
+const Filename = ".fernignore"
+
+// Matcher reports whether a path is ignored by the patterns in a .fernignore
+// file. The patterns follow the gitignore format, e.g.
+//
+//	# Comments and blank lines are ignored.
+//	README.md
+//	/internal/custom.go
+//	docs/
+//	**/*_custom.go
+//	!docs/generated.md
+//
+// A nil *Matcher doesn't ignore anything.
+type Matcher struct {
+	patterns []*pattern
+}
+
+// pattern is a single line of a .fernignore file.
+type pattern struct {
+	regexp *regexp.Regexp
+
+	// negate is set for patterns prefixed with a '!', which re-include
+	// a path ignored by a previous pattern.
+	negate bool
+
+	// directory is set for patterns with a trailing '/', which only match
+	// directories.
+	directory bool
+}
+
+// Read returns the *Matcher for the .fernignore file in the given directory.
+// If the directory doesn't have a .fernignore file, nothing is ignored.
+func Read(dir string) (*Matcher, error) {
+	content, err := os.ReadFile(filepath.Join(dir, Filename))
+	if errors.Is(err, fs.ErrNotExist) {
+		return new(Matcher), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return Parse(content)
+}
+
+// Parse returns the *Matcher for the given .fernignore content.
+func Parse(content []byte) (*Matcher, error) {
+	matcher := new(Matcher)
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for line := 1; scanner.Scan(); line++ {
+		pattern, err := parsePattern(scanner.Text())
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s pattern on line %d: %v", Filename, line, err)
+		}
+		if pattern != nil {
+			matcher.patterns = append(matcher.patterns, pattern)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return matcher, nil
+}
+
+// Match returns true if the given path is ignored. The path is relative to the
+// directory that contains the .fernignore file.
+//
+// Like git, a path is ignored if any of its parent directories are ignored, and
+// it can't be re-included by a negated pattern in that case.
+func (m *Matcher) Match(filename string) bool {
+	if m == nil || len(m.patterns) == 0 {
+		return false
+	}
+	filename = strings.TrimPrefix(path.Clean(filepath.ToSlash(filename)), "/")
+	if filename == "." || filename == "" {
+		return false
+	}
+	elements := strings.Split(filename, "/")
+	for i := range elements {
+		isDirectory := i < len(elements)-1
+		if m.matchPath(strings.Join(elements[:i+1], "/"), isDirectory) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchPath returns true if the given path is ignored, not considering
+// its parent directories. The last pattern that matches the path wins.
+func (m *Matcher) matchPath(filename string, isDirectory bool) bool {
+	var ignored bool
+	for _, pattern := range m.patterns {
+		if pattern.directory && !isDirectory {
+			continue
+		}
+		if pattern.regexp.MatchString(filename) {
+			ignored = !pattern.negate
+		}
+	}
+	return ignored
+}
+
+// parsePattern parses a single line of a .fernignore file, or returns nil
+// if it's blank or a comment.
+func parsePattern(line string) (*pattern, error) {
+	line = trimTrailingSpaces(line)
+	if line == "" || strings.HasPrefix(line, "#") {
+		return nil, nil
+	}
+	pattern := new(pattern)
+	if strings.HasPrefix(line, "!") {
+		pattern.negate = true
+		line = line[1:]
+	} else if strings.HasPrefix(line, `\#`) || strings.HasPrefix(line, `\!`) {
+		line = line[1:]
+	}
+	if strings.HasSuffix(line, "/") {
+		pattern.directory = true
+		line = strings.TrimRight(line, "/")
+	}
+	if line == "" {
+		return nil, nil
+	}
+
+	// A pattern that contains a separator (other than a trailing one) is relative
+	// to the root, whereas any other pattern matches at any depth.
+	anchored := strings.Contains(line, "/")
+	line = strings.TrimPrefix(line, "/")
+
+	expr, err := globToRegexp(line)
+	if err != nil {
+		return nil, err
+	}
+	if !anchored {
+		expr = "(?:.*/)?" + expr
+	}
+	pattern.regexp, err = regexp.Compile("^" + expr + "$")
+	if err != nil {
+		return nil, err
+	}
+	return pattern, nil
+}
+
+// globToRegexp converts the given gitignore glob into a regular expression.
+// A '*' matches anything except a separator, a '?' matches any single character
+// except a separator, and a '**' matches any number of directories.
+func globToRegexp(glob string) (string, error) {
+	var expr strings.Builder
+	for i := 0; i < len(glob); i++ {
+		switch c := glob[i]; c {
+		case '*':
+			if !strings.HasPrefix(glob[i:], "**") {
+				expr.WriteString("[^/]*")
+				continue
+			}
+			leading := i == 0 || glob[i-1] == '/'
+			trailing := i+2 == len(glob) || glob[i+2] == '/'
+			switch {
+			case leading && i+2 == len(glob):
+				// A trailing '/**' matches everything inside the directory.
+				expr.WriteString(".*")
+				i++
+			case leading && trailing:
+				// A leading '**/' or a '/**/' matches zero or more directories.
+				expr.WriteString("(?:.*/)?")
+				i += 2
+			default:
+				// Any other '**' is the same as a '*'.
+				expr.WriteString("[^/]*")
+				i++
+			}
+		case '?':
+			expr.WriteString("[^/]")
+		case '[':
+			end := strings.IndexByte(glob[i+1:], ']')
+			if end < 0 {
+				return "", fmt.Errorf("unterminated character class in %q", glob)
+			}
+			class := glob[i+1 : i+1+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			expr.WriteString("[" + class + "]")
+			i += end + 1
+		case '\\':
+			if i+1 < len(glob) {
+				i++
+			}
+			expr.WriteString(regexp.QuoteMeta(glob[i : i+1]))
+		default:
+			expr.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	return expr.String(), nil
+}
+
+// trimTrailingSpaces removes the trailing spaces from the given line,
+// unless they're escaped with a backslash.
+func trimTrailingSpaces(line string) string {
+	line = strings.TrimRight(line, "\r")
+	for strings.HasSuffix(line, " ") && !strings.HasSuffix(line, `\ `) {
+		line = line[:len(line)-1]
+	}
+	return line
+}
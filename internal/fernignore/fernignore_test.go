@@ -0,0 +1,122 @@
+package fernignore
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		description string
+		content     string
+		ignored     []string
+		included    []string
+	}{
+		{
+			description: "empty",
+			content:     "# Nothing is ignored.\n\n",
+			included:    []string{"README.md", "types.go", "internal/core.go"},
+		},
+		{
+			description: "basename",
+			content:     "README.md\n",
+			ignored:     []string{"README.md", "docs/README.md", "/README.md"},
+			included:    []string{"README.markdown", "types.go"},
+		},
+		{
+			description: "anchored",
+			content:     "/README.md\ninternal/custom.go\n",
+			ignored:     []string{"README.md", "internal/custom.go"},
+			included:    []string{"docs/README.md", "other/internal/custom.go"},
+		},
+		{
+			description: "directory",
+			content:     "docs/\n",
+			ignored:     []string{"docs/README.md", "internal/docs/guide/index.md"},
+			included:    []string{"docs", "documentation/README.md"},
+		},
+		{
+			description: "wildcards",
+			content:     "*_custom.go\nv?/\n",
+			ignored:     []string{"user_custom.go", "internal/user_custom.go", "v2/client.go"},
+			included:    []string{"user_custom_test.go", "v10/client.go"},
+		},
+		{
+			description: "double star",
+			content:     "**/fixtures\ninternal/**/custom.go\nexamples/**\n",
+			ignored: []string{
+				"fixtures/user.go",
+				"cmd/fixtures/user.go",
+				"internal/custom.go",
+				"internal/core/custom.go",
+				"internal/core/nested/custom.go",
+				"examples/main.go",
+			},
+			included: []string{"internal/core.go", "examples.go", "core/custom.go"},
+		},
+		{
+			description: "character class",
+			content:     "file[0-9].go\nother[!a].go\n",
+			ignored:     []string{"file1.go", "otherb.go"},
+			included:    []string{"filea.go", "othera.go"},
+		},
+		{
+			description: "negation",
+			content:     "*.md\n!CHANGELOG.md\n",
+			ignored:     []string{"README.md", "docs/guide.md"},
+			included:    []string{"CHANGELOG.md", "docs/CHANGELOG.md", "types.go"},
+		},
+		{
+			description: "negation in ignored directory",
+			content:     "docs/\n!docs/README.md\n",
+			ignored:     []string{"docs/README.md"},
+		},
+		{
+			description: "escaped",
+			content:     "\\#notes.md\n\\!important.go\ntrailing.go   \n",
+			ignored:     []string{"#notes.md", "!important.go", "trailing.go"},
+			included:    []string{"notes.md", "important.go"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			matcher, err := Parse([]byte(test.content))
+			require.NoError(t, err)
+			for _, filename := range test.ignored {
+				assert.True(t, matcher.Match(filename), "expected %q to be ignored", filename)
+			}
+			for _, filename := range test.included {
+				assert.False(t, matcher.Match(filename), "expected %q to be included", filename)
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	_, err := Parse([]byte("README.md\nfile[0-9.go\n"))
+	assert.EqualError(t, err, `invalid .fernignore pattern on line 2: unterminated character class in "file[0-9.go"`)
+}
+
+func TestRead(t *testing.T) {
+	t.Run("missing", func(t *testing.T) {
+		matcher, err := Read(t.TempDir())
+		require.NoError(t, err)
+		assert.False(t, matcher.Match("README.md"))
+	})
+	t.Run("present", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, os.WriteFile(filepath.Join(dir, Filename), []byte("README.md\n"), 0644))
+		matcher, err := Read(dir)
+		require.NoError(t, err)
+		assert.True(t, matcher.Match("README.md"))
+		assert.False(t, matcher.Match("types.go"))
+	})
+	t.Run("nil", func(t *testing.T) {
+		var matcher *Matcher
+		assert.False(t, matcher.Match("README.md"))
+	})
+}
@@ -0,0 +1,275 @@
+package generator
+
+import (
+	"fmt"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"github.com/fern-api/fern-go/ir"
+)
+
+// This file generates the XBuilder type for every object and in-lined request type
+// (see enableBuilders), which sets each field with a fluent WithX method, e.g.
+//
+//	request, err := acme.NewCreateUserRequestBuilder().
+//	  WithName("George").
+//	  WithNickname("Georgie").
+//	  WithPet(acme.NewPetFromDog(&acme.Dog{Name: "Fido"})).
+//	  Build()
+//
+// Optional values are set by value, so the pointer helpers (e.g. acme.String) aren't
+// required. Optional values represented with a *core.Optional[T] can also be set to
+// an explicit null with a WithXNull method. Literals are always set by Build, so they
+// don't have a WithX method.
+//
+// Build returns an error if any of the required properties (i.e. every property that
+// isn't optional, a literal, or unknown) haven't been set.
+
+// builderField is a single field set with a WithX method.
+type builderField struct {
+	field     string
+	wireValue string
+	paramName string
+	paramType string
+
+	// value formats the value assigned to the field (e.g. "&%s").
+	value string
+
+	// null is the value assigned to the field by the WithXNull method, if any.
+	null string
+
+	required bool
+}
+
+// builderLiteral is a field that's always set to a literal value by Build.
+type builderLiteral struct {
+	field string
+	value string
+}
+
+// newBuilderLiterals returns the *builderLiteral for each of the given literals,
+// which are held in unexported fields.
+func newBuilderLiterals(literals []*literal) []*builderLiteral {
+	builderLiterals := make([]*builderLiteral, 0, len(literals))
+	for _, literal := range literals {
+		builderLiterals = append(builderLiterals, &builderLiteral{field: literal.Name.CamelCase.SafeName, value: literalToValue(literal.Value)})
+	}
+	return builderLiterals
+}
+
+// newBuilderField returns the *builderField for a field of the given type.
+func (f *fileWriter) newBuilderField(name *ir.NameAndWireValue, typeReference *ir.TypeReference, importPath string, includeOptionals bool) *builderField {
+	builderField := &builderField{
+		field:     name.Name.PascalCase.UnsafeName,
+		wireValue: name.WireValue,
+		paramName: f.builderParamName(name.Name),
+		paramType: typeReferenceToGoType(typeReference, f.types, f.scope, f.baseImportPath, importPath, includeOptionals, f.encoding),
+		value:     "%s",
+		required:  isRequired(typeReference),
+	}
+	if typeReference.Container == nil || typeReference.Container.Optional == nil {
+		return builderField
+	}
+	goType := builderField.paramType
+	valueType := typeReferenceToGoType(typeReference.Container.Optional, f.types, f.scope, f.baseImportPath, importPath, includeOptionals, f.encoding)
+	if includeOptionals {
+		// Objects and unions are held by value in the core.Optional[T].
+		optionalType := strings.TrimPrefix(goType, "*")
+		builderField.paramType = strings.TrimSuffix(strings.TrimPrefix(optionalType, "core.Optional["), "]")
+		builderField.value = "&" + optionalType + "{Value: %s}"
+		builderField.null = "&" + optionalType + "{Null: true}"
+		return builderField
+	}
+	if strings.HasPrefix(goType, "*") && !strings.HasPrefix(valueType, "*") {
+		builderField.paramType = valueType
+		builderField.value = "&%s"
+	}
+	return builderField
+}
+
+// builderParamName returns the parameter name used by the WithX method of the given
+// field, which can't shadow the builder's receiver or any of the imported packages.
+func (f *fileWriter) builderParamName(name *ir.Name) string {
+	paramName := name.CamelCase.SafeName
+	if len(paramName) == 1 || token.IsKeyword(paramName) || types.Universe.Lookup(paramName) != nil {
+		// A single letter could shadow the receiver, keywords aren't valid identifiers,
+		// and a predeclared identifier (e.g. string) could shadow the type of the value.
+		paramName = "value"
+	}
+	return f.scope.Child().Add(paramName)
+}
+
+// writeObjectBuilder writes the XBuilder type for the given object.
+func (t *typeVisitor) writeObjectBuilder(object *ir.ObjectTypeDeclaration) {
+	properties, literals := t.flattenObjectProperties(object)
+	builderFields := make([]*builderField, 0, len(properties))
+	for _, property := range properties {
+		builderFields = append(builderFields, t.writer.newBuilderField(property.Name, property.ValueType, t.importPath, t.enableOptionalTypes))
+	}
+	t.writer.writeBuilder(t.typeName, builderFields, newBuilderLiterals(literals))
+}
+
+// WriteRequestBuilder writes the XBuilder type for the in-lined request type of the
+// given endpoint (see WriteRequestType).
+func (f *fileWriter) WriteRequestBuilder(fernFilepath *ir.FernFilepath, endpoint *ir.HttpEndpoint, includeGenericOptionals bool) error {
+	var (
+		typeName   = endpoint.SdkRequest.Shape.Wrapper.WrapperName.PascalCase.UnsafeName
+		importPath = fernFilepathToImportPath(f.baseImportPath, fernFilepath)
+	)
+
+	var (
+		builderFields []*builderField
+		literals      []*literal
+	)
+	for _, header := range endpoint.Headers {
+		if header.ValueType.Container != nil && header.ValueType.Container.Literal != nil {
+			literals = append(literals, &literal{Name: header.Name.Name, Value: header.ValueType.Container.Literal})
+			continue
+		}
+		builderFields = append(builderFields, f.newBuilderField(header.Name, header.ValueType, importPath, false))
+	}
+	for _, queryParam := range endpoint.QueryParameters {
+		if queryParam.ValueType.Container != nil && queryParam.ValueType.Container.Literal != nil {
+			literals = append(literals, &literal{Name: queryParam.Name.Name, Value: queryParam.ValueType.Container.Literal})
+			continue
+		}
+		builderField := f.newBuilderField(queryParam.Name, queryParam.ValueType, importPath, false)
+		if queryParam.AllowMultiple {
+			builderField.paramType = fmt.Sprintf("[]%s", typeReferenceToGoType(queryParam.ValueType, f.types, f.scope, f.baseImportPath, importPath, false, f.encoding))
+			builderField.value = "%s"
+			builderField.required = false
+		}
+		builderFields = append(builderFields, builderField)
+	}
+
+	var (
+		bodyObject  *ir.ObjectTypeDeclaration
+		bodyLiteral *builderLiteral
+	)
+	if requestBody := endpoint.RequestBody; requestBody != nil {
+		switch {
+		case requestBody.InlinedRequestBody != nil:
+			bodyObject = inlinedRequestBodyToObjectTypeDeclaration(requestBody.InlinedRequestBody)
+		case requestBody.FileUpload != nil:
+			var bodyProperties []*ir.InlinedRequestBodyProperty
+			for _, property := range requestBody.FileUpload.Properties {
+				if bodyProperty := property.BodyProperty; bodyProperty != nil {
+					bodyProperties = append(bodyProperties, bodyProperty)
+				}
+			}
+			bodyObject = inlinedRequestBodyPropertiesToObjectTypeDeclaration(bodyProperties)
+		case requestBody.Reference != nil:
+			// The referenced type is held in a field that matches the configured body key.
+			bodyKey := endpoint.SdkRequest.Shape.Wrapper.BodyKey
+			if requestBodyType := requestBody.Reference.RequestBodyType; requestBodyType.Container != nil && requestBodyType.Container.Literal != nil {
+				bodyLiteral = &builderLiteral{field: bodyKey.PascalCase.UnsafeName, value: literalToValue(requestBodyType.Container.Literal)}
+				break
+			}
+			builderFields = append(builderFields, f.newBuilderField(&ir.NameAndWireValue{Name: bodyKey, WireValue: bodyKey.OriginalName}, requestBody.Reference.RequestBodyType, importPath, false))
+		}
+	}
+	if bodyObject != nil {
+		typeVisitor := &typeVisitor{
+			typeName:       typeName,
+			baseImportPath: f.baseImportPath,
+			importPath:     importPath,
+			writer:         f,
+		}
+		properties, bodyLiterals := typeVisitor.flattenObjectProperties(bodyObject)
+		for _, property := range properties {
+			builderFields = append(builderFields, f.newBuilderField(property.Name, property.ValueType, importPath, includeGenericOptionals))
+		}
+		literals = append(literals, bodyLiterals...)
+	}
+
+	builderLiterals := newBuilderLiterals(literals)
+	if bodyLiteral != nil {
+		builderLiterals = append(builderLiterals, bodyLiteral)
+	}
+	f.writeBuilder(typeName, builderFields, builderLiterals)
+	return nil
+}
+
+// writeBuilder writes the XBuilder type for typeName, along with its constructor, the
+// WithX method for each of the given fields, and the Build method.
+func (f *fileWriter) writeBuilder(typeName string, builderFields []*builderField, builderLiterals []*builderLiteral) {
+	var (
+		builderName = typeName + "Builder"
+		receiver    = typeNameToReceiver(builderName)
+	)
+	f.P("// ", builderName, " builds the *", typeName, " returned by Build.")
+	f.P("type ", builderName, " struct {")
+	f.P("value ", typeName)
+	var hasRequired bool
+	for _, builderField := range builderFields {
+		if builderField.required {
+			if !hasRequired {
+				f.P()
+				hasRequired = true
+			}
+			f.P("has", builderField.field, " bool")
+		}
+	}
+	f.P("}")
+	f.P()
+
+	f.P("// New", builderName, " returns a new *", builderName, ".")
+	f.P("func New", builderName, "() *", builderName, " {")
+	f.P("return &", builderName, "{}")
+	f.P("}")
+	f.P()
+
+	methods := make(map[string]struct{}, len(builderFields))
+	for _, builderField := range builderFields {
+		methods["With"+builderField.field] = struct{}{}
+	}
+	for _, builderField := range builderFields {
+		field := receiver + ".value." + builderField.field
+		f.P("// With", builderField.field, " sets the ", builderField.field, " field.")
+		f.P("func (", receiver, " *", builderName, ") With", builderField.field, "(", builderField.paramName, " ", builderField.paramType, ") *", builderName, " {")
+		f.P(field, " = ", fmt.Sprintf(builderField.value, builderField.paramName))
+		if builderField.required {
+			f.P(receiver, ".has", builderField.field, " = true")
+		}
+		f.P("return ", receiver)
+		f.P("}")
+		f.P()
+
+		nullMethod := "With" + builderField.field + "Null"
+		if _, ok := methods[nullMethod]; ok || builderField.null == "" {
+			continue
+		}
+		f.P("// ", nullMethod, " sets the ", builderField.field, " field to an explicit null.")
+		f.P("func (", receiver, " *", builderName, ") ", nullMethod, "() *", builderName, " {")
+		f.P(field, " = ", builderField.null)
+		f.P("return ", receiver)
+		f.P("}")
+		f.P()
+	}
+
+	f.P("// Build returns the *", typeName, ", or an error if any of its required")
+	f.P("// properties aren't set.")
+	f.P("func (", receiver, " *", builderName, ") Build() (*", typeName, ", error) {")
+	if hasRequired {
+		f.P("var missing []string")
+		for _, builderField := range builderFields {
+			if !builderField.required {
+				continue
+			}
+			f.P("if !", receiver, ".has", builderField.field, " {")
+			f.P("missing = append(missing, ", fmt.Sprintf("%q", builderField.wireValue), ")")
+			f.P("}")
+		}
+		f.P("if len(missing) > 0 {")
+		f.P(`return nil, fmt.Errorf("%T is missing required properties %q", `, receiver, ".value, missing)")
+		f.P("}")
+	}
+	f.P("value := ", receiver, ".value")
+	for _, builderLiteral := range builderLiterals {
+		f.P("value.", builderLiteral.field, " = ", builderLiteral.value)
+	}
+	f.P("return &value, nil")
+	f.P("}")
+	f.P()
+}
@@ -0,0 +1,402 @@
+package generator
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/fern-api/fern-go/ir"
+)
+
+// This file generates deep Clone and Equal methods for objects and unions (see
+// enableCloneAndEqual).
+//
+// Clone starts with a shallow copy of the value, and then replaces every field
+// that would otherwise be shared with the original (e.g. pointers, slices, maps,
+// and nested objects) with a copy of its own. Equal compares every field by value,
+// so datetimes are compared with time.Time.Equal, and nested objects and unions
+// are compared with their own Equal method. Nil and empty slices and maps are
+// considered equal.
+//
+// The raw JSON that a value was deserialized from is never modified, so it's shared
+// between a value and its clone, and it's ignored by Equal. Unknown values are held
+// in an interface{}, so they're also shared and compared with reflect.DeepEqual.
+
+// cloneKind determines how a value is copied and compared.
+type cloneKind uint
+
+const (
+	// cloneKindValue is copied by assignment and compared with ==
+	// (e.g. strings, numbers, enums, and UUIDs).
+	cloneKindValue cloneKind = iota
+	// cloneKindTime is copied by assignment and compared with time.Time.Equal.
+	cloneKindTime
+	// cloneKindUnknown is copied by assignment and compared with reflect.DeepEqual.
+	cloneKindUnknown
+	// cloneKindBytes is a []byte (e.g. a base64 value).
+	cloneKindBytes
+	// cloneKindModel implements its own Clone and Equal methods
+	// (i.e. objects, unions, and core.Set).
+	cloneKindModel
+	// cloneKindList is a slice of the element.
+	cloneKindList
+	// cloneKindMap is a map from a comparable key to the element.
+	cloneKindMap
+	// cloneKindPointer is a pointer to the element (e.g. an optional string).
+	cloneKindPointer
+	// cloneKindOptional is a *core.Optional[T] of the element.
+	cloneKindOptional
+)
+
+// cloneShape describes how to copy and compare a value of the given Go type.
+type cloneShape struct {
+	kind   cloneKind
+	goType string
+	elem   *cloneShape
+
+	// embedsTime is set for the core.UnixSeconds and core.UnixMillis
+	// types, which embed a time.Time.
+	embedsTime bool
+
+	// isValue is set for objects and sets that are held by value rather
+	// than by pointer (i.e. the value of a *core.Optional[T]).
+	isValue bool
+}
+
+// isShallow returns true if the value can be copied by assignment.
+func (c *cloneShape) isShallow() bool {
+	switch c.kind {
+	case cloneKindValue, cloneKindTime, cloneKindUnknown:
+		return true
+	}
+	return false
+}
+
+// cloneField is a single field copied by Clone and compared by Equal.
+type cloneField struct {
+	name  string
+	shape *cloneShape
+}
+
+// writeObjectCloneAndEqual writes the Clone and Equal methods for an object.
+func (t *typeVisitor) writeObjectCloneAndEqual(object *ir.ObjectTypeDeclaration) {
+	properties, _ := t.flattenObjectProperties(object)
+	fields := make([]*cloneField, 0, len(properties))
+	for _, property := range properties {
+		fields = append(
+			fields,
+			&cloneField{
+				name:  property.Name.Name.PascalCase.UnsafeName,
+				shape: t.cloneShapeForTypeReference(property.ValueType, t.enableOptionalTypes),
+			},
+		)
+	}
+	t.writeCloneAndEqual(fields)
+}
+
+// writeUnionCloneAndEqual writes the Clone and Equal methods for a discriminated union.
+// Literals are never compared because they're constant for each variant.
+func (t *typeVisitor) writeUnionCloneAndEqual(union *ir.UnionTypeDeclaration, unknownName string) {
+	fields := []*cloneField{
+		{
+			name:  union.Discriminant.Name.PascalCase.UnsafeName,
+			shape: &cloneShape{kind: cloneKindValue, goType: "string"},
+		},
+	}
+	for _, extend := range union.Extends {
+		properties, _ := t.flattenObjectProperties(t.writer.types[extend.TypeId].Shape.Object)
+		for _, property := range properties {
+			fields = append(fields, t.cloneFieldForProperty(property.Name.Name.PascalCase.UnsafeName, property.ValueType))
+		}
+	}
+	for _, property := range union.BaseProperties {
+		if property.ValueType.Container != nil && property.ValueType.Container.Literal != nil {
+			continue
+		}
+		fields = append(fields, t.cloneFieldForProperty(property.Name.Name.PascalCase.UnsafeName, property.ValueType))
+	}
+	for _, unionType := range union.Types {
+		fieldName := unionType.DiscriminantValue.Name.PascalCase.UnsafeName
+		switch unionType.Shape.PropertiesType {
+		case "samePropertiesAsObject":
+			fields = append(fields, &cloneField{name: fieldName, shape: &cloneShape{kind: cloneKindModel}})
+		case "singleProperty":
+			if unionType.Shape.SingleProperty.Type.Container != nil && unionType.Shape.SingleProperty.Type.Container.Literal != nil {
+				continue
+			}
+			fields = append(fields, t.cloneFieldForProperty(fieldName, unionType.Shape.SingleProperty.Type))
+		}
+	}
+	if unknownName != "" {
+		fields = append(fields, &cloneField{name: unknownName, shape: &cloneShape{kind: cloneKindBytes, goType: "json.RawMessage"}})
+	}
+	t.writeCloneAndEqual(fields)
+}
+
+// writeUndiscriminatedUnionCloneAndEqual writes the Clone and Equal methods for an
+// undiscriminated union.
+func (t *typeVisitor) writeUndiscriminatedUnionCloneAndEqual(union *ir.UndiscriminatedUnionTypeDeclaration) {
+	fields := []*cloneField{
+		{
+			name:  "typeName",
+			shape: &cloneShape{kind: cloneKindValue, goType: "string"},
+		},
+	}
+	for _, member := range union.Members {
+		fields = append(fields, t.cloneFieldForProperty(typeReferenceToUndiscriminatedUnionField(member.Type, t.writer.types), member.Type))
+	}
+	t.writeCloneAndEqual(fields)
+}
+
+// writeAliasCloneAndEqual writes the Clone<Alias> and Equal<Alias> functions for an alias.
+//
+// Aliases are generated as Go type aliases, so they can't declare their own methods. Aliases
+// of objects and unions already share the methods of the type they refer to, and aliases of
+// primitives and enums can be copied by assignment, so functions are only generated for
+// aliases of containers.
+func (t *typeVisitor) writeAliasCloneAndEqual(alias *ir.AliasTypeDeclaration) {
+	shape := t.cloneShapeForTypeReference(alias.AliasOf, false)
+	switch shape.kind {
+	case cloneKindBytes, cloneKindList, cloneKindMap, cloneKindPointer:
+	default:
+		return
+	}
+	param := typeNameToReceiver(t.typeName)
+	t.writer.P("// Clone", t.typeName, " returns a deep copy of the given ", t.typeName, ".")
+	t.writer.P("func Clone", t.typeName, "(", param, " ", t.typeName, ") ", t.typeName, " {")
+	t.writer.P("var clone ", t.typeName)
+	t.writeCloneValue("clone", param, shape, 0)
+	t.writer.P("return clone")
+	t.writer.P("}")
+	t.writer.P()
+
+	t.writer.P("// Equal", t.typeName, " reports whether the given ", t.typeName, " values are equal.")
+	t.writer.P("func Equal", t.typeName, "(a, b ", t.typeName, ") bool {")
+	t.writeEqualValue("a", "b", shape, 0)
+	t.writer.P("return true")
+	t.writer.P("}")
+	t.writer.P()
+}
+
+// writeCloneAndEqual writes the Clone and Equal methods for a struct with the given fields.
+func (t *typeVisitor) writeCloneAndEqual(fields []*cloneField) {
+	receiver := typeNameToReceiver(t.typeName)
+
+	t.writer.P("// Clone returns a deep copy of the ", t.typeName, ".")
+	t.writer.P("func (", receiver, " *", t.typeName, ") Clone() *", t.typeName, " {")
+	t.writer.P("if ", receiver, " == nil {")
+	t.writer.P("return nil")
+	t.writer.P("}")
+	t.writer.P("clone := *", receiver)
+	for _, field := range fields {
+		if field.shape.isShallow() {
+			continue
+		}
+		t.writeCloneValue("clone."+field.name, receiver+"."+field.name, field.shape, 0)
+	}
+	t.writer.P("return &clone")
+	t.writer.P("}")
+	t.writer.P()
+
+	t.writer.P("// Equal reports whether the ", t.typeName, " is equal to the other ", t.typeName, ".")
+	t.writer.P("func (", receiver, " *", t.typeName, ") Equal(other *", t.typeName, ") bool {")
+	t.writer.P("if ", receiver, " == nil || other == nil {")
+	t.writer.P("return ", receiver, " == other")
+	t.writer.P("}")
+	for _, field := range fields {
+		t.writeEqualValue(receiver+"."+field.name, "other."+field.name, field.shape, 0)
+	}
+	t.writer.P("return true")
+	t.writer.P("}")
+	t.writer.P()
+}
+
+// writeCloneValue writes the statements that assign a deep copy of src to dst.
+func (t *typeVisitor) writeCloneValue(dst string, src string, shape *cloneShape, depth int) {
+	index, key, value := cloneVariable("index", depth), cloneVariable("key", depth), cloneVariable("value", depth)
+	switch shape.kind {
+	case cloneKindValue, cloneKindTime, cloneKindUnknown:
+		t.writer.P(dst, " = ", src)
+	case cloneKindModel:
+		if shape.isValue {
+			t.writer.P(dst, " = *", parenthesize(src), ".Clone()")
+			break
+		}
+		t.writer.P(dst, " = ", parenthesize(src), ".Clone()")
+	case cloneKindBytes:
+		t.writer.P("if ", src, " != nil {")
+		t.writer.P(dst, " = make(", shape.goType, ", len(", src, "))")
+		t.writer.P("copy(", dst, ", ", src, ")")
+		t.writer.P("}")
+	case cloneKindList:
+		t.writer.P("if ", src, " != nil {")
+		t.writer.P(dst, " = make(", shape.goType, ", len(", src, "))")
+		if shape.elem.isShallow() {
+			t.writer.P("copy(", dst, ", ", src, ")")
+		} else {
+			t.writer.P("for ", index, ", ", value, " := range ", src, " {")
+			t.writeCloneValue(dst+"["+index+"]", value, shape.elem, depth+1)
+			t.writer.P("}")
+		}
+		t.writer.P("}")
+	case cloneKindMap:
+		t.writer.P("if ", src, " != nil {")
+		t.writer.P(dst, " = make(", shape.goType, ", len(", src, "))")
+		t.writer.P("for ", key, ", ", value, " := range ", src, " {")
+		t.writeCloneValue(dst+"["+key+"]", value, shape.elem, depth+1)
+		t.writer.P("}")
+		t.writer.P("}")
+	case cloneKindPointer:
+		t.writer.P("if ", src, " != nil {")
+		t.writer.P(value, " := *", src)
+		if !shape.elem.isShallow() {
+			t.writeCloneValue(value, "*"+src, shape.elem, depth+1)
+		}
+		t.writer.P(dst, " = &", value)
+		t.writer.P("}")
+	case cloneKindOptional:
+		t.writer.P("if ", src, " != nil {")
+		t.writer.P(value, " := *", src)
+		if !shape.elem.isShallow() {
+			t.writeCloneValue(value+".Value", parenthesize(src)+".Value", shape.elem, depth+1)
+		}
+		t.writer.P(dst, " = &", value)
+		t.writer.P("}")
+	}
+}
+
+// writeEqualValue writes the statements that return false if a and b aren't equal.
+func (t *typeVisitor) writeEqualValue(a string, b string, shape *cloneShape, depth int) {
+	index, key, value, otherValue := cloneVariable("index", depth), cloneVariable("key", depth), cloneVariable("value", depth), cloneVariable("otherValue", depth)
+	switch shape.kind {
+	case cloneKindValue:
+		t.writer.P("if ", a, " != ", b, " {")
+	case cloneKindTime:
+		if shape.embedsTime {
+			b = parenthesize(b) + ".Time"
+		}
+		t.writer.P("if !", parenthesize(a), ".Equal(", b, ") {")
+	case cloneKindUnknown:
+		t.writer.P("if !", t.writer.scope.AddImport("reflect"), ".DeepEqual(", a, ", ", b, ") {")
+	case cloneKindBytes:
+		t.writer.P("if !bytes.Equal(", a, ", ", b, ") {")
+	case cloneKindModel:
+		if shape.isValue {
+			b = "&" + b
+		}
+		t.writer.P("if !", parenthesize(a), ".Equal(", b, ") {")
+	case cloneKindList:
+		t.writer.P("if len(", a, ") != len(", b, ") {")
+		t.writer.P("return false")
+		t.writer.P("}")
+		t.writer.P("for ", index, " := range ", a, " {")
+		t.writeEqualValue(parenthesize(a)+"["+index+"]", parenthesize(b)+"["+index+"]", shape.elem, depth+1)
+		t.writer.P("}")
+		return
+	case cloneKindMap:
+		t.writer.P("if len(", a, ") != len(", b, ") {")
+		t.writer.P("return false")
+		t.writer.P("}")
+		t.writer.P("for ", key, ", ", value, " := range ", a, " {")
+		t.writer.P(otherValue, ", ok := ", parenthesize(b), "[", key, "]")
+		t.writer.P("if !ok {")
+		t.writer.P("return false")
+		t.writer.P("}")
+		t.writeEqualValue(value, otherValue, shape.elem, depth+1)
+		t.writer.P("}")
+		return
+	case cloneKindPointer, cloneKindOptional:
+		t.writer.P("if (", a, " == nil) != (", b, " == nil) {")
+		t.writer.P("return false")
+		t.writer.P("}")
+		t.writer.P("if ", a, " != nil {")
+		if shape.kind == cloneKindPointer {
+			t.writeEqualValue("*"+a, "*"+b, shape.elem, depth+1)
+		} else {
+			t.writer.P("if ", parenthesize(a), ".Null != ", parenthesize(b), ".Null {")
+			t.writer.P("return false")
+			t.writer.P("}")
+			t.writeEqualValue(parenthesize(a)+".Value", parenthesize(b)+".Value", shape.elem, depth+1)
+		}
+		t.writer.P("}")
+		return
+	}
+	t.writer.P("return false")
+	t.writer.P("}")
+}
+
+// cloneFieldForProperty returns the *cloneField for a field that never uses *core.Optional[T].
+func (t *typeVisitor) cloneFieldForProperty(name string, typeReference *ir.TypeReference) *cloneField {
+	return &cloneField{
+		name:  name,
+		shape: t.cloneShapeForTypeReference(typeReference, false),
+	}
+}
+
+// cloneShapeForTypeReference returns the *cloneShape for the given type reference, which
+// mirrors the Go type produced by typeReferenceToGoType.
+func (t *typeVisitor) cloneShapeForTypeReference(typeReference *ir.TypeReference, includeOptionals bool) *cloneShape {
+	goType := typeReferenceToGoType(typeReference, t.writer.types, t.writer.scope, t.baseImportPath, t.importPath, includeOptionals, t.writer.encoding)
+	switch {
+	case typeReference.Container != nil:
+		container := typeReference.Container
+		switch {
+		case container.List != nil:
+			return &cloneShape{kind: cloneKindList, goType: goType, elem: t.cloneShapeForTypeReference(container.List, false)}
+		case container.Map != nil:
+			return &cloneShape{kind: cloneKindMap, goType: goType, elem: t.cloneShapeForTypeReference(container.Map.ValueType, false)}
+		case container.Set != nil:
+			if isSetType(typeReference, t.writer.types, t.writer.encoding) {
+				return &cloneShape{kind: cloneKindModel, goType: goType}
+			}
+			return &cloneShape{kind: cloneKindList, goType: goType, elem: t.cloneShapeForTypeReference(container.Set, false)}
+		case container.Optional != nil:
+			elem := t.cloneShapeForTypeReference(container.Optional, includeOptionals)
+			if includeOptionals {
+				if elem.kind == cloneKindModel && strings.HasPrefix(elem.goType, "*") {
+					// The pointer is trimmed from the core.Optional[T] value.
+					elem = &cloneShape{kind: cloneKindModel, goType: strings.TrimLeft(elem.goType, "*"), isValue: true}
+				}
+				return &cloneShape{kind: cloneKindOptional, goType: goType, elem: elem}
+			}
+			if !strings.HasPrefix(goType, "*") || strings.HasPrefix(elem.goType, "*") {
+				// The optional doesn't add a pointer (see containerTypeVisitor.VisitOptional).
+				return elem
+			}
+			return &cloneShape{kind: cloneKindPointer, goType: goType, elem: elem}
+		}
+		return &cloneShape{kind: cloneKindValue, goType: goType}
+	case typeReference.Named != nil:
+		typeDeclaration := t.writer.types[typeReference.Named.TypeId]
+		switch typeDeclaration.Shape.Type {
+		case "alias":
+			shape := *t.cloneShapeForTypeReference(typeDeclaration.Shape.Alias.AliasOf, false)
+			shape.goType = goType
+			return &shape
+		case "enum":
+			return &cloneShape{kind: cloneKindValue, goType: goType}
+		}
+		return &cloneShape{kind: cloneKindModel, goType: goType}
+	case typeReference.Primitive != "":
+		switch primitiveToGoType(typeReference.Primitive, t.writer.encoding) {
+		case "time.Time":
+			return &cloneShape{kind: cloneKindTime, goType: goType}
+		case "core.UnixSeconds", "core.UnixMillis":
+			return &cloneShape{kind: cloneKindTime, goType: goType, embedsTime: true}
+		case "[]byte":
+			return &cloneShape{kind: cloneKindBytes, goType: goType}
+		case "interface{}":
+			return &cloneShape{kind: cloneKindUnknown, goType: goType}
+		}
+		return &cloneShape{kind: cloneKindValue, goType: goType}
+	}
+	return &cloneShape{kind: cloneKindUnknown, goType: goType}
+}
+
+// cloneVariable returns the name of a variable declared at the given depth,
+// so that nested loops don't shadow each other.
+func cloneVariable(name string, depth int) string {
+	if depth == 0 {
+		return name
+	}
+	return name + strconv.Itoa(depth)
+}
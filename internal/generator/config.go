@@ -1,17 +1,39 @@
 package generator
 
+import (
+	"errors"
+	"fmt"
+
+	"github.com/fern-api/fern-go/ir"
+)
+
 // Config represents the Fern generator configuration.
 type Config struct {
-	DryRun             bool
-	EnableExplicitNull bool
-	IncludeReadme      bool
-	Organization       string
-	Version            string
-	IRFilepath         string
-	ImportPath         string
+	DryRun                            bool
+	EnableExplicitNull                bool
+	EnableForwardCompatibility        bool
+	EnableFastJSON                    bool
+	EnableOptionalTypes               bool
+	EnableStrictUndiscriminatedUnions bool
+	EnableCloneAndEqual               bool
+	EnableDatabaseSQL                 bool
+	EnableJSONSchema                  bool
+	EnablePatchTypes                  bool
+	EnableBuilders                    bool
+	IncludeReadme                     bool
+	Organization                      string
+	Version                           string
+	IRFilepath                        string
+	ImportPath                        string
 
 	// If not specified, a go.mod and go.sum will not be generated.
 	ModuleConfig *ModuleConfig
+
+	// If not specified, every primitive uses its default encoding.
+	EncodingConfig *EncodingConfig
+
+	// StructTags are generated alongside the JSON tag of every property.
+	StructTags []*StructTag
 }
 
 // ModuleConfig represents the configuration used to generate
@@ -30,25 +52,273 @@ type ModuleConfig struct {
 	Imports map[string]string
 }
 
-// NewConfig returns a new *Config for the given values.
-func NewConfig(
-	dryRun bool,
-	enableExplicitNull bool,
-	includeReadme bool,
-	organization string,
-	version string,
-	irFilepath string,
-	importPath string,
-	moduleConfig *ModuleConfig,
-) (*Config, error) {
-	return &Config{
-		DryRun:             dryRun,
-		EnableExplicitNull: enableExplicitNull,
-		IncludeReadme:      includeReadme,
-		Organization:       organization,
-		Version:            version,
-		IRFilepath:         irFilepath,
-		ImportPath:         importPath,
-		ModuleConfig:       moduleConfig,
-	}, nil
+// StructTag is an additional struct tag (e.g. yaml) generated for every
+// property of objects, unions, and request types.
+type StructTag struct {
+	Key    string
+	Naming StructTagNaming
+}
+
+// name returns the name used for the given property in this tag.
+func (s *StructTag) name(name *ir.NameAndWireValue) string {
+	switch s.Naming {
+	case StructTagNamingOriginal:
+		return name.Name.OriginalName
+	case StructTagNamingSnakeCase:
+		return name.Name.SnakeCase.UnsafeName
+	case StructTagNamingCamelCase:
+		return name.Name.CamelCase.UnsafeName
+	}
+	return name.WireValue
+}
+
+// StructTagNaming is the naming strategy used for the property names
+// in a struct tag.
+type StructTagNaming string
+
+const (
+	// StructTagNamingWire uses the property's wire value, i.e. the same
+	// name used in its JSON tag.
+	StructTagNamingWire StructTagNaming = "wire"
+
+	// StructTagNamingOriginal uses the property's name as it's written
+	// in the API definition.
+	StructTagNamingOriginal StructTagNaming = "original"
+
+	// StructTagNamingSnakeCase uses the snake_case property name.
+	StructTagNamingSnakeCase StructTagNaming = "snake_case"
+
+	// StructTagNamingCamelCase uses the camelCase property name.
+	StructTagNamingCamelCase StructTagNaming = "camelCase"
+)
+
+// EncodingConfig represents the configuration used to select the JSON
+// encoding of primitives, containers, and unions that are commonly
+// represented in more than one way.
+//
+// The zero value of each field uses the type's default encoding.
+type EncodingConfig struct {
+	Long     LongEncoding
+	Double   DoubleEncoding
+	DateTime DateTimeEncoding
+	Date     DateEncoding
+	Set      SetEncoding
+	Union    UnionEncoding
+}
+
+// requiresCoreTypes returns true if the encoding uses any of
+// the wrapper types generated in the core package.
+func (e *EncodingConfig) requiresCoreTypes() bool {
+	if e == nil {
+		return false
+	}
+	return e.Long == LongEncodingString ||
+		e.DateTime == DateTimeEncodingUnixSeconds ||
+		e.DateTime == DateTimeEncodingUnixMillis
+}
+
+// usesDateType returns true if date primitives are
+// represented with core.Date rather than time.Time.
+func (e *EncodingConfig) usesDateType() bool {
+	return e != nil && e.Date == DateEncodingDate
+}
+
+// usesSetType returns true if sets of comparable elements
+// are represented with core.Set.
+func (e *EncodingConfig) usesSetType() bool {
+	return e != nil && e.Set == SetEncodingSet
+}
+
+// usesInterfaceUnions returns true if unions are represented
+// with a sealed interface rather than a struct.
+func (e *EncodingConfig) usesInterfaceUnions() bool {
+	return e != nil && e.Union == UnionEncodingInterface
+}
+
+// LongEncoding is the JSON encoding used for long primitives.
+type LongEncoding string
+
+const (
+	// LongEncodingNumber encodes longs as JSON numbers (e.g. 42) with int64.
+	LongEncodingNumber LongEncoding = "number"
+
+	// LongEncodingString encodes longs as JSON strings (e.g. "42") with core.Int64String.
+	LongEncodingString LongEncoding = "string"
+)
+
+// DoubleEncoding is the JSON encoding used for double primitives.
+type DoubleEncoding string
+
+const (
+	// DoubleEncodingNumber decodes doubles with float64.
+	DoubleEncodingNumber DoubleEncoding = "number"
+
+	// DoubleEncodingJSONNumber decodes doubles with json.Number so that
+	// they're never subject to floating point error.
+	DoubleEncodingJSONNumber DoubleEncoding = "jsonNumber"
+)
+
+// DateTimeEncoding is the JSON encoding used for datetime primitives.
+type DateTimeEncoding string
+
+const (
+	// DateTimeEncodingRFC3339 encodes datetimes as RFC 3339 strings with time.Time.
+	DateTimeEncodingRFC3339 DateTimeEncoding = "rfc3339"
+
+	// DateTimeEncodingUnixSeconds encodes datetimes as the number of seconds
+	// since the Unix epoch with core.UnixSeconds.
+	DateTimeEncodingUnixSeconds DateTimeEncoding = "unixSeconds"
+
+	// DateTimeEncodingUnixMillis encodes datetimes as the number of milliseconds
+	// since the Unix epoch with core.UnixMillis.
+	DateTimeEncodingUnixMillis DateTimeEncoding = "unixMillis"
+)
+
+// DateEncoding is the JSON encoding used for date primitives.
+type DateEncoding string
+
+const (
+	// DateEncodingTime represents dates with time.Time, so they're encoded as
+	// RFC 3339 datetimes (e.g. "2023-09-04T00:00:00Z").
+	DateEncodingTime DateEncoding = "time"
+
+	// DateEncodingDate represents dates with core.Date, so they're encoded as
+	// RFC 3339 full-dates (e.g. "2023-09-04").
+	DateEncodingDate DateEncoding = "date"
+)
+
+// SetEncoding is the encoding used for set containers.
+type SetEncoding string
+
+const (
+	// SetEncodingList represents sets with a plain slice, just like lists.
+	SetEncodingList SetEncoding = "list"
+
+	// SetEncodingSet represents sets of comparable elements with core.Set,
+	// which collapses any duplicates. Sets of other elements (e.g. objects)
+	// still use a plain slice.
+	SetEncodingSet SetEncoding = "set"
+)
+
+// UnionEncoding is the encoding used for discriminated and
+// undiscriminated unions.
+type UnionEncoding string
+
+const (
+	// UnionEncodingStruct represents unions with a struct that has a field
+	// for every variant, along with a Visitor interface.
+	UnionEncodingStruct UnionEncoding = "struct"
+
+	// UnionEncodingInterface represents unions with a sealed interface that's
+	// implemented by a separate type for every variant, so that they can be
+	// used in type switches.
+	UnionEncodingInterface UnionEncoding = "interface"
+)
+
+// Validate returns an error if the configuration specifies an unrecognized
+// encoding or struct tag, or a combination of options that isn't supported.
+func (c *Config) Validate() error {
+	if err := c.EncodingConfig.validate(); err != nil {
+		return err
+	}
+	if c.EncodingConfig.usesInterfaceUnions() {
+		for _, option := range []struct {
+			name    string
+			enabled bool
+		}{
+			{name: "enableFastJSON", enabled: c.EnableFastJSON},
+			{name: "enableCloneAndEqual", enabled: c.EnableCloneAndEqual},
+			{name: "enableDatabaseSQL", enabled: c.EnableDatabaseSQL},
+			{name: "enablePatchTypes", enabled: c.EnablePatchTypes},
+		} {
+			if option.enabled {
+				return fmt.Errorf("the %q union encoding is not supported with %s", UnionEncodingInterface, option.name)
+			}
+		}
+	}
+	keys := make(map[string]struct{}, len(c.StructTags))
+	for _, tag := range c.StructTags {
+		if tag == nil || !isValidStructTagKey(tag.Key) {
+			return errors.New("invalid struct tag; every struct tag must specify a key with letters, digits, and underscores")
+		}
+		if tag.Key == "json" {
+			return fmt.Errorf("the %q struct tag is always generated and cannot be configured", tag.Key)
+		}
+		if _, ok := keys[tag.Key]; ok {
+			return fmt.Errorf("the %q struct tag is specified more than once", tag.Key)
+		}
+		keys[tag.Key] = struct{}{}
+		switch tag.Naming {
+		case "", StructTagNamingWire, StructTagNamingOriginal, StructTagNamingSnakeCase, StructTagNamingCamelCase:
+		default:
+			return fmt.Errorf(
+				"unrecognized naming %q for the %q struct tag; expected one of %q, %q, %q, or %q",
+				tag.Naming,
+				tag.Key,
+				StructTagNamingWire,
+				StructTagNamingOriginal,
+				StructTagNamingSnakeCase,
+				StructTagNamingCamelCase,
+			)
+		}
+	}
+	return nil
+}
+
+// validate returns an error if any of the encodings aren't recognized.
+func (e *EncodingConfig) validate() error {
+	if e == nil {
+		return nil
+	}
+	switch e.Long {
+	case "", LongEncodingNumber, LongEncodingString:
+	default:
+		return fmt.Errorf("unrecognized long encoding %q; expected one of %q or %q", e.Long, LongEncodingNumber, LongEncodingString)
+	}
+	switch e.Double {
+	case "", DoubleEncodingNumber, DoubleEncodingJSONNumber:
+	default:
+		return fmt.Errorf("unrecognized double encoding %q; expected one of %q or %q", e.Double, DoubleEncodingNumber, DoubleEncodingJSONNumber)
+	}
+	switch e.DateTime {
+	case "", DateTimeEncodingRFC3339, DateTimeEncodingUnixSeconds, DateTimeEncodingUnixMillis:
+	default:
+		return fmt.Errorf(
+			"unrecognized datetime encoding %q; expected one of %q, %q, or %q",
+			e.DateTime,
+			DateTimeEncodingRFC3339,
+			DateTimeEncodingUnixSeconds,
+			DateTimeEncodingUnixMillis,
+		)
+	}
+	switch e.Date {
+	case "", DateEncodingTime, DateEncodingDate:
+	default:
+		return fmt.Errorf("unrecognized date encoding %q; expected one of %q or %q", e.Date, DateEncodingTime, DateEncodingDate)
+	}
+	switch e.Set {
+	case "", SetEncodingList, SetEncodingSet:
+	default:
+		return fmt.Errorf("unrecognized set encoding %q; expected one of %q or %q", e.Set, SetEncodingList, SetEncodingSet)
+	}
+	switch e.Union {
+	case "", UnionEncodingStruct, UnionEncodingInterface:
+	default:
+		return fmt.Errorf("unrecognized union encoding %q; expected one of %q or %q", e.Union, UnionEncodingStruct, UnionEncodingInterface)
+	}
+	return nil
+}
+
+// isValidStructTagKey returns true if the given key can be used in a
+// struct tag without any quoting (e.g. yaml or bson).
+func isValidStructTagKey(key string) bool {
+	if key == "" {
+		return false
+	}
+	for _, r := range key {
+		if r != '_' && (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') && (r < '0' || r > '9') {
+			return false
+		}
+	}
+	return true
 }
@@ -4,7 +4,7 @@ import (
 	"fmt"
 	"sort"
 
-	fernir "github.com/fern-api/fern-go/internal/fern/ir"
+	fernir "github.com/fern-api/fern-go/ir"
 	"github.com/hmdsefi/gograph"
 	"github.com/hmdsefi/gograph/connectivity"
 )
@@ -0,0 +1,760 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/fern-api/fern-go/ir"
+)
+
+// This file generates the reflection-free JSON marshalers that are used when
+// the enableFastJSON option is set. Every object and union implements
+// MarshalJSONTo and UnmarshalJSONFrom in terms of the core.JSONWriter and
+// core.JSONReader, so nested types are [de]serialized in a single pass
+// without any intermediate allocations. The standard MarshalJSON and
+// UnmarshalJSON methods are thin wrappers around these.
+//
+// The wire format is identical to the one produced by encoding/json for
+// the default (reflection-based) output. Like encoding/json, object keys
+// are matched exactly if possible, and case-insensitively otherwise.
+
+// writeFastJSONEnum writes the json.Marshaler and json.Unmarshaler
+// implementations for an enum.
+func (t *typeVisitor) writeFastJSONEnum() {
+	receiver := typeNameToReceiver(t.typeName)
+	t.writer.P("func (", receiver, " ", t.typeName, ") MarshalJSON() ([]byte, error) {")
+	t.writer.P("writer := core.NewJSONWriter()")
+	t.writer.P("writer.String(string(", receiver, "))")
+	t.writer.P("return writer.Bytes()")
+	t.writer.P("}")
+	t.writer.P()
+
+	t.writer.P("func (", receiver, " *", t.typeName, ") UnmarshalJSON(data []byte) error {")
+	t.writer.P("reader := core.NewJSONReader(data)")
+	t.writer.P("if !reader.IsNull() {")
+	t.writer.P("*", receiver, " = ", t.typeName, "(reader.String())")
+	t.writer.P("}")
+	t.writer.P("return reader.Done()")
+	t.writer.P("}")
+	t.writer.P()
+}
+
+// writeFastJSONObject writes the json.Marshaler and json.Unmarshaler
+// implementations for an object.
+func (t *typeVisitor) writeFastJSONObject(object *ir.ObjectTypeDeclaration) {
+	receiver := typeNameToReceiver(t.typeName)
+	properties, literals := t.flattenObjectProperties(object)
+
+	t.writeFastJSONUnmarshalJSON(receiver, "*")
+	t.writer.P("func (", receiver, " *", t.typeName, ") UnmarshalJSONFrom(reader *core.JSONReader) {")
+	t.writer.P("if reader.IsNull() {")
+	t.writer.P("return")
+	t.writer.P("}")
+	if t.includeRawJSON {
+		t.writer.P("mark := reader.Mark()")
+	}
+	t.writer.P("*", receiver, " = ", t.typeName, "{}")
+	t.writer.P("reader.ObjectStart()")
+	t.writer.P("for reader.More() {")
+	keys := make([]string, 0, len(properties))
+	for _, property := range properties {
+		keys = append(keys, property.Name.WireValue)
+	}
+	t.writeFastJSONKeySwitch("reader", keys, func(i int) {
+		property := properties[i]
+		if t.usesOptionalType(property.ValueType) {
+			t.writeUnmarshalJSONOptional(property.ValueType, receiver+"."+property.Name.Name.PascalCase.UnsafeName)
+			return
+		}
+		t.writeUnmarshalJSONValue("reader", property.ValueType, receiver+"."+property.Name.Name.PascalCase.UnsafeName, 0)
+	})
+	t.writer.P("}")
+	t.writer.P("reader.ObjectEnd()")
+	for _, literal := range literals {
+		t.writer.P(receiver, ".", literal.Name.CamelCase.SafeName, " = ", literalToValue(literal.Value))
+	}
+	if t.includeRawJSON {
+		t.writer.P(receiver, "._rawJSON = json.RawMessage(reader.Since(mark))")
+	}
+	t.writer.P("}")
+	t.writer.P()
+
+	t.writeFastJSONMarshalJSON(receiver, "*")
+	t.writer.P("func (", receiver, " *", t.typeName, ") MarshalJSONTo(writer *core.JSONWriter) {")
+	t.writer.P("if ", receiver, " == nil {")
+	t.writer.P("writer.Null()")
+	t.writer.P("return")
+	t.writer.P("}")
+	t.writer.P("writer.ObjectStart()")
+	for _, property := range properties {
+		if t.usesOptionalType(property.ValueType) {
+			t.writeMarshalJSONOptional(property.Name.WireValue, property.ValueType, receiver+"."+property.Name.Name.PascalCase.UnsafeName)
+			continue
+		}
+		t.writeMarshalJSONProperty(property.Name.WireValue, property.ValueType, receiver+"."+property.Name.Name.PascalCase.UnsafeName)
+	}
+	for _, literal := range literals {
+		t.writer.P("writer.Key(", fmt.Sprintf("%q", literal.Name.OriginalName), ")")
+		t.writeMarshalJSONLiteral(literal.Value)
+	}
+	t.writer.P("writer.ObjectEnd()")
+	t.writer.P("}")
+	t.writer.P()
+}
+
+// writeFastJSONUnion writes the json.Marshaler and json.Unmarshaler
+// implementations for a discriminated union.
+func (t *typeVisitor) writeFastJSONUnion(union *ir.UnionTypeDeclaration, unknownName string) {
+	receiver := typeNameToReceiver(t.typeName)
+	discriminantName := union.Discriminant.Name.PascalCase.UnsafeName
+	var (
+		properties []*ir.ObjectProperty
+		literals   []*literal
+	)
+	for _, extend := range union.Extends {
+		extendedProperties, extendedLiterals := t.flattenObjectProperties(t.writer.types[extend.TypeId].Shape.Object)
+		properties = append(properties, extendedProperties...)
+		literals = append(literals, extendedLiterals...)
+	}
+	for _, property := range union.BaseProperties {
+		if property.ValueType.Container != nil && property.ValueType.Container.Literal != nil {
+			literals = append(literals, &literal{Name: property.Name.Name, Value: property.ValueType.Container.Literal})
+			continue
+		}
+		properties = append(properties, property)
+	}
+
+	// Unions are read in two passes; the first reads the discriminant and
+	// all of the shared properties, and the second reads the variant.
+	t.writeFastJSONUnmarshalJSON(receiver, "*")
+	t.writer.P("func (", receiver, " *", t.typeName, ") UnmarshalJSONFrom(reader *core.JSONReader) {")
+	t.writer.P("if reader.IsNull() {")
+	t.writer.P("return")
+	t.writer.P("}")
+	t.writer.P("data := reader.Raw()")
+	t.writer.P("if reader.Err() != nil {")
+	t.writer.P("return")
+	t.writer.P("}")
+	t.writer.P("unmarshaler := core.NewJSONReader(data)")
+	t.writer.P("unmarshaler.ObjectStart()")
+	t.writer.P("for unmarshaler.More() {")
+	keys := []string{union.Discriminant.WireValue}
+	for _, property := range properties {
+		keys = append(keys, property.Name.WireValue)
+	}
+	t.writeFastJSONKeySwitch("unmarshaler", keys, func(i int) {
+		if i == 0 {
+			t.writer.P(receiver, ".", discriminantName, " = unmarshaler.String()")
+			return
+		}
+		property := properties[i-1]
+		t.writeUnmarshalJSONValue("unmarshaler", property.ValueType, receiver+"."+property.Name.Name.PascalCase.UnsafeName, 0)
+	})
+	t.writer.P("}")
+	t.writer.P("unmarshaler.ObjectEnd()")
+	t.writer.P("reader.SetErr(unmarshaler.Err())")
+	for _, literal := range literals {
+		t.writer.P(receiver, ".", literal.Name.CamelCase.SafeName, " = ", literalToValue(literal.Value))
+	}
+	t.writer.P("switch ", receiver, ".", discriminantName, " {")
+	for _, unionType := range union.Types {
+		fieldName := unionType.DiscriminantValue.Name.PascalCase.UnsafeName
+		t.writer.P("case \"", unionType.DiscriminantValue.Name.OriginalName, "\":")
+		switch unionType.Shape.PropertiesType {
+		case "singleProperty":
+			property := unionType.Shape.SingleProperty
+			if property.Type.Container != nil && property.Type.Container.Literal != nil {
+				t.writer.P(receiver, ".", unionType.DiscriminantValue.Name.CamelCase.SafeName, " = ", literalToValue(property.Type.Container.Literal))
+				continue
+			}
+			t.writer.P("valueUnmarshaler := core.NewJSONReader(data)")
+			t.writer.P("valueUnmarshaler.ObjectStart()")
+			t.writer.P("for valueUnmarshaler.More() {")
+			t.writer.P("if !strings.EqualFold(string(valueUnmarshaler.KeyBytes()), ", fmt.Sprintf("%q", property.Name.WireValue), ") {")
+			t.writer.P("valueUnmarshaler.Skip()")
+			t.writer.P("continue")
+			t.writer.P("}")
+			t.writeUnmarshalJSONValue("valueUnmarshaler", property.Type, receiver+"."+fieldName, 0)
+			t.writer.P("}")
+			t.writer.P("valueUnmarshaler.ObjectEnd()")
+			t.writer.P("reader.SetErr(valueUnmarshaler.Err())")
+		case "samePropertiesAsObject":
+			t.writer.P("valueUnmarshaler := core.NewJSONReader(data)")
+			t.writeUnmarshalJSONValue("valueUnmarshaler", ir.NewTypeReferenceFromNamed(unionType.Shape.SamePropertiesAsObject), receiver+"."+fieldName, 0)
+			t.writer.P("reader.SetErr(valueUnmarshaler.Err())")
+		case "noProperties":
+			t.writer.P("value := make(map[string]interface{})")
+			t.writer.P("reader.SetErr(json.Unmarshal(data, &value))")
+			t.writer.P(receiver, ".", fieldName, " = value")
+		}
+	}
+	if unknownName != "" {
+		// The data must be copied because it refers
+		// to the caller's input.
+		t.writer.P("default:")
+		t.writer.P(receiver, ".", unknownName, " = append(json.RawMessage(nil), data...)")
+	}
+	t.writer.P("}")
+	t.writer.P("}")
+	t.writer.P()
+
+	t.writeFastJSONMarshalJSON(receiver, "")
+	t.writer.P("func (", receiver, " ", t.typeName, ") MarshalJSONTo(writer *core.JSONWriter) {")
+	t.writer.P("switch ", receiver, ".", discriminantName, " {")
+	for i, unionType := range union.Types {
+		if i == 0 {
+			// Implement the default case first.
+			t.writer.P("default:")
+			if unknownName != "" {
+				t.writer.P("if ", receiver, ".", unknownName, " != nil {")
+				t.writer.P("writer.Raw(", receiver, ".", unknownName, ", nil)")
+				t.writer.P("return")
+				t.writer.P("}")
+			}
+			t.writer.P("writer.SetErr(fmt.Errorf(\"invalid type %s in %T\", ", receiver, ".", discriminantName, ", ", receiver, "))")
+		}
+		fieldName := receiver + "." + unionType.DiscriminantValue.Name.PascalCase.UnsafeName
+		t.writer.P("case \"", unionType.DiscriminantValue.Name.OriginalName, "\":")
+		t.writer.P("writer.ObjectStart()")
+		t.writer.P("writer.Key(", fmt.Sprintf("%q", union.Discriminant.WireValue), ")")
+		t.writer.P("writer.String(", receiver, ".", discriminantName, ")")
+		for _, property := range properties {
+			t.writeMarshalJSONProperty(property.Name.WireValue, property.ValueType, receiver+"."+property.Name.Name.PascalCase.UnsafeName)
+		}
+		for _, literal := range literals {
+			t.writer.P("writer.Key(", fmt.Sprintf("%q", literal.Name.OriginalName), ")")
+			t.writeMarshalJSONLiteral(literal.Value)
+		}
+		switch unionType.Shape.PropertiesType {
+		case "singleProperty":
+			property := unionType.Shape.SingleProperty
+			if literal := property.Type.Container; literal != nil && literal.Literal != nil {
+				// Literals are tagged with omitempty, so they're only
+				// omitted if they're set to their zero value.
+				if literal.Literal.String != "" || literal.Literal.Boolean {
+					t.writer.P("writer.Key(", fmt.Sprintf("%q", property.Name.WireValue), ")")
+					t.writeMarshalJSONLiteral(literal.Literal)
+				}
+				break
+			}
+			t.writeMarshalJSONProperty(property.Name.WireValue, property.Type, fieldName)
+		case "samePropertiesAsObject":
+			// The object's properties are embedded in the union.
+			t.writer.P("if ", fieldName, " != nil {")
+			t.writer.P("writer.Inline()")
+			t.writer.P(fieldName, ".MarshalJSONTo(writer)")
+			t.writer.P("}")
+		case "noProperties":
+			t.writer.P("if ", fieldName, " != nil {")
+			t.writer.P("writer.Key(", fmt.Sprintf("%q", unionType.DiscriminantValue.WireValue), ")")
+			t.writer.P("writer.Value(", fieldName, ")")
+			t.writer.P("}")
+		}
+		t.writer.P("writer.ObjectEnd()")
+	}
+	t.writer.P("}")
+	t.writer.P("}")
+	t.writer.P()
+}
+
+// writeFastJSONKeySwitch writes a switch on the next object key read by the given
+// reader, which calls writeCase to write the statements for the key at each index.
+// Like encoding/json, a key is matched exactly if possible, and case-insensitively
+// otherwise, and unrecognized keys are skipped.
+func (t *typeVisitor) writeFastJSONKeySwitch(reader string, keys []string, writeCase func(int)) {
+	if len(keys) == 0 {
+		t.writer.P(reader, ".KeyBytes()")
+		t.writer.P(reader, ".Skip()")
+		return
+	}
+	t.writer.P("key := ", reader, ".KeyBytes()")
+	t.writer.P("switch string(key) {")
+	for i, key := range keys {
+		t.writer.P("case ", fmt.Sprintf("%q", key), ":")
+		writeCase(i)
+	}
+	t.writer.P("default:")
+	t.writer.P("switch {")
+	for i, key := range keys {
+		t.writer.P("case strings.EqualFold(string(key), ", fmt.Sprintf("%q", key), "):")
+		writeCase(i)
+	}
+	t.writer.P("default:")
+	t.writer.P(reader, ".Skip()")
+	t.writer.P("}")
+	t.writer.P("}")
+}
+
+// writeFastJSONUnmarshalJSON writes the json.Unmarshaler implementation
+// in terms of the type's UnmarshalJSONFrom method.
+func (t *typeVisitor) writeFastJSONUnmarshalJSON(receiver string, pointer string) {
+	t.writer.P("func (", receiver, " ", pointer, t.typeName, ") UnmarshalJSON(data []byte) error {")
+	t.writer.P("reader := core.NewJSONReader(data)")
+	t.writer.P(receiver, ".UnmarshalJSONFrom(reader)")
+	t.writer.P("return reader.Done()")
+	t.writer.P("}")
+	t.writer.P()
+}
+
+// writeFastJSONMarshalJSON writes the json.Marshaler implementation
+// in terms of the type's MarshalJSONTo method.
+func (t *typeVisitor) writeFastJSONMarshalJSON(receiver string, pointer string) {
+	t.writer.P("func (", receiver, " ", pointer, t.typeName, ") MarshalJSON() ([]byte, error) {")
+	t.writer.P("writer := core.NewJSONWriter()")
+	t.writer.P(receiver, ".MarshalJSONTo(writer)")
+	t.writer.P("return writer.Bytes()")
+	t.writer.P("}")
+	t.writer.P()
+}
+
+// flattenObjectProperties returns all of the given object's properties, including
+// those it extends. Like visitObjectProperties, the literals are returned separately.
+func (t *typeVisitor) flattenObjectProperties(object *ir.ObjectTypeDeclaration) ([]*ir.ObjectProperty, []*literal) {
+	var properties []*ir.ObjectProperty
+	var literals []*literal
+	for _, extend := range object.Extends {
+		extendedProperties, extendedLiterals := t.flattenObjectProperties(t.writer.types[extend.TypeId].Shape.Object)
+		properties = append(properties, extendedProperties...)
+		literals = append(literals, extendedLiterals...)
+	}
+	for _, property := range object.Properties {
+		if property.ValueType.Container != nil && property.ValueType.Container.Literal != nil {
+			literals = append(literals, &literal{Name: property.Name.Name, Value: property.ValueType.Container.Literal})
+			continue
+		}
+		properties = append(properties, property)
+	}
+	return properties, literals
+}
+
+// writeMarshalJSONProperty writes the given object property, respecting
+// the omitempty option set by jsonTagForType.
+func (t *typeVisitor) writeMarshalJSONProperty(wireValue string, valueType *ir.TypeReference, value string) {
+	var condition string
+	if strings.Contains(jsonTagForType(wireValue, valueType, t.writer.types), ",omitempty") {
+		condition = t.jsonNonEmptyCondition(valueType, value)
+	}
+	if condition != "" {
+		t.writer.P("if ", condition, " {")
+	}
+	t.writer.P("writer.Key(", fmt.Sprintf("%q", wireValue), ")")
+	t.writeMarshalJSONValue(valueType, value, 0, condition != "")
+	if condition != "" {
+		t.writer.P("}")
+	}
+}
+
+// writeMarshalJSONOptional writes the given *core.Optional[T] property,
+// which is omitted if it's nil (see enableOptionalTypes).
+func (t *typeVisitor) writeMarshalJSONOptional(wireValue string, valueType *ir.TypeReference, value string) {
+	t.writer.P("if ", value, " != nil {")
+	t.writer.P("writer.Key(", fmt.Sprintf("%q", wireValue), ")")
+	t.writer.P("if ", value, ".Null {")
+	t.writer.P("writer.Null()")
+	t.writer.P("} else {")
+	if valueType := valueType.Container.Optional; valueType.Container != nil && valueType.Container.Optional != nil {
+		// Nested optionals are rare, so we defer to encoding/json.
+		t.writer.P("writer.Value(&", value, ".Value)")
+	} else {
+		// The optional's value is never a pointer to an object, union, or set
+		// (see containerTypeVisitor.VisitOptional), but it can be a nil slice or map.
+		nonNil := (valueType.Named != nil && isPointer(t.writer.types[valueType.Named.TypeId], t.writer.encoding)) ||
+			isSetType(valueType, t.writer.types, t.writer.encoding)
+		t.writeMarshalJSONValue(valueType, value+".Value", 0, nonNil)
+	}
+	t.writer.P("}")
+	t.writer.P("}")
+}
+
+// writeMarshalJSONLiteral writes the given literal value.
+func (t *typeVisitor) writeMarshalJSONLiteral(literal *ir.Literal) {
+	switch literal.Type {
+	case "boolean":
+		t.writer.P("writer.Bool(", literalToValue(literal), ")")
+	default:
+		t.writer.P("writer.String(", literalToValue(literal), ")")
+	}
+}
+
+// writeMarshalJSONValue writes the statements that marshal the given value
+// with the core.JSONWriter named 'writer'. The depth is used to generate
+// unique variable names for nested containers, and nonNil is set when the
+// value is already known to be non-nil (i.e. it isn't empty).
+func (t *typeVisitor) writeMarshalJSONValue(typeReference *ir.TypeReference, value string, depth int, nonNil bool) {
+	switch {
+	case typeReference.Container != nil:
+		container := typeReference.Container
+		switch {
+		case container.Literal != nil:
+			t.writeMarshalJSONLiteral(container.Literal)
+		case container.Optional != nil:
+			if !t.optionalRequiresPointer(container.Optional) {
+				t.writeMarshalJSONValue(container.Optional, value, depth, nonNil)
+				return
+			}
+			t.writeMarshalJSONNullCheck(value, nonNil)
+			t.writeMarshalJSONValue(container.Optional, "*"+value, depth, false)
+			t.writeMarshalJSONNullCheckEnd(nonNil)
+		case container.List != nil, container.Set != nil:
+			elementType := container.List
+			if elementType == nil {
+				elementType = container.Set
+			}
+			element := fmt.Sprintf("elem%d", depth)
+			values := value
+			if isSetType(typeReference, t.writer.types, t.writer.encoding) {
+				values = parenthesize(value) + ".Values()"
+			}
+			t.writeMarshalJSONNullCheck(value, nonNil)
+			t.writer.P("writer.ArrayStart()")
+			t.writer.P("for _, ", element, " := range ", values, " {")
+			t.writeMarshalJSONValue(elementType, element, depth+1, false)
+			t.writer.P("}")
+			t.writer.P("writer.ArrayEnd()")
+			t.writeMarshalJSONNullCheckEnd(nonNil)
+		case container.Map != nil:
+			if !t.isJSONString(container.Map.KeyType) {
+				// Non-string keys are rare, so we defer to encoding/json.
+				t.writer.P("writer.Value(", value, ")")
+				return
+			}
+			// Keys are sorted for parity with encoding/json.
+			keys := fmt.Sprintf("keys%d", depth)
+			key := fmt.Sprintf("key%d", depth)
+			keyType := t.goType(container.Map.KeyType)
+			t.writeMarshalJSONNullCheck(value, nonNil)
+			t.writer.P(keys, " := make([]string, 0, len(", value, "))")
+			t.writer.P("for ", key, " := range ", value, " {")
+			if keyType == "string" {
+				t.writer.P(keys, " = append(", keys, ", ", key, ")")
+			} else {
+				t.writer.P(keys, " = append(", keys, ", string(", key, "))")
+			}
+			t.writer.P("}")
+			t.writer.P("sort.Strings(", keys, ")")
+			t.writer.P("writer.ObjectStart()")
+			t.writer.P("for _, ", key, " := range ", keys, " {")
+			t.writer.P("writer.Key(", key, ")")
+			if keyType == "string" {
+				t.writeMarshalJSONValue(container.Map.ValueType, value+"["+key+"]", depth+1, false)
+			} else {
+				t.writeMarshalJSONValue(container.Map.ValueType, value+"["+keyType+"("+key+")]", depth+1, false)
+			}
+			t.writer.P("}")
+			t.writer.P("writer.ObjectEnd()")
+			t.writeMarshalJSONNullCheckEnd(nonNil)
+		}
+	case typeReference.Named != nil:
+		typeDeclaration := t.writer.types[typeReference.Named.TypeId]
+		switch typeDeclaration.Shape.Type {
+		case "alias":
+			t.writeMarshalJSONValue(typeDeclaration.Shape.Alias.AliasOf, value, depth, nonNil)
+		case "enum":
+			t.writer.P("writer.String(string(", value, "))")
+		case "object", "union":
+			t.writeMarshalJSONNullCheck(value, nonNil)
+			t.writer.P(parenthesize(value), ".MarshalJSONTo(writer)")
+			t.writeMarshalJSONNullCheckEnd(nonNil)
+		default:
+			t.writeMarshalJSONNullCheck(value, nonNil)
+			t.writer.P("writer.Raw(", parenthesize(value), ".MarshalJSON())")
+			t.writeMarshalJSONNullCheckEnd(nonNil)
+		}
+	case typeReference.Primitive != "":
+		switch primitiveToGoType(typeReference.Primitive, t.writer.encoding) {
+		case "int":
+			t.writer.P("writer.Int(", value, ")")
+		case "int64":
+			t.writer.P("writer.Int64(", value, ")")
+		case "float64":
+			t.writer.P("writer.Float64(", value, ")")
+		case "json.Number":
+			t.writer.P("writer.Number(", value, ")")
+		case "string":
+			t.writer.P("writer.String(", value, ")")
+		case "bool":
+			t.writer.P("writer.Bool(", value, ")")
+		case "[]byte":
+			t.writer.P("writer.Base64(", value, ")")
+		case "uuid.UUID":
+			t.writer.P("writer.String(", parenthesize(value), ".String())")
+		default:
+			t.writer.P("writer.Raw(", parenthesize(value), ".MarshalJSON())")
+		}
+	default:
+		t.writer.P("writer.Value(", value, ")")
+	}
+}
+
+// writeMarshalJSONNullCheck writes null if the given value is nil, unless
+// it's already known to be non-nil. It must be followed by a corresponding
+// call to writeMarshalJSONNullCheckEnd.
+func (t *typeVisitor) writeMarshalJSONNullCheck(value string, nonNil bool) {
+	if nonNil {
+		return
+	}
+	t.writer.P("if ", value, " == nil {")
+	t.writer.P("writer.Null()")
+	t.writer.P("} else {")
+}
+
+// writeMarshalJSONNullCheckEnd closes the block opened by writeMarshalJSONNullCheck.
+func (t *typeVisitor) writeMarshalJSONNullCheckEnd(nonNil bool) {
+	if !nonNil {
+		t.writer.P("}")
+	}
+}
+
+// writeUnmarshalJSONValue writes the statements that unmarshal the given
+// target with the core.JSONReader of the given name. The depth is used to
+// generate unique variable names for nested containers.
+func (t *typeVisitor) writeUnmarshalJSONValue(reader string, typeReference *ir.TypeReference, target string, depth int) {
+	switch {
+	case typeReference.Container != nil:
+		container := typeReference.Container
+		switch {
+		case container.Literal != nil:
+			t.writer.P(reader, ".Skip()")
+		case container.Optional != nil:
+			if !t.optionalRequiresPointer(container.Optional) {
+				t.writeUnmarshalJSONValue(reader, container.Optional, target, depth)
+				return
+			}
+			t.writer.P("if ", reader, ".IsNull() {")
+			t.writer.P(target, " = nil")
+			t.writer.P("} else {")
+			t.writer.P(target, " = new(", strings.TrimPrefix(t.goType(typeReference), "*"), ")")
+			t.writeUnmarshalJSONValue(reader, container.Optional, "*"+target, depth)
+			t.writer.P("}")
+		case container.List != nil, container.Set != nil:
+			elementType := container.List
+			if elementType == nil {
+				elementType = container.Set
+			}
+			element := fmt.Sprintf("elem%d", depth)
+			isSet := isSetType(typeReference, t.writer.types, t.writer.encoding)
+			t.writer.P("if ", reader, ".IsNull() {")
+			t.writer.P(target, " = nil")
+			t.writer.P("} else {")
+			if isSet {
+				t.writer.P(target, " = new(", strings.TrimPrefix(t.goType(typeReference), "*"), ")")
+			} else {
+				t.writer.P(target, " = make(", t.goType(typeReference), ", 0)")
+			}
+			t.writer.P(reader, ".ArrayStart()")
+			t.writer.P("for ", reader, ".More() {")
+			t.writer.P("var ", element, " ", t.goType(elementType))
+			t.writeUnmarshalJSONValue(reader, elementType, element, depth+1)
+			if isSet {
+				t.writer.P(parenthesize(target), ".Add(", element, ")")
+			} else {
+				t.writer.P(target, " = append(", target, ", ", element, ")")
+			}
+			t.writer.P("}")
+			t.writer.P(reader, ".ArrayEnd()")
+			t.writer.P("}")
+		case container.Map != nil:
+			if !t.isJSONString(container.Map.KeyType) {
+				t.writer.P(reader, ".Value(", addressOf(target), ")")
+				return
+			}
+			key := fmt.Sprintf("key%d", depth)
+			value := fmt.Sprintf("value%d", depth)
+			keyType := t.goType(container.Map.KeyType)
+			t.writer.P("if ", reader, ".IsNull() {")
+			t.writer.P(target, " = nil")
+			t.writer.P("} else {")
+			t.writer.P(target, " = make(", t.goType(typeReference), ")")
+			t.writer.P(reader, ".ObjectStart()")
+			t.writer.P("for ", reader, ".More() {")
+			if keyType == "string" {
+				t.writer.P(key, " := ", reader, ".Key()")
+			} else {
+				t.writer.P(key, " := ", keyType, "(", reader, ".Key())")
+			}
+			t.writer.P("var ", value, " ", t.goType(container.Map.ValueType))
+			t.writeUnmarshalJSONValue(reader, container.Map.ValueType, value, depth+1)
+			t.writer.P(target, "[", key, "] = ", value)
+			t.writer.P("}")
+			t.writer.P(reader, ".ObjectEnd()")
+			t.writer.P("}")
+		}
+	case typeReference.Named != nil:
+		typeDeclaration := t.writer.types[typeReference.Named.TypeId]
+		switch typeDeclaration.Shape.Type {
+		case "alias":
+			t.writeUnmarshalJSONValue(reader, typeDeclaration.Shape.Alias.AliasOf, target, depth)
+		case "enum":
+			t.writer.P(target, " = ", t.goType(typeReference), "(", reader, ".String())")
+		case "object", "union":
+			t.writer.P("if ", reader, ".IsNull() {")
+			t.writer.P(target, " = nil")
+			t.writer.P("} else {")
+			t.writer.P(target, " = new(", strings.TrimPrefix(t.goType(typeReference), "*"), ")")
+			t.writer.P(parenthesize(target), ".UnmarshalJSONFrom(", reader, ")")
+			t.writer.P("}")
+		default:
+			t.writer.P("if ", reader, ".IsNull() {")
+			t.writer.P(target, " = nil")
+			t.writer.P("} else {")
+			t.writer.P(target, " = new(", strings.TrimPrefix(t.goType(typeReference), "*"), ")")
+			t.writer.P(reader, ".Unmarshaler(", target, ")")
+			t.writer.P("}")
+		}
+	case typeReference.Primitive != "":
+		switch primitiveToGoType(typeReference.Primitive, t.writer.encoding) {
+		case "int":
+			t.writer.P(target, " = ", reader, ".Int()")
+		case "int64":
+			t.writer.P(target, " = ", reader, ".Int64()")
+		case "float64":
+			t.writer.P(target, " = ", reader, ".Float64()")
+		case "json.Number":
+			t.writer.P(target, " = ", reader, ".Number()")
+		case "string":
+			t.writer.P(target, " = ", reader, ".String()")
+		case "bool":
+			t.writer.P(target, " = ", reader, ".Bool()")
+		case "[]byte":
+			t.writer.P(target, " = ", reader, ".Base64()")
+		case "uuid.UUID":
+			t.writer.P(reader, ".TextUnmarshaler(", addressOf(target), ")")
+		default:
+			t.writer.P(reader, ".Unmarshaler(", addressOf(target), ")")
+		}
+	default:
+		t.writer.P(reader, ".Value(", addressOf(target), ")")
+	}
+}
+
+// writeUnmarshalJSONOptional writes the statements that unmarshal the given
+// *core.Optional[T] target, which records explicit nulls.
+func (t *typeVisitor) writeUnmarshalJSONOptional(valueType *ir.TypeReference, target string) {
+	optionalType := strings.TrimPrefix(t.optionalType(valueType), "*")
+	t.writer.P("if reader.IsNull() {")
+	t.writer.P(target, " = &", optionalType, "{Null: true}")
+	t.writer.P("} else {")
+	t.writer.P(target, " = new(", optionalType, ")")
+	switch valueType := valueType.Container.Optional; {
+	case valueType.Container != nil && valueType.Container.Optional != nil, isSetType(valueType, t.writer.types, t.writer.encoding):
+		t.writer.P("reader.Unmarshaler(&", target, ".Value)")
+	case valueType.Named != nil && isPointer(t.writer.types[valueType.Named.TypeId], t.writer.encoding):
+		// The optional's value is never a pointer (see containerTypeVisitor.VisitOptional).
+		if shape := t.writer.types[valueType.Named.TypeId].Shape.Type; shape == "object" || shape == "union" {
+			t.writer.P(target, ".Value.UnmarshalJSONFrom(reader)")
+		} else {
+			t.writer.P("reader.Unmarshaler(&", target, ".Value)")
+		}
+	default:
+		t.writeUnmarshalJSONValue("reader", valueType, target+".Value", 0)
+	}
+	t.writer.P("}")
+}
+
+// jsonNonEmptyCondition returns the condition that holds when the given value
+// would not be omitted by the omitempty option, or an empty string if the
+// value is never omitted (e.g. structs like time.Time).
+func (t *typeVisitor) jsonNonEmptyCondition(typeReference *ir.TypeReference, value string) string {
+	switch {
+	case typeReference.Container != nil:
+		container := typeReference.Container
+		switch {
+		case container.Optional != nil:
+			if !t.optionalRequiresPointer(container.Optional) {
+				return t.jsonNonEmptyCondition(container.Optional, value)
+			}
+			return value + " != nil"
+		case container.List != nil, container.Set != nil, container.Map != nil:
+			if isSetType(typeReference, t.writer.types, t.writer.encoding) {
+				return value + " != nil"
+			}
+			return "len(" + value + ") > 0"
+		}
+		return ""
+	case typeReference.Named != nil:
+		typeDeclaration := t.writer.types[typeReference.Named.TypeId]
+		switch typeDeclaration.Shape.Type {
+		case "alias":
+			return t.jsonNonEmptyCondition(typeDeclaration.Shape.Alias.AliasOf, value)
+		case "enum":
+			return value + ` != ""`
+		}
+		return value + " != nil"
+	case typeReference.Primitive != "":
+		switch primitiveToGoType(typeReference.Primitive, t.writer.encoding) {
+		case "int", "int64", "float64", "core.Int64String":
+			return value + " != 0"
+		case "string", "json.Number":
+			return value + ` != ""`
+		case "bool":
+			return value
+		case "[]byte":
+			return "len(" + value + ") > 0"
+		}
+		return ""
+	}
+	return value + " != nil"
+}
+
+// isJSONString returns true if the given type is represented as a
+// Go string (e.g. strings and enums), which is required for map keys.
+func (t *typeVisitor) isJSONString(typeReference *ir.TypeReference) bool {
+	if typeReference.Named != nil {
+		typeDeclaration := t.writer.types[typeReference.Named.TypeId]
+		switch typeDeclaration.Shape.Type {
+		case "alias":
+			return t.isJSONString(typeDeclaration.Shape.Alias.AliasOf)
+		case "enum":
+			return true
+		}
+		return false
+	}
+	return typeReference.Primitive == ir.PrimitiveTypeString
+}
+
+// goType returns the Go type of the given type reference.
+func (t *typeVisitor) goType(typeReference *ir.TypeReference) string {
+	return typeReferenceToGoType(typeReference, t.writer.types, t.writer.scope, t.baseImportPath, t.importPath, false, t.writer.encoding)
+}
+
+// usesOptionalType returns true if the given property type is represented
+// with *core.Optional[T] (see enableOptionalTypes).
+func (t *typeVisitor) usesOptionalType(typeReference *ir.TypeReference) bool {
+	return t.enableOptionalTypes && typeReference.Container != nil && typeReference.Container.Optional != nil
+}
+
+// optionalType returns the *core.Optional[T] type of the given optional
+// property (see enableOptionalTypes).
+func (t *typeVisitor) optionalType(typeReference *ir.TypeReference) string {
+	return typeReferenceToGoType(typeReference, t.writer.types, t.writer.scope, t.baseImportPath, t.importPath, true, t.writer.encoding)
+}
+
+// optionalRequiresPointer returns true if an optional of the given type is
+// represented with an additional pointer (see containerTypeVisitor.VisitOptional).
+func (t *typeVisitor) optionalRequiresPointer(typeReference *ir.TypeReference) bool {
+	switch {
+	case typeReference.Container != nil:
+		return typeReference.Container.Literal != nil
+	case typeReference.Named != nil:
+		// Objects and unions are already pointers.
+		return !isPointer(t.writer.types[typeReference.Named.TypeId], t.writer.encoding)
+	}
+	return typeReference.Primitive != ""
+}
+
+// parenthesize wraps the given value in parentheses if it's dereferenced
+// so that it can be used as a method receiver.
+func parenthesize(value string) string {
+	if strings.HasPrefix(value, "*") {
+		return "(" + value + ")"
+	}
+	return value
+}
+
+// addressOf returns the address of the given value.
+func addressOf(value string) string {
+	if strings.HasPrefix(value, "*") {
+		return strings.TrimPrefix(value, "*")
+	}
+	return "&" + value
+}
@@ -0,0 +1,298 @@
+package generator
+
+import (
+	"encoding/json"
+	"testing"
+
+	builtin "github.com/fern-api/fern-go/internal/testdata/model/builtin/fixtures"
+	enum "github.com/fern-api/fern-go/internal/testdata/model/enum/fixtures"
+	fastjson "github.com/fern-api/fern-go/internal/testdata/model/fast-json/fixtures"
+	union "github.com/fern-api/fern-go/internal/testdata/model/union/fixtures"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// TestFastJSON verifies that the reflection-free JSON marshalers
+// produce the same output as the default marshalers.
+func TestFastJSON(t *testing.T) {
+	tests := []struct {
+		desc       string
+		data       string
+		reflection func() any
+		fast       func() any
+	}{
+		{
+			desc: "built-in types",
+			data: `{
+				"one": 42,
+				"two": 3.14,
+				"three": "fern <3 \"quotes\" \u2028 \ud83c\udf3f",
+				"four": true,
+				"five": 9007199254740993,
+				"six": "2023-09-04T12:30:00.123456789-08:00",
+				"seven": "2023-09-04",
+				"eight": "c1e6c4b1-a7cf-4a2b-9d1c-3b0a6a1e5f4d",
+				"nine": "YWJj",
+				"ten": [3, 1, 4],
+				"eleven": [1.618, 1e-7, 6.02e+23],
+				"twelve": {"b": false, "a": true},
+				"thirteen": -42,
+				"fourteen": {"custom": ["object", null, 1.5]},
+				"fifteen": [[3, 1], [], [4]],
+				"sixteen": [{"key": 5}],
+				"seventeen": [null, "c1e6c4b1-a7cf-4a2b-9d1c-3b0a6a1e5f4d"],
+				"eighteen": "ignored",
+				"unrecognized": {"nested": [true]}
+			}`,
+			reflection: func() any { return new(builtin.Type) },
+			fast:       func() any { return new(fastjson.Type) },
+		},
+		{
+			desc:       "built-in types with zero values",
+			data:       `{"thirteen": null, "ten": [], "twelve": null}`,
+			reflection: func() any { return new(builtin.Type) },
+			fast:       func() any { return new(fastjson.Type) },
+		},
+		{
+			desc:       "union",
+			data:       `{"type": "foo", "foo": {"name": "fern"}}`,
+			reflection: func() any { return new(union.Union) },
+			fast:       func() any { return new(fastjson.Union) },
+		},
+		{
+			desc:       "union with discriminant",
+			data:       `{"bar": {"name": "fern"}, "_type": "bar"}`,
+			reflection: func() any { return new(union.UnionWithDiscriminant) },
+			fast:       func() any { return new(fastjson.UnionWithDiscriminant) },
+		},
+		{
+			desc:       "union with primitive",
+			data:       `{"type": "boolean", "value": false}`,
+			reflection: func() any { return new(union.UnionWithPrimitive) },
+			fast:       func() any { return new(fastjson.UnionWithPrimitive) },
+		},
+		{
+			desc:       "union without key",
+			data:       `{"type": "bar", "name": "fern"}`,
+			reflection: func() any { return new(union.UnionWithoutKey) },
+			fast:       func() any { return new(fastjson.UnionWithoutKey) },
+		},
+		{
+			desc:       "union with unknown",
+			data:       `{"type": "unknown", "custom": 42}`,
+			reflection: func() any { return new(union.UnionWithUnknown) },
+			fast:       func() any { return new(fastjson.UnionWithUnknown) },
+		},
+		{
+			desc:       "union with literal",
+			data:       `{"type": "fern", "value": "fern", "base": "ignored"}`,
+			reflection: func() any { return new(union.UnionWithLiteral) },
+			fast:       func() any { return new(fastjson.UnionWithLiteral) },
+		},
+		{
+			desc:       "enum",
+			data:       `"TWO"`,
+			reflection: func() any { return new(enum.Enum) },
+			fast:       func() any { return new(fastjson.Enum) },
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			assert.Equal(t, roundTripJSON(t, test.data, test.reflection()), roundTripJSON(t, test.data, test.fast()))
+		})
+	}
+
+	t.Run("nested types", func(t *testing.T) {
+		data := `{
+			"title": "The Matrix",
+			"rating": 8.7,
+			"enum": "ONE",
+			"optionalEnum": "THREE",
+			"foo": {"name": "foo"},
+			"optionalFoo": null,
+			"union": {"type": "bar", "bar": {"name": "bar"}},
+			"unions": [{"_type": "foo", "foo": {"name": "first"}}, null],
+			"bars": {"b": {"name": "b"}, "a": null},
+			"counts": {"TWO": 2, "ONE": 1},
+			"tags": ["action"],
+			"metadata": {"runtime": 136}
+		}`
+		value := new(fastjson.Movie)
+		bytes := roundTripJSON(t, data, value)
+		assert.Equal(t, "The Matrix", value.Title)
+		assert.Equal(t, fastjson.EnumThree, *value.OptionalEnum)
+		assert.Equal(t, "bar", value.Union.Bar.Name)
+		assert.Equal(t, "first", value.Unions[0].Foo.Name)
+		assert.Nil(t, value.Unions[1])
+		assert.Nil(t, value.OptionalFoo)
+		assert.Equal(t, map[fastjson.Enum]int{fastjson.EnumOne: 1, fastjson.EnumTwo: 2}, value.Counts)
+
+		// Nil optionals are omitted.
+		assert.Equal(
+			t,
+			`{"title":"The Matrix","rating":8.7,"enum":"ONE","optionalEnum":"THREE","foo":{"name":"foo"},"union":{"type":"bar","bar":{"name":"bar"}},"unions":[{"_type":"foo","foo":{"name":"first"}},null],"bars":{"a":null,"b":{"name":"b"}},"counts":{"ONE":1,"TWO":2},"tags":["action"],"metadata":{"runtime":136}}`,
+			bytes,
+		)
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		var value *fastjson.Foo
+		bytes, err := value.MarshalJSON()
+		require.NoError(t, err)
+		assert.Equal(t, "null", string(bytes))
+
+		movie := &fastjson.Movie{Unions: []*fastjson.UnionWithDiscriminant{nil}}
+		assert.Contains(t, marshalJSON(t, movie), `"unions":[null]`)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, data := range []string{
+			`{"one": "42"}`,
+			`{"one": 4.2}`,
+			`{"ten": [1,]}`,
+			`{"three": "unterminated}`,
+			`{"seven": "2023-09-04T00:00:00Z"}`,
+			`{"one": 42} trailing`,
+			`[]`,
+		} {
+			assert.Error(t, json.Unmarshal([]byte(data), new(fastjson.Type)), data)
+		}
+		assert.Error(t, json.Unmarshal([]byte(`{"type": "foo", "foo": {"name": 42}}`), new(fastjson.Union)))
+
+		_, err := json.Marshal(&fastjson.Union{Type: "baz"})
+		assert.EqualError(t, err, "json: error calling MarshalJSON for type *api.Union: invalid type baz in api.Union")
+	})
+}
+
+// TestFastJSONKeys verifies that object keys are matched just like
+// encoding/json, i.e. exactly if possible, and case-insensitively
+// otherwise, with the last of any duplicate keys taking precedence.
+func TestFastJSONKeys(t *testing.T) {
+	tests := []struct {
+		desc       string
+		data       string
+		reflection func() any
+		fast       func() any
+	}{
+		{
+			desc:       "object",
+			data:       `{"ONE": 42, "Three": "fern", "tEN": [3, 1], "TWELVE": {"Key": true}, "seventeen": null}`,
+			reflection: func() any { return new(builtin.Type) },
+			fast:       func() any { return new(fastjson.Type) },
+		},
+		{
+			desc:       "duplicate keys",
+			data:       `{"one": 1, "ONE": 2, "three": "exact", "Three": "folded", "three": "last"}`,
+			reflection: func() any { return new(builtin.Type) },
+			fast:       func() any { return new(fastjson.Type) },
+		},
+		{
+			desc:       "non-ASCII keys",
+			data:       `{"ſix": "2023-09-04T12:30:00Z", "K": "ignored", "ONE": 1}`,
+			reflection: func() any { return new(builtin.Type) },
+			fast:       func() any { return new(fastjson.Type) },
+		},
+		{
+			desc:       "union",
+			data:       `{"TYPE": "foo", "Foo": {"NAME": "fern"}}`,
+			reflection: func() any { return new(union.Union) },
+			fast:       func() any { return new(fastjson.Union) },
+		},
+		{
+			desc:       "union with discriminant",
+			data:       `{"_TYPE": "bar", "BAR": {"Name": "fern"}, "bar": {"name": "last"}}`,
+			reflection: func() any { return new(union.UnionWithDiscriminant) },
+			fast:       func() any { return new(fastjson.UnionWithDiscriminant) },
+		},
+		{
+			desc:       "union with primitive",
+			data:       `{"Type": "boolean", "VALUE": true}`,
+			reflection: func() any { return new(union.UnionWithPrimitive) },
+			fast:       func() any { return new(fastjson.UnionWithPrimitive) },
+		},
+		{
+			desc:       "union without key",
+			data:       `{"type": "bar", "NAME": "fern"}`,
+			reflection: func() any { return new(union.UnionWithoutKey) },
+			fast:       func() any { return new(fastjson.UnionWithoutKey) },
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			assert.Equal(t, roundTripJSON(t, test.data, test.reflection()), roundTripJSON(t, test.data, test.fast()))
+		})
+	}
+}
+
+// BenchmarkJSON compares the reflection-free JSON marshalers
+// with the default marshalers.
+func BenchmarkJSON(b *testing.B) {
+	data := []byte(`{
+		"one": 42,
+		"two": 3.14,
+		"three": "fern",
+		"four": true,
+		"five": 9007199254740993,
+		"six": "2023-09-04T12:30:00Z",
+		"seven": "2023-09-04",
+		"eight": "c1e6c4b1-a7cf-4a2b-9d1c-3b0a6a1e5f4d",
+		"nine": "YWJj",
+		"ten": [3, 1, 4, 1, 5, 9, 2, 6],
+		"eleven": [1.618, 3.14, 6.02],
+		"twelve": {"key": false, "other": true},
+		"thirteen": 42,
+		"fifteen": [[3, 1, 4], [1, 5, 9]],
+		"sixteen": [{"key": 5}],
+		"seventeen": ["c1e6c4b1-a7cf-4a2b-9d1c-3b0a6a1e5f4d"],
+		"eighteen": "fern"
+	}`)
+	unionData := []byte(`{"type": "foo", "foo": {"name": "fern"}}`)
+
+	benchmarks := []struct {
+		desc  string
+		data  []byte
+		value func() any
+	}{
+		{desc: "object/reflection", data: data, value: func() any { return new(builtin.Type) }},
+		{desc: "object/fast", data: data, value: func() any { return new(fastjson.Type) }},
+		{desc: "union/reflection", data: unionData, value: func() any { return new(union.Union) }},
+		{desc: "union/fast", data: unionData, value: func() any { return new(fastjson.Union) }},
+	}
+	for _, benchmark := range benchmarks {
+		b.Run("marshal/"+benchmark.desc, func(b *testing.B) {
+			value := benchmark.value()
+			require.NoError(b, json.Unmarshal(benchmark.data, value))
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := value.(json.Marshaler).MarshalJSON(); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+		b.Run("unmarshal/"+benchmark.desc, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if err := benchmark.value().(json.Unmarshaler).UnmarshalJSON(benchmark.data); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// roundTripJSON unmarshals the given JSON into the value, and returns
+// the value's JSON encoding.
+func roundTripJSON(t *testing.T, data string, value any) string {
+	t.Helper()
+	require.NoError(t, json.Unmarshal([]byte(data), value))
+	return marshalJSON(t, value)
+}
+
+// marshalJSON returns the JSON encoding of the given value.
+func marshalJSON(t *testing.T, value any) string {
+	t.Helper()
+	bytes, err := json.Marshal(value)
+	require.NoError(t, err)
+	return string(bytes)
+}
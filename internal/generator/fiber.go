@@ -5,7 +5,7 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/fern-api/fern-go/internal/fern/ir"
+	"github.com/fern-api/fern-go/ir"
 )
 
 // WriteFiberRequestType writes a Fiber-compatible type dedicated to the in-lined request (if any).
@@ -20,7 +20,10 @@ func (f *fileWriter) WriteFiberRequestType(fernFilepath *ir.FernFilepath, endpoi
 		importPath = fernFilepathToImportPath(f.baseImportPath, fernFilepath)
 	)
 
-	var literals []*literal
+	var (
+		literals []*literal
+		getters  []*getter
+	)
 	f.P("type ", typeName, " struct {")
 	for _, header := range endpoint.Headers {
 		f.WriteDocs(header.Docs)
@@ -34,11 +37,12 @@ func (f *fileWriter) WriteFiberRequestType(fernFilepath *ir.FernFilepath, endpoi
 			)
 			continue
 		}
-		goType := typeReferenceToGoType(header.ValueType, f.types, f.scope, f.baseImportPath, importPath, false)
-		f.P(header.Name.Name.PascalCase.UnsafeName, " ", goType, " `header:\"", header.Name.Name.OriginalName, "\"`")
+		goType := typeReferenceToGoType(header.ValueType, f.types, f.scope, f.baseImportPath, importPath, false, f.encoding)
+		getters = append(getters, f.newGetter(header.Name.Name.PascalCase.UnsafeName, header.ValueType, importPath, false))
+		f.P(header.Name.Name.PascalCase.UnsafeName, " ", goType, appendStructTags(" `header:\""+header.Name.Name.OriginalName+"\"`", f.extraStructTags(header.Name, header.ValueType)))
 	}
 	for _, queryParam := range endpoint.QueryParameters {
-		value := typeReferenceToGoType(queryParam.ValueType, f.types, f.scope, f.baseImportPath, importPath, false)
+		value := typeReferenceToGoType(queryParam.ValueType, f.types, f.scope, f.baseImportPath, importPath, false, f.encoding)
 		if queryParam.AllowMultiple {
 			value = fmt.Sprintf("[]%s", value)
 		}
@@ -53,7 +57,12 @@ func (f *fileWriter) WriteFiberRequestType(fernFilepath *ir.FernFilepath, endpoi
 			)
 			continue
 		}
-		f.P(queryParam.Name.Name.PascalCase.UnsafeName, " ", value, " `query:\"", queryParam.Name.Name.OriginalName, "\"`")
+		if queryParam.AllowMultiple {
+			getters = append(getters, newValueGetter(queryParam.Name.Name.PascalCase.UnsafeName, value, "nil"))
+		} else {
+			getters = append(getters, f.newGetter(queryParam.Name.Name.PascalCase.UnsafeName, queryParam.ValueType, importPath, false))
+		}
+		f.P(queryParam.Name.Name.PascalCase.UnsafeName, " ", value, appendStructTags(" `query:\""+queryParam.Name.Name.OriginalName+"\"`", f.extraStructTags(queryParam.Name, queryParam.ValueType)))
 	}
 	if endpoint.RequestBody == nil {
 		// If the request doesn't have a body, we don't need any custom [de]serialization logic.
@@ -68,13 +77,15 @@ func (f *fileWriter) WriteFiberRequestType(fernFilepath *ir.FernFilepath, endpoi
 			f.P("}")
 			f.P()
 		}
+		f.writeGetters(typeName, getters)
 		return nil
 	}
-	fieldLiterals, err := requestBodyToFieldDeclaration(endpoint.RequestBody, f, importPath, bodyField, includeGenericOptionals)
+	fieldLiterals, fieldGetters, err := requestBodyToFieldDeclaration(endpoint.RequestBody, f, importPath, bodyField, includeGenericOptionals)
 	if err != nil {
 		return err
 	}
 	literals = append(literals, fieldLiterals...)
+	getters = append(getters, fieldGetters...)
 	for _, literal := range literals {
 		f.P(literal.Name.CamelCase.SafeName, " ", literalToGoType(literal.Value))
 	}
@@ -87,26 +98,31 @@ func (f *fileWriter) WriteFiberRequestType(fernFilepath *ir.FernFilepath, endpoi
 		f.P("}")
 		f.P()
 	}
+	f.writeGetters(typeName, getters)
 
 	var (
 		referenceType      string
 		referenceIsPointer bool
+		referenceIsUnion   bool
 		referenceLiteral   string
 	)
 	if reference := endpoint.RequestBody.Reference; reference != nil {
 		referenceType = strings.TrimPrefix(
-			typeReferenceToGoType(reference.RequestBodyType, f.types, f.scope, f.baseImportPath, importPath, false),
+			typeReferenceToGoType(reference.RequestBodyType, f.types, f.scope, f.baseImportPath, importPath, false, f.encoding),
 			"*",
 		)
-		referenceIsPointer = reference.RequestBodyType.Named != nil && isPointer(f.types[reference.RequestBodyType.Named.TypeId])
+		referenceIsPointer = reference.RequestBodyType.Named != nil && isPointer(f.types[reference.RequestBodyType.Named.TypeId], f.encoding)
+		referenceIsUnion = containsInterfaceUnion(reference.RequestBodyType, f.types, f.encoding)
 		if reference.RequestBodyType.Container != nil && reference.RequestBodyType.Container.Literal != nil {
 			referenceLiteral = literalToValue(reference.RequestBodyType.Container.Literal)
 		}
 	}
 
-	if len(literals) == 0 && len(referenceType) == 0 {
-		// If the request doesn't specify any literals or a reference type,
-		// we don't need to customize the [de]serialization logic at all.
+	unionProperties := requestBodyInterfaceUnionProperties(endpoint.RequestBody, f.types, f.encoding)
+	if len(literals) == 0 && len(referenceType) == 0 && len(unionProperties) == 0 {
+		// If the request doesn't specify any literals, a reference type, or
+		// any unions represented with an interface, we don't need to customize
+		// the [de]serialization logic at all.
 		return nil
 	}
 
@@ -118,13 +134,25 @@ func (f *fileWriter) WriteFiberRequestType(fernFilepath *ir.FernFilepath, endpoi
 		} else {
 			f.P("var body ", referenceType)
 		}
+	} else if len(unionProperties) > 0 {
+		f.P("type unmarshaler ", typeName)
+		f.P("var body = struct{")
+		f.P("unmarshaler")
+		for _, property := range unionProperties {
+			f.P(property.Name.Name.PascalCase.UnsafeName, " json.RawMessage `json:\"", property.Name.WireValue, "\"`")
+		}
+		f.P("}{}")
 	} else {
 		f.P("type unmarshaler ", typeName)
 		f.P("var body unmarshaler")
 	}
-	f.P("if err := json.Unmarshal(data, &body); err != nil {")
-	f.P("return err")
-	f.P("}")
+	if referenceIsUnion {
+		f.writeInterfaceUnionDecoder("body", "data", endpoint.RequestBody.Reference.RequestBodyType, importPath, "return err")
+	} else {
+		f.P("if err := json.Unmarshal(data, &body); err != nil {")
+		f.P("return err")
+		f.P("}")
+	}
 	if len(referenceType) > 0 {
 		if len(referenceLiteral) > 0 {
 			f.P("if body != ", referenceLiteral, "{")
@@ -132,6 +160,15 @@ func (f *fileWriter) WriteFiberRequestType(fernFilepath *ir.FernFilepath, endpoi
 			f.P("}")
 		}
 		f.P(receiver, ".", bodyField, " = body")
+	} else if len(unionProperties) > 0 {
+		f.P("*", receiver, " = ", typeName, "(body.unmarshaler)")
+		for _, property := range unionProperties {
+			var (
+				field            = receiver + "." + property.Name.Name.PascalCase.UnsafeName
+				usesOptionalType = includeGenericOptionals && property.ValueType.Container != nil && property.ValueType.Container.Optional != nil
+			)
+			f.writeInterfaceUnionPropertyDecoder(field, "body."+property.Name.Name.PascalCase.UnsafeName, property.ValueType, importPath, usesOptionalType)
+		}
 	} else {
 		f.P("*", receiver, " = ", typeName, "(body)")
 	}
@@ -142,6 +179,12 @@ func (f *fileWriter) WriteFiberRequestType(fernFilepath *ir.FernFilepath, endpoi
 	f.P("}")
 	f.P()
 
+	if len(literals) == 0 && len(referenceType) == 0 {
+		// Unions represented with an interface are already serialized
+		// by encoding/json, so we only need the json.Unmarshaler.
+		return nil
+	}
+
 	// Implement the json.Marshaler interface.
 	f.P("func (", receiver, " *", typeName, ") MarshalJSON() ([]byte, error) {")
 	if len(referenceType) > 0 {
@@ -4,16 +4,12 @@ import (
 	"bytes"
 	"fmt"
 	"go/format"
-	"go/parser"
-	"go/token"
 	"path"
-	"strconv"
+	"sort"
 	"strings"
 
-	"github.com/fern-api/fern-go/internal/coordinator"
-	"github.com/fern-api/fern-go/internal/fern/ir"
 	"github.com/fern-api/fern-go/internal/gospec"
-	"golang.org/x/tools/go/ast/astutil"
+	"github.com/fern-api/fern-go/ir"
 )
 
 // fileHeader is the comment included in every generated Go file.
@@ -21,6 +17,27 @@ const fileHeader = `// This file was auto-generated by Fern from our API Definit
 
 `
 
+// defaultImports are the packages that the generated code references with
+// their alias directly (e.g. json.Marshal). These imports are only included
+// in the generated output if they're used.
+var defaultImports = []string{
+	"bytes",
+	"context",
+	"encoding/base64",
+	"encoding/json",
+	"errors",
+	"fmt",
+	"io",
+	"mime/multipart",
+	"net/http",
+	"net/url",
+	"sort",
+	"strconv",
+	"strings",
+	"time",
+	"github.com/google/uuid",
+}
+
 // fileWriter wries and formats Go files.
 type fileWriter struct {
 	filename       string
@@ -29,77 +46,101 @@ type fileWriter struct {
 	scope          *gospec.Scope
 	types          map[ir.TypeId]*ir.TypeDeclaration
 	errors         map[ir.ErrorId]*ir.ErrorDeclaration
-	coordinator    *coordinator.Client
+	coordinator    Coordinator
+
+	enableForwardCompatibility        bool
+	encoding                          *EncodingConfig
+	enableFastJSON                    bool
+	enableOptionalTypes               bool
+	enableStrictUndiscriminatedUnions bool
+	enableCloneAndEqual               bool
+	enableDatabaseSQL                 bool
+	enablePatchTypes                  bool
+	enableBuilders                    bool
+	structTags                        []*StructTag
 
 	buffer *bytes.Buffer
 }
 
+// newFileWriter returns a new *fileWriter for the given file, which generates
+// code according to the given configuration.
 func newFileWriter(
 	filename string,
 	packageName string,
-	baseImportPath string,
+	config *Config,
 	types map[ir.TypeId]*ir.TypeDeclaration,
 	errors map[ir.ErrorId]*ir.ErrorDeclaration,
-	coordinator *coordinator.Client,
+	coordinator Coordinator,
 ) *fileWriter {
-	// The default set of imports used in the generated output.
-	// These imports are removed from the generated output if
-	// they aren't used.
+	// The default imports are registered up front, and are only
+	// imported once they're referenced in the generated code.
 	scope := gospec.NewScope()
-	scope.AddImport("bytes")
-	scope.AddImport("context")
-	scope.AddImport("encoding/base64")
-	scope.AddImport("encoding/json")
-	scope.AddImport("errors")
-	scope.AddImport("fmt")
-	scope.AddImport("io")
-	scope.AddImport("mime/multipart")
-	scope.AddImport("net/http")
-	scope.AddImport("net/url")
-	scope.AddImport("strconv")
-	scope.AddImport("strings")
-	scope.AddImport("time")
-	scope.AddImport("github.com/google/uuid")
-
-	// Add an import to the core utilities package generated for
-	// the SDK.
-	scope.AddImport(path.Join(baseImportPath, "core"))
+	for _, importPath := range defaultImports {
+		scope.Imports.Register(path.Base(importPath), importPath)
+	}
+
+	// Register an import to the core utilities package generated
+	// for the SDK.
+	scope.Imports.Register("core", path.Join(config.ImportPath, "core"))
 
 	return &fileWriter{
 		filename:       filename,
 		packageName:    packageName,
-		baseImportPath: baseImportPath,
+		baseImportPath: config.ImportPath,
 		scope:          scope,
 		types:          types,
 		errors:         errors,
 		coordinator:    coordinator,
 		buffer:         new(bytes.Buffer),
+
+		enableForwardCompatibility:        config.EnableForwardCompatibility,
+		encoding:                          config.EncodingConfig,
+		enableFastJSON:                    config.EnableFastJSON,
+		enableOptionalTypes:               config.EnableOptionalTypes,
+		enableStrictUndiscriminatedUnions: config.EnableStrictUndiscriminatedUnions,
+		enableCloneAndEqual:               config.EnableCloneAndEqual,
+		enableDatabaseSQL:                 config.EnableDatabaseSQL,
+		enablePatchTypes:                  config.EnablePatchTypes,
+		enableBuilders:                    config.EnableBuilders,
+		structTags:                        config.StructTags,
 	}
 }
 
 // P writes the given element into a single line, concluding with a newline.
+// Every default import referenced in the line is added to the file's imports,
+// and the references are rewritten if the import needs a different alias.
 func (f *fileWriter) P(elements ...any) {
+	var line strings.Builder
 	for _, element := range elements {
-		fmt.Fprint(f.buffer, element)
+		fmt.Fprint(&line, element)
 	}
-	fmt.Fprintln(f.buffer)
+	fmt.Fprintln(&line)
+	f.buffer.WriteString(f.scope.Imports.UseReferences(line.String()))
 }
 
 // File formats and writes the content stored in the writer's buffer into a *File.
 func (f *fileWriter) File() (*File, error) {
-	// Start with the package declaration and import statements.
-	header := newFileWriter(f.filename, f.packageName, f.baseImportPath, f.types, f.errors, f.coordinator)
-	header.P(fileHeader)
-	header.P("package ", f.packageName)
-	header.P("import (")
-	for importDecl, importAlias := range f.scope.Imports.Values {
-		header.P(fmt.Sprintf("%s %q", importAlias, importDecl))
+	// Start with the package declaration and import statements, which
+	// only include the imports that are used.
+	buffer := bytes.NewBufferString(fileHeader)
+	fmt.Fprintf(buffer, "package %s\n", f.packageName)
+	if imports := f.scope.Imports.Values; len(imports) > 0 {
+		importPaths := make([]string, 0, len(imports))
+		for importPath := range imports {
+			importPaths = append(importPaths, importPath)
+		}
+		sort.Strings(importPaths)
+		fmt.Fprintln(buffer, "import (")
+		for _, importPath := range importPaths {
+			fmt.Fprintf(buffer, "%s %q\n", imports[importPath], importPath)
+		}
+		fmt.Fprintln(buffer, ")")
 	}
-	header.P(")")
+	buffer.Write(f.buffer.Bytes())
 
-	formatted, err := removeUnusedImports(f.filename, append(header.buffer.Bytes(), f.buffer.Bytes()...))
+	formatted, err := format.Source(buffer.Bytes())
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("failed to parse Go code: %s:%v", f.filename, err)
 	}
 
 	return NewFile(f.coordinator, f.filename, formatted), nil
@@ -123,38 +164,3 @@ func (f *fileWriter) WriteDocs(docs *string) {
 		f.P("// " + line)
 	}
 }
-
-// removeUnusedImports parses the buffer, interpreting it as Go code,
-// and removes all unused imports. If successful, the result is then
-// formatted.
-func removeUnusedImports(filename string, buf []byte) ([]byte, error) {
-	fset := token.NewFileSet()
-	f, err := parser.ParseFile(fset, filename, buf, parser.ParseComments)
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse Go code: %v", err)
-	}
-
-	imports := make(map[string]string)
-	for _, route := range f.Imports {
-		importPath, err := strconv.Unquote(route.Path.Value)
-		if err != nil {
-			// Unreachable. If the file parsed successfully,
-			// the unquote will never fail.
-			return nil, err
-		}
-		imports[route.Name.Name] = importPath
-	}
-
-	for name, path := range imports {
-		if !astutil.UsesImport(f, path) {
-			astutil.DeleteNamedImport(fset, f, name, path)
-		}
-	}
-
-	var buffer bytes.Buffer
-	if err := format.Node(&buffer, fset, f); err != nil {
-		return nil, fmt.Errorf("failed to format Go code: %v", err)
-	}
-
-	return buffer.Bytes(), nil
-}
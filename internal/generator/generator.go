@@ -1,17 +1,18 @@
 package generator
 
 import (
+	"context"
 	_ "embed"
-	"encoding/json"
 	"fmt"
 	"os"
 	"path/filepath"
+	"runtime"
 	"sort"
 	"strings"
 
 	"github.com/fern-api/fern-go/internal/ast"
-	"github.com/fern-api/fern-go/internal/coordinator"
-	fernir "github.com/fern-api/fern-go/internal/fern/ir"
+	"github.com/fern-api/fern-go/internal/irmigrate"
+	fernir "github.com/fern-api/fern-go/ir"
 	generatorexec "github.com/fern-api/generator-exec-go"
 )
 
@@ -29,10 +30,20 @@ const (
 	ModeFiber
 )
 
+// Coordinator receives the logs sent during generation, and generates the
+// README.md (if enabled). It's implemented by the *coordinator.Client.
+type Coordinator interface {
+	Log(level generatorexec.LogLevel, message string) error
+	GenerateReadme(request *generatorexec.GenerateReadmeRequest) error
+}
+
 // Generator represents the Go code generator.
 type Generator struct {
 	config      *Config
-	coordinator *coordinator.Client
+	coordinator Coordinator
+
+	// workers is the maximum number of files generated concurrently.
+	workers int
 }
 
 // File is a generated file.
@@ -90,7 +101,7 @@ func NewSubpackagesToGenerate(ir *fernir.IntermediateRepresentation) []*Subpacka
 }
 
 // NewFile returns a new *File with the given content, and send a log to the coordinator.
-func NewFile(coordinator *coordinator.Client, filename string, content []byte) *File {
+func NewFile(coordinator Coordinator, filename string, content []byte) *File {
 	// It's OK if we fail to send an update to the coordinator - we shouldn't fail
 	// generation when we'd otherwise succeed just because a log is missed.
 	_ = coordinator.Log(
@@ -103,66 +114,101 @@ func NewFile(coordinator *coordinator.Client, filename string, content []byte) *
 	}
 }
 
-// New returns a new *Generator.
-func New(config *Config, coordinator *coordinator.Client) (*Generator, error) {
+// New returns a new *Generator, or an error if the configuration isn't valid.
+func New(config *Config, coordinator Coordinator) (*Generator, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
 	return &Generator{
-		config:      config,
-		coordinator: coordinator,
+		config: config,
+		// The coordinator is shared by every file generated concurrently.
+		coordinator: &lockedCoordinator{coordinator: coordinator},
+		workers:     runtime.GOMAXPROCS(0),
 	}, nil
 }
 
-// GenerateTypes runs the code generation process.
+// Generate runs the code generation process. The files are returned in order of
+// their path.
 func (g *Generator) Generate(mode Mode) ([]*File, error) {
-	ir, err := readIR(g.config.IRFilepath)
+	ir, version, err := readIR(g.config.IRFilepath)
 	if err != nil {
 		return nil, err
 	}
-	return g.generate(ir, mode)
+	if version < irmigrate.CurrentVersion {
+		_ = g.coordinator.Log(
+			generatorexec.LogLevelInfo,
+			fmt.Sprintf("Migrated the intermediate representation from v%d to v%d", version, irmigrate.CurrentVersion),
+		)
+	}
+	return g.generate(context.Background(), ir, mode)
 }
 
-func (g *Generator) generateModelTypes(ir *fernir.IntermediateRepresentation, mode Mode) ([]*File, error) {
+// GenerateIR runs the code generation process for the given IR, rather than
+// the IR found at the configured filepath. No more files are generated once
+// the context is done, in which case the context's error is returned.
+//
+// Note that the IR is modified during generation, so it should not be reused.
+func (g *Generator) GenerateIR(ctx context.Context, ir *fernir.IntermediateRepresentation, mode Mode) ([]*File, error) {
+	return g.generate(ctx, ir, mode)
+}
+
+func (g *Generator) generateModelTypes(ctx context.Context, ir *fernir.IntermediateRepresentation, mode Mode) ([]*File, error) {
 	fileInfoToTypes, err := fileInfoToTypes(ir.ApiName, ir.Types, ir.Services, ir.ServiceTypeReferenceInfo)
 	if err != nil {
 		return nil, err
 	}
-	files := make([]*File, 0, len(fileInfoToTypes))
-	for fileInfo, typesToGenerate := range fileInfoToTypes {
-		writer := newFileWriter(
-			fileInfo.filename,
-			fileInfo.packageName,
-			g.config.ImportPath,
-			ir.Types,
-			ir.Errors,
-			g.coordinator,
-		)
-		for _, typeToGenerate := range typesToGenerate {
-			switch {
-			case typeToGenerate.TypeDeclaration != nil:
-				if err := writer.WriteType(typeToGenerate.TypeDeclaration, mode == ModeClient); err != nil {
+	tasks := make([]generateTask, 0, len(fileInfoToTypes))
+	for _, fileInfo := range sortedFileInfos(fileInfoToTypes) {
+		fileInfo, typesToGenerate := fileInfo, fileInfoToTypes[fileInfo]
+		tasks = append(tasks, func() (*File, error) {
+			return g.generateModelFile(ir, mode, fileInfo, typesToGenerate)
+		})
+	}
+	return runGenerateTasks(ctx, g.workers, tasks)
+}
+
+// generateModelFile generates the file that contains the given types.
+func (g *Generator) generateModelFile(
+	ir *fernir.IntermediateRepresentation,
+	mode Mode,
+	fileInfo fileInfo,
+	typesToGenerate []*typeToGenerate,
+) (*File, error) {
+	writer := newFileWriter(
+		fileInfo.filename,
+		fileInfo.packageName,
+		g.config,
+		ir.Types,
+		ir.Errors,
+		g.coordinator,
+	)
+	for _, typeToGenerate := range typesToGenerate {
+		switch {
+		case typeToGenerate.TypeDeclaration != nil:
+			if err := writer.WriteType(typeToGenerate.TypeDeclaration, mode == ModeClient); err != nil {
+				return nil, err
+			}
+		case typeToGenerate.Endpoint != nil:
+			if mode == ModeFiber {
+				if err := writer.WriteFiberRequestType(typeToGenerate.FernFilepath, typeToGenerate.Endpoint, g.config.EnableExplicitNull); err != nil {
 					return nil, err
 				}
-			case typeToGenerate.Endpoint != nil:
-				if mode == ModeFiber {
-					if err := writer.WriteFiberRequestType(typeToGenerate.FernFilepath, typeToGenerate.Endpoint, g.config.EnableExplicitNull); err != nil {
-						return nil, err
-					}
-				} else if mode == ModeClient {
-					if err := writer.WriteRequestType(typeToGenerate.FernFilepath, typeToGenerate.Endpoint, g.config.EnableExplicitNull); err != nil {
+			} else if mode == ModeClient {
+				if err := writer.WriteRequestType(typeToGenerate.FernFilepath, typeToGenerate.Endpoint, g.config.EnableExplicitNull); err != nil {
+					return nil, err
+				}
+				if g.config.EnableBuilders {
+					if err := writer.WriteRequestBuilder(typeToGenerate.FernFilepath, typeToGenerate.Endpoint, g.config.EnableExplicitNull); err != nil {
 						return nil, err
 					}
 				}
 			}
 		}
-		file, err := writer.File()
-		if err != nil {
-			return nil, err
-		}
-		files = append(files, file)
 	}
-	return files, nil
+	return writer.File()
 }
 
-func (g *Generator) generate(ir *fernir.IntermediateRepresentation, mode Mode) ([]*File, error) {
+func (g *Generator) generate(ctx context.Context, ir *fernir.IntermediateRepresentation, mode Mode) ([]*File, error) {
 	if g.config.ImportPath == "" {
 		// If an import path is not configured, we need to validate that none of types
 		// import types from another package.
@@ -223,7 +269,7 @@ func (g *Generator) generate(ir *fernir.IntermediateRepresentation, mode Mode) (
 	// Write all of the package-level documentation, if any (i.e. in a doc.go file).
 	if ir.RootPackage != nil && ir.RootPackage.Docs != nil && len(*ir.RootPackage.Docs) > 0 {
 		fileInfo := fileInfoForPackage(ir.ApiName, ir.RootPackage.FernFilepath)
-		writer := newFileWriter(fileInfo.filename, fileInfo.packageName, "", nil, nil, g.coordinator)
+		writer := newFileWriter(fileInfo.filename, fileInfo.packageName, g.config, nil, nil, g.coordinator)
 		writer.WriteDocs(ir.RootPackage.Docs)
 		files = append(files, writer.DocsFile())
 	}
@@ -232,18 +278,66 @@ func (g *Generator) generate(ir *fernir.IntermediateRepresentation, mode Mode) (
 			continue
 		}
 		fileInfo := fileInfoForPackage(ir.ApiName, subpackage.FernFilepath)
-		writer := newFileWriter(fileInfo.filename, fileInfo.packageName, "", nil, nil, g.coordinator)
+		writer := newFileWriter(fileInfo.filename, fileInfo.packageName, g.config, nil, nil, g.coordinator)
 		writer.WriteDocs(subpackage.Docs)
 		files = append(files, writer.DocsFile())
 	}
 	// Then split up all the types based on the Fern directory they belong to (i.e. the root package,
 	// or some other subpackage).
-	modelFiles, err := g.generateModelTypes(ir, mode)
+	modelFiles, err := g.generateModelTypes(ctx, ir, mode)
 	if err != nil {
 		return nil, err
 	}
 	files = append(files, modelFiles...)
 	files = append(files, newStringerFile(g.coordinator))
+	if g.config.EncodingConfig.usesDateType() && irUsesPrimitive(ir, fernir.PrimitiveTypeDate) {
+		files = append(files, newDateFile(g.coordinator))
+	}
+	if g.config.EncodingConfig.requiresCoreTypes() {
+		files = append(files, newEncodingFile(g.coordinator))
+	}
+	if g.config.EnableFastJSON {
+		files = append(files, newJSONFile(g.coordinator))
+	}
+	if g.config.EncodingConfig.usesSetType() {
+		files = append(files, newSetFile(g.coordinator))
+	}
+	if g.config.EnableStrictUndiscriminatedUnions {
+		files = append(files, newUnionFile(g.coordinator))
+	}
+	if g.config.EnableDatabaseSQL {
+		files = append(files, newSQLFile(g.coordinator))
+	}
+	if g.config.EnableJSONSchema {
+		schemaFiles, err := g.generateJSONSchemas(ir.Types, fileInfoForSchemas(ir.Types, generatedPackages))
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, schemaFiles...)
+	}
+	if g.config.EnableOptionalTypes || g.config.EnablePatchTypes || (mode == ModeClient && g.config.EnableExplicitNull) {
+		// Generate the Optional[T] type and its constructors, which are
+		// also used by the patch types (see enablePatchTypes).
+		fileInfo, useCore := fileInfoForOptionalHelpers(ir.ApiName, generatedNames, generatedPackages)
+		writer := newFileWriter(
+			fileInfo.filename,
+			fileInfo.packageName,
+			g.config,
+			ir.Types,
+			ir.Errors,
+			g.coordinator,
+		)
+		if err := writer.WriteOptionalHelpers(useCore); err != nil {
+			return nil, err
+		}
+		file, err := writer.File()
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, file)
+		files = append(files, newOptionalFile(g.coordinator))
+		files = append(files, newOptionalTestFile(g.coordinator))
+	}
 	// Then handle mode-specific generation tasks.
 	var generatedClient *GeneratedClient
 	switch mode {
@@ -259,7 +353,7 @@ func (g *Generator) generate(ir *fernir.IntermediateRepresentation, mode Mode) (
 		writer := newFileWriter(
 			fileInfo.filename,
 			fileInfo.packageName,
-			g.config.ImportPath,
+			g.config,
 			ir.Types,
 			ir.Errors,
 			g.coordinator,
@@ -279,7 +373,7 @@ func (g *Generator) generate(ir *fernir.IntermediateRepresentation, mode Mode) (
 			writer = newFileWriter(
 				fileInfo.filename,
 				fileInfo.packageName,
-				g.config.ImportPath,
+				g.config,
 				ir.Types,
 				ir.Errors,
 				g.coordinator,
@@ -299,7 +393,7 @@ func (g *Generator) generate(ir *fernir.IntermediateRepresentation, mode Mode) (
 		writer = newFileWriter(
 			fileInfo.filename,
 			fileInfo.packageName,
-			g.config.ImportPath,
+			g.config,
 			ir.Types,
 			ir.Errors,
 			g.coordinator,
@@ -313,28 +407,6 @@ func (g *Generator) generate(ir *fernir.IntermediateRepresentation, mode Mode) (
 			return nil, err
 		}
 		files = append(files, file)
-		// Generate the Optional[T] constructors.
-		fileInfo, useCore := fileInfoForOptionalHelpers(ir.ApiName, generatedNames, generatedPackages)
-		writer = newFileWriter(
-			fileInfo.filename,
-			fileInfo.packageName,
-			g.config.ImportPath,
-			ir.Types,
-			ir.Errors,
-			g.coordinator,
-		)
-		if g.config.EnableExplicitNull {
-			if err := writer.WriteOptionalHelpers(useCore); err != nil {
-				return nil, err
-			}
-			file, err = writer.File()
-			if err != nil {
-				return nil, err
-			}
-			files = append(files, file)
-			files = append(files, newOptionalFile(g.coordinator))
-			files = append(files, newOptionalTestFile(g.coordinator))
-		}
 		files = append(files, newClientTestFile(g.coordinator))
 		files = append(files, newCoreFile(g.coordinator))
 		files = append(files, newCoreTestFile(g.coordinator))
@@ -343,28 +415,25 @@ func (g *Generator) generate(ir *fernir.IntermediateRepresentation, mode Mode) (
 			files = append(files, newStreamFile(g.coordinator))
 		}
 		// Generate the error types, if any.
-		for fileInfo, irErrors := range fileInfoToErrors(ir.ApiName, ir.Errors) {
-			writer := newFileWriter(
-				fileInfo.filename,
-				fileInfo.packageName,
-				g.config.ImportPath,
-				ir.Types,
-				ir.Errors,
-				g.coordinator,
-			)
-			for _, irError := range irErrors {
-				if err := writer.WriteError(irError); err != nil {
-					return nil, err
-				}
-			}
-			file, err := writer.File()
-			if err != nil {
-				return nil, err
-			}
-			files = append(files, file)
+		fileInfoToErrors := fileInfoToErrors(ir.ApiName, ir.Errors)
+		errorTasks := make([]generateTask, 0, len(fileInfoToErrors))
+		for _, fileInfo := range sortedFileInfos(fileInfoToErrors) {
+			fileInfo, irErrors := fileInfo, fileInfoToErrors[fileInfo]
+			errorTasks = append(errorTasks, func() (*File, error) {
+				return g.generateErrorFile(ir, fileInfo, irErrors)
+			})
 		}
-		// First generate the client at the root package, if any.
-		subpackagesToGenerate := NewSubpackagesToGenerate(ir)
+		errorFiles, err := runGenerateTasks(ctx, g.workers, errorTasks)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, errorFiles...)
+		// The clients for every package are generated concurrently, starting
+		// with the client at the root package, if any.
+		var (
+			subpackagesToGenerate = NewSubpackagesToGenerate(ir)
+			clientTasks           []generateTask
+		)
 		if ir.RootPackage != nil {
 			var rootSubpackages []*fernir.Subpackage
 			for _, subpackageID := range ir.RootPackage.Subpackages {
@@ -375,32 +444,31 @@ func (g *Generator) generate(ir *fernir.IntermediateRepresentation, mode Mode) (
 				}
 				rootSubpackages = append(rootSubpackages, subpackage)
 			}
-			if ir.RootPackage.Service != nil {
-				file, generatedClient, err = g.generateService(
-					ir,
-					ir.Services[*ir.RootPackage.Service],
-					rootSubpackages,
-					generatedAuth,
-					generatedEnvironment,
-					ir.RootPackage.FernFilepath,
+			clientTasks = append(clientTasks, func() (*File, error) {
+				var (
+					file *File
+					err  error
 				)
-				if err != nil {
-					return nil, err
-				}
-				files = append(files, file)
-			} else {
-				file, generatedClient, err = g.generateRootServiceWithoutEndpoints(
-					ir,
-					ir.RootPackage.FernFilepath,
-					rootSubpackages,
-					generatedAuth,
-					generatedEnvironment,
-				)
-				if err != nil {
-					return nil, err
+				if ir.RootPackage.Service != nil {
+					file, generatedClient, err = g.generateService(
+						ir,
+						ir.Services[*ir.RootPackage.Service],
+						rootSubpackages,
+						generatedAuth,
+						generatedEnvironment,
+						ir.RootPackage.FernFilepath,
+					)
+				} else {
+					file, generatedClient, err = g.generateRootServiceWithoutEndpoints(
+						ir,
+						ir.RootPackage.FernFilepath,
+						rootSubpackages,
+						generatedAuth,
+						generatedEnvironment,
+					)
 				}
-				files = append(files, file)
-			}
+				return file, err
+			})
 		}
 		// Then generate the client for all of the subpackages.
 		for _, subpackageToGenerate := range subpackagesToGenerate {
@@ -419,45 +487,49 @@ func (g *Generator) generate(ir *fernir.IntermediateRepresentation, mode Mode) (
 				// so we don't need to generate a client for it.
 				continue
 			}
+			originalFernFilepath := subpackageToGenerate.OriginalFernFilepath
 			if irSubpackage.Service == nil {
 				// This subpackage doesn't have a service, but we still need
 				// to generate an intermediary client for it to access the
 				// nested endpoints.
-				file, err := g.generateServiceWithoutEndpoints(
+				clientTasks = append(clientTasks, func() (*File, error) {
+					return g.generateServiceWithoutEndpoints(
+						ir,
+						irSubpackage,
+						subpackages,
+						generatedAuth,
+						generatedEnvironment,
+						originalFernFilepath,
+					)
+				})
+				continue
+			}
+			// This service has endpoints, so we proceed with the normal flow.
+			irService := ir.Services[*irSubpackage.Service]
+			clientTasks = append(clientTasks, func() (*File, error) {
+				file, _, err := g.generateService(
 					ir,
-					irSubpackage,
+					irService,
 					subpackages,
 					generatedAuth,
 					generatedEnvironment,
-					subpackageToGenerate.OriginalFernFilepath,
+					originalFernFilepath,
 				)
-				if err != nil {
-					return nil, err
-				}
-				files = append(files, file)
-				continue
-			}
-			// This service has endpoints, so we proceed with the normal flow.
-			file, _, err := g.generateService(
-				ir,
-				ir.Services[*irSubpackage.Service],
-				subpackages,
-				generatedAuth,
-				generatedEnvironment,
-				subpackageToGenerate.OriginalFernFilepath,
-			)
-			if err != nil {
-				return nil, err
-			}
-			files = append(files, file)
+				return file, err
+			})
 		}
+		clientFiles, err := runGenerateTasks(ctx, g.workers, clientTasks)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, clientFiles...)
 	}
 	// Finally, generate the go.mod file, if needed.
 	//
 	// The go.sum file will be generated after the
 	// go.mod file is written to disk.
 	if g.config.ModuleConfig != nil {
-		requiresGenerics := g.config.EnableExplicitNull || ir.SdkConfig.HasStreamingEndpoints
+		requiresGenerics := g.config.EnableExplicitNull || g.config.EnableOptionalTypes || g.config.EnablePatchTypes || g.config.EncodingConfig.usesSetType() || ir.SdkConfig.HasStreamingEndpoints
 		file, generatedGoVersion, err := NewModFile(g.coordinator, g.config.ModuleConfig, requiresGenerics)
 		if err != nil {
 			return nil, err
@@ -471,9 +543,32 @@ func (g *Generator) generate(ir *fernir.IntermediateRepresentation, mode Mode) (
 			files = append(files, file)
 		}
 	}
+	sort.SliceStable(files, func(i, j int) bool { return files[i].Path < files[j].Path })
 	return files, nil
 }
 
+// generateErrorFile generates the file that contains the given errors.
+func (g *Generator) generateErrorFile(
+	ir *fernir.IntermediateRepresentation,
+	fileInfo fileInfo,
+	irErrors []*fernir.ErrorDeclaration,
+) (*File, error) {
+	writer := newFileWriter(
+		fileInfo.filename,
+		fileInfo.packageName,
+		g.config,
+		ir.Types,
+		ir.Errors,
+		g.coordinator,
+	)
+	for _, irError := range irErrors {
+		if err := writer.WriteError(irError); err != nil {
+			return nil, err
+		}
+	}
+	return writer.File()
+}
+
 // generateReadme generates a README.md file for a generated Go module, called
 // if a module config was provided.
 //
@@ -531,7 +626,7 @@ func (g *Generator) generateService(
 	writer := newFileWriter(
 		fileInfo.filename,
 		fileInfo.packageName,
-		g.config.ImportPath,
+		g.config,
 		ir.Types,
 		ir.Errors,
 		g.coordinator,
@@ -570,7 +665,7 @@ func (g *Generator) generateServiceWithoutEndpoints(
 	writer := newFileWriter(
 		fileInfo.filename,
 		fileInfo.packageName,
-		g.config.ImportPath,
+		g.config,
 		ir.Types,
 		ir.Errors,
 		g.coordinator,
@@ -603,7 +698,7 @@ func (g *Generator) generateRootServiceWithoutEndpoints(
 	writer := newFileWriter(
 		fileInfo.filename,
 		fileInfo.packageName,
-		g.config.ImportPath,
+		g.config,
 		ir.Types,
 		ir.Errors,
 		g.coordinator,
@@ -627,17 +722,193 @@ func (g *Generator) generateRootServiceWithoutEndpoints(
 	return file, generatedClient, nil
 }
 
-// readIR reads the *InermediateRepresentation from the given filename.
-func readIR(irFilename string) (*fernir.IntermediateRepresentation, error) {
+// sortedFileInfos returns the keys of the given map in order of their filename,
+// so that the files are generated in a deterministic order.
+func sortedFileInfos[T any](m map[fileInfo]T) []fileInfo {
+	fileInfos := make([]fileInfo, 0, len(m))
+	for fileInfo := range m {
+		fileInfos = append(fileInfos, fileInfo)
+	}
+	sort.Slice(fileInfos, func(i, j int) bool { return fileInfos[i].filename < fileInfos[j].filename })
+	return fileInfos
+}
+
+// readIR reads the *InermediateRepresentation from the given filename, and
+// migrates it to the current version if necessary. The IR's original version
+// is returned alongside it.
+func readIR(irFilename string) (*fernir.IntermediateRepresentation, int, error) {
 	bytes, err := os.ReadFile(irFilename)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read intermediate representation: %v", err)
+		return nil, 0, fmt.Errorf("failed to read intermediate representation: %v", err)
+	}
+	return irmigrate.Unmarshal(bytes)
+}
+
+// irUsesPrimitive returns true if the given primitive is referenced anywhere in the
+// given IR that code is generated for (e.g. a type declaration, header, query parameter,
+// etc). Webhooks aren't generated, so they're not included.
+func irUsesPrimitive(ir *fernir.IntermediateRepresentation, primitive fernir.PrimitiveType) bool {
+	for _, typeReference := range irTypeReferences(ir) {
+		if typeReferenceUsesPrimitive(typeReference, primitive) {
+			return true
+		}
+	}
+	return false
+}
+
+// typeReferenceUsesPrimitive returns true if the given type reference is, or holds
+// (e.g. in a list or map), the given primitive. Named types aren't followed because
+// every type declaration is visited on its own.
+func typeReferenceUsesPrimitive(typeReference *fernir.TypeReference, primitive fernir.PrimitiveType) bool {
+	if typeReference == nil {
+		return false
+	}
+	if typeReference.Container == nil {
+		return typeReference.Type == "primitive" && typeReference.Primitive == primitive
+	}
+	container := typeReference.Container
+	switch {
+	case container.List != nil:
+		return typeReferenceUsesPrimitive(container.List, primitive)
+	case container.Set != nil:
+		return typeReferenceUsesPrimitive(container.Set, primitive)
+	case container.Optional != nil:
+		return typeReferenceUsesPrimitive(container.Optional, primitive)
+	case container.Map != nil:
+		return typeReferenceUsesPrimitive(container.Map.KeyType, primitive) ||
+			typeReferenceUsesPrimitive(container.Map.ValueType, primitive)
+	}
+	return false
+}
+
+// irTypeReferences returns every top-level type reference in the given IR
+// that code is generated for. Any of the type references can be nil.
+func irTypeReferences(ir *fernir.IntermediateRepresentation) []*fernir.TypeReference {
+	var typeReferences []*fernir.TypeReference
+	for _, typeDeclaration := range ir.Types {
+		typeReferences = append(typeReferences, typeDeclarationTypeReferences(typeDeclaration.Shape)...)
+	}
+	for _, service := range ir.Services {
+		typeReferences = append(typeReferences, headerTypeReferences(service.Headers)...)
+		typeReferences = append(typeReferences, pathParameterTypeReferences(service.PathParameters)...)
+		for _, endpoint := range service.Endpoints {
+			typeReferences = append(typeReferences, endpointTypeReferences(endpoint)...)
+		}
+	}
+	for _, errorDeclaration := range ir.Errors {
+		typeReferences = append(typeReferences, errorDeclaration.Type)
+	}
+	if ir.Auth != nil {
+		for _, authScheme := range ir.Auth.Schemes {
+			if authScheme.Header != nil {
+				typeReferences = append(typeReferences, authScheme.Header.ValueType)
+			}
+		}
+	}
+	for _, variable := range ir.Variables {
+		typeReferences = append(typeReferences, variable.Type)
+	}
+	typeReferences = append(typeReferences, headerTypeReferences(ir.Headers)...)
+	typeReferences = append(typeReferences, pathParameterTypeReferences(ir.PathParameters)...)
+	return typeReferences
+}
+
+// typeDeclarationTypeReferences returns the type references in the given type shape.
+func typeDeclarationTypeReferences(shape *fernir.Type) []*fernir.TypeReference {
+	var typeReferences []*fernir.TypeReference
+	switch {
+	case shape == nil:
+	case shape.Alias != nil:
+		typeReferences = append(typeReferences, shape.Alias.AliasOf)
+	case shape.Object != nil:
+		typeReferences = append(typeReferences, objectPropertyTypeReferences(shape.Object.Properties)...)
+	case shape.Union != nil:
+		typeReferences = append(typeReferences, objectPropertyTypeReferences(shape.Union.BaseProperties)...)
+		for _, singleUnionType := range shape.Union.Types {
+			if singleUnionType.Shape != nil && singleUnionType.Shape.SingleProperty != nil {
+				typeReferences = append(typeReferences, singleUnionType.Shape.SingleProperty.Type)
+			}
+		}
+	case shape.UndiscriminatedUnion != nil:
+		for _, member := range shape.UndiscriminatedUnion.Members {
+			typeReferences = append(typeReferences, member.Type)
+		}
 	}
-	ir := new(fernir.IntermediateRepresentation)
-	if err := json.Unmarshal(bytes, ir); err != nil {
-		return nil, fmt.Errorf("failed to unmarshal intermediate representation: %v", err)
+	return typeReferences
+}
+
+// endpointTypeReferences returns the type references in the given endpoint's
+// parameters, request, and response.
+func endpointTypeReferences(endpoint *fernir.HttpEndpoint) []*fernir.TypeReference {
+	typeReferences := headerTypeReferences(endpoint.Headers)
+	typeReferences = append(typeReferences, pathParameterTypeReferences(endpoint.AllPathParameters)...)
+	typeReferences = append(typeReferences, pathParameterTypeReferences(endpoint.PathParameters)...)
+	for _, queryParameter := range endpoint.QueryParameters {
+		typeReferences = append(typeReferences, queryParameter.ValueType)
+	}
+	if requestBody := endpoint.RequestBody; requestBody != nil {
+		switch {
+		case requestBody.InlinedRequestBody != nil:
+			for _, property := range requestBody.InlinedRequestBody.Properties {
+				typeReferences = append(typeReferences, property.ValueType)
+			}
+		case requestBody.Reference != nil:
+			typeReferences = append(typeReferences, requestBody.Reference.RequestBodyType)
+		case requestBody.FileUpload != nil:
+			for _, property := range requestBody.FileUpload.Properties {
+				if property.BodyProperty != nil {
+					typeReferences = append(typeReferences, property.BodyProperty.ValueType)
+				}
+			}
+		}
+	}
+	if sdkRequest := endpoint.SdkRequest; sdkRequest != nil && sdkRequest.Shape != nil && sdkRequest.Shape.JustRequestBody != nil {
+		if reference := sdkRequest.Shape.JustRequestBody.TypeReference; reference != nil {
+			typeReferences = append(typeReferences, reference.RequestBodyType)
+		}
+	}
+	if response := endpoint.Response; response != nil {
+		switch {
+		case response.Json != nil && response.Json.Response != nil:
+			typeReferences = append(typeReferences, response.Json.Response.ResponseBodyType)
+		case response.Json != nil && response.Json.NestedPropertyAsResponse != nil:
+			nested := response.Json.NestedPropertyAsResponse
+			typeReferences = append(typeReferences, nested.ResponseBodyType)
+			if nested.ResponseProperty != nil {
+				typeReferences = append(typeReferences, nested.ResponseProperty.ValueType)
+			}
+		case response.Streaming != nil && response.Streaming.DataEventType != nil:
+			typeReferences = append(typeReferences, response.Streaming.DataEventType.Json)
+		}
+	}
+	return typeReferences
+}
+
+// objectPropertyTypeReferences returns the type references of the given properties.
+func objectPropertyTypeReferences(properties []*fernir.ObjectProperty) []*fernir.TypeReference {
+	typeReferences := make([]*fernir.TypeReference, 0, len(properties))
+	for _, property := range properties {
+		typeReferences = append(typeReferences, property.ValueType)
 	}
-	return ir, nil
+	return typeReferences
+}
+
+// headerTypeReferences returns the type references of the given headers.
+func headerTypeReferences(headers []*fernir.HttpHeader) []*fernir.TypeReference {
+	typeReferences := make([]*fernir.TypeReference, 0, len(headers))
+	for _, header := range headers {
+		typeReferences = append(typeReferences, header.ValueType)
+	}
+	return typeReferences
+}
+
+// pathParameterTypeReferences returns the type references of the given path parameters.
+func pathParameterTypeReferences(pathParameters []*fernir.PathParameter) []*fernir.TypeReference {
+	typeReferences := make([]*fernir.TypeReference, 0, len(pathParameters))
+	for _, pathParameter := range pathParameters {
+		typeReferences = append(typeReferences, pathParameter.ValueType)
+	}
+	return typeReferences
 }
 
 // newPointerFile returns a *File containing the pointer helper functions
@@ -647,7 +918,7 @@ func readIR(irFilename string) (*fernir.IntermediateRepresentation, error) {
 // access the helpers alongside the rest of the top-level definitions. However,
 // if any naming conflict exists between the generated types, this file is
 // deposited in the core package.
-func newPointerFile(coordinator *coordinator.Client, apiName *fernir.Name, generatedNames map[string]struct{}) *File {
+func newPointerFile(coordinator Coordinator, apiName *fernir.Name, generatedNames map[string]struct{}) *File {
 	// First determine whether or not we need to generate the type in the
 	// core package.
 	var useCorePackage bool
@@ -686,7 +957,7 @@ func newPointerFile(coordinator *coordinator.Client, apiName *fernir.Name, gener
 	)
 }
 
-func newClientTestFile(coordinator *coordinator.Client) *File {
+func newClientTestFile(coordinator Coordinator) *File {
 	return NewFile(
 		coordinator,
 		"client/client_test.go",
@@ -694,7 +965,7 @@ func newClientTestFile(coordinator *coordinator.Client) *File {
 	)
 }
 
-func newCoreFile(coordinator *coordinator.Client) *File {
+func newCoreFile(coordinator Coordinator) *File {
 	return NewFile(
 		coordinator,
 		"core/core.go",
@@ -702,7 +973,7 @@ func newCoreFile(coordinator *coordinator.Client) *File {
 	)
 }
 
-func newCoreTestFile(coordinator *coordinator.Client) *File {
+func newCoreTestFile(coordinator Coordinator) *File {
 	return NewFile(
 		coordinator,
 		"core/core_test.go",
@@ -710,7 +981,7 @@ func newCoreTestFile(coordinator *coordinator.Client) *File {
 	)
 }
 
-func newOptionalFile(coordinator *coordinator.Client) *File {
+func newOptionalFile(coordinator Coordinator) *File {
 	return NewFile(
 		coordinator,
 		"core/optional.go",
@@ -718,7 +989,7 @@ func newOptionalFile(coordinator *coordinator.Client) *File {
 	)
 }
 
-func newOptionalTestFile(coordinator *coordinator.Client) *File {
+func newOptionalTestFile(coordinator Coordinator) *File {
 	return NewFile(
 		coordinator,
 		"core/optional_test.go",
@@ -726,7 +997,7 @@ func newOptionalTestFile(coordinator *coordinator.Client) *File {
 	)
 }
 
-func newStreamFile(coordinator *coordinator.Client) *File {
+func newStreamFile(coordinator Coordinator) *File {
 	return NewFile(
 		coordinator,
 		"core/stream.go",
@@ -734,7 +1005,39 @@ func newStreamFile(coordinator *coordinator.Client) *File {
 	)
 }
 
-func newStringerFile(coordinator *coordinator.Client) *File {
+func newDateFile(coordinator Coordinator) *File {
+	return NewFile(
+		coordinator,
+		"core/date.go",
+		[]byte(dateFile),
+	)
+}
+
+func newEncodingFile(coordinator Coordinator) *File {
+	return NewFile(
+		coordinator,
+		"core/encoding.go",
+		[]byte(encodingFile),
+	)
+}
+
+func newJSONFile(coordinator Coordinator) *File {
+	return NewFile(
+		coordinator,
+		"core/json.go",
+		[]byte(jsonFile),
+	)
+}
+
+func newSetFile(coordinator Coordinator) *File {
+	return NewFile(
+		coordinator,
+		"core/set.go",
+		[]byte(setFile),
+	)
+}
+
+func newStringerFile(coordinator Coordinator) *File {
 	return NewFile(
 		coordinator,
 		"core/stringer.go",
@@ -742,6 +1045,22 @@ func newStringerFile(coordinator *coordinator.Client) *File {
 	)
 }
 
+func newUnionFile(coordinator Coordinator) *File {
+	return NewFile(
+		coordinator,
+		"core/union.go",
+		[]byte(unionFile),
+	)
+}
+
+func newSQLFile(coordinator Coordinator) *File {
+	return NewFile(
+		coordinator,
+		"core/sql.go",
+		[]byte(sqlFile),
+	)
+}
+
 type fileInfo struct {
 	filename    string
 	packageName string
@@ -771,6 +1090,27 @@ func fileInfoForCoreClientOptions() *fileInfo {
 	}
 }
 
+// fileInfoForSchemas returns the location of the schemas package, which is moved into
+// the core package if the API already defines a schemas package.
+func fileInfoForSchemas(types map[fernir.TypeId]*fernir.TypeDeclaration, generatedPackages map[string]struct{}) *fileInfo {
+	_, hasSchemas := generatedPackages["schemas"]
+	for _, typeDeclaration := range types {
+		if packagePath := typeDeclaration.Name.FernFilepath.PackagePath; len(packagePath) > 0 && strings.ToLower(packagePath[0].CamelCase.SafeName) == "schemas" {
+			hasSchemas = true
+		}
+	}
+	if hasSchemas {
+		return &fileInfo{
+			filename:    "core/schemas/schemas.go",
+			packageName: "schemas",
+		}
+	}
+	return &fileInfo{
+		filename:    "schemas/schemas.go",
+		packageName: "schemas",
+	}
+}
+
 func fileInfoForOptionalHelpers(apiName *fernir.Name, generatedNames map[string]struct{}, generatedPackages map[string]struct{}) (*fileInfo, bool) {
 	_, hasOptional := generatedNames["Optional"]
 	_, hasNull := generatedNames["Null"]
@@ -0,0 +1,146 @@
+package generator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	fernir "github.com/fern-api/fern-go/ir"
+	generatorexec "github.com/fern-api/generator-exec-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testdataPath = "../testdata"
+
+// BenchmarkGenerate measures the time it takes to generate every IR in the
+// testdata for each mode, both sequentially and on the default worker pool.
+//
+//	go test ./internal/generator -run=^$ -bench=Generate
+func BenchmarkGenerate(b *testing.B) {
+	modes := []struct {
+		name string
+		mode Mode
+	}{
+		{name: "model", mode: ModeModel},
+		{name: "sdk", mode: ModeClient},
+		{name: "fiber", mode: ModeFiber},
+	}
+	poolSizes := []int{1}
+	if workers := runtime.GOMAXPROCS(0); workers > 1 {
+		poolSizes = append(poolSizes, workers)
+	}
+	for _, mode := range modes {
+		fixtures := readBenchmarkFixtures(b, filepath.Join(testdataPath, mode.name))
+		for _, workers := range poolSizes {
+			b.Run(fmt.Sprintf("%s/workers=%d", mode.name, workers), func(b *testing.B) {
+				for i := 0; i < b.N; i++ {
+					for _, fixture := range fixtures {
+						// GenerateIR rewrites the FernFilepath of nested root packages in place
+						// (see NewSubpackagesToGenerate), so every iteration decodes a fresh IR,
+						// just like gen.Generate generates from a copy.
+						b.StopTimer()
+						ir := new(fernir.IntermediateRepresentation)
+						require.NoError(b, json.Unmarshal(fixture.ir, ir))
+						g := &Generator{
+							config:      fixture.config,
+							coordinator: &lockedCoordinator{coordinator: nopCoordinator{}},
+							workers:     workers,
+						}
+						b.StartTimer()
+						_, err := g.GenerateIR(context.Background(), ir, mode.mode)
+						require.NoError(b, err, fixture.name)
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestIRUsesPrimitive(t *testing.T) {
+	// Every fixture that references the date primitive with the date
+	// encoding includes the core.Date type.
+	dirs, err := filepath.Glob(filepath.Join(testdataPath, "*"))
+	require.NoError(t, err)
+	for _, dir := range dirs {
+		for _, fixture := range readBenchmarkFixtures(t, dir) {
+			ir := new(fernir.IntermediateRepresentation)
+			require.NoError(t, json.Unmarshal(fixture.ir, ir))
+			_, err = os.Stat(filepath.Join(dir, fixture.name, "fixtures", "core", "date.go"))
+			assert.Equal(
+				t,
+				err == nil,
+				fixture.config.EncodingConfig.usesDateType() && irUsesPrimitive(ir, fernir.PrimitiveTypeDate),
+				filepath.Join(dir, fixture.name),
+			)
+		}
+	}
+}
+
+// benchmarkFixture is an IR in the testdata, along with its configuration.
+type benchmarkFixture struct {
+	name   string
+	config *Config
+	ir     []byte
+}
+
+// readBenchmarkFixtures reads every IR in the given testdata directory.
+func readBenchmarkFixtures(tb testing.TB, dir string) []*benchmarkFixture {
+	entries, err := os.ReadDir(dir)
+	require.NoError(tb, err)
+	var fixtures []*benchmarkFixture
+	for _, entry := range entries {
+		ir, err := os.ReadFile(filepath.Join(dir, entry.Name(), "ir.json"))
+		if os.IsNotExist(err) {
+			continue
+		}
+		require.NoError(tb, err)
+		content, err := os.ReadFile(filepath.Join(dir, entry.Name(), "config.json"))
+		require.NoError(tb, err)
+		var generatorConfig struct {
+			CustomConfig json.RawMessage `json:"customConfig"`
+		}
+		require.NoError(tb, json.Unmarshal(content, &generatorConfig))
+		// The custom configuration's options share their names with the *Config,
+		// except for the module and encoding.
+		config := new(Config)
+		if len(generatorConfig.CustomConfig) > 0 {
+			var customConfig struct {
+				Module   *ModuleConfig   `json:"module"`
+				Encoding *EncodingConfig `json:"encoding"`
+			}
+			require.NoError(tb, json.Unmarshal(generatorConfig.CustomConfig, config))
+			require.NoError(tb, json.Unmarshal(generatorConfig.CustomConfig, &customConfig))
+			config.ModuleConfig = customConfig.Module
+			config.EncodingConfig = customConfig.Encoding
+			if config.ImportPath == "" && config.ModuleConfig != nil {
+				config.ImportPath = config.ModuleConfig.Path
+			}
+		}
+		if config.ImportPath == "" {
+			// The import path is otherwise inferred from the output mode
+			// (e.g. the GitHub repository).
+			config.ImportPath = "github.com/example/" + entry.Name()
+		}
+		fixtures = append(
+			fixtures,
+			&benchmarkFixture{
+				name:   entry.Name(),
+				config: config,
+				ir:     ir,
+			},
+		)
+	}
+	return fixtures
+}
+
+// nopCoordinator discards every log.
+type nopCoordinator struct{}
+
+func (nopCoordinator) Log(generatorexec.LogLevel, string) error { return nil }
+
+func (nopCoordinator) GenerateReadme(*generatorexec.GenerateReadmeRequest) error { return nil }
@@ -0,0 +1,135 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/fern-api/fern-go/ir"
+)
+
+// This file generates the nil-safe getter methods for the fields of objects, unions,
+// and request types. Every getter returns the zero value if its receiver is nil, so
+// deeply nested values can be read without a chain of nil checks, e.g.
+//
+//	city := response.GetUser().GetAddress().GetCity()
+//
+// Optional values are dereferenced, so an optional string is returned as a string
+// (and an unset *core.Optional[T] is returned as the zero value of T). Objects and
+// unions are returned as pointers so that their own getters can be chained.
+
+// getter is a single field exposed with a GetX method.
+type getter struct {
+	field      string
+	returnType string
+	zero       string
+
+	// value formats the value returned from the field (e.g. "*%s").
+	value string
+
+	// checkField is set if the field must be non-nil before it's dereferenced.
+	checkField bool
+}
+
+// newGetter returns the *getter for a field of the given type.
+func (f *fileWriter) newGetter(field string, typeReference *ir.TypeReference, importPath string, includeOptionals bool) *getter {
+	goType := typeReferenceToGoType(typeReference, f.types, f.scope, f.baseImportPath, importPath, includeOptionals, f.encoding)
+	if typeReference.Container != nil && typeReference.Container.Optional != nil {
+		optional := typeReference.Container.Optional
+		valueType := typeReferenceToGoType(optional, f.types, f.scope, f.baseImportPath, importPath, includeOptionals, f.encoding)
+		if includeOptionals {
+			if strings.HasPrefix(valueType, "*") {
+				// Objects and unions are held by value in the core.Optional[T].
+				return &getter{field: field, returnType: valueType, zero: "nil", value: "&%s.Value", checkField: true}
+			}
+			return &getter{field: field, returnType: valueType, zero: defaultValueForTypeReference(optional, f.types, f.encoding), value: "%s.Value", checkField: true}
+		}
+		if strings.HasPrefix(goType, "*") && !strings.HasPrefix(valueType, "*") {
+			return &getter{field: field, returnType: valueType, zero: defaultValueForTypeReference(optional, f.types, f.encoding), value: "*%s", checkField: true}
+		}
+	}
+	return &getter{field: field, returnType: goType, zero: defaultValueForTypeReference(typeReference, f.types, f.encoding), value: "%s"}
+}
+
+// newValueGetter returns the *getter for a field of the given type, which is returned as-is.
+func newValueGetter(field string, goType string, zero string) *getter {
+	return &getter{field: field, returnType: goType, zero: zero, value: "%s"}
+}
+
+// writeGetters writes the GetX method for each of the given fields of typeName.
+//
+// A getter is skipped if its name is already used by another field (e.g. a
+// 'getName' property alongside a 'name' property).
+func (f *fileWriter) writeGetters(typeName string, getters []*getter) {
+	fields := make(map[string]struct{}, len(getters))
+	for _, getter := range getters {
+		fields[getter.field] = struct{}{}
+	}
+	receiver := typeNameToReceiver(typeName)
+	for _, getter := range getters {
+		if _, ok := fields["Get"+getter.field]; ok {
+			continue
+		}
+		field := receiver + "." + getter.field
+		f.P("func (", receiver, " *", typeName, ") Get", getter.field, "() ", getter.returnType, " {")
+		if getter.checkField {
+			f.P("if ", receiver, " == nil || ", field, " == nil {")
+		} else {
+			f.P("if ", receiver, " == nil {")
+		}
+		f.P("return ", getter.zero)
+		f.P("}")
+		f.P("return ", fmt.Sprintf(getter.value, field))
+		f.P("}")
+		f.P()
+	}
+}
+
+// objectGetters returns the getters for the properties of the given object.
+func (f *fileWriter) objectGetters(object *ir.ObjectTypeDeclaration, importPath string, includeOptionals bool) []*getter {
+	var getters []*getter
+	for _, extend := range object.Extends {
+		getters = append(getters, f.objectGetters(f.types[extend.TypeId].Shape.Object, importPath, includeOptionals)...)
+	}
+	for _, property := range object.Properties {
+		if property.ValueType.Container != nil && property.ValueType.Container.Literal != nil {
+			// Literals already have their own getter.
+			continue
+		}
+		getters = append(getters, f.newGetter(property.Name.Name.PascalCase.UnsafeName, property.ValueType, importPath, includeOptionals))
+	}
+	return getters
+}
+
+// unionGetters returns the getters for the discriminant, properties, and variants of
+// the given union.
+func (t *typeVisitor) unionGetters(union *ir.UnionTypeDeclaration, unknownName string) []*getter {
+	getters := []*getter{
+		newValueGetter(union.Discriminant.Name.PascalCase.UnsafeName, "string", `""`),
+	}
+	for _, extend := range union.Extends {
+		getters = append(getters, t.writer.objectGetters(t.writer.types[extend.TypeId].Shape.Object, t.importPath, false)...)
+	}
+	for _, property := range union.BaseProperties {
+		if property.ValueType.Container != nil && property.ValueType.Container.Literal != nil {
+			continue
+		}
+		getters = append(getters, t.writer.newGetter(property.Name.Name.PascalCase.UnsafeName, property.ValueType, t.importPath, false))
+	}
+	for _, unionType := range union.Types {
+		fieldName := unionType.DiscriminantValue.Name.PascalCase.UnsafeName
+		switch unionType.Shape.PropertiesType {
+		case "samePropertiesAsObject":
+			typeName := singleUnionTypePropertiesToGoType(unionType.Shape, t.writer.types, t.writer.scope, t.baseImportPath, t.importPath, t.writer.encoding)
+			getters = append(getters, newValueGetter(fieldName, typeName, "nil"))
+		case "singleProperty":
+			if unionType.Shape.SingleProperty.Type.Container != nil && unionType.Shape.SingleProperty.Type.Container.Literal != nil {
+				continue
+			}
+			getters = append(getters, t.writer.newGetter(fieldName, unionType.Shape.SingleProperty.Type, t.importPath, false))
+		}
+	}
+	if unknownName != "" {
+		getters = append(getters, newValueGetter(unknownName, "json.RawMessage", "nil"))
+	}
+	return getters
+}
@@ -0,0 +1,558 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/fern-api/fern-go/ir"
+)
+
+// This file generates unions represented with a sealed interface (see UnionEncodingInterface).
+//
+// Every variant is generated as a separate type that implements the union's interface, so
+// that only one variant can ever be set and the union can be used in a type switch, e.g.
+//
+//	type Shape interface {
+//	  isShape()
+//	}
+//
+//	type ShapeCircle struct {
+//	  Value *Circle
+//	}
+//
+//	func (*ShapeCircle) isShape() {}
+//
+// encoding/json can't deserialize interface values on its own, so every union is generated
+// alongside an UnmarshalShape function, and every type that holds a union (e.g. objects,
+// errors, and request types) uses it to deserialize the union's JSON.
+
+// writeInterfaceUnion writes a discriminated union represented with a sealed interface.
+func (t *typeVisitor) writeInterfaceUnion(union *ir.UnionTypeDeclaration) error {
+	var (
+		discriminantName = union.Discriminant.Name.PascalCase.UnsafeName
+		marker           = "is" + t.typeName
+	)
+
+	// Write the sealed interface.
+	t.writer.P("type ", t.typeName, " interface {")
+	t.writer.P(marker, "()")
+	t.writer.P("}")
+	t.writer.P()
+
+	// Every variant includes the union's extended and base properties.
+	var (
+		properties []*ir.ObjectProperty
+		literals   []*literal
+	)
+	for _, extend := range union.Extends {
+		extendedProperties, extendedLiterals := t.flattenObjectProperties(t.writer.types[extend.TypeId].Shape.Object)
+		properties = append(properties, extendedProperties...)
+		literals = append(literals, extendedLiterals...)
+	}
+	for _, property := range union.BaseProperties {
+		if property.ValueType.Container != nil && property.ValueType.Container.Literal != nil {
+			literals = append(literals, &literal{Name: property.Name.Name, Value: property.ValueType.Container.Literal})
+			continue
+		}
+		properties = append(properties, property)
+	}
+
+	for _, unionType := range union.Types {
+		variantName := t.typeName + unionType.DiscriminantValue.Name.PascalCase.UnsafeName
+		receiver := typeNameToReceiver(variantName)
+		typeName := singleUnionTypePropertiesToGoType(unionType.Shape, t.writer.types, t.writer.scope, t.baseImportPath, t.importPath, t.writer.encoding)
+		var (
+			singleProperty = unionType.Shape.SingleProperty
+			isLiteral      = singleProperty != nil && singleProperty.Type.Container != nil && singleProperty.Type.Container.Literal != nil
+			hasValue       = unionType.Shape.PropertiesType == "samePropertiesAsObject" || (singleProperty != nil && !isLiteral)
+		)
+
+		// Write the variant type definition.
+		t.writer.WriteDocs(unionType.Docs)
+		if len(properties) == 0 && !hasValue {
+			t.writer.P("type ", variantName, " struct{}")
+		} else {
+			t.writer.P("type ", variantName, " struct {")
+			for _, property := range properties {
+				t.writer.WriteDocs(property.Docs)
+				t.writer.P(property.Name.Name.PascalCase.UnsafeName, " ", typeReferenceToGoType(property.ValueType, t.writer.types, t.writer.scope, t.baseImportPath, t.importPath, false, t.writer.encoding), appendStructTags("", t.writer.extraStructTags(property.Name, property.ValueType)))
+			}
+			if hasValue {
+				t.writer.P("Value ", typeName)
+			}
+			t.writer.P("}")
+		}
+		t.writer.P()
+		t.writer.P("func (*", variantName, ") ", marker, "() {}")
+		t.writer.P()
+
+		// Implement the getter methods.
+		getters := make([]*getter, 0, len(properties)+1)
+		for _, property := range properties {
+			getters = append(getters, t.writer.newGetter(property.Name.Name.PascalCase.UnsafeName, property.ValueType, t.importPath, false))
+		}
+		switch {
+		case unionType.Shape.PropertiesType == "samePropertiesAsObject":
+			getters = append(getters, newValueGetter("Value", typeName, "nil"))
+		case singleProperty != nil && !isLiteral:
+			getters = append(getters, t.writer.newGetter("Value", singleProperty.Type, t.importPath, false))
+		}
+		t.writer.writeGetters(variantName, getters)
+
+		// Implement the json.Unmarshaler interface.
+		t.writer.P("func (", receiver, " *", variantName, ") UnmarshalJSON(data []byte) error {")
+		if len(properties) > 0 || (singleProperty != nil && !isLiteral) {
+			t.writer.P("var unmarshaler struct {")
+			for _, property := range properties {
+				t.writer.P(property.Name.Name.PascalCase.UnsafeName, " ", t.unmarshalerType(property.ValueType), jsonTagForType(property.Name.WireValue, property.ValueType, t.writer.types))
+			}
+			if singleProperty != nil && !isLiteral {
+				t.writer.P("Value ", t.unmarshalerType(singleProperty.Type), jsonTagForType(singleProperty.Name.WireValue, singleProperty.Type, t.writer.types))
+			}
+			t.writer.P("}")
+			t.writer.P("if err := json.Unmarshal(data, &unmarshaler); err != nil {")
+			t.writer.P("return err")
+			t.writer.P("}")
+			for _, property := range properties {
+				t.writeUnmarshalerAssignment(receiver, property.Name.Name.PascalCase.UnsafeName, property.ValueType)
+			}
+			if singleProperty != nil && !isLiteral {
+				t.writeUnmarshalerAssignment(receiver, "Value", singleProperty.Type)
+			}
+		}
+		if unionType.Shape.PropertiesType == "samePropertiesAsObject" {
+			t.writer.P("value := new(", strings.TrimPrefix(typeName, "*"), ")")
+			t.writer.P("if err := json.Unmarshal(data, value); err != nil {")
+			t.writer.P("return err")
+			t.writer.P("}")
+			t.writer.P(receiver, ".Value = value")
+		}
+		t.writer.P("return nil")
+		t.writer.P("}")
+		t.writer.P()
+
+		// Implement the json.Marshaler interface.
+		t.writer.P("func (", receiver, " *", variantName, ") MarshalJSON() ([]byte, error) {")
+		t.writer.P("var marshaler = struct {")
+		t.writer.P(discriminantName, " string `json:\"", union.Discriminant.WireValue, "\"`")
+		for _, property := range properties {
+			t.writer.P(property.Name.Name.PascalCase.UnsafeName, " ", typeReferenceToGoType(property.ValueType, t.writer.types, t.writer.scope, t.baseImportPath, t.importPath, false, t.writer.encoding), jsonTagForType(property.Name.WireValue, property.ValueType, t.writer.types))
+		}
+		for _, literal := range literals {
+			t.writer.P(literal.Name.PascalCase.UnsafeName, " ", literalToGoType(literal.Value), " `json:\"", literal.Name.OriginalName, "\"`")
+		}
+		switch {
+		case unionType.Shape.PropertiesType == "samePropertiesAsObject":
+			t.writer.P(typeName)
+		case singleProperty != nil:
+			t.writer.P("Value ", typeName, jsonTagForType(singleProperty.Name.WireValue, singleProperty.Type, t.writer.types))
+		}
+		t.writer.P("}{")
+		t.writer.P(discriminantName, ": \"", unionType.DiscriminantValue.Name.OriginalName, "\",")
+		for _, property := range properties {
+			t.writer.P(property.Name.Name.PascalCase.UnsafeName, ": ", receiver, ".", property.Name.Name.PascalCase.UnsafeName, ",")
+		}
+		for _, literal := range literals {
+			t.writer.P(literal.Name.PascalCase.UnsafeName, ": ", literalToValue(literal.Value), ",")
+		}
+		switch {
+		case unionType.Shape.PropertiesType == "samePropertiesAsObject":
+			// If the object is embedded, the field name is equivalent to
+			// the object's name, without any leading pointers.
+			t.writer.P(typeNameToFieldName(typeName), ": ", receiver, ".Value,")
+		case isLiteral:
+			t.writer.P("Value: ", literalToValue(singleProperty.Type.Container.Literal), ",")
+		case singleProperty != nil:
+			t.writer.P("Value: ", receiver, ".Value,")
+		}
+		t.writer.P("}")
+		t.writer.P("return json.Marshal(marshaler)")
+		t.writer.P("}")
+		t.writer.P()
+	}
+
+	// Forward-compatible unions preserve the raw JSON of any variant that
+	// isn't recognized so that it can be re-serialized as-is.
+	var unknownVariantName string
+	if t.enableForwardCompatibility {
+		unknownVariantName = t.typeName + unknownUnionVariantName(union)
+		receiver := typeNameToReceiver(unknownVariantName)
+		t.writer.P("// ", unknownVariantName, " is a variant that isn't recognized by this version of the SDK.")
+		t.writer.P("type ", unknownVariantName, " struct {")
+		t.writer.P(discriminantName, " string")
+		t.writer.P("Raw json.RawMessage")
+		t.writer.P("}")
+		t.writer.P()
+		t.writer.P("func (*", unknownVariantName, ") ", marker, "() {}")
+		t.writer.P()
+		t.writer.P("func (", receiver, " *", unknownVariantName, ") MarshalJSON() ([]byte, error) {")
+		t.writer.P("if ", receiver, ".Raw == nil {")
+		t.writer.P("return nil, fmt.Errorf(\"invalid type %s in %T\", ", receiver, ".", discriminantName, ", ", receiver, ")")
+		t.writer.P("}")
+		t.writer.P("return ", receiver, ".Raw, nil")
+		t.writer.P("}")
+		t.writer.P()
+	}
+
+	// Generate the function used to deserialize the union.
+	t.writer.P("// Unmarshal", t.typeName, " deserializes the given JSON into the ", t.typeName, " variant")
+	t.writer.P("// identified by its \"", union.Discriminant.WireValue, "\" discriminant.")
+	t.writer.P("func Unmarshal", t.typeName, "(data []byte) (", t.typeName, ", error) {")
+	t.writer.P("if len(data) == 0 || string(data) == \"null\" {")
+	t.writer.P("return nil, nil")
+	t.writer.P("}")
+	t.writer.P("var unmarshaler struct {")
+	t.writer.P(discriminantName, " string `json:\"", union.Discriminant.WireValue, "\"`")
+	t.writer.P("}")
+	t.writer.P("if err := json.Unmarshal(data, &unmarshaler); err != nil {")
+	t.writer.P("return nil, err")
+	t.writer.P("}")
+	t.writer.P("var value ", t.typeName)
+	t.writer.P("switch unmarshaler.", discriminantName, " {")
+	for _, unionType := range union.Types {
+		t.writer.P("case \"", unionType.DiscriminantValue.Name.OriginalName, "\":")
+		t.writer.P("value = new(", t.typeName, unionType.DiscriminantValue.Name.PascalCase.UnsafeName, ")")
+	}
+	t.writer.P("default:")
+	if unknownVariantName != "" {
+		// The data must be copied because the caller is allowed to reuse it.
+		t.writer.P("return &", unknownVariantName, "{", discriminantName, ": unmarshaler.", discriminantName, ", Raw: append(json.RawMessage(nil), data...)}, nil")
+	} else {
+		t.writer.P("return nil, fmt.Errorf(\"invalid type %s in ", t.typeName, "\", unmarshaler.", discriminantName, ")")
+	}
+	t.writer.P("}")
+	t.writer.P("if err := json.Unmarshal(data, value); err != nil {")
+	t.writer.P("return nil, err")
+	t.writer.P("}")
+	t.writer.P("return value, nil")
+	t.writer.P("}")
+	t.writer.P()
+
+	return nil
+}
+
+// writeInterfaceUndiscriminatedUnion writes an undiscriminated union represented
+// with a sealed interface.
+func (t *typeVisitor) writeInterfaceUndiscriminatedUnion(union *ir.UndiscriminatedUnionTypeDeclaration) error {
+	marker := "is" + t.typeName
+
+	// Write the sealed interface.
+	t.writer.P("type ", t.typeName, " interface {")
+	t.writer.P(marker, "()")
+	t.writer.P("}")
+	t.writer.P()
+
+	variantNames := make([]string, len(union.Members))
+	for i, member := range union.Members {
+		variantNames[i] = t.typeName + strings.Title(typeReferenceToUndiscriminatedUnionField(member.Type, t.writer.types))
+		receiver := typeNameToReceiver(variantNames[i])
+		isLiteral := member.Type.Container != nil && member.Type.Container.Literal != nil
+
+		// Write the variant type definition.
+		t.writer.WriteDocs(member.Docs)
+		if isLiteral {
+			t.writer.P("type ", variantNames[i], " struct{}")
+		} else {
+			t.writer.P("type ", variantNames[i], " struct {")
+			t.writer.P("Value ", typeReferenceToGoType(member.Type, t.writer.types, t.writer.scope, t.baseImportPath, t.importPath, false, t.writer.encoding))
+			t.writer.P("}")
+		}
+		t.writer.P()
+		t.writer.P("func (*", variantNames[i], ") ", marker, "() {}")
+		t.writer.P()
+		if !isLiteral {
+			t.writer.writeGetters(variantNames[i], []*getter{t.writer.newGetter("Value", member.Type, t.importPath, false)})
+		}
+
+		// Implement the json.Marshaler interface.
+		t.writer.P("func (", receiver, " *", variantNames[i], ") MarshalJSON() ([]byte, error) {")
+		if isLiteral {
+			t.writer.P("return json.Marshal(", literalToValue(member.Type.Container.Literal), ")")
+		} else {
+			t.writer.P("return json.Marshal(", receiver, ".Value)")
+		}
+		t.writer.P("}")
+		t.writer.P()
+	}
+
+	// Generate the function used to deserialize the union.
+	t.writer.P("// Unmarshal", t.typeName, " deserializes the given JSON into the first ", t.typeName)
+	t.writer.P("// member it's compatible with.")
+	t.writer.P("func Unmarshal", t.typeName, "(data []byte) (", t.typeName, ", error) {")
+	t.writer.P("if len(data) == 0 || string(data) == \"null\" {")
+	t.writer.P("return nil, nil")
+	t.writer.P("}")
+	if t.enableStrictUndiscriminatedUnions {
+		t.warnAmbiguousUndiscriminatedUnionMembers(union)
+		t.writer.P("decoder := core.NewUnionDecoder(data)")
+	}
+	for i, member := range union.Members {
+		var (
+			variable = "value" + strings.TrimPrefix(variantNames[i], t.typeName)
+			value    = typeReferenceToGoType(member.Type, t.writer.types, t.writer.scope, t.baseImportPath, t.importPath, false, t.writer.encoding)
+		)
+		if t.enableStrictUndiscriminatedUnions {
+			isLiteral := member.Type.Container != nil && member.Type.Container.Literal != nil
+			t.writeStrictUndiscriminatedUnionMember(
+				member,
+				firstLetterToLower(strings.TrimPrefix(variantNames[i], t.typeName)),
+				variable,
+				value,
+				func(value string) {
+					if isLiteral {
+						t.writer.P("return &", variantNames[i], "{}, nil")
+						return
+					}
+					t.writer.P("return &", variantNames[i], "{Value: ", value, "}, nil")
+				},
+			)
+			continue
+		}
+		if containsInterfaceUnion(member.Type, t.writer.types, t.writer.encoding) {
+			t.writer.P("if ", variable, ", err := func() (", value, ", error) {")
+			t.writer.P("var value ", value)
+			t.writer.writeInterfaceUnionDecoder("value", "data", member.Type, t.importPath, "return nil, err")
+			t.writer.P("return value, nil")
+			t.writer.P("}(); err == nil {")
+			t.writer.P("return &", variantNames[i], "{Value: ", variable, "}, nil")
+			t.writer.P("}")
+			continue
+		}
+		if member.Type.Named != nil && isPointer(t.writer.types[member.Type.Named.TypeId], t.writer.encoding) {
+			t.writer.P(variable, " := new(", strings.TrimLeft(value, "*"), ")")
+		} else {
+			t.writer.P("var ", variable, " ", value)
+		}
+		t.writer.P("if err := json.Unmarshal(data, &", variable, "); err == nil {")
+		if member.Type.Container != nil && member.Type.Container.Literal != nil {
+			// If the undiscriminated union specifies a literal, it will only
+			// succeed if the literal matches exactly.
+			t.writer.P("if ", variable, " == ", literalToValue(member.Type.Container.Literal), " {")
+			t.writer.P("return &", variantNames[i], "{}, nil")
+			t.writer.P("}")
+			t.writer.P("}")
+			continue
+		}
+		t.writer.P("return &", variantNames[i], "{Value: ", variable, "}, nil")
+		t.writer.P("}")
+	}
+	if t.enableStrictUndiscriminatedUnions {
+		t.writer.P("return nil, decoder.Error(\"", t.typeName, "\")")
+	} else {
+		t.writer.P("return nil, fmt.Errorf(\"%s cannot be deserialized as a ", t.typeName, "\", data)")
+	}
+	t.writer.P("}")
+	t.writer.P()
+
+	return nil
+}
+
+// unmarshalerType returns the type of the given property in an unmarshaler struct. Values
+// that hold a union represented with an interface are captured with a json.RawMessage so
+// that they can be deserialized separately (see writeUnmarshalerAssignment).
+func (t *typeVisitor) unmarshalerType(typeReference *ir.TypeReference) string {
+	if containsInterfaceUnion(typeReference, t.writer.types, t.writer.encoding) {
+		return "json.RawMessage"
+	}
+	return typeReferenceToGoType(typeReference, t.writer.types, t.writer.scope, t.baseImportPath, t.importPath, false, t.writer.encoding)
+}
+
+// writeUnmarshalerAssignment assigns the given field from the unmarshaler struct
+// written with unmarshalerType.
+func (t *typeVisitor) writeUnmarshalerAssignment(receiver string, field string, typeReference *ir.TypeReference) {
+	if !containsInterfaceUnion(typeReference, t.writer.types, t.writer.encoding) {
+		t.writer.P(receiver, ".", field, " = unmarshaler.", field)
+		return
+	}
+	t.writer.writeInterfaceUnionPropertyDecoder(receiver+"."+field, "unmarshaler."+field, typeReference, t.importPath, false)
+}
+
+// writeInterfaceUnionPropertyDecoder writes the statements that deserialize the raw JSON
+// of a property into the given field, if the property was specified. Optional properties
+// represented with *core.Optional[T] are set to null or deserialized into their value.
+func (f *fileWriter) writeInterfaceUnionPropertyDecoder(
+	field string,
+	raw string,
+	typeReference *ir.TypeReference,
+	importPath string,
+	usesOptionalType bool,
+) {
+	f.P("if ", raw, " != nil {")
+	if usesOptionalType {
+		optionalType := typeReferenceToGoType(typeReference, f.types, f.scope, f.baseImportPath, importPath, true, f.encoding)
+		f.P(field, " = new(", strings.TrimPrefix(optionalType, "*"), ")")
+		f.P("if string(", raw, ") == \"null\" {")
+		f.P(field, ".Null = true")
+		f.P("} else {")
+		f.writeInterfaceUnionDecoder(field+".Value", raw, typeReference.Container.Optional, importPath, "return err")
+		f.P("}")
+	} else {
+		f.writeInterfaceUnionDecoder(field, raw, typeReference, importPath, "return err")
+	}
+	f.P("}")
+}
+
+// writeInterfaceUnionDecoder writes the statements that deserialize the JSON held by the
+// raw expression into the target expression, which has the Go type of the given type
+// reference. The type must contain a union represented with an interface (see
+// containsInterfaceUnion), which is deserialized with its UnmarshalX function.
+//
+// The onError statement is written for every error that's encountered (e.g. 'return err').
+func (f *fileWriter) writeInterfaceUnionDecoder(
+	target string,
+	raw string,
+	typeReference *ir.TypeReference,
+	importPath string,
+	onError string,
+) {
+	f.writeInterfaceUnionDecoderAtDepth(target, raw, typeReference, importPath, onError, 0)
+}
+
+func (f *fileWriter) writeInterfaceUnionDecoderAtDepth(
+	target string,
+	raw string,
+	typeReference *ir.TypeReference,
+	importPath string,
+	onError string,
+	depth int,
+) {
+	if typeReference.Named != nil {
+		typeDeclaration := f.types[typeReference.Named.TypeId]
+		if typeDeclaration.Shape.Alias != nil {
+			// Aliases are equivalent to the type they refer to.
+			f.writeInterfaceUnionDecoderAtDepth(target, raw, typeDeclaration.Shape.Alias.AliasOf, importPath, onError, depth)
+			return
+		}
+		var (
+			value    = fmt.Sprintf("value%d", depth)
+			goType   = typeReferenceToGoType(typeReference, f.types, f.scope, f.baseImportPath, importPath, false, f.encoding)
+			separate = strings.LastIndex(goType, ".") + 1
+		)
+		f.P(value, ", err := ", goType[:separate], "Unmarshal", goType[separate:], "(", raw, ")")
+		f.P("if err != nil {")
+		f.P(onError)
+		f.P("}")
+		f.P(target, " = ", value)
+		return
+	}
+	container := typeReference.Container
+	switch {
+	case container.Optional != nil:
+		// Optional unions are already nil-able, and optional containers
+		// deserialize null values as nil.
+		f.writeInterfaceUnionDecoderAtDepth(target, raw, container.Optional, importPath, onError, depth)
+	case container.List != nil, container.Set != nil:
+		element := container.List
+		if element == nil {
+			// Sets of unions are always represented with a plain slice.
+			element = container.Set
+		}
+		var (
+			raws   = fmt.Sprintf("raws%d", depth)
+			index  = fmt.Sprintf("i%d", depth)
+			elem   = fmt.Sprintf("raw%d", depth)
+			goType = typeReferenceToGoType(typeReference, f.types, f.scope, f.baseImportPath, importPath, false, f.encoding)
+		)
+		f.P("var ", raws, " []json.RawMessage")
+		f.P("if err := json.Unmarshal(", raw, ", &", raws, "); err != nil {")
+		f.P(onError)
+		f.P("}")
+		f.P("if ", raws, " != nil {")
+		f.P(target, " = make(", goType, ", len(", raws, "))")
+		f.P("for ", index, ", ", elem, " := range ", raws, " {")
+		f.writeInterfaceUnionDecoderAtDepth(target+"["+index+"]", elem, element, importPath, onError, depth+1)
+		f.P("}")
+		f.P("}")
+	case container.Map != nil:
+		var (
+			raws    = fmt.Sprintf("raws%d", depth)
+			key     = fmt.Sprintf("key%d", depth)
+			elem    = fmt.Sprintf("raw%d", depth)
+			goType  = typeReferenceToGoType(typeReference, f.types, f.scope, f.baseImportPath, importPath, false, f.encoding)
+			keyType = typeReferenceToGoType(container.Map.KeyType, f.types, f.scope, f.baseImportPath, importPath, false, f.encoding)
+		)
+		f.P("var ", raws, " map[", keyType, "]json.RawMessage")
+		f.P("if err := json.Unmarshal(", raw, ", &", raws, "); err != nil {")
+		f.P(onError)
+		f.P("}")
+		f.P("if ", raws, " != nil {")
+		f.P(target, " = make(", goType, ", len(", raws, "))")
+		f.P("for ", key, ", ", elem, " := range ", raws, " {")
+		f.writeInterfaceUnionDecoderAtDepth(target+"["+key+"]", elem, container.Map.ValueType, importPath, onError, depth+1)
+		f.P("}")
+		f.P("}")
+	}
+}
+
+// isInterfaceUnion returns true if the given type reference is a union
+// represented with an interface (see UnionEncodingInterface).
+func isInterfaceUnion(typeReference *ir.TypeReference, types map[ir.TypeId]*ir.TypeDeclaration, encoding *EncodingConfig) bool {
+	if typeReference.Named == nil || !encoding.usesInterfaceUnions() {
+		return false
+	}
+	typeDeclaration := types[typeReference.Named.TypeId]
+	switch typeDeclaration.Shape.Type {
+	case "alias":
+		return isInterfaceUnion(typeDeclaration.Shape.Alias.AliasOf, types, encoding)
+	case "union", "undiscriminatedUnion":
+		return true
+	}
+	return false
+}
+
+// containsInterfaceUnion returns true if the given type reference is, or holds (e.g. in
+// a list or map), a union represented with an interface. Objects aren't included because
+// they deserialize their own properties.
+func containsInterfaceUnion(typeReference *ir.TypeReference, types map[ir.TypeId]*ir.TypeDeclaration, encoding *EncodingConfig) bool {
+	if !encoding.usesInterfaceUnions() {
+		return false
+	}
+	if typeReference.Named != nil {
+		if typeDeclaration := types[typeReference.Named.TypeId]; typeDeclaration.Shape.Alias != nil {
+			return containsInterfaceUnion(typeDeclaration.Shape.Alias.AliasOf, types, encoding)
+		}
+		return isInterfaceUnion(typeReference, types, encoding)
+	}
+	container := typeReference.Container
+	if container == nil {
+		return false
+	}
+	switch {
+	case container.List != nil:
+		return containsInterfaceUnion(container.List, types, encoding)
+	case container.Set != nil:
+		return containsInterfaceUnion(container.Set, types, encoding)
+	case container.Optional != nil:
+		return containsInterfaceUnion(container.Optional, types, encoding)
+	case container.Map != nil:
+		return containsInterfaceUnion(container.Map.ValueType, types, encoding)
+	}
+	return false
+}
+
+// requestBodyInterfaceUnionProperties returns the in-lined request body properties that
+// contain a union represented with an interface (see containsInterfaceUnion).
+func requestBodyInterfaceUnionProperties(
+	requestBody *ir.HttpRequestBody,
+	types map[ir.TypeId]*ir.TypeDeclaration,
+	encoding *EncodingConfig,
+) []*ir.InlinedRequestBodyProperty {
+	var properties []*ir.InlinedRequestBodyProperty
+	switch {
+	case requestBody.InlinedRequestBody != nil:
+		properties = requestBody.InlinedRequestBody.Properties
+	case requestBody.FileUpload != nil:
+		for _, property := range requestBody.FileUpload.Properties {
+			if property.BodyProperty != nil {
+				properties = append(properties, property.BodyProperty)
+			}
+		}
+	}
+	var unionProperties []*ir.InlinedRequestBodyProperty
+	for _, property := range properties {
+		if containsInterfaceUnion(property.ValueType, types, encoding) {
+			unionProperties = append(unionProperties, property)
+		}
+	}
+	return unionProperties
+}
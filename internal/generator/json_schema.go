@@ -0,0 +1,391 @@
+package generator
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/fern-api/fern-go/ir"
+)
+
+// This file generates a JSON Schema (draft 2020-12) for every type declaration (see
+// enableJSONSchema). Each schema is written to its own file in the schemas package
+// (e.g. schemas/imdb.Movie.json), and refers to the other types with a relative $ref.
+//
+// The schemas describe the API contract as the types encode it, so required properties,
+// literals, and enum values are all constrained even though the types don't enforce them
+// when they're deserialized. Everything else the types accept is allowed:
+//
+//   - Unknown properties are allowed, since they're retained in the raw JSON.
+//   - Optional properties accept null, which is distinguished from an omitted value with
+//     enableOptionalTypes.
+//   - Undiscriminated unions accept any of their members, and object members don't accept
+//     unknown properties with enableStrictUndiscriminatedUnions.
+//   - Enums and unions accept unknown values with enableForwardCompatibility.
+
+// jsonSchemaDialect is the $schema of every generated schema.
+const jsonSchemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+// jsonSchema is the subset of JSON Schema used to describe the generated types.
+type jsonSchema struct {
+	Schema                string                `json:"$schema,omitempty"`
+	Title                 string                `json:"title,omitempty"`
+	Description           string                `json:"description,omitempty"`
+	Ref                   string                `json:"$ref,omitempty"`
+	Type                  string                `json:"type,omitempty"`
+	Format                string                `json:"format,omitempty"`
+	ContentEncoding       string                `json:"contentEncoding,omitempty"`
+	Pattern               string                `json:"pattern,omitempty"`
+	Const                 json.RawMessage       `json:"const,omitempty"`
+	Enum                  []string              `json:"enum,omitempty"`
+	Not                   *jsonSchema           `json:"not,omitempty"`
+	Items                 *jsonSchema           `json:"items,omitempty"`
+	Properties            *jsonSchemaProperties `json:"properties,omitempty"`
+	PropertyNames         *jsonSchema           `json:"propertyNames,omitempty"`
+	AdditionalProperties  *jsonSchema           `json:"additionalProperties,omitempty"`
+	UnevaluatedProperties *bool                 `json:"unevaluatedProperties,omitempty"`
+	Required              []string              `json:"required,omitempty"`
+	AllOf                 []*jsonSchema         `json:"allOf,omitempty"`
+	AnyOf                 []*jsonSchema         `json:"anyOf,omitempty"`
+	OneOf                 []*jsonSchema         `json:"oneOf,omitempty"`
+}
+
+// jsonSchemaProperties are the properties of an object schema, which are
+// serialized in the order they're declared.
+type jsonSchemaProperties struct {
+	names   []string
+	schemas map[string]*jsonSchema
+}
+
+// add adds the property with the given name, and records whether or not it's required.
+func (j *jsonSchema) add(name string, schema *jsonSchema, required bool) {
+	if j.Properties == nil {
+		j.Properties = &jsonSchemaProperties{schemas: make(map[string]*jsonSchema)}
+	}
+	if _, ok := j.Properties.schemas[name]; !ok {
+		j.Properties.names = append(j.Properties.names, name)
+	}
+	j.Properties.schemas[name] = schema
+	if required {
+		j.Required = append(j.Required, name)
+	}
+}
+
+func (j *jsonSchemaProperties) MarshalJSON() ([]byte, error) {
+	var buffer strings.Builder
+	buffer.WriteString("{")
+	for i, name := range j.names {
+		if i > 0 {
+			buffer.WriteString(",")
+		}
+		key, err := json.Marshal(name)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(j.schemas[name])
+		if err != nil {
+			return nil, err
+		}
+		buffer.Write(key)
+		buffer.WriteString(":")
+		buffer.Write(value)
+	}
+	buffer.WriteString("}")
+	return []byte(buffer.String()), nil
+}
+
+// jsonSchemaWriter generates the JSON Schema for each type declaration.
+type jsonSchemaWriter struct {
+	types    map[ir.TypeId]*ir.TypeDeclaration
+	encoding *EncodingConfig
+
+	enableForwardCompatibility        bool
+	enableStrictUndiscriminatedUnions bool
+}
+
+// jsonSchemaFilename returns the filename of the schema for the given type, which is
+// qualified by its package (e.g. imdb.Movie.json).
+func jsonSchemaFilename(name *ir.DeclaredTypeName) string {
+	packagePath := fernFilepathToImportPath("", name.FernFilepath)
+	elements := append(strings.Split(packagePath, "/"), name.Name.PascalCase.UnsafeName)
+	return strings.TrimPrefix(strings.Join(elements, "."), ".") + ".json"
+}
+
+// generateJSONSchemas generates the schema files for all of the given types, along with
+// the Go file that embeds them.
+func (g *Generator) generateJSONSchemas(types map[ir.TypeId]*ir.TypeDeclaration, fileInfo *fileInfo) ([]*File, error) {
+	if len(types) == 0 {
+		return nil, nil
+	}
+	writer := &jsonSchemaWriter{
+		types:                             types,
+		encoding:                          g.config.EncodingConfig,
+		enableForwardCompatibility:        g.config.EnableForwardCompatibility,
+		enableStrictUndiscriminatedUnions: g.config.EnableStrictUndiscriminatedUnions,
+	}
+	directory := path.Dir(fileInfo.filename)
+
+	filenames := make([]string, 0, len(types))
+	schemas := make(map[string]*jsonSchema, len(types))
+	for _, typeDeclaration := range types {
+		schema, err := writer.schemaForTypeDeclaration(typeDeclaration)
+		if err != nil {
+			return nil, err
+		}
+		filename := jsonSchemaFilename(typeDeclaration.Name)
+		filenames = append(filenames, filename)
+		schemas[filename] = schema
+	}
+	sort.Strings(filenames)
+
+	files := make([]*File, 0, len(filenames)+1)
+	for _, filename := range filenames {
+		content, err := json.MarshalIndent(schemas[filename], "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, NewFile(g.coordinator, path.Join(directory, filename), append(content, '\n')))
+	}
+
+	fileWriter := newFileWriter(fileInfo.filename, fileInfo.packageName, g.config, nil, nil, g.coordinator)
+	fileWriter.WriteSchemas()
+	file, err := fileWriter.File()
+	if err != nil {
+		return nil, err
+	}
+	return append(files, file), nil
+}
+
+// WriteSchemas writes the Go file that embeds the JSON Schema files in the same directory.
+func (f *fileWriter) WriteSchemas() {
+	embed := f.scope.AddImport("embed")
+	f.P("// FS contains the JSON Schema for every type, each of which is named")
+	f.P("// after the type and the package it's defined in (e.g. imdb.Movie.json).")
+	f.P("//")
+	f.P("//go:embed *.json")
+	f.P("var FS ", embed, ".FS")
+	f.P()
+	f.P("// Schema returns the JSON Schema for the type with the given name, which")
+	f.P("// is qualified by the package it's defined in (e.g. imdb.Movie).")
+	f.P("func Schema(name string) ([]byte, error) {")
+	f.P(`return FS.ReadFile(name + ".json")`)
+	f.P("}")
+}
+
+// schemaForTypeDeclaration returns the root schema for the given type declaration.
+func (j *jsonSchemaWriter) schemaForTypeDeclaration(typeDeclaration *ir.TypeDeclaration) (*jsonSchema, error) {
+	var (
+		schema *jsonSchema
+		err    error
+	)
+	switch shape := typeDeclaration.Shape; shape.Type {
+	case "alias":
+		schema = j.schemaForTypeReference(shape.Alias.AliasOf)
+	case "enum":
+		schema = j.schemaForEnum(shape.Enum)
+	case "object":
+		schema = j.schemaForObject(shape.Object)
+	case "union":
+		schema = j.schemaForUnion(shape.Union)
+	case "undiscriminatedUnion":
+		schema = j.schemaForUndiscriminatedUnion(shape.UndiscriminatedUnion)
+	default:
+		err = fmt.Errorf("%s has an unrecognized shape %q", typeDeclaration.Name.TypeId, shape.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+	root := &jsonSchema{
+		Schema: jsonSchemaDialect,
+		Title:  typeDeclaration.Name.Name.PascalCase.UnsafeName,
+	}
+	if typeDeclaration.Docs != nil {
+		root.Description = *typeDeclaration.Docs
+	}
+	if schema.Ref != "" {
+		// A $ref can't be merged with the root schema's
+		// other keywords, so it's nested instead.
+		root.AllOf = []*jsonSchema{schema}
+		return root, nil
+	}
+	schema.Schema = root.Schema
+	schema.Title = root.Title
+	schema.Description = root.Description
+	return schema, nil
+}
+
+func (j *jsonSchemaWriter) schemaForEnum(enum *ir.EnumTypeDeclaration) *jsonSchema {
+	values := make([]string, 0, len(enum.Values))
+	for _, value := range enum.Values {
+		values = append(values, value.Name.WireValue)
+	}
+	if j.enableForwardCompatibility {
+		// Unknown values are accepted, but the known values are still listed.
+		return &jsonSchema{
+			AnyOf: []*jsonSchema{
+				{Enum: values},
+				{Type: "string"},
+			},
+		}
+	}
+	return &jsonSchema{Type: "string", Enum: values}
+}
+
+func (j *jsonSchemaWriter) schemaForObject(object *ir.ObjectTypeDeclaration) *jsonSchema {
+	schema := &jsonSchema{Type: "object"}
+	for _, extend := range object.Extends {
+		schema.AllOf = append(schema.AllOf, &jsonSchema{Ref: jsonSchemaFilename(extend)})
+	}
+	j.addProperties(schema, object.Properties)
+	return schema
+}
+
+// addProperties adds the given object properties to the schema.
+func (j *jsonSchemaWriter) addProperties(schema *jsonSchema, properties []*ir.ObjectProperty) {
+	for _, property := range properties {
+		schema.add(property.Name.WireValue, j.schemaForTypeReference(property.ValueType), isRequired(property.ValueType))
+	}
+}
+
+func (j *jsonSchemaWriter) schemaForUnion(union *ir.UnionTypeDeclaration) *jsonSchema {
+	discriminant := union.Discriminant.WireValue
+	schema := &jsonSchema{Type: "object"}
+	knownValues := make([]string, 0, len(union.Types))
+	for _, unionType := range union.Types {
+		variant := &jsonSchema{}
+		for _, extend := range union.Extends {
+			variant.AllOf = append(variant.AllOf, &jsonSchema{Ref: jsonSchemaFilename(extend)})
+		}
+		variant.add(discriminant, &jsonSchema{Const: jsonLiteral(unionType.DiscriminantValue.WireValue)}, true)
+		j.addProperties(variant, union.BaseProperties)
+		switch unionType.Shape.PropertiesType {
+		case "samePropertiesAsObject":
+			variant.AllOf = append(variant.AllOf, &jsonSchema{Ref: jsonSchemaFilename(unionType.Shape.SamePropertiesAsObject)})
+		case "singleProperty":
+			singleProperty := unionType.Shape.SingleProperty
+			variant.add(singleProperty.Name.WireValue, j.schemaForTypeReference(singleProperty.Type), isRequired(singleProperty.Type))
+		}
+		if unionType.Docs != nil {
+			variant.Description = *unionType.Docs
+		}
+		schema.OneOf = append(schema.OneOf, variant)
+		knownValues = append(knownValues, unionType.DiscriminantValue.WireValue)
+	}
+	if j.enableForwardCompatibility {
+		// Variants added after the types were generated are retained as raw JSON.
+		unknown := &jsonSchema{}
+		unknown.add(discriminant, &jsonSchema{Type: "string", Not: &jsonSchema{Enum: knownValues}}, true)
+		schema.OneOf = append(schema.OneOf, unknown)
+	}
+	return schema
+}
+
+func (j *jsonSchemaWriter) schemaForUndiscriminatedUnion(union *ir.UndiscriminatedUnionTypeDeclaration) *jsonSchema {
+	schema := new(jsonSchema)
+	for _, member := range union.Members {
+		memberSchema := j.schemaForTypeReference(member.Type)
+		if j.enableStrictUndiscriminatedUnions && member.Type.Named != nil && j.types[member.Type.Named.TypeId].Shape.Object != nil {
+			// Strict unions reject the unknown properties of object members.
+			unevaluatedProperties := false
+			memberSchema.UnevaluatedProperties = &unevaluatedProperties
+		}
+		if member.Docs != nil {
+			memberSchema.Description = *member.Docs
+		}
+		schema.AnyOf = append(schema.AnyOf, memberSchema)
+	}
+	return schema
+}
+
+// schemaForTypeReference returns the schema for the given type reference, where named
+// types refer to the schema file generated for them.
+func (j *jsonSchemaWriter) schemaForTypeReference(typeReference *ir.TypeReference) *jsonSchema {
+	switch typeReference.Type {
+	case "named":
+		return &jsonSchema{Ref: jsonSchemaFilename(typeReference.Named)}
+	case "primitive":
+		return j.schemaForPrimitive(typeReference.Primitive)
+	case "container":
+		return j.schemaForContainer(typeReference.Container)
+	}
+	// Unknown values accept anything.
+	return new(jsonSchema)
+}
+
+func (j *jsonSchemaWriter) schemaForContainer(container *ir.ContainerType) *jsonSchema {
+	switch container.Type {
+	case "list":
+		return &jsonSchema{Type: "array", Items: j.schemaForTypeReference(container.List)}
+	case "set":
+		// Duplicate values are accepted (and removed by core.Set).
+		return &jsonSchema{Type: "array", Items: j.schemaForTypeReference(container.Set)}
+	case "map":
+		schema := &jsonSchema{Type: "object", AdditionalProperties: j.schemaForTypeReference(container.Map.ValueType)}
+		if container.Map.KeyType.Named != nil {
+			schema.PropertyNames = j.schemaForTypeReference(container.Map.KeyType)
+		}
+		return schema
+	case "optional":
+		return &jsonSchema{AnyOf: []*jsonSchema{j.schemaForTypeReference(container.Optional), {Type: "null"}}}
+	case "literal":
+		switch container.Literal.Type {
+		case "boolean":
+			return &jsonSchema{Const: jsonLiteral(container.Literal.Boolean)}
+		default:
+			return &jsonSchema{Const: jsonLiteral(container.Literal.String)}
+		}
+	}
+	return new(jsonSchema)
+}
+
+func (j *jsonSchemaWriter) schemaForPrimitive(primitive ir.PrimitiveType) *jsonSchema {
+	switch primitive {
+	case ir.PrimitiveTypeInteger:
+		return &jsonSchema{Type: "integer"}
+	case ir.PrimitiveTypeLong:
+		if j.encoding != nil && j.encoding.Long == LongEncodingString {
+			// core.Int64String accepts both strings and numbers.
+			return &jsonSchema{AnyOf: []*jsonSchema{{Type: "string", Pattern: "^-?[0-9]+$"}, {Type: "integer"}}}
+		}
+		return &jsonSchema{Type: "integer"}
+	case ir.PrimitiveTypeDouble:
+		return &jsonSchema{Type: "number"}
+	case ir.PrimitiveTypeString:
+		return &jsonSchema{Type: "string"}
+	case ir.PrimitiveTypeBoolean:
+		return &jsonSchema{Type: "boolean"}
+	case ir.PrimitiveTypeDateTime:
+		if j.encoding != nil && (j.encoding.DateTime == DateTimeEncodingUnixSeconds || j.encoding.DateTime == DateTimeEncodingUnixMillis) {
+			return &jsonSchema{Type: "number"}
+		}
+		return &jsonSchema{Type: "string", Format: "date-time"}
+	case ir.PrimitiveTypeDate:
+		return &jsonSchema{Type: "string", Format: "date"}
+	case ir.PrimitiveTypeUuid:
+		return &jsonSchema{Type: "string", Format: "uuid"}
+	case ir.PrimitiveTypeBase64:
+		return &jsonSchema{Type: "string", ContentEncoding: "base64"}
+	}
+	return new(jsonSchema)
+}
+
+// isRequired returns true if a property of the given type must be specified, i.e. it
+// isn't optional or unknown, and it isn't a literal (which is always set by the generated
+// types).
+func isRequired(typeReference *ir.TypeReference) bool {
+	if typeReference.Type == "unknown" {
+		return false
+	}
+	if typeReference.Container == nil {
+		return true
+	}
+	return typeReference.Container.Optional == nil && typeReference.Container.Literal == nil
+}
+
+// jsonLiteral returns the JSON representation of the given literal value.
+func jsonLiteral(value interface{}) json.RawMessage {
+	bytes, _ := json.Marshal(value)
+	return bytes
+}
@@ -3,8 +3,7 @@ package generator
 import (
 	"bytes"
 	"fmt"
-
-	"github.com/fern-api/fern-go/internal/coordinator"
+	"sort"
 )
 
 const (
@@ -30,7 +29,7 @@ const (
 // go 1.13
 //
 // require github.com/google/uuid v1.4.0
-func NewModFile(coordinator *coordinator.Client, c *ModuleConfig, requiresGenerics bool) (*File, string, error) {
+func NewModFile(coordinator Coordinator, c *ModuleConfig, requiresGenerics bool) (*File, string, error) {
 	if c.Path == "" {
 		return nil, "", fmt.Errorf("module path is required")
 	}
@@ -54,9 +53,14 @@ func NewModFile(coordinator *coordinator.Client, c *ModuleConfig, requiresGeneri
 	fmt.Fprintln(buffer)
 
 	// Write all of the imports in a single require block.
+	paths := make([]string, 0, len(c.Imports))
+	for path := range c.Imports {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
 	fmt.Fprint(buffer, "require (\n")
-	for path, version := range c.Imports {
-		fmt.Fprintf(buffer, "\t%s %s\n", path, version)
+	for _, path := range paths {
+		fmt.Fprintf(buffer, "\t%s %s\n", path, c.Imports[path])
 	}
 	fmt.Fprint(buffer, ")\n")
 	fmt.Fprintln(buffer)
@@ -8,13 +8,31 @@ import (
 	"strings"
 	"unicode"
 
-	"github.com/fern-api/fern-go/internal/fern/ir"
 	"github.com/fern-api/fern-go/internal/gospec"
+	"github.com/fern-api/fern-go/ir"
 )
 
 var (
+	//go:embed model/core/date.go
+	dateFile string
+
+	//go:embed model/core/encoding.go
+	encodingFile string
+
+	//go:embed model/core/json.go
+	jsonFile string
+
+	//go:embed model/core/set.go
+	setFile string
+
 	//go:embed model/core/stringer.go
 	stringerFile string
+
+	//go:embed model/core/sql.go
+	sqlFile string
+
+	//go:embed model/core/union.go
+	unionFile string
 )
 
 // WriteType writes a complete type, including all of its properties.
@@ -25,6 +43,16 @@ func (f *fileWriter) WriteType(typeDeclaration *ir.TypeDeclaration, includeRawJS
 		importPath:     fernFilepathToImportPath(f.baseImportPath, typeDeclaration.Name.FernFilepath),
 		writer:         f,
 		includeRawJSON: includeRawJSON,
+
+		enableForwardCompatibility: f.enableForwardCompatibility,
+		enableFastJSON:             f.enableFastJSON,
+		enableOptionalTypes:        f.enableOptionalTypes,
+
+		enableStrictUndiscriminatedUnions: f.enableStrictUndiscriminatedUnions,
+		enableCloneAndEqual:               f.enableCloneAndEqual,
+		enableDatabaseSQL:                 f.enableDatabaseSQL,
+		enablePatchTypes:                  f.enablePatchTypes,
+		enableBuilders:                    f.enableBuilders,
 	}
 	f.WriteDocs(typeDeclaration.Docs)
 	return typeDeclaration.Shape.Accept(visitor)
@@ -38,14 +66,50 @@ type typeVisitor struct {
 	writer         *fileWriter
 
 	includeRawJSON bool
+
+	// enableForwardCompatibility generates enums and unions that tolerate
+	// values added to the API after the SDK was generated.
+	enableForwardCompatibility bool
+
+	// enableFastJSON generates reflection-free JSON marshalers
+	// (see fast_json.go).
+	enableFastJSON bool
+
+	// enableOptionalTypes represents the optional properties of objects
+	// with *core.Optional[T] so that explicit nulls can be distinguished
+	// from omitted values.
+	enableOptionalTypes bool
+
+	// enableStrictUndiscriminatedUnions only deserializes an undiscriminated
+	// union member if the JSON matches it exactly (see undiscriminated_union.go).
+	enableStrictUndiscriminatedUnions bool
+
+	// enableCloneAndEqual generates deep Clone and Equal methods for
+	// objects and unions (see clone.go).
+	enableCloneAndEqual bool
+
+	// enableDatabaseSQL generates the database/sql Value and Scan methods
+	// for enums, objects, and unions (see sql.go).
+	enableDatabaseSQL bool
+
+	// enablePatchTypes generates an XPatch type for every object that
+	// represents a partial update (see patch.go).
+	enablePatchTypes bool
+
+	// enableBuilders generates an XBuilder type for every object that
+	// validates its required properties (see builder.go).
+	enableBuilders bool
 }
 
 // Compile-time assertion.
 var _ ir.TypeVisitor = (*typeVisitor)(nil)
 
 func (t *typeVisitor) VisitAlias(alias *ir.AliasTypeDeclaration) error {
-	t.writer.P("type ", t.typeName, " = ", typeReferenceToGoType(alias.AliasOf, t.writer.types, t.writer.scope, t.baseImportPath, t.importPath, false))
+	t.writer.P("type ", t.typeName, " = ", typeReferenceToGoType(alias.AliasOf, t.writer.types, t.writer.scope, t.baseImportPath, t.importPath, false, t.writer.encoding))
 	t.writer.P()
+	if t.enableCloneAndEqual {
+		t.writeAliasCloneAndEqual(alias)
+	}
 	return nil
 }
 
@@ -115,12 +179,54 @@ func (t *typeVisitor) VisitEnum(enum *ir.EnumTypeDeclaration) error {
 	t.writer.P("}")
 	t.writer.P()
 
+	if t.enableFastJSON {
+		t.writeFastJSONEnum()
+	}
+
+	if t.enableDatabaseSQL {
+		t.writeEnumSQL()
+	}
+
+	if !t.enableForwardCompatibility {
+		return nil
+	}
+
+	// Enums already accept any string value on the wire, so we only need to
+	// expose whether or not the value is recognized by this version of the SDK.
+	knownEnumNames := make([]string, 0, len(enum.Values))
+	for _, enumValue := range enum.Values {
+		enumName := t.typeName + enumValue.Name.Name.PascalCase.UnsafeName
+		if useEnumWireValue {
+			enumName = t.typeName + enumValue.Name.WireValue
+		}
+		knownEnumNames = append(knownEnumNames, enumName)
+	}
+	t.writer.P("func (", receiver, " ", t.typeName, ") IsKnown() bool {")
+	if len(knownEnumNames) > 0 {
+		t.writer.P("switch ", receiver, " {")
+		t.writer.P("case ", strings.Join(knownEnumNames, ", "), ":")
+		t.writer.P("return true")
+		t.writer.P("}")
+	}
+	t.writer.P("return false")
+	t.writer.P("}")
+	t.writer.P()
+
+	t.writer.P("func (", receiver, " ", t.typeName, ") Values() []", t.typeName, " {")
+	t.writer.P("return []", t.typeName, "{")
+	for _, enumName := range knownEnumNames {
+		t.writer.P(enumName, ",")
+	}
+	t.writer.P("}")
+	t.writer.P("}")
+	t.writer.P()
+
 	return nil
 }
 
 func (t *typeVisitor) VisitObject(object *ir.ObjectTypeDeclaration) error {
 	t.writer.P("type ", t.typeName, " struct {")
-	_, literals := t.visitObjectProperties(object, true /* includeTags */, false /* includeOptionals */)
+	_, literals := t.visitObjectProperties(object, propertyTagsAll, t.enableOptionalTypes)
 
 	// If the object has a literal, it needs custom [de]serialization logic,
 	// and a getter method to access the field so that it's impossible for
@@ -147,16 +253,63 @@ func (t *typeVisitor) VisitObject(object *ir.ObjectTypeDeclaration) error {
 		t.writer.P("}")
 		t.writer.P()
 	}
+	t.writer.writeGetters(t.typeName, t.writer.objectGetters(object, t.importPath, t.enableOptionalTypes))
+
+	// Implement the json.Unmarshaler and json.Marshaler interfaces.
+	if t.enableFastJSON {
+		t.writeFastJSONObject(object)
+	}
+
+	// Optional properties represented with *core.Optional[T] are set to nil
+	// by encoding/json when they're null, and unions represented with an
+	// interface can't be deserialized by encoding/json at all, so they're
+	// captured separately.
+	var optionals []*ir.ObjectProperty
+	if t.enableOptionalTypes || t.writer.encoding.usesInterfaceUnions() {
+		properties, _ := t.flattenObjectProperties(object)
+		for _, property := range properties {
+			if t.usesOptionalType(property.ValueType) || containsInterfaceUnion(property.ValueType, t.writer.types, t.writer.encoding) {
+				optionals = append(optionals, property)
+			}
+		}
+	}
 
 	// Implement the json.Unmarshaler interface.
-	if t.includeRawJSON || len(literals) > 0 {
+	if !t.enableFastJSON && (t.includeRawJSON || len(literals) > 0 || len(optionals) > 0) {
 		t.writer.P("func (", receiver, " *", t.typeName, ") UnmarshalJSON(data []byte) error {")
-		t.writer.P("type unmarshaler ", t.typeName)
-		t.writer.P("var value unmarshaler")
-		t.writer.P("if err := json.Unmarshal(data, &value); err != nil {")
-		t.writer.P("return err")
-		t.writer.P("}")
-		t.writer.P("*", receiver, " = ", t.typeName, "(value)")
+		if len(optionals) > 0 {
+			t.writer.P("type embed ", t.typeName)
+			t.writer.P("var unmarshaler = struct{")
+			t.writer.P("embed")
+			for _, property := range optionals {
+				t.writer.P(property.Name.Name.PascalCase.UnsafeName, " json.RawMessage `json:\"", property.Name.WireValue, "\"`")
+			}
+			t.writer.P("}{}")
+			t.writer.P("if err := json.Unmarshal(data, &unmarshaler); err != nil {")
+			t.writer.P("return err")
+			t.writer.P("}")
+			t.writer.P("*", receiver, " = ", t.typeName, "(unmarshaler.embed)")
+			for _, property := range optionals {
+				field := receiver + "." + property.Name.Name.PascalCase.UnsafeName
+				if containsInterfaceUnion(property.ValueType, t.writer.types, t.writer.encoding) {
+					t.writer.writeInterfaceUnionPropertyDecoder(field, "unmarshaler."+property.Name.Name.PascalCase.UnsafeName, property.ValueType, t.importPath, t.usesOptionalType(property.ValueType))
+					continue
+				}
+				t.writer.P("if unmarshaler.", property.Name.Name.PascalCase.UnsafeName, " != nil {")
+				t.writer.P(field, " = new(", strings.TrimPrefix(t.optionalType(property.ValueType), "*"), ")")
+				t.writer.P("if err := json.Unmarshal(unmarshaler.", property.Name.Name.PascalCase.UnsafeName, ", ", field, "); err != nil {")
+				t.writer.P("return err")
+				t.writer.P("}")
+				t.writer.P("}")
+			}
+		} else {
+			t.writer.P("type unmarshaler ", t.typeName)
+			t.writer.P("var value unmarshaler")
+			t.writer.P("if err := json.Unmarshal(data, &value); err != nil {")
+			t.writer.P("return err")
+			t.writer.P("}")
+			t.writer.P("*", receiver, " = ", t.typeName, "(value)")
+		}
 		for _, literal := range literals {
 			t.writer.P(receiver, ".", literal.Name.CamelCase.SafeName, " = ", literalToValue(literal.Value))
 		}
@@ -169,7 +322,7 @@ func (t *typeVisitor) VisitObject(object *ir.ObjectTypeDeclaration) error {
 	}
 
 	// Implement the json.Marshaler interface (if we have any literals).
-	if len(literals) > 0 {
+	if !t.enableFastJSON && len(literals) > 0 {
 		t.writer.P("func (", receiver, " *", t.typeName, ") MarshalJSON() ([]byte, error) {")
 		t.writer.P("type embed ", t.typeName)
 		t.writer.P("var marshaler = struct{")
@@ -204,17 +357,37 @@ func (t *typeVisitor) VisitObject(object *ir.ObjectTypeDeclaration) error {
 	t.writer.P("}")
 	t.writer.P()
 
+	if t.enableCloneAndEqual {
+		t.writeObjectCloneAndEqual(object)
+	}
+
+	if t.enableDatabaseSQL {
+		t.writeObjectSQL(object)
+	}
+
+	if t.enablePatchTypes {
+		t.writeObjectPatch(object)
+	}
+
+	if t.enableBuilders {
+		t.writeObjectBuilder(object)
+	}
+
 	return nil
 }
 
 func (t *typeVisitor) VisitUnion(union *ir.UnionTypeDeclaration) error {
+	if t.writer.encoding.usesInterfaceUnions() {
+		return t.writeInterfaceUnion(union)
+	}
+
 	// Write the union type definition.
 	discriminantName := union.Discriminant.Name.PascalCase.UnsafeName
 	t.writer.P("type ", t.typeName, " struct {")
 	t.writer.P(discriminantName, " string")
 	var literals []*literal
 	for _, extend := range union.Extends {
-		_, extendedLiterals := t.visitObjectProperties(t.writer.types[extend.TypeId].Shape.Object, false /* includeTags */, false /* includeOptionals */)
+		_, extendedLiterals := t.visitObjectProperties(t.writer.types[extend.TypeId].Shape.Object, propertyTagsExtra, false /* includeOptionals */)
 		literals = append(literals, extendedLiterals...)
 	}
 	for _, property := range union.BaseProperties {
@@ -222,14 +395,14 @@ func (t *typeVisitor) VisitUnion(union *ir.UnionTypeDeclaration) error {
 			literals = append(literals, &literal{Name: property.Name.Name, Value: property.ValueType.Container.Literal})
 			continue
 		}
-		t.writer.P(property.Name.Name.PascalCase.UnsafeName, " ", typeReferenceToGoType(property.ValueType, t.writer.types, t.writer.scope, t.baseImportPath, t.importPath, false))
+		t.writer.P(property.Name.Name.PascalCase.UnsafeName, " ", typeReferenceToGoType(property.ValueType, t.writer.types, t.writer.scope, t.baseImportPath, t.importPath, false, t.writer.encoding), appendStructTags("", t.writer.extraStructTags(property.Name, property.ValueType)))
 	}
 	// We handle the union's literals separate from the extended and base
 	// literals because we only want to set them if they were actually
 	// specified by the user.
 	var unionLiterals []*literal
 	for _, unionType := range union.Types {
-		typeName := singleUnionTypePropertiesToGoType(unionType.Shape, t.writer.types, t.writer.scope, t.baseImportPath, t.importPath)
+		typeName := singleUnionTypePropertiesToGoType(unionType.Shape, t.writer.types, t.writer.scope, t.baseImportPath, t.importPath, t.writer.encoding)
 		if typeName == "" {
 			// If the union has no properties, there's nothing for us to do.
 			continue
@@ -251,6 +424,13 @@ func (t *typeVisitor) VisitUnion(union *ir.UnionTypeDeclaration) error {
 		}
 		t.writer.P(unionType.DiscriminantValue.Name.PascalCase.UnsafeName, " ", typeName)
 	}
+	// Forward-compatible unions preserve the raw JSON of any variant that
+	// isn't recognized so that it can be visited and re-serialized as-is.
+	var unknownName string
+	if t.enableForwardCompatibility {
+		unknownName = unknownUnionVariantName(union)
+		t.writer.P(unknownName, " json.RawMessage")
+	}
 	for _, literal := range literals {
 		t.writer.P(literal.Name.CamelCase.SafeName, " ", literalToGoType(literal.Value))
 	}
@@ -267,7 +447,7 @@ func (t *typeVisitor) VisitUnion(union *ir.UnionTypeDeclaration) error {
 			t.writer.P("func New", t.typeName, "With", unionType.DiscriminantValue.Name.PascalCase.UnsafeName, "() *", t.typeName, "{")
 			t.writer.P("return &", t.typeName, "{", discriminantName, ": \"", unionType.DiscriminantValue.Name.OriginalName, "\", ", fieldName, ": ", literalToValue(literal), "}")
 		} else {
-			t.writer.P("func New", t.typeName, "From", unionType.DiscriminantValue.Name.PascalCase.UnsafeName, "(value ", singleUnionTypePropertiesToGoType(unionType.Shape, t.writer.types, t.writer.scope, t.baseImportPath, t.importPath), ") *", t.typeName, "{")
+			t.writer.P("func New", t.typeName, "From", unionType.DiscriminantValue.Name.PascalCase.UnsafeName, "(value ", singleUnionTypePropertiesToGoType(unionType.Shape, t.writer.types, t.writer.scope, t.baseImportPath, t.importPath, t.writer.encoding), ") *", t.typeName, "{")
 			t.writer.P("return &", t.typeName, "{", discriminantName, ": \"", unionType.DiscriminantValue.Name.OriginalName, "\", ", fieldName, ": value}")
 		}
 		t.writer.P("}")
@@ -283,6 +463,67 @@ func (t *typeVisitor) VisitUnion(union *ir.UnionTypeDeclaration) error {
 		t.writer.P("}")
 		t.writer.P()
 	}
+	t.writer.writeGetters(t.typeName, t.unionGetters(union, unknownName))
+
+	// Implement the json.Unmarshaler and json.Marshaler interfaces.
+	if t.enableFastJSON {
+		t.writeFastJSONUnion(union, unknownName)
+	} else {
+		t.writeUnionJSON(union, literals, unknownName)
+	}
+
+	// Generate the Visitor interface.
+	t.writer.P("type ", t.typeName, "Visitor interface {")
+	for _, unionType := range union.Types {
+		t.writer.P("Visit", unionType.DiscriminantValue.Name.PascalCase.UnsafeName, "(", singleUnionTypePropertiesToGoType(unionType.Shape, t.writer.types, t.writer.scope, t.baseImportPath, t.importPath, t.writer.encoding), ") error")
+	}
+	if unknownName != "" {
+		t.writer.P("Visit", unknownName, "(string, json.RawMessage) error")
+	}
+	t.writer.P("}")
+	t.writer.P()
+
+	// Generate the Accept method.
+	t.writer.P("func (", receiver, " *", t.typeName, ") Accept(visitor ", t.typeName, "Visitor) error {")
+	t.writer.P("switch ", receiver, ".", discriminantName, "{")
+	for i, unionType := range union.Types {
+		if i == 0 {
+			// Implement the default case first.
+			t.writer.P("default:")
+			if unknownName != "" {
+				t.writer.P("if ", receiver, ".", unknownName, " != nil {")
+				t.writer.P("return visitor.Visit", unknownName, "(", receiver, ".", discriminantName, ", ", receiver, ".", unknownName, ")")
+				t.writer.P("}")
+			}
+			t.writer.P("return fmt.Errorf(\"invalid type %s in %T\", ", receiver, ".", discriminantName, ", ", receiver, ")")
+		}
+		t.writer.P("case \"", unionType.DiscriminantValue.Name.OriginalName, "\":")
+		fieldName := unionType.DiscriminantValue.Name.PascalCase.UnsafeName
+		if unionType.Shape.SingleProperty != nil && unionType.Shape.SingleProperty.Type.Container != nil && unionType.Shape.SingleProperty.Type.Container.Literal != nil {
+			fieldName = unionType.DiscriminantValue.Name.CamelCase.SafeName
+		}
+		t.writer.P("return visitor.Visit", unionType.DiscriminantValue.Name.PascalCase.UnsafeName, "(", receiver, ".", fieldName, ")")
+	}
+	t.writer.P("}")
+	t.writer.P("}")
+	t.writer.P()
+
+	if t.enableCloneAndEqual {
+		t.writeUnionCloneAndEqual(union, unknownName)
+	}
+
+	if t.enableDatabaseSQL {
+		t.writeUnionSQL(union, unknownName)
+	}
+
+	return nil
+}
+
+// writeUnionJSON writes the json.Unmarshaler and json.Marshaler
+// implementations for a discriminated union with encoding/json.
+func (t *typeVisitor) writeUnionJSON(union *ir.UnionTypeDeclaration, literals []*literal, unknownName string) {
+	discriminantName := union.Discriminant.Name.PascalCase.UnsafeName
+	receiver := typeNameToReceiver(t.typeName)
 
 	// Implement the json.Unmarshaler interface.
 	t.writer.P("func (", receiver, " *", t.typeName, ") UnmarshalJSON(data []byte) error {")
@@ -290,7 +531,7 @@ func (t *typeVisitor) VisitUnion(union *ir.UnionTypeDeclaration) error {
 	t.writer.P(discriminantName, " string `json:\"", union.Discriminant.WireValue, "\"`")
 	var propertyNames []string
 	for _, extend := range union.Extends {
-		extendedProperties, _ := t.visitObjectProperties(t.writer.types[extend.TypeId].Shape.Object, true /* includeTags */, false /* includeOptionals */)
+		extendedProperties, _ := t.visitObjectProperties(t.writer.types[extend.TypeId].Shape.Object, propertyTagsJSON, false /* includeOptionals */)
 		propertyNames = append(propertyNames, extendedProperties...)
 	}
 	for _, property := range union.BaseProperties {
@@ -298,7 +539,7 @@ func (t *typeVisitor) VisitUnion(union *ir.UnionTypeDeclaration) error {
 			continue
 		}
 		propertyNames = append(propertyNames, property.Name.Name.PascalCase.UnsafeName)
-		t.writer.P(property.Name.Name.PascalCase.UnsafeName, " ", typeReferenceToGoType(property.ValueType, t.writer.types, t.writer.scope, t.baseImportPath, t.importPath, false), jsonTagForType(property.Name.WireValue, property.ValueType, t.writer.types))
+		t.writer.P(property.Name.Name.PascalCase.UnsafeName, " ", typeReferenceToGoType(property.ValueType, t.writer.types, t.writer.scope, t.baseImportPath, t.importPath, false, t.writer.encoding), jsonTagForType(property.Name.WireValue, property.ValueType, t.writer.types))
 	}
 	t.writer.P("}")
 	t.writer.P("if err := json.Unmarshal(data, &unmarshaler); err != nil {")
@@ -335,7 +576,7 @@ func (t *typeVisitor) VisitUnion(union *ir.UnionTypeDeclaration) error {
 			//    Boolean bool  `json:"value"`
 			//  }
 			t.writer.P("var valueUnmarshaler struct {")
-			typeName := singleUnionTypePropertiesToGoType(unionType.Shape, t.writer.types, t.writer.scope, t.baseImportPath, t.importPath)
+			typeName := singleUnionTypePropertiesToGoType(unionType.Shape, t.writer.types, t.writer.scope, t.baseImportPath, t.importPath, t.writer.encoding)
 			t.writer.P(unionType.DiscriminantValue.Name.PascalCase.UnsafeName, " ", typeName, jsonTagForType(unionType.Shape.SingleProperty.Name.WireValue, unionType.Shape.SingleProperty.Type, t.writer.types))
 			t.writer.P("}")
 			t.writer.P("if err := json.Unmarshal(data, &valueUnmarshaler); err != nil {")
@@ -344,12 +585,18 @@ func (t *typeVisitor) VisitUnion(union *ir.UnionTypeDeclaration) error {
 			t.writer.P(receiver, ".", unionType.DiscriminantValue.Name.PascalCase.UnsafeName, " = valueUnmarshaler.", unionType.DiscriminantValue.Name.PascalCase.UnsafeName)
 			continue
 		}
-		t.writer.P(singleUnionTypePropertiesToInitializer(unionType.Shape, t.writer.types, t.writer.scope, t.baseImportPath, t.importPath, unionType.DiscriminantValue.Name.PascalCase.UnsafeName, receiver))
+		t.writer.P(singleUnionTypePropertiesToInitializer(unionType.Shape, t.writer.types, t.writer.scope, t.baseImportPath, t.importPath, unionType.DiscriminantValue.Name.PascalCase.UnsafeName, receiver, t.writer.encoding))
 		t.writer.P("if err := json.Unmarshal(data, &value); err != nil {")
 		t.writer.P("return err")
 		t.writer.P("}")
 		t.writer.P(receiver, ".", unionType.DiscriminantValue.Name.PascalCase.UnsafeName, " = value")
 	}
+	if unknownName != "" {
+		// The data must be copied because the json.Unmarshaler is not
+		// allowed to retain it.
+		t.writer.P("default:")
+		t.writer.P(receiver, ".", unknownName, " = append(json.RawMessage(nil), data...)")
+	}
 	t.writer.P("}")
 	t.writer.P("return nil")
 	t.writer.P("}")
@@ -362,6 +609,11 @@ func (t *typeVisitor) VisitUnion(union *ir.UnionTypeDeclaration) error {
 		if i == 0 {
 			// Implement the default case first.
 			t.writer.P("default:")
+			if unknownName != "" {
+				t.writer.P("if ", receiver, ".", unknownName, " != nil {")
+				t.writer.P("return ", receiver, ".", unknownName, ", nil")
+				t.writer.P("}")
+			}
 			t.writer.P("return nil, fmt.Errorf(\"invalid type %s in %T\", ", receiver, ".", discriminantName, ", ", receiver, ")")
 		}
 		t.writer.P("case \"", unionType.DiscriminantValue.Name.OriginalName, "\":")
@@ -369,18 +621,18 @@ func (t *typeVisitor) VisitUnion(union *ir.UnionTypeDeclaration) error {
 		t.writer.P(discriminantName, " string `json:\"", union.Discriminant.WireValue, "\"`")
 		// Include all of the extended and base properties.
 		for _, extend := range union.Extends {
-			_, _ = t.visitObjectProperties(t.writer.types[extend.TypeId].Shape.Object, true /* includeTags */, false /* includeOptionals */)
+			_, _ = t.visitObjectProperties(t.writer.types[extend.TypeId].Shape.Object, propertyTagsJSON, false /* includeOptionals */)
 		}
 		for _, property := range union.BaseProperties {
 			if property.ValueType.Container != nil && property.ValueType.Container.Literal != nil {
 				continue
 			}
-			t.writer.P(property.Name.Name.PascalCase.UnsafeName, " ", typeReferenceToGoType(property.ValueType, t.writer.types, t.writer.scope, t.baseImportPath, t.importPath, false), jsonTagForType(property.Name.WireValue, property.ValueType, t.writer.types))
+			t.writer.P(property.Name.Name.PascalCase.UnsafeName, " ", typeReferenceToGoType(property.ValueType, t.writer.types, t.writer.scope, t.baseImportPath, t.importPath, false, t.writer.encoding), jsonTagForType(property.Name.WireValue, property.ValueType, t.writer.types))
 		}
 		for _, literal := range literals {
 			t.writer.P(literal.Name.PascalCase.UnsafeName, " ", literalToGoType(literal.Value), " `json:\"", literal.Name.OriginalName, "\"`")
 		}
-		typeName := singleUnionTypePropertiesToGoType(unionType.Shape, t.writer.types, t.writer.scope, t.baseImportPath, t.importPath)
+		typeName := singleUnionTypePropertiesToGoType(unionType.Shape, t.writer.types, t.writer.scope, t.baseImportPath, t.importPath, t.writer.encoding)
 		switch unionType.Shape.PropertiesType {
 		case "singleProperty":
 			t.writer.P(unionType.DiscriminantValue.Name.PascalCase.UnsafeName, " ", typeName, jsonTagForType(unionType.Shape.SingleProperty.Name.WireValue, unionType.Shape.SingleProperty.Type, t.writer.types))
@@ -417,39 +669,13 @@ func (t *typeVisitor) VisitUnion(union *ir.UnionTypeDeclaration) error {
 	t.writer.P("}")
 	t.writer.P("}")
 	t.writer.P()
-
-	// Generate the Visitor interface.
-	t.writer.P("type ", t.typeName, "Visitor interface {")
-	for _, unionType := range union.Types {
-		t.writer.P("Visit", unionType.DiscriminantValue.Name.PascalCase.UnsafeName, "(", singleUnionTypePropertiesToGoType(unionType.Shape, t.writer.types, t.writer.scope, t.baseImportPath, t.importPath), ") error")
-	}
-	t.writer.P("}")
-	t.writer.P()
-
-	// Generate the Accept method.
-	t.writer.P("func (", receiver, " *", t.typeName, ") Accept(visitor ", t.typeName, "Visitor) error {")
-	t.writer.P("switch ", receiver, ".", discriminantName, "{")
-	for i, unionType := range union.Types {
-		if i == 0 {
-			// Implement the default case first.
-			t.writer.P("default:")
-			t.writer.P("return fmt.Errorf(\"invalid type %s in %T\", ", receiver, ".", discriminantName, ", ", receiver, ")")
-		}
-		t.writer.P("case \"", unionType.DiscriminantValue.Name.OriginalName, "\":")
-		fieldName := unionType.DiscriminantValue.Name.PascalCase.UnsafeName
-		if unionType.Shape.SingleProperty != nil && unionType.Shape.SingleProperty.Type.Container != nil && unionType.Shape.SingleProperty.Type.Container.Literal != nil {
-			fieldName = unionType.DiscriminantValue.Name.CamelCase.SafeName
-		}
-		t.writer.P("return visitor.Visit", unionType.DiscriminantValue.Name.PascalCase.UnsafeName, "(", receiver, ".", fieldName, ")")
-	}
-	t.writer.P("}")
-	t.writer.P("}")
-	t.writer.P()
-
-	return nil
 }
 
 func (t *typeVisitor) VisitUndiscriminatedUnion(union *ir.UndiscriminatedUnionTypeDeclaration) error {
+	if t.writer.encoding.usesInterfaceUnions() {
+		return t.writeInterfaceUndiscriminatedUnion(union)
+	}
+
 	// member represents a single undiscriminated union member.
 	//
 	// We define a separate struct here so that we can collect
@@ -486,7 +712,7 @@ func (t *typeVisitor) VisitUndiscriminatedUnion(union *ir.UndiscriminatedUnionTy
 				field:     field,
 				variable:  fmt.Sprintf("value%s", strings.Title(field)),
 				caseName:  firstLetterToLower(field),
-				value:     typeReferenceToGoType(unionMember.Type, t.writer.types, t.writer.scope, t.baseImportPath, t.importPath, false),
+				value:     typeReferenceToGoType(unionMember.Type, t.writer.types, t.writer.scope, t.baseImportPath, t.importPath, false, t.writer.encoding),
 				docs:      unionMember.Docs,
 				literal:   literal,
 				isLiteral: isLiteral,
@@ -520,8 +746,10 @@ func (t *typeVisitor) VisitUndiscriminatedUnion(union *ir.UndiscriminatedUnionTy
 	receiver := typeNameToReceiver(t.typeName)
 
 	// Write getters for literal values, if any.
-	for _, member := range members {
+	var getters []*getter
+	for i, member := range members {
 		if !member.isLiteral {
+			getters = append(getters, t.writer.newGetter(member.field, union.Members[i].Type, t.importPath, false))
 			continue
 		}
 		t.writer.P("func (", receiver, " *", t.typeName, ") ", strings.Title(member.field), "() ", member.value, "{")
@@ -529,13 +757,32 @@ func (t *typeVisitor) VisitUndiscriminatedUnion(union *ir.UndiscriminatedUnionTy
 		t.writer.P("}")
 		t.writer.P()
 	}
+	t.writer.writeGetters(t.typeName, getters)
 
 	// Implement the json.Unmarshaler interface.
 	t.writer.P("func (", receiver, " *", t.typeName, ") UnmarshalJSON(data []byte) error {")
-	for _, member := range members {
+	if t.enableStrictUndiscriminatedUnions {
+		t.warnAmbiguousUndiscriminatedUnionMembers(union)
+		t.writer.P("decoder := core.NewUnionDecoder(data)")
+	}
+	for i, member := range members {
+		if t.enableStrictUndiscriminatedUnions {
+			t.writeStrictUndiscriminatedUnionMember(
+				union.Members[i],
+				member.caseName,
+				member.variable,
+				member.value,
+				func(value string) {
+					t.writer.P(receiver, ".typeName = \"", member.caseName, "\"")
+					t.writer.P(receiver, ".", member.field, " = ", value)
+					t.writer.P("return nil")
+				},
+			)
+			continue
+		}
 		value := member.value
 		format := "var " + member.variable + " %s"
-		if member.typeName != "" && isPointer(t.writer.types[member.typeName]) {
+		if member.typeName != "" && isPointer(t.writer.types[member.typeName], t.writer.encoding) {
 			format = member.variable + " := new(%s)"
 			value = strings.TrimLeft(value, "*")
 		}
@@ -557,7 +804,11 @@ func (t *typeVisitor) VisitUndiscriminatedUnion(union *ir.UndiscriminatedUnionTy
 		t.writer.P("return nil")
 		t.writer.P("}")
 	}
-	t.writer.P(`return fmt.Errorf("%s cannot be deserialized as a %T", data, `, receiver, ")")
+	if t.enableStrictUndiscriminatedUnions {
+		t.writer.P("return decoder.Error(\"", t.typeName, "\")")
+	} else {
+		t.writer.P(`return fmt.Errorf("%s cannot be deserialized as a %T", data, `, receiver, ")")
+	}
 	t.writer.P("}")
 	t.writer.P()
 
@@ -605,9 +856,40 @@ func (t *typeVisitor) VisitUndiscriminatedUnion(union *ir.UndiscriminatedUnionTy
 	t.writer.P("}")
 	t.writer.P()
 
+	if t.enableCloneAndEqual {
+		t.writeUndiscriminatedUnionCloneAndEqual(union)
+	}
+
+	if t.enableDatabaseSQL {
+		t.writeUndiscriminatedUnionSQL(union)
+	}
+
 	return nil
 }
 
+// unknownUnionVariantName returns the name of the field (and Visitor method suffix)
+// used to hold unrecognized variants in a forward-compatible union. The default
+// name is 'Unknown', but the union might already define a variant or property
+// with that name (e.g. a variant that uses the 'unknown' type).
+func unknownUnionVariantName(union *ir.UnionTypeDeclaration) string {
+	names := map[string]struct{}{
+		union.Discriminant.Name.PascalCase.UnsafeName: {},
+	}
+	for _, property := range union.BaseProperties {
+		names[property.Name.Name.PascalCase.UnsafeName] = struct{}{}
+	}
+	for _, unionType := range union.Types {
+		names[unionType.DiscriminantValue.Name.PascalCase.UnsafeName] = struct{}{}
+	}
+	name := "Unknown"
+	for {
+		if _, ok := names[name]; !ok {
+			return name
+		}
+		name += "Variant"
+	}
+}
+
 // undiscriminatedUnionTypeReferenceVisitor retrieves the string representation of type references
 // (e.g. containers, primitives, etc), but specifically for undiscriminated union generation.
 type undiscriminatedUnionTypeReferenceVisitor struct {
@@ -683,22 +965,39 @@ type literal struct {
 	Value *ir.Literal
 }
 
+// propertyTags selects the struct tags generated for each property.
+type propertyTags uint
+
+const (
+	// propertyTagsJSON generates the JSON tag, which is used for the
+	// unexported types used to [de]serialize unions.
+	propertyTagsJSON propertyTags = 1 << iota
+
+	// propertyTagsExtra generates the configured extra struct tags (e.g. yaml),
+	// which is used for the fields of unions (which implement their own JSON
+	// [de]serialization).
+	propertyTagsExtra
+
+	// propertyTagsAll generates the JSON tag and the extra struct tags.
+	propertyTagsAll = propertyTagsJSON | propertyTagsExtra
+)
+
 // visitObjectProperties writes all of this object's properties, and recursively calls itself with
-// the object's extended properties (if any). The 'includeTags' parameter controls whether or not
-// to generate JSON struct tags, which is only relevant for object types (not unions).
+// the object's extended properties (if any). The 'tags' parameter controls which struct tags are
+// generated for each property (see propertyTags).
 //
 // A slice of all the transitive property names, as well as a sentinel value that signals whether
 // any of the properties are a literal value, are returned.
 func (t *typeVisitor) visitObjectProperties(
 	object *ir.ObjectTypeDeclaration,
-	includeTags bool,
+	tags propertyTags,
 	includeOptionals bool,
 ) ([]string, []*literal) {
 	var names []string
 	var literals []*literal
 	for _, extend := range object.Extends {
 		// You can only extend other objects.
-		extendedNames, extendedLiterals := t.visitObjectProperties(t.writer.types[extend.TypeId].Shape.Object, includeTags, includeOptionals)
+		extendedNames, extendedLiterals := t.visitObjectProperties(t.writer.types[extend.TypeId].Shape.Object, tags, includeOptionals)
 		names = append(names, extendedNames...)
 		literals = append(literals, extendedLiterals...)
 	}
@@ -709,12 +1008,15 @@ func (t *typeVisitor) visitObjectProperties(
 			continue
 		}
 		names = append(names, property.Name.Name.PascalCase.UnsafeName)
-		goType := typeReferenceToGoType(property.ValueType, t.writer.types, t.writer.scope, t.baseImportPath, t.importPath, includeOptionals)
-		if includeTags {
-			t.writer.P(property.Name.Name.PascalCase.UnsafeName, " ", goType, jsonTagForType(property.Name.WireValue, property.ValueType, t.writer.types))
-			continue
+		goType := typeReferenceToGoType(property.ValueType, t.writer.types, t.writer.scope, t.baseImportPath, t.importPath, includeOptionals, t.writer.encoding)
+		var tag string
+		if tags&propertyTagsJSON != 0 {
+			tag = jsonTagForType(property.Name.WireValue, property.ValueType, t.writer.types)
 		}
-		t.writer.P(property.Name.Name.PascalCase.UnsafeName, " ", goType)
+		if tags&propertyTagsExtra != 0 {
+			tag = appendStructTags(tag, t.writer.extraStructTags(property.Name, property.ValueType))
+		}
+		t.writer.P(property.Name.Name.PascalCase.UnsafeName, " ", goType, tag)
 	}
 	return names, literals
 }
@@ -728,19 +1030,20 @@ type typeReferenceVisitor struct {
 	scope            *gospec.Scope
 	types            map[ir.TypeId]*ir.TypeDeclaration
 	includeOptionals bool
+	encoding         *EncodingConfig
 }
 
 // Compile-time assertion.
 var _ ir.TypeReferenceVisitor = (*typeReferenceVisitor)(nil)
 
 func (t *typeReferenceVisitor) VisitContainer(container *ir.ContainerType) error {
-	t.value = containerTypeToGoType(container, t.types, t.scope, t.baseImportPath, t.importPath, t.includeOptionals)
+	t.value = containerTypeToGoType(container, t.types, t.scope, t.baseImportPath, t.importPath, t.includeOptionals, t.encoding)
 	return nil
 }
 
 func (t *typeReferenceVisitor) VisitNamed(named *ir.DeclaredTypeName) error {
 	format := "%s"
-	if isPointer(t.types[named.TypeId]) {
+	if isPointer(t.types[named.TypeId], t.encoding) {
 		format = "*%s"
 	}
 	name := named.Name.PascalCase.UnsafeName
@@ -752,7 +1055,12 @@ func (t *typeReferenceVisitor) VisitNamed(named *ir.DeclaredTypeName) error {
 }
 
 func (t *typeReferenceVisitor) VisitPrimitive(primitive ir.PrimitiveType) error {
-	t.value = primitiveToGoType(primitive)
+	t.value = primitiveToGoType(primitive, t.encoding)
+	if t.importPath == path.Join(t.baseImportPath, "core") {
+		// Types generated in the core package (e.g. the client options) can't
+		// refer to the core package by name.
+		t.value = strings.TrimPrefix(t.value, "core.")
+	}
 	return nil
 }
 
@@ -770,18 +1078,19 @@ type containerTypeVisitor struct {
 	scope            *gospec.Scope
 	types            map[ir.TypeId]*ir.TypeDeclaration
 	includeOptionals bool
+	encoding         *EncodingConfig
 }
 
 // Compile-time assertion.
 var _ ir.ContainerTypeVisitor = (*containerTypeVisitor)(nil)
 
 func (c *containerTypeVisitor) VisitList(list *ir.TypeReference) error {
-	c.value = fmt.Sprintf("[]%s", typeReferenceToGoType(list, c.types, c.scope, c.baseImportPath, c.importPath, false))
+	c.value = fmt.Sprintf("[]%s", typeReferenceToGoType(list, c.types, c.scope, c.baseImportPath, c.importPath, false, c.encoding))
 	return nil
 }
 
 func (c *containerTypeVisitor) VisitMap(mapType *ir.MapType) error {
-	c.value = fmt.Sprintf("map[%s]%s", typeReferenceToGoType(mapType.KeyType, c.types, c.scope, c.baseImportPath, c.importPath, false), typeReferenceToGoType(mapType.ValueType, c.types, c.scope, c.baseImportPath, c.importPath, false))
+	c.value = fmt.Sprintf("map[%s]%s", typeReferenceToGoType(mapType.KeyType, c.types, c.scope, c.baseImportPath, c.importPath, false, c.encoding), typeReferenceToGoType(mapType.ValueType, c.types, c.scope, c.baseImportPath, c.importPath, false, c.encoding))
 	return nil
 }
 
@@ -789,14 +1098,14 @@ func (c *containerTypeVisitor) VisitOptional(optional *ir.TypeReference) error {
 	// Trim all of the preceding pointers from the underlying type so that we don't
 	// unnecessarily generate double pointers for objects and unions (e.g. '**Foo)').
 	//
-	// We also don't want to specify pointers for any container types because those
-	// values are already nil-able.
-	value := strings.TrimLeft(typeReferenceToGoType(optional, c.types, c.scope, c.baseImportPath, c.importPath, c.includeOptionals), "*")
+	// We also don't want to specify pointers for any container types (or unions
+	// represented with an interface) because those values are already nil-able.
+	value := strings.TrimLeft(typeReferenceToGoType(optional, c.types, c.scope, c.baseImportPath, c.importPath, c.includeOptionals, c.encoding), "*")
 	if c.includeOptionals {
 		c.value = fmt.Sprintf("*core.Optional[%s]", value)
 		return nil
 	}
-	if optional.Unknown != nil || (optional.Container != nil && optional.Container.Literal == nil) {
+	if optional.Unknown != nil || (optional.Container != nil && optional.Container.Literal == nil && !isSetType(optional, c.types, c.encoding)) || isInterfaceUnion(optional, c.types, c.encoding) {
 		c.value = value
 		return nil
 	}
@@ -805,7 +1114,11 @@ func (c *containerTypeVisitor) VisitOptional(optional *ir.TypeReference) error {
 }
 
 func (c *containerTypeVisitor) VisitSet(set *ir.TypeReference) error {
-	c.value = fmt.Sprintf("[]%s", typeReferenceToGoType(set, c.types, c.scope, c.baseImportPath, c.importPath, false))
+	format := "[]%s"
+	if c.encoding.usesSetType() && isComparableType(set, c.types, c.encoding) {
+		format = "*core.Set[%s]"
+	}
+	c.value = fmt.Sprintf(format, typeReferenceToGoType(set, c.types, c.scope, c.baseImportPath, c.importPath, false, c.encoding))
 	return nil
 }
 
@@ -822,6 +1135,7 @@ type singleUnionTypePropertiesVisitor struct {
 	importPath     string
 	scope          *gospec.Scope
 	types          map[ir.TypeId]*ir.TypeDeclaration
+	encoding       *EncodingConfig
 }
 
 // Compile-time assertion.
@@ -829,7 +1143,7 @@ var _ ir.SingleUnionTypePropertiesVisitor = (*singleUnionTypePropertiesVisitor)(
 
 func (c *singleUnionTypePropertiesVisitor) VisitSamePropertiesAsObject(named *ir.DeclaredTypeName) error {
 	format := "%s"
-	if isPointer(c.types[named.TypeId]) {
+	if isPointer(c.types[named.TypeId], c.encoding) {
 		format = "*%s"
 	}
 	name := named.Name.PascalCase.UnsafeName
@@ -841,7 +1155,7 @@ func (c *singleUnionTypePropertiesVisitor) VisitSamePropertiesAsObject(named *ir
 }
 
 func (c *singleUnionTypePropertiesVisitor) VisitSingleProperty(property *ir.SingleUnionTypeProperty) error {
-	c.value = typeReferenceToGoType(property.Type, c.types, c.scope, c.baseImportPath, c.importPath, false)
+	c.value = typeReferenceToGoType(property.Type, c.types, c.scope, c.baseImportPath, c.importPath, false, c.encoding)
 	return nil
 }
 
@@ -863,6 +1177,7 @@ type singleUnionTypePropertiesInitializerVisitor struct {
 	importPath       string
 	scope            *gospec.Scope
 	types            map[ir.TypeId]*ir.TypeDeclaration
+	encoding         *EncodingConfig
 }
 
 // Compile-time assertion.
@@ -870,7 +1185,7 @@ var _ ir.SingleUnionTypePropertiesVisitor = (*singleUnionTypePropertiesInitializ
 
 func (c *singleUnionTypePropertiesInitializerVisitor) VisitSamePropertiesAsObject(named *ir.DeclaredTypeName) error {
 	format := "var value %s"
-	if isPointer(c.types[named.TypeId]) {
+	if isPointer(c.types[named.TypeId], c.encoding) {
 		format = "value := new(%s)"
 	}
 	name := named.Name.PascalCase.UnsafeName
@@ -937,6 +1252,7 @@ func typeReferenceToGoType(
 	baseImportPath string,
 	importPath string,
 	includeOptionals bool,
+	encoding *EncodingConfig,
 ) string {
 	visitor := &typeReferenceVisitor{
 		baseImportPath:   baseImportPath,
@@ -944,6 +1260,7 @@ func typeReferenceToGoType(
 		scope:            scope,
 		types:            types,
 		includeOptionals: includeOptionals,
+		encoding:         encoding,
 	}
 	_ = typeReference.Accept(visitor)
 	return visitor.value
@@ -957,6 +1274,7 @@ func containerTypeToGoType(
 	baseImportPath string,
 	importPath string,
 	includeOptionals bool,
+	encoding *EncodingConfig,
 ) string {
 	visitor := &containerTypeVisitor{
 		baseImportPath:   baseImportPath,
@@ -964,6 +1282,7 @@ func containerTypeToGoType(
 		scope:            scope,
 		types:            types,
 		includeOptionals: includeOptionals,
+		encoding:         encoding,
 	}
 	_ = containerType.Accept(visitor)
 	return visitor.value
@@ -976,12 +1295,14 @@ func singleUnionTypePropertiesToGoType(
 	scope *gospec.Scope,
 	baseImportPath string,
 	importPath string,
+	encoding *EncodingConfig,
 ) string {
 	visitor := &singleUnionTypePropertiesVisitor{
 		baseImportPath: baseImportPath,
 		importPath:     importPath,
 		scope:          scope,
 		types:          types,
+		encoding:       encoding,
 	}
 	_ = singleUnionTypeProperties.Accept(visitor)
 	return visitor.value
@@ -999,6 +1320,7 @@ func singleUnionTypePropertiesToInitializer(
 	importPath string,
 	discriminantName string,
 	receiver string,
+	encoding *EncodingConfig,
 ) string {
 	visitor := &singleUnionTypePropertiesInitializerVisitor{
 		discriminantName: discriminantName,
@@ -1007,6 +1329,7 @@ func singleUnionTypePropertiesToInitializer(
 		importPath:       importPath,
 		scope:            scope,
 		types:            types,
+		encoding:         encoding,
 	}
 	_ = singleUnionTypeProperties.Accept(visitor)
 	return visitor.value
@@ -1062,11 +1385,14 @@ func packagePathToImportPath(baseImportPath string, packagePath []string) string
 }
 
 // isPointer returns true if the given type is a pointer type (e.g. objects and
-// unions). Enums, primitives, and aliases of these types do not require pointers.
-func isPointer(typeDeclaration *ir.TypeDeclaration) bool {
+// unions). Enums, primitives, and aliases of these types do not require pointers,
+// and neither do unions represented with an interface (see UnionEncodingInterface).
+func isPointer(typeDeclaration *ir.TypeDeclaration, encoding *EncodingConfig) bool {
 	switch typeDeclaration.Shape.Type {
-	case "object", "union", "undiscriminatedUnion":
+	case "object":
 		return true
+	case "union", "undiscriminatedUnion":
+		return !encoding.usesInterfaceUnions()
 	case "alias", "enum", "primitive":
 		return false
 	}
@@ -1074,6 +1400,41 @@ func isPointer(typeDeclaration *ir.TypeDeclaration) bool {
 	return false
 }
 
+// isSetType returns true if the given type reference is a set
+// represented with core.Set (see SetEncodingSet).
+func isSetType(typeReference *ir.TypeReference, types map[ir.TypeId]*ir.TypeDeclaration, encoding *EncodingConfig) bool {
+	if typeReference.Container == nil || typeReference.Container.Set == nil {
+		return false
+	}
+	return encoding.usesSetType() && isComparableType(typeReference.Container.Set, types, encoding)
+}
+
+// isComparableType returns true if the given type reference is represented
+// with a Go type that can be compared by value (and used as a map key).
+//
+// Objects and unions are excluded because they're compared by reference, and
+// datetimes are excluded because time.Time values shouldn't be compared with ==.
+func isComparableType(typeReference *ir.TypeReference, types map[ir.TypeId]*ir.TypeDeclaration, encoding *EncodingConfig) bool {
+	switch {
+	case typeReference.Named != nil:
+		typeDeclaration := types[typeReference.Named.TypeId]
+		switch typeDeclaration.Shape.Type {
+		case "alias":
+			return isComparableType(typeDeclaration.Shape.Alias.AliasOf, types, encoding)
+		case "enum":
+			return true
+		}
+		return false
+	case typeReference.Primitive != "":
+		switch primitiveToGoType(typeReference.Primitive, encoding) {
+		case "int", "int64", "float64", "json.Number", "core.Int64String", "string", "bool", "core.Date", "uuid.UUID":
+			return true
+		}
+		return false
+	}
+	return false
+}
+
 // typeNameToReceiver returns the receiver name for
 // the given type name. This is just the lowercase
 // equivalent of the first character.
@@ -1115,6 +1476,33 @@ func jsonTagForType(wireValue string, valueType *ir.TypeReference, types map[ir.
 	return fmt.Sprintf(" `json:\"%s,omitempty\"`", wireValue)
 }
 
+// extraStructTags returns the configured extra struct tags (e.g. yaml:"name,omitempty")
+// for the given property. Unlike the JSON tag, omitempty is only included for optional
+// properties.
+func (f *fileWriter) extraStructTags(name *ir.NameAndWireValue, valueType *ir.TypeReference) string {
+	tags := make([]string, 0, len(f.structTags))
+	for _, structTag := range f.structTags {
+		value := structTag.name(name)
+		if valueType.Container != nil && valueType.Container.Optional != nil {
+			value += ",omitempty"
+		}
+		tags = append(tags, fmt.Sprintf("%s:%q", structTag.Key, value))
+	}
+	return strings.Join(tags, " ")
+}
+
+// appendStructTags appends the given tags to the struct tag (e.g. " `json:\"name\"`"),
+// which might be empty.
+func appendStructTags(tag string, tags string) string {
+	if tags == "" {
+		return tag
+	}
+	if tag == "" {
+		return " `" + tags + "`"
+	}
+	return strings.TrimSuffix(tag, "`") + " " + tags + "`"
+}
+
 // unknownToGoType maps the given unknown into its Go-equivalent.
 func unknownToGoType(_ any) string {
 	return "interface{}"
@@ -1131,22 +1519,40 @@ func literalToUndiscriminatedUnionField(literal *ir.Literal) string {
 	}
 }
 
-// primitiveToGoType maps Fern's primitive types to their Go-equivalent.
-func primitiveToGoType(primitive ir.PrimitiveType) string {
+// primitiveToGoType maps Fern's primitive types to their Go-equivalent,
+// respecting the configured encoding (if any).
+func primitiveToGoType(primitive ir.PrimitiveType, encoding *EncodingConfig) string {
 	switch primitive {
 	case ir.PrimitiveTypeInteger:
 		return "int"
 	case ir.PrimitiveTypeDouble:
+		if encoding != nil && encoding.Double == DoubleEncodingJSONNumber {
+			return "json.Number"
+		}
 		return "float64"
 	case ir.PrimitiveTypeString:
 		return "string"
 	case ir.PrimitiveTypeBoolean:
 		return "bool"
 	case ir.PrimitiveTypeLong:
+		if encoding != nil && encoding.Long == LongEncodingString {
+			return "core.Int64String"
+		}
 		return "int64"
 	case ir.PrimitiveTypeDateTime:
+		if encoding != nil {
+			switch encoding.DateTime {
+			case DateTimeEncodingUnixSeconds:
+				return "core.UnixSeconds"
+			case DateTimeEncodingUnixMillis:
+				return "core.UnixMillis"
+			}
+		}
 		return "time.Time"
 	case ir.PrimitiveTypeDate:
+		if encoding.usesDateType() {
+			return "core.Date"
+		}
 		return "time.Time"
 	case ir.PrimitiveTypeUuid:
 		return "uuid.UUID"
@@ -1196,37 +1602,43 @@ func typeNameToFieldName(typeName string) string {
 // defaultValueForTypeReference returns the default value associated with the given *ir.TypeReference.
 // For named types and built-ins, this will just be nil, otherwise it will be the associated primitive
 // value.
-func defaultValueForTypeReference(typeReference *ir.TypeReference, types map[string]*ir.TypeDeclaration) string {
+func defaultValueForTypeReference(typeReference *ir.TypeReference, types map[string]*ir.TypeDeclaration, encoding *EncodingConfig) string {
 	if typeReference.Container != nil {
 		if typeReference.Container.Literal != nil {
-			return ""
+			if literalToGoType(typeReference.Container.Literal) == "bool" {
+				return "false"
+			}
+			return `""`
 		}
 		return "nil"
 	}
 	if typeReference.Named != nil {
-		return defaultValueForTypeDeclaration(types[typeReference.Named.TypeId], types)
+		return defaultValueForTypeDeclaration(types[typeReference.Named.TypeId], types, encoding)
 	}
 	if typeReference.Primitive != "" {
-		return defaultValueForPrimitiveType(typeReference.Primitive)
+		return defaultValueForPrimitiveType(typeReference.Primitive, encoding)
 	}
 	return "nil"
 }
 
-func defaultValueForTypeDeclaration(typeDeclaration *ir.TypeDeclaration, types map[string]*ir.TypeDeclaration) string {
+func defaultValueForTypeDeclaration(typeDeclaration *ir.TypeDeclaration, types map[string]*ir.TypeDeclaration, encoding *EncodingConfig) string {
 	if typeDeclaration.Shape.Alias != nil {
-		return defaultValueForTypeReference(typeDeclaration.Shape.Alias.AliasOf, types)
+		return defaultValueForTypeReference(typeDeclaration.Shape.Alias.AliasOf, types, encoding)
 	}
 	if typeDeclaration.Shape.Enum != nil {
-		return ""
+		return `""`
 	}
 	return "nil"
 }
 
-func defaultValueForPrimitiveType(primitiveType ir.PrimitiveType) string {
+func defaultValueForPrimitiveType(primitiveType ir.PrimitiveType, encoding *EncodingConfig) string {
 	switch primitiveType {
 	case ir.PrimitiveTypeInteger:
 		return "0"
 	case ir.PrimitiveTypeDouble:
+		if encoding != nil && encoding.Double == DoubleEncodingJSONNumber {
+			return `""`
+		}
 		return "0"
 	case ir.PrimitiveTypeString:
 		return `""`
@@ -1234,10 +1646,8 @@ func defaultValueForPrimitiveType(primitiveType ir.PrimitiveType) string {
 		return "false"
 	case ir.PrimitiveTypeLong:
 		return "0"
-	case ir.PrimitiveTypeDateTime:
-		return "time.Time{}"
-	case ir.PrimitiveTypeDate:
-		return "time.Time{}"
+	case ir.PrimitiveTypeDateTime, ir.PrimitiveTypeDate:
+		return primitiveToGoType(primitiveType, encoding) + "{}"
 	case ir.PrimitiveTypeUuid:
 		return "uuid.Nil"
 	case ir.PrimitiveTypeBase64:
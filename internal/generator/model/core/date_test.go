@@ -0,0 +1,57 @@
+package core
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type dateRequest struct {
+	Date         Date  `json:"date"`
+	OptionalDate *Date `json:"optionalDate,omitempty"`
+}
+
+func TestDate(t *testing.T) {
+	t.Run("json", func(t *testing.T) {
+		request := &dateRequest{
+			Date:         Date{Year: 2023, Month: time.September, Day: 4},
+			OptionalDate: Date{Year: 1999, Month: time.December, Day: 31}.Ptr(),
+		}
+		bytes, err := json.Marshal(request)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"date":"2023-09-04","optionalDate":"1999-12-31"}`, string(bytes))
+
+		value := new(dateRequest)
+		require.NoError(t, json.Unmarshal(bytes, value))
+		assert.Equal(t, request, value)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		value := new(dateRequest)
+		assert.Error(t, json.Unmarshal([]byte(`{"date":"2023-09-04T00:00:00Z"}`), value))
+		assert.Error(t, json.Unmarshal([]byte(`{"date":20230904}`), value))
+	})
+
+	t.Run("time", func(t *testing.T) {
+		location := time.FixedZone("UTC-8", -8*60*60)
+		date := NewDate(time.Date(2023, time.September, 4, 23, 30, 0, 0, location))
+		assert.Equal(t, "2023-09-04", date.String())
+		assert.Equal(t, time.Date(2023, time.September, 4, 0, 0, 0, 0, time.UTC), date.Time())
+		assert.False(t, date.IsZero())
+		assert.True(t, Date{}.IsZero())
+	})
+
+	t.Run("text", func(t *testing.T) {
+		date, err := ParseDate("2024-02-29")
+		require.NoError(t, err)
+		text, err := date.MarshalText()
+		require.NoError(t, err)
+		assert.Equal(t, "2024-02-29", string(text))
+
+		_, err = ParseDate("2023-02-29")
+		assert.Error(t, err)
+	})
+}
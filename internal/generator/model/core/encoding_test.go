@@ -0,0 +1,70 @@
+package core
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type encodingRequest struct {
+	Id               Int64String  `json:"id"`
+	OptionalId       *Int64String `json:"optionalId,omitempty"`
+	CreatedAt        UnixSeconds  `json:"createdAt"`
+	OptionalDeadline *UnixMillis  `json:"optionalDeadline,omitempty"`
+}
+
+func TestEncoding(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		request := &encodingRequest{
+			Id:               Int64String(9007199254740993),
+			OptionalId:       Int64String(-42).Ptr(),
+			CreatedAt:        NewUnixSeconds(time.Unix(1136214245, 0)),
+			OptionalDeadline: NewUnixMillis(time.UnixMilli(1136214245123)).Ptr(),
+		}
+		bytes, err := json.Marshal(request)
+		require.NoError(t, err)
+		assert.JSONEq(
+			t,
+			`{"id":"9007199254740993","optionalId":"-42","createdAt":1136214245,"optionalDeadline":1136214245123}`,
+			string(bytes),
+		)
+
+		value := new(encodingRequest)
+		require.NoError(t, json.Unmarshal(bytes, value))
+		assert.Equal(t, request.Id, value.Id)
+		assert.Equal(t, *request.OptionalId, *value.OptionalId)
+		assert.True(t, request.CreatedAt.Equal(value.CreatedAt.Time))
+		assert.True(t, request.OptionalDeadline.Equal(value.OptionalDeadline.Time))
+	})
+
+	t.Run("lenient", func(t *testing.T) {
+		value := new(encodingRequest)
+		require.NoError(t, json.Unmarshal([]byte(`{"id":42,"createdAt":"1136214245","optionalDeadline":1136214245123.5}`), value))
+		assert.Equal(t, Int64String(42), value.Id)
+		assert.Equal(t, int64(1136214245), value.CreatedAt.Unix())
+		assert.Equal(t, int64(1136214245123500), value.OptionalDeadline.UnixMicro())
+
+		require.NoError(t, json.Unmarshal([]byte(`{"createdAt":1136214245.25}`), value))
+		assert.Equal(t, int64(1136214245250), value.CreatedAt.UnixMilli())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		value := new(encodingRequest)
+		assert.Error(t, json.Unmarshal([]byte(`{"id":"fern"}`), value))
+		assert.Error(t, json.Unmarshal([]byte(`{"id":4.2}`), value))
+		assert.Error(t, json.Unmarshal([]byte(`{"createdAt":"2006-01-02T15:04:05Z"}`), value))
+	})
+
+	t.Run("text", func(t *testing.T) {
+		assert.Equal(t, "42", Int64String(42).String())
+		assert.Equal(t, "1136214245", NewUnixSeconds(time.Unix(1136214245, 0)).String())
+		assert.Equal(t, "1136214245123", NewUnixMillis(time.UnixMilli(1136214245123)).String())
+
+		var millis UnixMillis
+		require.NoError(t, millis.UnmarshalText([]byte("-1500")))
+		assert.Equal(t, int64(-1500), millis.UnixMilli())
+	})
+}
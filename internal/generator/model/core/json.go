@@ -0,0 +1,763 @@
+package core
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// JSONWriter incrementally writes a JSON document without reflection.
+// It's used by the generated MarshalJSON implementations.
+//
+// The first error encountered is retained and returned by Bytes, so
+// callers don't need to check for an error after every write.
+type JSONWriter struct {
+	buf    []byte
+	err    error
+	frames []jsonWriterFrame
+
+	afterKey bool
+	inline   bool
+}
+
+// jsonWriterFrame tracks the state of an open object or array.
+type jsonWriterFrame struct {
+	needsComma bool
+	inline     bool
+}
+
+// NewJSONWriter returns a new, empty JSONWriter.
+func NewJSONWriter() *JSONWriter {
+	return &JSONWriter{
+		buf: make([]byte, 0, 128),
+	}
+}
+
+// Bytes returns the JSON document written so far, or the first error
+// encountered while writing it.
+func (w *JSONWriter) Bytes() ([]byte, error) {
+	if w.err != nil {
+		return nil, w.err
+	}
+	return w.buf, nil
+}
+
+// ObjectStart opens a new object.
+func (w *JSONWriter) ObjectStart() {
+	if w.inline {
+		// The object's fields are written directly into the enclosing object.
+		w.inline = false
+		w.frames = append(w.frames, jsonWriterFrame{needsComma: w.frames[len(w.frames)-1].needsComma, inline: true})
+		return
+	}
+	w.beforeValue()
+	w.buf = append(w.buf, '{')
+	w.frames = append(w.frames, jsonWriterFrame{})
+}
+
+// ObjectEnd closes the current object.
+func (w *JSONWriter) ObjectEnd() {
+	frame := w.frames[len(w.frames)-1]
+	w.frames = w.frames[:len(w.frames)-1]
+	if frame.inline {
+		w.frames[len(w.frames)-1].needsComma = frame.needsComma
+		return
+	}
+	w.buf = append(w.buf, '}')
+}
+
+// Inline causes the fields of the next object to be written directly
+// into the current object, which is how embedded objects are represented.
+func (w *JSONWriter) Inline() {
+	w.inline = true
+}
+
+// ArrayStart opens a new array.
+func (w *JSONWriter) ArrayStart() {
+	w.beforeValue()
+	w.buf = append(w.buf, '[')
+	w.frames = append(w.frames, jsonWriterFrame{})
+}
+
+// ArrayEnd closes the current array.
+func (w *JSONWriter) ArrayEnd() {
+	w.frames = w.frames[:len(w.frames)-1]
+	w.buf = append(w.buf, ']')
+}
+
+// Key writes the given object key. It must be followed by exactly one value.
+func (w *JSONWriter) Key(key string) {
+	w.beforeValue()
+	w.buf = appendJSONString(w.buf, key)
+	w.buf = append(w.buf, ':')
+	w.afterKey = true
+}
+
+func (w *JSONWriter) Null() {
+	w.beforeValue()
+	w.buf = append(w.buf, "null"...)
+}
+
+func (w *JSONWriter) Bool(value bool) {
+	w.beforeValue()
+	w.buf = strconv.AppendBool(w.buf, value)
+}
+
+func (w *JSONWriter) Int(value int) {
+	w.Int64(int64(value))
+}
+
+func (w *JSONWriter) Int64(value int64) {
+	w.beforeValue()
+	w.buf = strconv.AppendInt(w.buf, value, 10)
+}
+
+// Float64 writes the given value in the same format used by encoding/json.
+func (w *JSONWriter) Float64(value float64) {
+	if math.IsInf(value, 0) || math.IsNaN(value) {
+		w.SetErr(fmt.Errorf("json: unsupported value: %s", strconv.FormatFloat(value, 'g', -1, 64)))
+		return
+	}
+	w.beforeValue()
+	format := byte('f')
+	if abs := math.Abs(value); abs != 0 && (abs < 1e-6 || abs >= 1e21) {
+		format = 'e'
+	}
+	w.buf = strconv.AppendFloat(w.buf, value, format, -1, 64)
+	if format == 'e' {
+		// Clean up e-09 to e-9.
+		n := len(w.buf)
+		if n >= 4 && w.buf[n-4] == 'e' && w.buf[n-3] == '-' && w.buf[n-2] == '0' {
+			w.buf[n-2] = w.buf[n-1]
+			w.buf = w.buf[:n-1]
+		}
+	}
+}
+
+func (w *JSONWriter) Number(value json.Number) {
+	if value == "" {
+		value = "0"
+	}
+	if !isValidJSONNumber(string(value)) {
+		w.SetErr(fmt.Errorf("json: invalid number literal %q", value))
+		return
+	}
+	w.beforeValue()
+	w.buf = append(w.buf, value...)
+}
+
+// String writes the given value, escaped in the same way as encoding/json.
+func (w *JSONWriter) String(value string) {
+	w.beforeValue()
+	w.buf = appendJSONString(w.buf, value)
+}
+
+// Base64 writes the given bytes as a base64-encoded string.
+func (w *JSONWriter) Base64(value []byte) {
+	if value == nil {
+		w.Null()
+		return
+	}
+	w.beforeValue()
+	encoded := make([]byte, base64.StdEncoding.EncodedLen(len(value)))
+	base64.StdEncoding.Encode(encoded, value)
+	w.buf = append(w.buf, '"')
+	w.buf = append(w.buf, encoded...)
+	w.buf = append(w.buf, '"')
+}
+
+// Raw writes the given JSON value as-is. It's designed to be used with
+// the result of a MarshalJSON method (e.g. w.Raw(value.MarshalJSON())).
+func (w *JSONWriter) Raw(value []byte, err error) {
+	if err != nil {
+		w.SetErr(err)
+		return
+	}
+	if len(value) == 0 {
+		w.Null()
+		return
+	}
+	w.beforeValue()
+	w.buf = append(w.buf, value...)
+}
+
+// Value writes the given value with encoding/json. It's used for
+// values that can't be written without reflection.
+func (w *JSONWriter) Value(value interface{}) {
+	w.Raw(json.Marshal(value))
+}
+
+// SetErr records the given error, unless an error was already recorded.
+func (w *JSONWriter) SetErr(err error) {
+	if w.err == nil && err != nil {
+		w.err = err
+	}
+}
+
+// beforeValue writes a comma if the value isn't the first one in the
+// enclosing object or array.
+func (w *JSONWriter) beforeValue() {
+	if w.afterKey {
+		w.afterKey = false
+		return
+	}
+	if n := len(w.frames); n > 0 {
+		if w.frames[n-1].needsComma {
+			w.buf = append(w.buf, ',')
+		}
+		w.frames[n-1].needsComma = true
+	}
+}
+
+// JSONReader incrementally reads a JSON document without reflection.
+// It's used by the generated UnmarshalJSON implementations.
+//
+// The first error encountered is retained, and every subsequent read
+// returns a zero value, so callers only need to check Err (or Done)
+// once they're finished. A JSON null is read as the zero value.
+type JSONReader struct {
+	data   []byte
+	pos    int
+	err    error
+	frames []bool
+}
+
+// NewJSONReader returns a new JSONReader for the given JSON document.
+func NewJSONReader(data []byte) *JSONReader {
+	return &JSONReader{
+		data:   data,
+		frames: make([]bool, 0, 8),
+	}
+}
+
+// Err returns the first error encountered, if any.
+func (r *JSONReader) Err() error {
+	return r.err
+}
+
+// SetErr records the given error, unless an error was already recorded.
+func (r *JSONReader) SetErr(err error) {
+	if r.err == nil && err != nil {
+		r.err = err
+	}
+}
+
+// Done verifies that the entire document was read, and returns the
+// first error encountered, if any.
+func (r *JSONReader) Done() error {
+	r.skipWhitespace()
+	if r.err == nil && r.pos < len(r.data) {
+		r.fail("end of input")
+	}
+	return r.err
+}
+
+// Mark returns the offset of the next value, which can be used
+// with Since to retrieve the value's raw bytes after it's read.
+func (r *JSONReader) Mark() int {
+	r.skipWhitespace()
+	return r.pos
+}
+
+// Since returns the raw bytes read since the given mark.
+func (r *JSONReader) Since(mark int) []byte {
+	if r.err != nil {
+		return nil
+	}
+	return r.data[mark:r.pos]
+}
+
+// IsNull reports whether the next value is null, and consumes it if so.
+func (r *JSONReader) IsNull() bool {
+	if r.err != nil {
+		return false
+	}
+	r.skipWhitespace()
+	if bytes.HasPrefix(r.data[r.pos:], []byte("null")) {
+		r.pos += len("null")
+		return true
+	}
+	return false
+}
+
+// ObjectStart reads the start of an object.
+func (r *JSONReader) ObjectStart() {
+	r.start('{', "object")
+}
+
+// ObjectEnd reads the end of an object.
+func (r *JSONReader) ObjectEnd() {
+	r.end('}')
+}
+
+// ArrayStart reads the start of an array.
+func (r *JSONReader) ArrayStart() {
+	r.start('[', "array")
+}
+
+// ArrayEnd reads the end of an array.
+func (r *JSONReader) ArrayEnd() {
+	r.end(']')
+}
+
+// More reports whether the current object or array has another element.
+func (r *JSONReader) More() bool {
+	if r.err != nil || len(r.frames) == 0 {
+		return false
+	}
+	r.skipWhitespace()
+	if r.pos >= len(r.data) {
+		r.fail("',' or end of object or array")
+		return false
+	}
+	if c := r.data[r.pos]; c == '}' || c == ']' {
+		return false
+	}
+	if r.frames[len(r.frames)-1] {
+		if r.data[r.pos] != ',' {
+			r.fail("','")
+			return false
+		}
+		r.pos++
+	}
+	r.frames[len(r.frames)-1] = true
+	return true
+}
+
+// Key reads the next object key, including the subsequent colon.
+func (r *JSONReader) Key() string {
+	return string(r.KeyBytes())
+}
+
+// KeyBytes acts like Key, but returns the key's bytes without allocating.
+// The returned slice is only valid until the next read.
+func (r *JSONReader) KeyBytes() []byte {
+	key := r.readStringBytes("object key")
+	r.skipWhitespace()
+	if r.err == nil {
+		if r.pos >= len(r.data) || r.data[r.pos] != ':' {
+			r.fail("':'")
+			return nil
+		}
+		r.pos++
+	}
+	return key
+}
+
+func (r *JSONReader) String() string {
+	if r.IsNull() {
+		return ""
+	}
+	return r.readString("string")
+}
+
+func (r *JSONReader) Bool() bool {
+	if r.IsNull() || r.err != nil {
+		return false
+	}
+	switch {
+	case bytes.HasPrefix(r.data[r.pos:], []byte("true")):
+		r.pos += len("true")
+		return true
+	case bytes.HasPrefix(r.data[r.pos:], []byte("false")):
+		r.pos += len("false")
+		return false
+	}
+	r.fail("boolean")
+	return false
+}
+
+func (r *JSONReader) Int() int {
+	value, err := strconv.ParseInt(string(r.readNumber()), 10, 0)
+	if err != nil {
+		r.SetErr(fmt.Errorf("json: cannot unmarshal number into int: %w", err))
+	}
+	return int(value)
+}
+
+func (r *JSONReader) Int64() int64 {
+	value, err := strconv.ParseInt(string(r.readNumber()), 10, 64)
+	if err != nil {
+		r.SetErr(fmt.Errorf("json: cannot unmarshal number into int64: %w", err))
+	}
+	return value
+}
+
+func (r *JSONReader) Float64() float64 {
+	value, err := strconv.ParseFloat(string(r.readNumber()), 64)
+	if err != nil {
+		r.SetErr(fmt.Errorf("json: cannot unmarshal number into float64: %w", err))
+	}
+	return value
+}
+
+func (r *JSONReader) Number() json.Number {
+	return json.Number(r.readNumber())
+}
+
+// Base64 reads a base64-encoded string.
+func (r *JSONReader) Base64() []byte {
+	if r.IsNull() {
+		return nil
+	}
+	value, err := base64.StdEncoding.DecodeString(r.readString("string"))
+	if err != nil {
+		r.SetErr(err)
+		return nil
+	}
+	return value
+}
+
+// Raw returns the raw bytes of the next value.
+func (r *JSONReader) Raw() []byte {
+	mark := r.Mark()
+	r.Skip()
+	return r.Since(mark)
+}
+
+// Unmarshaler reads the next value with the given json.Unmarshaler.
+func (r *JSONReader) Unmarshaler(value json.Unmarshaler) {
+	if data := r.Raw(); r.err == nil {
+		r.SetErr(value.UnmarshalJSON(data))
+	}
+}
+
+// TextUnmarshaler reads the next string with the given encoding.TextUnmarshaler.
+func (r *JSONReader) TextUnmarshaler(value encoding.TextUnmarshaler) {
+	if r.IsNull() {
+		return
+	}
+	if text := r.readString("string"); r.err == nil {
+		r.SetErr(value.UnmarshalText([]byte(text)))
+	}
+}
+
+// Value reads the next value with encoding/json. It's used for values
+// that can't be read without reflection.
+func (r *JSONReader) Value(value interface{}) {
+	if data := r.Raw(); r.err == nil {
+		r.SetErr(json.Unmarshal(data, value))
+	}
+}
+
+// Skip skips the next value.
+func (r *JSONReader) Skip() {
+	if r.err != nil {
+		return
+	}
+	r.skipWhitespace()
+	if r.pos >= len(r.data) {
+		r.fail("value")
+		return
+	}
+	switch c := r.data[r.pos]; {
+	case c == '{':
+		r.ObjectStart()
+		for r.More() {
+			_ = r.Key()
+			r.Skip()
+		}
+		r.ObjectEnd()
+	case c == '[':
+		r.ArrayStart()
+		for r.More() {
+			r.Skip()
+		}
+		r.ArrayEnd()
+	case c == '"':
+		_ = r.readString("string")
+	case c == 't' || c == 'f':
+		_ = r.Bool()
+	case c == 'n':
+		if !r.IsNull() {
+			r.fail("null")
+		}
+	default:
+		_ = r.readNumber()
+	}
+}
+
+func (r *JSONReader) start(c byte, description string) {
+	if r.err != nil {
+		return
+	}
+	r.skipWhitespace()
+	if r.pos >= len(r.data) || r.data[r.pos] != c {
+		r.fail(description)
+		return
+	}
+	r.pos++
+	r.frames = append(r.frames, false)
+}
+
+func (r *JSONReader) end(c byte) {
+	if r.err != nil {
+		return
+	}
+	r.skipWhitespace()
+	if r.pos >= len(r.data) || r.data[r.pos] != c {
+		r.fail(fmt.Sprintf("'%c'", c))
+		return
+	}
+	r.pos++
+	r.frames = r.frames[:len(r.frames)-1]
+}
+
+// readNumber reads the next number literal. A null is read as zero.
+func (r *JSONReader) readNumber() []byte {
+	if r.IsNull() || r.err != nil {
+		return []byte("0")
+	}
+	start := r.pos
+	for r.pos < len(r.data) {
+		c := r.data[r.pos]
+		if (c < '0' || c > '9') && c != '-' && c != '+' && c != '.' && c != 'e' && c != 'E' {
+			break
+		}
+		r.pos++
+	}
+	number := r.data[start:r.pos]
+	if !isValidJSONNumber(string(number)) {
+		r.pos = start
+		r.fail("number")
+		return []byte("0")
+	}
+	return number
+}
+
+// readString reads the next string, decoding any escape sequences.
+func (r *JSONReader) readString(description string) string {
+	return string(r.readStringBytes(description))
+}
+
+// readStringBytes acts like readString, but returns the string's bytes, which
+// might refer to the reader's underlying data.
+func (r *JSONReader) readStringBytes(description string) []byte {
+	if r.err != nil {
+		return nil
+	}
+	r.skipWhitespace()
+	if r.pos >= len(r.data) || r.data[r.pos] != '"' {
+		r.fail(description)
+		return nil
+	}
+	r.pos++
+	start := r.pos
+
+	// Fast path for strings without any escape sequences.
+	for r.pos < len(r.data) {
+		c := r.data[r.pos]
+		if c == '"' {
+			value := r.data[start:r.pos]
+			r.pos++
+			if utf8.Valid(value) {
+				return value
+			}
+			return bytes.ToValidUTF8(value, []byte("\ufffd"))
+		}
+		if c == '\\' || c < 0x20 {
+			break
+		}
+		r.pos++
+	}
+
+	value := make([]byte, 0, r.pos-start+16)
+	value = append(value, r.data[start:r.pos]...)
+	for r.pos < len(r.data) {
+		c := r.data[r.pos]
+		switch {
+		case c == '"':
+			r.pos++
+			return bytes.ToValidUTF8(value, []byte("\ufffd"))
+		case c < 0x20:
+			r.fail("string character")
+			return nil
+		case c != '\\':
+			value = append(value, c)
+			r.pos++
+			continue
+		}
+		if r.pos+1 >= len(r.data) {
+			break
+		}
+		r.pos += 2
+		switch escaped := r.data[r.pos-1]; escaped {
+		case '"', '\\', '/':
+			value = append(value, escaped)
+		case 'b':
+			value = append(value, '\b')
+		case 'f':
+			value = append(value, '\f')
+		case 'n':
+			value = append(value, '\n')
+		case 'r':
+			value = append(value, '\r')
+		case 't':
+			value = append(value, '\t')
+		case 'u':
+			decoded, ok := r.readUnicodeEscape()
+			if !ok {
+				r.fail("unicode escape")
+				return nil
+			}
+			if utf16.IsSurrogate(decoded) {
+				// Surrogate pairs are written as two consecutive escape sequences.
+				first := decoded
+				decoded = utf8.RuneError
+				if r.pos+1 < len(r.data) && r.data[r.pos] == '\\' && r.data[r.pos+1] == 'u' {
+					pos := r.pos
+					r.pos += 2
+					second, ok := r.readUnicodeEscape()
+					if pair := utf16.DecodeRune(first, second); ok && pair != utf8.RuneError {
+						decoded = pair
+					} else {
+						r.pos = pos
+					}
+				}
+			}
+			var encoded [utf8.UTFMax]byte
+			value = append(value, encoded[:utf8.EncodeRune(encoded[:], decoded)]...)
+		default:
+			r.fail("escape sequence")
+			return nil
+		}
+	}
+	r.fail("end of string")
+	return nil
+}
+
+// readUnicodeEscape reads the four hex digits following a \u escape.
+func (r *JSONReader) readUnicodeEscape() (rune, bool) {
+	if r.pos+4 > len(r.data) {
+		return 0, false
+	}
+	value, err := strconv.ParseUint(string(r.data[r.pos:r.pos+4]), 16, 16)
+	if err != nil {
+		return 0, false
+	}
+	r.pos += 4
+	return rune(value), true
+}
+
+func (r *JSONReader) skipWhitespace() {
+	for r.pos < len(r.data) {
+		switch r.data[r.pos] {
+		case ' ', '\t', '\n', '\r':
+			r.pos++
+		default:
+			return
+		}
+	}
+}
+
+func (r *JSONReader) fail(expected string) {
+	if r.pos >= len(r.data) {
+		r.SetErr(fmt.Errorf("json: unexpected end of input, expected %s", expected))
+		return
+	}
+	r.SetErr(fmt.Errorf("json: invalid character %q at offset %d, expected %s", r.data[r.pos], r.pos, expected))
+}
+
+// isValidJSONNumber reports whether s is a valid JSON number literal.
+func isValidJSONNumber(s string) bool {
+	if s == "" {
+		return false
+	}
+	if s[0] == '-' {
+		s = s[1:]
+		if s == "" {
+			return false
+		}
+	}
+	switch {
+	case s[0] == '0':
+		s = s[1:]
+	case '1' <= s[0] && s[0] <= '9':
+		s = s[1:]
+		for len(s) > 0 && '0' <= s[0] && s[0] <= '9' {
+			s = s[1:]
+		}
+	default:
+		return false
+	}
+	if len(s) >= 2 && s[0] == '.' && '0' <= s[1] && s[1] <= '9' {
+		s = s[2:]
+		for len(s) > 0 && '0' <= s[0] && s[0] <= '9' {
+			s = s[1:]
+		}
+	}
+	if len(s) >= 2 && (s[0] == 'e' || s[0] == 'E') {
+		s = s[1:]
+		if s[0] == '+' || s[0] == '-' {
+			s = s[1:]
+			if s == "" {
+				return false
+			}
+		}
+		for len(s) > 0 && '0' <= s[0] && s[0] <= '9' {
+			s = s[1:]
+		}
+	}
+	return s == ""
+}
+
+// appendJSONString appends the given string to dst, escaped in
+// the same way as encoding/json (including HTML characters).
+func appendJSONString(dst []byte, s string) []byte {
+	const hex = "0123456789abcdef"
+	dst = append(dst, '"')
+	start := 0
+	for i := 0; i < len(s); {
+		if c := s[i]; c < utf8.RuneSelf {
+			if c >= 0x20 && c != '"' && c != '\\' && c != '<' && c != '>' && c != '&' {
+				i++
+				continue
+			}
+			dst = append(dst, s[start:i]...)
+			switch c {
+			case '"', '\\':
+				dst = append(dst, '\\', c)
+			case '\b':
+				dst = append(dst, '\\', 'b')
+			case '\f':
+				dst = append(dst, '\\', 'f')
+			case '\n':
+				dst = append(dst, '\\', 'n')
+			case '\r':
+				dst = append(dst, '\\', 'r')
+			case '\t':
+				dst = append(dst, '\\', 't')
+			default:
+				dst = append(dst, '\\', 'u', '0', '0', hex[c>>4], hex[c&0xF])
+			}
+			i++
+			start = i
+			continue
+		}
+		decoded, size := utf8.DecodeRuneInString(s[i:])
+		if decoded == utf8.RuneError && size == 1 {
+			dst = append(dst, s[start:i]...)
+			dst = append(dst, "\ufffd"...)
+			i += size
+			start = i
+			continue
+		}
+		if decoded == '\u2028' || decoded == '\u2029' {
+			dst = append(dst, s[start:i]...)
+			dst = append(dst, '\\', 'u', '2', '0', '2', hex[decoded&0xF])
+			i += size
+			start = i
+			continue
+		}
+		i += size
+	}
+	dst = append(dst, s[start:]...)
+	dst = append(dst, '"')
+	return dst
+}
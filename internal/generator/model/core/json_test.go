@@ -0,0 +1,178 @@
+package core
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJSONWriter(t *testing.T) {
+	t.Run("parity", func(t *testing.T) {
+		strings := []string{
+			"",
+			"fern",
+			`"quoted" \back\slash/`,
+			"<html> & </html>",
+			"\b\f\n\r\t\x00\x1f",
+			"line\u2028separator\u2029",
+			"emoji 🌿 and ünïcödé",
+			"invalid \xff utf8",
+		}
+		for _, value := range strings {
+			expected, err := json.Marshal(value)
+			require.NoError(t, err)
+			writer := NewJSONWriter()
+			writer.String(value)
+			actual, err := writer.Bytes()
+			require.NoError(t, err)
+			assert.Equal(t, string(expected), string(actual), value)
+		}
+
+		floats := []float64{0, -0.5, 3.14, 1e-7, 1e20, 1e21, 123456789.123, math.MaxFloat64, math.SmallestNonzeroFloat64}
+		for _, value := range floats {
+			expected, err := json.Marshal(value)
+			require.NoError(t, err)
+			writer := NewJSONWriter()
+			writer.Float64(value)
+			actual, err := writer.Bytes()
+			require.NoError(t, err)
+			assert.Equal(t, string(expected), string(actual))
+		}
+	})
+
+	t.Run("structure", func(t *testing.T) {
+		writer := NewJSONWriter()
+		writer.ObjectStart()
+		writer.Key("list")
+		writer.ArrayStart()
+		writer.Int(1)
+		writer.Null()
+		writer.ObjectStart()
+		writer.ObjectEnd()
+		writer.ArrayEnd()
+		writer.Key("inline")
+		writer.Bool(true)
+		writer.Inline()
+		writer.ObjectStart()
+		writer.Key("embedded")
+		writer.Base64([]byte("abc"))
+		writer.ObjectEnd()
+		writer.Key("raw")
+		writer.Raw(time.Date(2023, time.September, 4, 0, 0, 0, 0, time.UTC).MarshalJSON())
+		writer.Key("number")
+		writer.Number("6.02e+23")
+		writer.ObjectEnd()
+		bytes, err := writer.Bytes()
+		require.NoError(t, err)
+		assert.Equal(
+			t,
+			`{"list":[1,null,{}],"inline":true,"embedded":"YWJj","raw":"2023-09-04T00:00:00Z","number":6.02e+23}`,
+			string(bytes),
+		)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		writer := NewJSONWriter()
+		writer.Float64(math.NaN())
+		_, err := writer.Bytes()
+		assert.Error(t, err)
+
+		writer = NewJSONWriter()
+		writer.Number("0x10")
+		_, err = writer.Bytes()
+		assert.Error(t, err)
+	})
+}
+
+func TestJSONReader(t *testing.T) {
+	t.Run("values", func(t *testing.T) {
+		reader := NewJSONReader([]byte(` {
+			"string": "fern \"quoted\" é 🌿",
+			"int": -42,
+			"float": 6.02e+23,
+			"bool": false,
+			"null": null,
+			"base64": "YWJj",
+			"list": [1, 2, 3],
+			"skipped": {"nested": [true, null, "\\"]},
+			"date": "2023-09-04"
+		} `))
+		var (
+			list []int
+			date Date
+		)
+		reader.ObjectStart()
+		for reader.More() {
+			switch reader.Key() {
+			case "string":
+				assert.Equal(t, "fern \"quoted\" é 🌿", reader.String())
+			case "int":
+				assert.Equal(t, int64(-42), reader.Int64())
+			case "float":
+				assert.Equal(t, 6.02e23, reader.Float64())
+			case "bool":
+				assert.False(t, reader.Bool())
+			case "null":
+				assert.Equal(t, "", reader.String())
+			case "base64":
+				assert.Equal(t, []byte("abc"), reader.Base64())
+			case "list":
+				reader.ArrayStart()
+				for reader.More() {
+					list = append(list, reader.Int())
+				}
+				reader.ArrayEnd()
+			case "date":
+				reader.Unmarshaler(&date)
+			default:
+				reader.Skip()
+			}
+		}
+		reader.ObjectEnd()
+		require.NoError(t, reader.Done())
+		assert.Equal(t, []int{1, 2, 3}, list)
+		assert.Equal(t, Date{Year: 2023, Month: time.September, Day: 4}, date)
+	})
+
+	t.Run("raw", func(t *testing.T) {
+		reader := NewJSONReader([]byte(`[{"key": [1, 2]}, "value"]`))
+		reader.ArrayStart()
+		require.True(t, reader.More())
+		assert.Equal(t, `{"key": [1, 2]}`, string(reader.Raw()))
+		require.True(t, reader.More())
+		mark := reader.Mark()
+		reader.Skip()
+		assert.Equal(t, `"value"`, string(reader.Since(mark)))
+		assert.False(t, reader.More())
+		reader.ArrayEnd()
+		require.NoError(t, reader.Done())
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		tests := []struct {
+			data string
+			read func(*JSONReader)
+		}{
+			{data: `"fern"`, read: func(r *JSONReader) { r.Int() }},
+			{data: `4.2`, read: func(r *JSONReader) { r.Int() }},
+			{data: `01`, read: func(r *JSONReader) { r.Float64() }},
+			{data: `"unterminated`, read: func(r *JSONReader) { _ = r.String() }},
+			{data: `"\x"`, read: func(r *JSONReader) { _ = r.String() }},
+			{data: "\"control\n\"", read: func(r *JSONReader) { _ = r.String() }},
+			{data: `[1,]`, read: func(r *JSONReader) { r.Skip() }},
+			{data: `{"key" 1}`, read: func(r *JSONReader) { r.Skip() }},
+			{data: `[1 2]`, read: func(r *JSONReader) { r.Skip() }},
+			{data: `true false`, read: func(r *JSONReader) { r.Bool() }},
+			{data: `nope`, read: func(r *JSONReader) { r.Skip() }},
+		}
+		for _, test := range tests {
+			reader := NewJSONReader([]byte(test.data))
+			test.read(reader)
+			assert.Error(t, reader.Done(), test.data)
+		}
+	})
+}
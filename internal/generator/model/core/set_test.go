@@ -0,0 +1,64 @@
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSet(t *testing.T) {
+	t.Run("add", func(t *testing.T) {
+		var set Set[string]
+		assert.True(t, set.Add("b"))
+		assert.True(t, set.Add("a"))
+		assert.False(t, set.Add("b"))
+		assert.True(t, set.Has("a"))
+		assert.False(t, set.Has("c"))
+		assert.Equal(t, 2, set.Len())
+		assert.Equal(t, []string{"b", "a"}, set.Values())
+		assert.Equal(t, fmt.Sprint([]string{"b", "a"}), set.String())
+	})
+
+	t.Run("nil", func(t *testing.T) {
+		var set *Set[int]
+		assert.False(t, set.Has(1))
+		assert.Equal(t, 0, set.Len())
+		assert.Nil(t, set.Values())
+		assert.Equal(t, fmt.Sprint([]int(nil)), set.String())
+	})
+
+	t.Run("clone and equal", func(t *testing.T) {
+		set := NewSet("a", "b")
+		clone := set.Clone()
+		assert.True(t, set.Equal(clone))
+		assert.True(t, set.Equal(NewSet("b", "a")))
+
+		clone.Add("c")
+		assert.False(t, set.Equal(clone))
+		assert.False(t, set.Has("c"))
+
+		var empty *Set[string]
+		assert.Nil(t, empty.Clone())
+		assert.True(t, empty.Equal(NewSet[string]()))
+		assert.False(t, empty.Equal(set))
+	})
+
+	t.Run("json", func(t *testing.T) {
+		var set *Set[int]
+		require.NoError(t, json.Unmarshal([]byte(`[3, 1, 3, 2, 1]`), &set))
+		assert.Equal(t, []int{3, 1, 2}, set.Values())
+
+		bytes, err := json.Marshal(set)
+		require.NoError(t, err)
+		assert.Equal(t, `[3,1,2]`, string(bytes))
+
+		bytes, err = json.Marshal(NewSet[int]())
+		require.NoError(t, err)
+		assert.Equal(t, `[]`, string(bytes))
+
+		assert.Error(t, json.Unmarshal([]byte(`["one"]`), &set))
+	})
+}
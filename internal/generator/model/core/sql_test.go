@@ -0,0 +1,55 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type sqlObject struct {
+	Name string `json:"name"`
+}
+
+func TestSQL(t *testing.T) {
+	t.Run("value", func(t *testing.T) {
+		value, err := JSONValue(&sqlObject{Name: "fern"})
+		require.NoError(t, err)
+		assert.Equal(t, `{"name":"fern"}`, value)
+	})
+
+	t.Run("scan", func(t *testing.T) {
+		for _, src := range []interface{}{`{"name":"fern"}`, []byte(`{"name":"fern"}`)} {
+			value := new(sqlObject)
+			require.NoError(t, ScanJSON(src, value))
+			assert.Equal(t, "fern", value.Name)
+		}
+	})
+
+	t.Run("scan null", func(t *testing.T) {
+		value := &sqlObject{Name: "fern"}
+		require.NoError(t, ScanJSON(nil, value))
+		assert.Equal(t, "fern", value.Name)
+	})
+
+	t.Run("scan string", func(t *testing.T) {
+		var value string
+		scanned, err := ScanString([]byte("fern"), &value)
+		require.NoError(t, err)
+		assert.Equal(t, "fern", scanned)
+
+		_, err = ScanString(int64(42), &value)
+		assert.EqualError(t, err, "cannot scan int64 into *string")
+	})
+
+	t.Run("column", func(t *testing.T) {
+		tags := []string{"a", "b"}
+		value, err := NewJSONColumn(tags).Value()
+		require.NoError(t, err)
+		assert.Equal(t, `["a","b"]`, value)
+
+		var scanned []string
+		require.NoError(t, NewJSONColumn(&scanned).Scan(value))
+		assert.Equal(t, tags, scanned)
+	})
+}
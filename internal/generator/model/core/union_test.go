@@ -0,0 +1,103 @@
+package core
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type unionObject struct {
+	Name    string  `json:"name"`
+	Age     *int    `json:"age,omitempty"`
+	Version *string `json:"version,omitempty"`
+}
+
+func TestUnionDecoder(t *testing.T) {
+	fields := &ObjectFields{
+		Known:    []string{"name", "age", "version"},
+		Required: []string{"name", "version"},
+		Literals: map[string]interface{}{"version": "v1"},
+	}
+
+	t.Run("object", func(t *testing.T) {
+		decoder := NewUnionDecoder([]byte(`{"name": "fern", "version": "v1"}`))
+		value := new(unionObject)
+		require.True(t, decoder.DecodeObject("object", value, fields))
+		assert.Equal(t, "fern", value.Name)
+	})
+
+	t.Run("object fields", func(t *testing.T) {
+		tests := []struct {
+			desc string
+			data string
+			want string
+		}{
+			{
+				desc: "unknown field",
+				data: `{"name": "fern", "version": "v1", "extra": true}`,
+				want: `object: unknown field "extra"`,
+			},
+			{
+				desc: "missing required field",
+				data: `{"name": "fern"}`,
+				want: `object: missing required field "version"`,
+			},
+			{
+				desc: "literal mismatch",
+				data: `{"name": "fern", "version": "v2"}`,
+				want: `object: expected literal "v1" for field "version", got "v2"`,
+			},
+			{
+				desc: "not an object",
+				data: `["fern"]`,
+				want: `object: expected a JSON object, got ["fern"]`,
+			},
+		}
+		for _, test := range tests {
+			t.Run(test.desc, func(t *testing.T) {
+				decoder := NewUnionDecoder([]byte(test.data))
+				assert.False(t, decoder.DecodeObject("object", new(unionObject), fields))
+				require.Len(t, decoder.errors, 1)
+				assert.EqualError(t, decoder.errors[0], test.want)
+			})
+		}
+	})
+
+	t.Run("unknown fields", func(t *testing.T) {
+		decoder := NewUnionDecoder([]byte(`[{"name": "fern", "extra": true}]`))
+		var value []*unionObject
+		assert.False(t, decoder.Decode("list", &value))
+	})
+
+	t.Run("literal", func(t *testing.T) {
+		decoder := NewUnionDecoder([]byte(`"fern"`))
+		assert.True(t, decoder.DecodeLiteral("fern", "fern"))
+		assert.False(t, decoder.DecodeLiteral("other", "other"))
+		assert.False(t, decoder.DecodeLiteral("boolean", true))
+	})
+
+	t.Run("error", func(t *testing.T) {
+		decoder := NewUnionDecoder([]byte(`{"name": 42}`))
+		var value string
+		assert.False(t, decoder.Decode("string", &value))
+		assert.False(t, decoder.DecodeObject("object", new(unionObject), fields))
+		decoder.Reject("custom", errors.New("custom error"))
+
+		err := decoder.Error("Union")
+		assert.EqualError(
+			t,
+			err,
+			`{"name": 42} cannot be deserialized as a Union (`+
+				`string: json: cannot unmarshal object into Go value of type string; `+
+				`object: missing required field "version"; `+
+				`custom: custom error)`,
+		)
+
+		var unionError *UnionError
+		require.True(t, errors.As(err, &unionError))
+		require.Len(t, unionError.Members, 3)
+		assert.Equal(t, "object", unionError.Members[1].Member)
+	})
+}
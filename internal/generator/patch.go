@@ -0,0 +1,242 @@
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/fern-api/fern-go/ir"
+	generatorexec "github.com/fern-api/generator-exec-go"
+)
+
+// This file generates the XPatch companion type for every object (see enablePatchTypes),
+// which represents a partial update of the object in the JSON Merge Patch (RFC 7386)
+// format, e.g.
+//
+//	patch := &api.UserPatch{
+//	  Name:     api.Optional("George"),
+//	  Nickname: api.Null[string](),
+//	}
+//	patch.Apply(user)
+//
+// Every property (including the properties of the objects it extends) is represented
+// with a *core.Optional[T], where a nil value leaves the property unchanged, an explicit
+// null clears it (i.e. it's set to its zero value), and any other value replaces it.
+// Properties that hold an object are represented with the object's own patch type (e.g.
+// *core.Optional[AddressPatch]), which is merged recursively just like RFC 7386 requires.
+// Maps, unions, and aliases are still replaced as a whole. Literals can't be changed, so
+// they're not included.
+//
+// Patches are always [de]serialized with encoding/json so that an omitted property can be
+// distinguished from an explicit null.
+
+// patchProperty is a single property of a patch type.
+type patchProperty struct {
+	field     string
+	wireValue string
+
+	// patchType is the *core.Optional[T] type of the field.
+	patchType string
+
+	// valueType is the T in patchType.
+	valueType string
+
+	// goType is the type of the field in the object.
+	goType string
+
+	// zero is the value the field is set to when it's cleared.
+	zero string
+
+	// objectType is the object type held by the field (e.g. Address), which is
+	// merged with its own patch type. It's empty for every other field.
+	objectType string
+}
+
+// writeObjectPatch writes the XPatch type for the given object, along with its Apply,
+// UnmarshalJSON, and String methods. The patch is marshaled with the default encoding,
+// which omits the nil fields and writes null for the cleared fields.
+func (t *typeVisitor) writeObjectPatch(object *ir.ObjectTypeDeclaration) {
+	if !t.hasPatchType(object) {
+		// It's OK if we fail to send the warning - it's purely informational.
+		_ = t.writer.coordinator.Log(
+			generatorexec.LogLevelWarn,
+			fmt.Sprintf(
+				"The patch type is not generated for %s because its Apply field conflicts with the Apply method.",
+				t.typeName,
+			),
+		)
+		return
+	}
+	properties, _ := t.flattenObjectProperties(object)
+	patchProperties := make([]*patchProperty, 0, len(properties))
+	for _, property := range properties {
+		patchProperties = append(patchProperties, t.newPatchProperty(property))
+	}
+
+	var (
+		patchName = t.typeName + "Patch"
+		receiver  = typeNameToReceiver(patchName)
+	)
+	t.writer.P("// ", patchName, " is a partial update that's merged into a *", t.typeName, " with Apply.")
+	t.writer.P("// A nil field is left unchanged, a null field is cleared, a nested object is")
+	t.writer.P("// merged with its own patch, and any other field is replaced.")
+	t.writer.P("type ", patchName, " struct {")
+	for _, property := range patchProperties {
+		t.writer.P(property.field, " ", property.patchType, " `json:\"", property.wireValue, ",omitempty\"`")
+	}
+	t.writer.P("}")
+	t.writer.P()
+
+	// Implement the Apply method.
+	target := "target"
+	t.writer.P("// Apply merges the patch into the given *", t.typeName, ".")
+	t.writer.P("func (", receiver, " *", patchName, ") Apply(", target, " *", t.typeName, ") {")
+	t.writer.P("if ", receiver, " == nil || ", target, " == nil {")
+	t.writer.P("return")
+	t.writer.P("}")
+	for _, property := range patchProperties {
+		var (
+			patchField  = receiver + "." + property.field
+			targetField = target + "." + property.field
+		)
+		t.writer.P("if ", patchField, " != nil {")
+		t.writer.P("if ", patchField, ".Null {")
+		t.writer.P(targetField, " = ", property.zero)
+		t.writer.P("} else {")
+		if property.objectType != "" {
+			t.writeObjectPatchMerge(property, patchField, targetField)
+			t.writer.P("}")
+			t.writer.P("}")
+			continue
+		}
+		switch property.goType {
+		case property.patchType:
+			t.writer.P(targetField, " = &", strings.TrimPrefix(property.patchType, "*"), "{Value: ", patchField, ".Value}")
+		case "*" + property.valueType:
+			t.writer.P("value := ", patchField, ".Value")
+			t.writer.P(targetField, " = &value")
+		default:
+			t.writer.P(targetField, " = ", patchField, ".Value")
+		}
+		t.writer.P("}")
+		t.writer.P("}")
+	}
+	if t.includeRawJSON {
+		// The object no longer matches the JSON it was deserialized from.
+		t.writer.P(target, "._rawJSON = nil")
+	}
+	t.writer.P("}")
+	t.writer.P()
+
+	// Implement the json.Unmarshaler interface. The *core.Optional[T] fields would
+	// otherwise be set to nil by encoding/json when they're null.
+	t.writer.P("func (", receiver, " *", patchName, ") UnmarshalJSON(data []byte) error {")
+	t.writer.P("var unmarshaler struct {")
+	for _, property := range patchProperties {
+		t.writer.P(property.field, " json.RawMessage `json:\"", property.wireValue, "\"`")
+	}
+	t.writer.P("}")
+	t.writer.P("if err := json.Unmarshal(data, &unmarshaler); err != nil {")
+	t.writer.P("return err")
+	t.writer.P("}")
+	t.writer.P("*", receiver, " = ", patchName, "{}")
+	for _, property := range patchProperties {
+		field := receiver + "." + property.field
+		t.writer.P("if unmarshaler.", property.field, " != nil {")
+		t.writer.P(field, " = new(", strings.TrimPrefix(property.patchType, "*"), ")")
+		t.writer.P("if err := json.Unmarshal(unmarshaler.", property.field, ", ", field, "); err != nil {")
+		t.writer.P("return err")
+		t.writer.P("}")
+		t.writer.P("}")
+	}
+	t.writer.P("return nil")
+	t.writer.P("}")
+	t.writer.P()
+
+	// Implement fmt.Stringer.
+	t.writer.P("func (", receiver, " *", patchName, ") String() string {")
+	t.writer.P("if value, err := core.StringifyJSON(", receiver, "); err == nil {")
+	t.writer.P("return value")
+	t.writer.P("}")
+	t.writer.P(`return fmt.Sprintf("%#v", `, receiver, ")")
+	t.writer.P("}")
+	t.writer.P()
+}
+
+// writeObjectPatchMerge writes the merge of the given object property's patch into the
+// target's field, which is initialized to the zero object if it's unset (as if the field
+// held an empty JSON object).
+func (t *typeVisitor) writeObjectPatchMerge(property *patchProperty, patchField string, targetField string) {
+	if property.goType == "*"+property.objectType {
+		t.writer.P("if ", targetField, " == nil {")
+		t.writer.P(targetField, " = new(", property.objectType, ")")
+		t.writer.P("}")
+		t.writer.P(patchField, ".Value.Apply(", targetField, ")")
+		return
+	}
+	// The field is a *core.Optional[T] (see enableOptionalTypes).
+	t.writer.P("if ", targetField, " == nil || ", targetField, ".Null {")
+	t.writer.P(targetField, " = &", strings.TrimPrefix(property.goType, "*"), "{}")
+	t.writer.P("}")
+	t.writer.P(patchField, ".Value.Apply(&", targetField, ".Value)")
+}
+
+// hasPatchType returns true if a patch type is generated for the given object, which
+// isn't the case if one of its fields conflicts with the Apply method.
+func (t *typeVisitor) hasPatchType(object *ir.ObjectTypeDeclaration) bool {
+	properties, _ := t.flattenObjectProperties(object)
+	for _, property := range properties {
+		if property.Name.Name.PascalCase.UnsafeName == "Apply" {
+			return false
+		}
+	}
+	return true
+}
+
+// patchObjectType returns the object type held by the given property (if any), which
+// is merged with its own patch type.
+func (t *typeVisitor) patchObjectType(valueType *ir.TypeReference) string {
+	if valueType.Container != nil && valueType.Container.Optional != nil {
+		valueType = valueType.Container.Optional
+	}
+	if valueType.Named == nil {
+		return ""
+	}
+	typeDeclaration := t.writer.types[valueType.Named.TypeId]
+	if typeDeclaration.Shape.Object == nil || !t.hasPatchType(typeDeclaration.Shape.Object) {
+		return ""
+	}
+	return strings.TrimPrefix(t.goType(valueType), "*")
+}
+
+// newPatchProperty returns the *patchProperty for the given object property.
+func (t *typeVisitor) newPatchProperty(property *ir.ObjectProperty) *patchProperty {
+	optional := property.ValueType
+	if optional.Container == nil || optional.Container.Optional == nil {
+		optional = ir.NewTypeReferenceFromContainer(ir.NewContainerTypeFromOptional(property.ValueType))
+	}
+	patchType := t.optionalType(optional)
+	goType := typeReferenceToGoType(property.ValueType, t.writer.types, t.writer.scope, t.baseImportPath, t.importPath, t.enableOptionalTypes, t.writer.encoding)
+	zero := defaultValueForTypeReference(property.ValueType, t.writer.types, t.writer.encoding)
+	if strings.HasPrefix(goType, "*") {
+		zero = "nil"
+	}
+	if objectType := t.patchObjectType(property.ValueType); objectType != "" {
+		return &patchProperty{
+			field:      property.Name.Name.PascalCase.UnsafeName,
+			wireValue:  property.Name.WireValue,
+			patchType:  "*core.Optional[" + objectType + "Patch]",
+			valueType:  objectType + "Patch",
+			goType:     goType,
+			zero:       zero,
+			objectType: objectType,
+		}
+	}
+	return &patchProperty{
+		field:     property.Name.Name.PascalCase.UnsafeName,
+		wireValue: property.Name.WireValue,
+		patchType: patchType,
+		valueType: strings.TrimSuffix(strings.TrimPrefix(patchType, "*core.Optional["), "]"),
+		goType:    goType,
+		zero:      zero,
+	}
+}
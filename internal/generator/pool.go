@@ -0,0 +1,95 @@
+package generator
+
+import (
+	"context"
+	"sync"
+
+	generatorexec "github.com/fern-api/generator-exec-go"
+)
+
+// generateTask generates a single file.
+type generateTask func() (*File, error)
+
+// runGenerateTasks runs the given tasks on at most the given number of workers,
+// and returns the generated files in the same order as the tasks, regardless of
+// the order they complete in. If any of the tasks fail, the error returned by
+// the first failed task (in task order) is returned.
+//
+// No more tasks are started once the context is done, in which case the context's
+// error is returned.
+//
+// The tasks only read the IR, so they're safe to run concurrently.
+func runGenerateTasks(ctx context.Context, workers int, tasks []generateTask) ([]*File, error) {
+	if workers > len(tasks) {
+		workers = len(tasks)
+	}
+	files := make([]*File, len(tasks))
+	if workers <= 1 {
+		for i, task := range tasks {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			file, err := task()
+			if err != nil {
+				return nil, err
+			}
+			files[i] = file
+		}
+		return files, nil
+	}
+	var (
+		errs    = make([]error, len(tasks))
+		indices = make(chan int)
+		wg      sync.WaitGroup
+	)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range indices {
+				files[index], errs[index] = tasks[index]()
+			}
+		}()
+	}
+dispatch:
+	for i := range tasks {
+		if ctx.Err() != nil {
+			break
+		}
+		select {
+		case indices <- i:
+		case <-ctx.Done():
+			break dispatch
+		}
+	}
+	close(indices)
+	wg.Wait()
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return files, nil
+}
+
+// lockedCoordinator serializes the calls to a Coordinator, which is shared
+// by every file generated concurrently.
+type lockedCoordinator struct {
+	mu          sync.Mutex
+	coordinator Coordinator
+}
+
+func (l *lockedCoordinator) Log(level generatorexec.LogLevel, message string) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.coordinator.Log(level, message)
+}
+
+func (l *lockedCoordinator) GenerateReadme(request *generatorexec.GenerateReadmeRequest) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.coordinator.GenerateReadme(request)
+}
@@ -0,0 +1,101 @@
+package generator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunGenerateTasks(t *testing.T) {
+	newTasks := func(n int, failures ...int) []generateTask {
+		tasks := make([]generateTask, 0, n)
+		for i := 0; i < n; i++ {
+			i := i
+			tasks = append(tasks, func() (*File, error) {
+				// The later tasks finish first.
+				time.Sleep(time.Duration(n-i) * time.Millisecond)
+				for _, failure := range failures {
+					if i == failure {
+						return nil, fmt.Errorf("task %d failed", i)
+					}
+				}
+				return &File{Path: fmt.Sprintf("%d.go", i)}, nil
+			})
+		}
+		return tasks
+	}
+	for _, workers := range []int{0, 1, 4, 32} {
+		t.Run(fmt.Sprintf("workers=%d", workers), func(t *testing.T) {
+			files, err := runGenerateTasks(context.Background(), workers, newTasks(10))
+			require.NoError(t, err)
+			require.Len(t, files, 10)
+			for i, file := range files {
+				assert.Equal(t, fmt.Sprintf("%d.go", i), file.Path)
+			}
+
+			_, err = runGenerateTasks(context.Background(), workers, newTasks(10, 7, 3))
+			assert.EqualError(t, err, "task 3 failed")
+
+			files, err = runGenerateTasks(context.Background(), workers, nil)
+			require.NoError(t, err)
+			assert.Empty(t, files)
+		})
+	}
+}
+
+func TestRunGenerateTasksBounded(t *testing.T) {
+	var (
+		running = make(chan struct{}, 2)
+		tasks   []generateTask
+	)
+	for i := 0; i < 8; i++ {
+		tasks = append(tasks, func() (*File, error) {
+			select {
+			case running <- struct{}{}:
+			default:
+				return nil, errors.New("more than 2 tasks are running")
+			}
+			time.Sleep(time.Millisecond)
+			<-running
+			return new(File), nil
+		})
+	}
+	_, err := runGenerateTasks(context.Background(), 2, tasks)
+	assert.NoError(t, err)
+}
+
+func TestRunGenerateTasksCanceled(t *testing.T) {
+	for _, workers := range []int{1, 4} {
+		t.Run(fmt.Sprintf("workers=%d", workers), func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			// The first task cancels the context, and the others wait until it's canceled,
+			// so at most one more task is dispatched after the context is done.
+			var (
+				started = make(chan int, 16)
+				tasks   []generateTask
+			)
+			for i := 0; i < 16; i++ {
+				i := i
+				tasks = append(tasks, func() (*File, error) {
+					started <- i
+					if i == 0 {
+						cancel()
+					}
+					<-ctx.Done()
+					return new(File), nil
+				})
+			}
+			_, err := runGenerateTasks(ctx, workers, tasks)
+			assert.ErrorIs(t, err, context.Canceled)
+			close(started)
+			assert.LessOrEqual(t, len(started), workers+1)
+		})
+	}
+}
@@ -9,8 +9,8 @@ import (
 	"strings"
 
 	"github.com/fern-api/fern-go/internal/ast"
-	"github.com/fern-api/fern-go/internal/fern/ir"
 	"github.com/fern-api/fern-go/internal/gospec"
+	"github.com/fern-api/fern-go/ir"
 )
 
 // goLanguageHeader is the identifier used for the X-Fern-Language platform header.
@@ -111,7 +111,7 @@ func (f *fileWriter) WriteClientOptionsDefinition(
 			f.P(
 				authScheme.Header.Name.Name.PascalCase.UnsafeName,
 				" ",
-				typeReferenceToGoType(authScheme.Header.ValueType, f.types, f.scope, f.baseImportPath, importPath, false),
+				typeReferenceToGoType(authScheme.Header.ValueType, f.types, f.scope, f.baseImportPath, importPath, false, f.encoding),
 			)
 		}
 	}
@@ -123,7 +123,7 @@ func (f *fileWriter) WriteClientOptionsDefinition(
 		f.P(
 			header.Name.Name.PascalCase.UnsafeName,
 			" ",
-			typeReferenceToGoType(header.ValueType, f.types, f.scope, f.baseImportPath, importPath, false),
+			typeReferenceToGoType(header.ValueType, f.types, f.scope, f.baseImportPath, importPath, false, f.encoding),
 		)
 	}
 	f.P("}")
@@ -174,7 +174,7 @@ func (f *fileWriter) WriteClientOptionsDefinition(
 				f.P(`header.Set("`, header.Name.WireValue, `", fmt.Sprintf("`, prefix, `%v",`, literalToValue(header.ValueType.Container.Literal), "))")
 				continue
 			}
-			valueTypeFormat := formatForValueType(header.ValueType)
+			valueTypeFormat := formatForValueType(header.ValueType, f.encoding)
 			value := valueTypeFormat.Prefix + "c." + header.Name.Name.PascalCase.UnsafeName + valueTypeFormat.Suffix
 			if valueTypeFormat.IsOptional {
 				f.P("if c.", header.Name.Name.PascalCase.UnsafeName, " != nil {")
@@ -190,7 +190,7 @@ func (f *fileWriter) WriteClientOptionsDefinition(
 			f.P(`header.Set("`, header.Name.WireValue, `", fmt.Sprintf("%v",`, literalToValue(header.ValueType.Container.Literal), "))")
 			continue
 		}
-		valueTypeFormat := formatForValueType(header.ValueType)
+		valueTypeFormat := formatForValueType(header.ValueType, f.encoding)
 		value := valueTypeFormat.Prefix + "c." + header.Name.Name.PascalCase.UnsafeName + valueTypeFormat.Suffix
 		if valueTypeFormat.IsOptional {
 			f.P("if c.", header.Name.Name.PascalCase.UnsafeName, " != nil {")
@@ -224,10 +224,15 @@ func (f *fileWriter) writePlatformHeaders(
 		return nil
 	}
 	if sdkConfig.PlatformHeaders != nil {
+		// The SDK is named after its module, or its import path if it isn't a module.
+		sdkName := f.baseImportPath
+		if moduleConfig != nil {
+			sdkName = moduleConfig.Path
+		}
 		f.P("func (c *ClientOptions) cloneHeader() http.Header {")
 		f.P("headers := c.HTTPHeader.Clone()")
 		f.P(fmt.Sprintf("headers.Set(%q, %q)", sdkConfig.PlatformHeaders.Language, goLanguageHeader))
-		f.P(fmt.Sprintf("headers.Set(%q, %q)", sdkConfig.PlatformHeaders.SdkName, moduleConfig.Path))
+		f.P(fmt.Sprintf("headers.Set(%q, %q)", sdkConfig.PlatformHeaders.SdkName, sdkName))
 		f.P(fmt.Sprintf("headers.Set(%q, %q)", sdkConfig.PlatformHeaders.SdkVersion, sdkVersion))
 		f.P("return headers")
 		f.P("}")
@@ -347,7 +352,7 @@ func (f *fileWriter) WriteClientOptions(
 				optionName = fmt.Sprintf("With%s", pascalCase)
 				field      = authScheme.Header.Name.Name.PascalCase.UnsafeName
 				param      = authScheme.Header.Name.Name.CamelCase.SafeName
-				value      = typeReferenceToGoType(authScheme.Header.ValueType, f.types, f.scope, f.baseImportPath, importPath, false)
+				value      = typeReferenceToGoType(authScheme.Header.ValueType, f.types, f.scope, f.baseImportPath, importPath, false, f.encoding)
 			)
 			if i == 0 {
 				option = ast.NewCallExpr(
@@ -383,7 +388,7 @@ func (f *fileWriter) WriteClientOptions(
 			optionName = fmt.Sprintf("With%s", header.Name.Name.PascalCase.UnsafeName)
 			field      = header.Name.Name.PascalCase.UnsafeName
 			param      = header.Name.Name.CamelCase.SafeName
-			value      = typeReferenceToGoType(header.ValueType, f.types, f.scope, f.baseImportPath, importPath, false)
+			value      = typeReferenceToGoType(header.ValueType, f.types, f.scope, f.baseImportPath, importPath, false, f.encoding)
 		)
 		f.P("// ", optionName, " sets the ", param, " header on every request.")
 		if header.Docs != nil && len(*header.Docs) > 0 {
@@ -509,7 +514,7 @@ func (f *fileWriter) WriteClient(
 			f.P()
 			f.P("queryParams := make(url.Values)")
 			for _, queryParameter := range endpoint.QueryParameters {
-				valueTypeFormat := formatForValueType(queryParameter.ValueType)
+				valueTypeFormat := formatForValueType(queryParameter.ValueType, f.encoding)
 				if queryParameter.AllowMultiple {
 					requestField := valueTypeFormat.Prefix + "value" + valueTypeFormat.Suffix
 					f.P("for _, value := range ", endpoint.RequestParameterName, ".", queryParameter.Name.Name.PascalCase.UnsafeName, "{")
@@ -539,7 +544,7 @@ func (f *fileWriter) WriteClient(
 			f.P()
 			f.P("headers := ", receiver, ".header.Clone()")
 			for _, header := range endpoint.Headers {
-				valueTypeFormat := formatForValueType(header.ValueType)
+				valueTypeFormat := formatForValueType(header.ValueType, f.encoding)
 				requestField := valueTypeFormat.Prefix + endpoint.RequestParameterName + "." + header.Name.Name.PascalCase.UnsafeName + valueTypeFormat.Suffix
 				if valueTypeFormat.IsOptional {
 					f.P("if ", endpoint.RequestParameterName, ".", header.Name.Name.PascalCase.UnsafeName, "!= nil {")
@@ -617,6 +622,9 @@ func (f *fileWriter) WriteClient(
 		if endpoint.ResponseType != "" && !endpoint.IsStreaming {
 			f.P(fmt.Sprintf(endpoint.ResponseInitializerFormat, endpoint.ResponseType))
 		}
+		if endpoint.ResponseUnionType != nil {
+			f.P("var responseJSON json.RawMessage")
+		}
 
 		if len(endpoint.FileProperties) > 0 || len(endpoint.FileBodyProperties) > 0 {
 			f.P("requestBuffer := bytes.NewBuffer(nil)")
@@ -654,7 +662,7 @@ func (f *fileWriter) WriteClient(
 					f.P("}")
 					continue
 				}
-				valueTypeFormat := formatForValueType(fileBodyProperty.ValueType)
+				valueTypeFormat := formatForValueType(fileBodyProperty.ValueType, f.encoding)
 				requestField := valueTypeFormat.Prefix + endpoint.RequestParameterName + "." + fileBodyProperty.Name.Name.PascalCase.UnsafeName + valueTypeFormat.Suffix
 
 				// Encapsulate the multipart form WriteField in a closure so that we can easily
@@ -730,6 +738,11 @@ func (f *fileWriter) WriteClient(
 			f.P("); err != nil {")
 			f.P("return ", endpoint.ErrorReturnValues)
 			f.P("}")
+			if endpoint.ResponseUnionType != nil {
+				f.P("if len(responseJSON) > 0 {")
+				f.writeInterfaceUnionDecoder("response", "responseJSON", endpoint.ResponseUnionType, "" /* The type is always imported */, "return "+endpoint.ErrorReturnValues)
+				f.P("}")
+			}
 			f.P("return ", endpoint.SuccessfulReturnValues)
 			f.P("}")
 			f.P()
@@ -775,6 +788,7 @@ type endpoint struct {
 	ResponseParameterName       string
 	ResponseInitializerFormat   string
 	ResponseIsOptionalParameter bool
+	ResponseUnionType           *ir.TypeReference
 	PathParameterNames          string
 	SignatureParameters         string
 	ReturnValues                string
@@ -810,7 +824,7 @@ func (f *fileWriter) endpointFromIR(
 	var pathParameterNames []string
 	for _, pathParameter := range irEndpoint.AllPathParameters {
 		pathParameterName := scope.Add(pathParameter.Name.CamelCase.SafeName)
-		parameterType := typeReferenceToGoType(pathParameter.ValueType, f.types, scope, f.baseImportPath, "" /* The type is always imported */, false)
+		parameterType := typeReferenceToGoType(pathParameter.ValueType, f.types, scope, f.baseImportPath, "" /* The type is always imported */, false, f.encoding)
 		signatureParameters += fmt.Sprintf(", %s %s", pathParameterName, parameterType)
 		pathParameterNames = append(pathParameterNames, pathParameterName)
 	}
@@ -843,7 +857,7 @@ func (f *fileWriter) endpointFromIR(
 		if needsRequestParameter(irEndpoint) {
 			var requestType string
 			if requestBody := irEndpoint.SdkRequest.Shape.JustRequestBody; requestBody != nil {
-				requestType = typeReferenceToGoType(requestBody.TypeReference.RequestBodyType, f.types, scope, f.baseImportPath, "" /* The type is always imported */, false)
+				requestType = typeReferenceToGoType(requestBody.TypeReference.RequestBodyType, f.types, scope, f.baseImportPath, "" /* The type is always imported */, false, f.encoding)
 			}
 			if irEndpoint.SdkRequest.Shape.Wrapper != nil {
 				requestImportPath := fernFilepathToImportPath(f.baseImportPath, fernFilepath)
@@ -875,7 +889,10 @@ func (f *fileWriter) endpointFromIR(
 		streamDelimiter           string
 		isStreaming               bool
 	)
-	var responseIsOptionalParameter bool
+	var (
+		responseIsOptionalParameter bool
+		responseUnionType           *ir.TypeReference
+	)
 	if irEndpoint.Response != nil {
 		switch irEndpoint.Response.Type {
 		case "json":
@@ -883,21 +900,27 @@ func (f *fileWriter) endpointFromIR(
 			if typeReference == nil {
 				return nil, fmt.Errorf("unsupported json response type: %s", irEndpoint.Response.Json.Type)
 			}
-			responseType = typeReferenceToGoType(typeReference, f.types, scope, f.baseImportPath, "" /* The type is always imported */, false)
+			responseType = typeReferenceToGoType(typeReference, f.types, scope, f.baseImportPath, "" /* The type is always imported */, false, f.encoding)
 			responseInitializerFormat = "var response %s"
 			responseIsOptionalParameter = typeReference.Container != nil && typeReference.Container.Optional != nil
 			responseParameterName = "&response"
+			if containsInterfaceUnion(typeReference, f.types, f.encoding) {
+				// Unions represented with an interface are deserialized
+				// from the raw JSON after the call completes.
+				responseParameterName = "&responseJSON"
+				responseUnionType = typeReference
+			}
 			signatureReturnValues = fmt.Sprintf("(%s, error)", responseType)
 			successfulReturnValues = "response, nil"
-			errorReturnValues = fmt.Sprintf("%s, err", defaultValueForTypeReference(typeReference, f.types))
+			errorReturnValues = fmt.Sprintf("%s, err", defaultValueForTypeReference(typeReference, f.types, f.encoding))
 
 			if irEndpoint.Response.Json.NestedPropertyAsResponse != nil && irEndpoint.Response.Json.NestedPropertyAsResponse.ResponseProperty != nil {
 				responseProperty := irEndpoint.Response.Json.NestedPropertyAsResponse.ResponseProperty
 				responsePropertyTypeReference := responseProperty.ValueType
-				responsePropertyType := typeReferenceToGoType(responsePropertyTypeReference, f.types, f.scope, f.baseImportPath, "" /* The type is always imported */, false)
+				responsePropertyType := typeReferenceToGoType(responsePropertyTypeReference, f.types, f.scope, f.baseImportPath, "" /* The type is always imported */, f.enableOptionalTypes, f.encoding)
 				signatureReturnValues = fmt.Sprintf("(%s, error)", responsePropertyType)
 				successfulReturnValues = fmt.Sprintf("response.%s, nil", responseProperty.Name.Name.PascalCase.UnsafeName)
-				errorReturnValues = fmt.Sprintf("%s, err", defaultValueForTypeReference(responsePropertyTypeReference, f.types))
+				errorReturnValues = fmt.Sprintf("%s, err", defaultValueForTypeReference(responsePropertyTypeReference, f.types, f.encoding))
 			}
 		case "fileDownload":
 			responseType = "bytes.NewBuffer(nil)"
@@ -921,7 +944,10 @@ func (f *fileWriter) endpointFromIR(
 			if typeReference == nil {
 				return nil, fmt.Errorf("unsupported streaming response type: %s", irEndpoint.Response.Streaming.DataEventType.Type)
 			}
-			responseType = strings.TrimPrefix(typeReferenceToGoType(typeReference, f.types, scope, f.baseImportPath, "" /* The type is always imported */, false), "*")
+			if containsInterfaceUnion(typeReference, f.types, f.encoding) {
+				return nil, fmt.Errorf("streaming responses of unions with the %q encoding are not supported yet", UnionEncodingInterface)
+			}
+			responseType = strings.TrimPrefix(typeReferenceToGoType(typeReference, f.types, scope, f.baseImportPath, "" /* The type is always imported */, false, f.encoding), "*")
 			responseParameterName = "response"
 			signatureReturnValues = fmt.Sprintf("(*core.Stream[%s], error)", responseType)
 			errorReturnValues = "nil, err"
@@ -991,6 +1017,7 @@ func (f *fileWriter) endpointFromIR(
 		ResponseParameterName:       responseParameterName,
 		ResponseInitializerFormat:   responseInitializerFormat,
 		ResponseIsOptionalParameter: responseIsOptionalParameter,
+		ResponseUnionType:           responseUnionType,
 		PathParameterNames:          strings.Join(pathParameterNames, ", "),
 		SignatureParameters:         signatureParameters,
 		ReturnValues:                signatureReturnValues,
@@ -1048,7 +1075,7 @@ func (f *fileWriter) WriteError(errorDeclaration *ir.ErrorDeclaration) error {
 	}
 	var (
 		importPath = fernFilepathToImportPath(f.baseImportPath, errorDeclaration.Name.FernFilepath)
-		value      = typeReferenceToGoType(errorDeclaration.Type, f.types, f.scope, f.baseImportPath, importPath, false)
+		value      = typeReferenceToGoType(errorDeclaration.Type, f.types, f.scope, f.baseImportPath, importPath, false, f.encoding)
 	)
 	var literal string
 	if errorDeclaration.Type.Container != nil && errorDeclaration.Type.Container.Literal != nil {
@@ -1068,9 +1095,13 @@ func (f *fileWriter) WriteError(errorDeclaration *ir.ErrorDeclaration) error {
 		f.P("}")
 	}
 	f.P(fmt.Sprintf("var body %s", value))
-	f.P("if err := json.Unmarshal(data, &body); err != nil {")
-	f.P("return err")
-	f.P("}")
+	if containsInterfaceUnion(errorDeclaration.Type, f.types, f.encoding) {
+		f.writeInterfaceUnionDecoder("body", "data", errorDeclaration.Type, importPath, "return err")
+	} else {
+		f.P("if err := json.Unmarshal(data, &body); err != nil {")
+		f.P("return err")
+		f.P("}")
+	}
 	if literal != "" {
 		// If the error specifies a literal, it will only succeed if the literal matches exactly.
 		f.P("if body != ", literal, " {")
@@ -1118,7 +1149,10 @@ func (f *fileWriter) WriteRequestType(fernFilepath *ir.FernFilepath, endpoint *i
 		importPath = fernFilepathToImportPath(f.baseImportPath, fernFilepath)
 	)
 
-	var literals []*literal
+	var (
+		literals []*literal
+		getters  []*getter
+	)
 	f.P("type ", typeName, " struct {")
 	for _, header := range endpoint.Headers {
 		f.WriteDocs(header.Docs)
@@ -1132,11 +1166,12 @@ func (f *fileWriter) WriteRequestType(fernFilepath *ir.FernFilepath, endpoint *i
 			)
 			continue
 		}
-		goType := typeReferenceToGoType(header.ValueType, f.types, f.scope, f.baseImportPath, importPath, false)
-		f.P(header.Name.Name.PascalCase.UnsafeName, " ", goType, " `json:\"-\"`")
+		goType := typeReferenceToGoType(header.ValueType, f.types, f.scope, f.baseImportPath, importPath, false, f.encoding)
+		getters = append(getters, f.newGetter(header.Name.Name.PascalCase.UnsafeName, header.ValueType, importPath, false))
+		f.P(header.Name.Name.PascalCase.UnsafeName, " ", goType, appendStructTags(" `json:\"-\"`", f.extraStructTags(header.Name, header.ValueType)))
 	}
 	for _, queryParam := range endpoint.QueryParameters {
-		value := typeReferenceToGoType(queryParam.ValueType, f.types, f.scope, f.baseImportPath, importPath, false)
+		value := typeReferenceToGoType(queryParam.ValueType, f.types, f.scope, f.baseImportPath, importPath, false, f.encoding)
 		if queryParam.AllowMultiple {
 			value = fmt.Sprintf("[]%s", value)
 		}
@@ -1151,7 +1186,12 @@ func (f *fileWriter) WriteRequestType(fernFilepath *ir.FernFilepath, endpoint *i
 			)
 			continue
 		}
-		f.P(queryParam.Name.Name.PascalCase.UnsafeName, " ", value, " `json:\"-\"`")
+		if queryParam.AllowMultiple {
+			getters = append(getters, newValueGetter(queryParam.Name.Name.PascalCase.UnsafeName, value, "nil"))
+		} else {
+			getters = append(getters, f.newGetter(queryParam.Name.Name.PascalCase.UnsafeName, queryParam.ValueType, importPath, false))
+		}
+		f.P(queryParam.Name.Name.PascalCase.UnsafeName, " ", value, appendStructTags(" `json:\"-\"`", f.extraStructTags(queryParam.Name, queryParam.ValueType)))
 	}
 	if endpoint.RequestBody == nil {
 		// If the request doesn't have a body, we don't need any custom [de]serialization logic.
@@ -1166,13 +1206,15 @@ func (f *fileWriter) WriteRequestType(fernFilepath *ir.FernFilepath, endpoint *i
 			f.P("}")
 			f.P()
 		}
+		f.writeGetters(typeName, getters)
 		return nil
 	}
-	fieldLiterals, err := requestBodyToFieldDeclaration(endpoint.RequestBody, f, importPath, bodyField, includeGenericOptionals)
+	fieldLiterals, fieldGetters, err := requestBodyToFieldDeclaration(endpoint.RequestBody, f, importPath, bodyField, includeGenericOptionals)
 	if err != nil {
 		return err
 	}
 	literals = append(literals, fieldLiterals...)
+	getters = append(getters, fieldGetters...)
 	for _, literal := range literals {
 		f.P(literal.Name.CamelCase.SafeName, " ", literalToGoType(literal.Value))
 	}
@@ -1185,26 +1227,31 @@ func (f *fileWriter) WriteRequestType(fernFilepath *ir.FernFilepath, endpoint *i
 		f.P("}")
 		f.P()
 	}
+	f.writeGetters(typeName, getters)
 
 	var (
 		referenceType      string
 		referenceIsPointer bool
+		referenceIsUnion   bool
 		referenceLiteral   string
 	)
 	if reference := endpoint.RequestBody.Reference; reference != nil {
 		referenceType = strings.TrimPrefix(
-			typeReferenceToGoType(reference.RequestBodyType, f.types, f.scope, f.baseImportPath, importPath, false),
+			typeReferenceToGoType(reference.RequestBodyType, f.types, f.scope, f.baseImportPath, importPath, false, f.encoding),
 			"*",
 		)
-		referenceIsPointer = reference.RequestBodyType.Named != nil && isPointer(f.types[reference.RequestBodyType.Named.TypeId])
+		referenceIsPointer = reference.RequestBodyType.Named != nil && isPointer(f.types[reference.RequestBodyType.Named.TypeId], f.encoding)
+		referenceIsUnion = containsInterfaceUnion(reference.RequestBodyType, f.types, f.encoding)
 		if reference.RequestBodyType.Container != nil && reference.RequestBodyType.Container.Literal != nil {
 			referenceLiteral = literalToValue(reference.RequestBodyType.Container.Literal)
 		}
 	}
 
-	if len(literals) == 0 && len(referenceType) == 0 {
-		// If the request doesn't specify any literals or a reference type,
-		// we don't need to customize the [de]serialization logic at all.
+	unionProperties := requestBodyInterfaceUnionProperties(endpoint.RequestBody, f.types, f.encoding)
+	if len(literals) == 0 && len(referenceType) == 0 && len(unionProperties) == 0 {
+		// If the request doesn't specify any literals, a reference type, or
+		// any unions represented with an interface, we don't need to customize
+		// the [de]serialization logic at all.
 		return nil
 	}
 
@@ -1216,13 +1263,25 @@ func (f *fileWriter) WriteRequestType(fernFilepath *ir.FernFilepath, endpoint *i
 		} else {
 			f.P("var body ", referenceType)
 		}
+	} else if len(unionProperties) > 0 {
+		f.P("type unmarshaler ", typeName)
+		f.P("var body = struct{")
+		f.P("unmarshaler")
+		for _, property := range unionProperties {
+			f.P(property.Name.Name.PascalCase.UnsafeName, " json.RawMessage `json:\"", property.Name.WireValue, "\"`")
+		}
+		f.P("}{}")
 	} else {
 		f.P("type unmarshaler ", typeName)
 		f.P("var body unmarshaler")
 	}
-	f.P("if err := json.Unmarshal(data, &body); err != nil {")
-	f.P("return err")
-	f.P("}")
+	if referenceIsUnion {
+		f.writeInterfaceUnionDecoder("body", "data", endpoint.RequestBody.Reference.RequestBodyType, importPath, "return err")
+	} else {
+		f.P("if err := json.Unmarshal(data, &body); err != nil {")
+		f.P("return err")
+		f.P("}")
+	}
 	if len(referenceType) > 0 {
 		if len(referenceLiteral) > 0 {
 			f.P("if body != ", referenceLiteral, "{")
@@ -1230,6 +1289,15 @@ func (f *fileWriter) WriteRequestType(fernFilepath *ir.FernFilepath, endpoint *i
 			f.P("}")
 		}
 		f.P(receiver, ".", bodyField, " = body")
+	} else if len(unionProperties) > 0 {
+		f.P("*", receiver, " = ", typeName, "(body.unmarshaler)")
+		for _, property := range unionProperties {
+			var (
+				field            = receiver + "." + property.Name.Name.PascalCase.UnsafeName
+				usesOptionalType = includeGenericOptionals && property.ValueType.Container != nil && property.ValueType.Container.Optional != nil
+			)
+			f.writeInterfaceUnionPropertyDecoder(field, "body."+property.Name.Name.PascalCase.UnsafeName, property.ValueType, importPath, usesOptionalType)
+		}
 	} else {
 		f.P("*", receiver, " = ", typeName, "(body)")
 	}
@@ -1240,6 +1308,12 @@ func (f *fileWriter) WriteRequestType(fernFilepath *ir.FernFilepath, endpoint *i
 	f.P("}")
 	f.P()
 
+	if len(literals) == 0 && len(referenceType) == 0 {
+		// Unions represented with an interface are already serialized
+		// by encoding/json, so we only need the json.Unmarshaler.
+		return nil
+	}
+
 	// Implement the json.Marshaler interface.
 	f.P("func (", receiver, " *", typeName, ") MarshalJSON() ([]byte, error) {")
 	if len(referenceType) > 0 {
@@ -1452,7 +1526,7 @@ func requestBodyToFieldDeclaration(
 	importPath string,
 	bodyField string,
 	includeGenericOptionals bool,
-) ([]*literal, error) {
+) ([]*literal, []*getter, error) {
 	visitor := &requestBodyVisitor{
 		bodyField:               bodyField,
 		baseImportPath:          writer.baseImportPath,
@@ -1463,13 +1537,14 @@ func requestBodyToFieldDeclaration(
 		includeGenericOptionals: includeGenericOptionals,
 	}
 	if err := requestBody.Accept(visitor); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	return visitor.literals, nil
+	return visitor.literals, visitor.getters, nil
 }
 
 type requestBodyVisitor struct {
 	literals       []*literal
+	getters        []*getter
 	bodyField      string
 	baseImportPath string
 	importPath     string
@@ -1489,8 +1564,9 @@ func (r *requestBodyVisitor) VisitInlinedRequestBody(inlinedRequestBody *ir.Inli
 		writer:         r.writer,
 	}
 	objectTypeDeclaration := inlinedRequestBodyToObjectTypeDeclaration(inlinedRequestBody)
-	_, literals := typeVisitor.visitObjectProperties(objectTypeDeclaration, true /* includeTags */, r.includeGenericOptionals)
+	_, literals := typeVisitor.visitObjectProperties(objectTypeDeclaration, propertyTagsAll, r.includeGenericOptionals)
 	r.literals = literals
+	r.getters = r.writer.objectGetters(objectTypeDeclaration, r.importPath, r.includeGenericOptionals)
 	return nil
 }
 
@@ -1499,9 +1575,10 @@ func (r *requestBodyVisitor) VisitReference(reference *ir.HttpRequestBodyReferen
 	r.writer.P(
 		r.bodyField,
 		" ",
-		typeReferenceToGoType(reference.RequestBodyType, r.types, r.scope, r.baseImportPath, r.importPath, false),
+		typeReferenceToGoType(reference.RequestBodyType, r.types, r.scope, r.baseImportPath, r.importPath, false, r.writer.encoding),
 		" `json:\"-\"`",
 	)
+	r.getters = []*getter{r.writer.newGetter(r.bodyField, reference.RequestBodyType, r.importPath, false)}
 	return nil
 }
 
@@ -1525,8 +1602,9 @@ func (r *requestBodyVisitor) VisitFileUpload(fileUpload *ir.FileUploadRequest) e
 		writer:         r.writer,
 	}
 	objectTypeDeclaration := inlinedRequestBodyPropertiesToObjectTypeDeclaration(bodyProperties)
-	_, literals := typeVisitor.visitObjectProperties(objectTypeDeclaration, true /* includeTags */, r.includeGenericOptionals)
+	_, literals := typeVisitor.visitObjectProperties(objectTypeDeclaration, propertyTagsAll, r.includeGenericOptionals)
 	r.literals = literals
+	r.getters = r.writer.objectGetters(objectTypeDeclaration, r.importPath, r.includeGenericOptionals)
 	return nil
 }
 
@@ -1595,7 +1673,7 @@ type valueTypeFormat struct {
 	IsPrimitive bool
 }
 
-func formatForValueType(typeReference *ir.TypeReference) *valueTypeFormat {
+func formatForValueType(typeReference *ir.TypeReference, encoding *EncodingConfig) *valueTypeFormat {
 	var (
 		prefix      string
 		suffix      string
@@ -1614,9 +1692,17 @@ func formatForValueType(typeReference *ir.TypeReference) *valueTypeFormat {
 		case ir.PrimitiveTypeDateTime:
 			prefix = ""
 			suffix = ".Format(time.RFC3339)"
+			if primitiveToGoType(primitive, encoding) != "time.Time" {
+				// The Unix timestamp wrappers format themselves consistently with their JSON encoding.
+				suffix = ".String()"
+			}
 		case ir.PrimitiveTypeDate:
 			prefix = ""
 			suffix = `.Format("2006-01-02")`
+			if primitiveToGoType(primitive, encoding) != "time.Time" {
+				// The core.Date type formats itself consistently with its JSON encoding.
+				suffix = ".String()"
+			}
 		case ir.PrimitiveTypeBase64:
 			prefix = "base64.StdEncoding.EncodeToString(" + prefix
 			suffix = ")"
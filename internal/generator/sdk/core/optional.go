@@ -8,6 +8,9 @@ import (
 // Optional is a wrapper used to distinguish zero values from
 // null or omitted fields.
 //
+// A nil *Optional represents an omitted field, and an Optional
+// with Null set represents an explicit null.
+//
 // To instantiate an Optional, use the `Optional()` and `Null()`
 // helpers exported from the root package.
 type Optional[T any] struct {
@@ -15,6 +18,17 @@ type Optional[T any] struct {
 	Null  bool
 }
 
+// IsSet returns true if the field was specified, either with
+// a value or as an explicit null.
+func (o *Optional[T]) IsSet() bool {
+	return o != nil
+}
+
+// IsNull returns true if the field was specified as an explicit null.
+func (o *Optional[T]) IsNull() bool {
+	return o != nil && o.Null
+}
+
 func (o *Optional[T]) String() string {
 	if o == nil {
 		return ""
@@ -34,3 +48,16 @@ func (o *Optional[T]) MarshalJSON() ([]byte, error) {
 	}
 	return json.Marshal(&o.Value)
 }
+
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*o = Optional[T]{Null: true}
+		return nil
+	}
+	var value T
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*o = Optional[T]{Value: value}
+	return nil
+}
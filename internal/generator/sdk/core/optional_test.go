@@ -129,3 +129,75 @@ func TestOptional(t *testing.T) {
 		})
 	}
 }
+
+func TestOptionalUnmarshal(t *testing.T) {
+	tests := []struct {
+		desc        string
+		giveBytes   []byte
+		wantRequest *OptionalRequest
+	}{
+		{
+			desc:      "omitted",
+			giveBytes: []byte(`{"id":"xyz"}`),
+			wantRequest: &OptionalRequest{
+				Id: "xyz",
+			},
+		},
+		{
+			desc:      "value",
+			giveBytes: []byte(`{"id":"xyz","filter":"foo"}`),
+			wantRequest: &OptionalRequest{
+				Id: "xyz",
+				Filter: &Optional[string]{
+					Value: "foo",
+				},
+			},
+		},
+		{
+			desc:      "zero value",
+			giveBytes: []byte(`{"id":"xyz","filter":""}`),
+			wantRequest: &OptionalRequest{
+				Id: "xyz",
+				Filter: &Optional[string]{
+					Value: "",
+				},
+			},
+		},
+		{
+			desc:      "nested object",
+			giveBytes: []byte(`{"id":"xyz","reference":{"id":"abc","tags":["one"]}}`),
+			wantRequest: &OptionalRequest{
+				Id: "xyz",
+				Reference: &Optional[Reference]{
+					Value: Reference{
+						Id:   "abc",
+						Tags: []string{"one"},
+					},
+				},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.desc, func(t *testing.T) {
+			var request *OptionalRequest
+			require.NoError(t, json.Unmarshal(test.giveBytes, &request))
+			assert.Equal(t, test.wantRequest, request)
+		})
+	}
+
+	t.Run("null", func(t *testing.T) {
+		var optional Optional[string]
+		require.NoError(t, json.Unmarshal([]byte("null"), &optional))
+		assert.True(t, optional.IsSet())
+		assert.True(t, optional.IsNull())
+
+		var omitted *Optional[string]
+		assert.False(t, omitted.IsSet())
+		assert.False(t, omitted.IsNull())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		var optional Optional[int]
+		assert.Error(t, json.Unmarshal([]byte(`"foo"`), &optional))
+	})
+}
@@ -0,0 +1,145 @@
+package generator
+
+import (
+	"fmt"
+
+	"github.com/fern-api/fern-go/ir"
+	generatorexec "github.com/fern-api/generator-exec-go"
+)
+
+// This file generates the database/sql Value and Scan methods (i.e. the driver.Valuer
+// and sql.Scanner interfaces) for enums, objects, and unions (see enableDatabaseSQL).
+//
+// Enums are stored as text, and objects and unions are stored as JSON (e.g. in a JSONB
+// column) with the same representation used on the wire. A NULL column is scanned as
+// the zero value.
+//
+// Aliases are generated as Go type aliases, so they share the methods of the type they
+// refer to. Primitives are already supported by database/sql, and everything else (e.g.
+// lists and maps) can be wrapped with core.NewJSONColumn.
+
+// writeEnumSQL writes the Value and Scan methods for an enum.
+func (t *typeVisitor) writeEnumSQL() {
+	driver := t.writer.scope.AddImport("database/sql/driver")
+	receiver := typeNameToReceiver(t.typeName)
+	t.writer.P("func (", receiver, " ", t.typeName, ") Value() (", driver, ".Value, error) {")
+	t.writer.P("return string(", receiver, "), nil")
+	t.writer.P("}")
+	t.writer.P()
+
+	t.writer.P("func (", receiver, " *", t.typeName, ") Scan(src interface{}) error {")
+	t.writer.P("if src == nil {")
+	t.writer.P("*", receiver, " = \"\"")
+	t.writer.P("return nil")
+	t.writer.P("}")
+	t.writer.P("value, err := core.ScanString(src, ", receiver, ")")
+	t.writer.P("if err != nil {")
+	t.writer.P("return err")
+	t.writer.P("}")
+	if t.enableForwardCompatibility {
+		t.writer.P("*", receiver, " = ", t.typeName, "(value)")
+		t.writer.P("return nil")
+		t.writer.P("}")
+		t.writer.P()
+		return
+	}
+	t.writer.P("enum, err := New", t.typeName, "FromString(value)")
+	t.writer.P("if err != nil {")
+	t.writer.P("return err")
+	t.writer.P("}")
+	t.writer.P("*", receiver, " = enum")
+	t.writer.P("return nil")
+	t.writer.P("}")
+	t.writer.P()
+}
+
+// writeObjectSQL writes the Value and Scan methods for an object.
+func (t *typeVisitor) writeObjectSQL(object *ir.ObjectTypeDeclaration) {
+	properties, _ := t.flattenObjectProperties(object)
+	fields := make([]string, 0, len(properties))
+	for _, property := range properties {
+		if property.ValueType.Container != nil && property.ValueType.Container.Literal != nil {
+			continue
+		}
+		fields = append(fields, property.Name.Name.PascalCase.UnsafeName)
+	}
+	t.writeJSONSQL(fields)
+}
+
+// writeUnionSQL writes the Value and Scan methods for a discriminated union.
+func (t *typeVisitor) writeUnionSQL(union *ir.UnionTypeDeclaration, unknownName string) {
+	fields := []string{union.Discriminant.Name.PascalCase.UnsafeName}
+	for _, extend := range union.Extends {
+		properties, _ := t.flattenObjectProperties(t.writer.types[extend.TypeId].Shape.Object)
+		for _, property := range properties {
+			if property.ValueType.Container != nil && property.ValueType.Container.Literal != nil {
+				continue
+			}
+			fields = append(fields, property.Name.Name.PascalCase.UnsafeName)
+		}
+	}
+	for _, property := range union.BaseProperties {
+		if property.ValueType.Container != nil && property.ValueType.Container.Literal != nil {
+			// Literals are held in unexported fields.
+			continue
+		}
+		fields = append(fields, property.Name.Name.PascalCase.UnsafeName)
+	}
+	for _, unionType := range union.Types {
+		if unionType.Shape.SingleProperty != nil && unionType.Shape.SingleProperty.Type.Container != nil && unionType.Shape.SingleProperty.Type.Container.Literal != nil {
+			continue
+		}
+		fields = append(fields, unionType.DiscriminantValue.Name.PascalCase.UnsafeName)
+	}
+	if unknownName != "" {
+		fields = append(fields, unknownName)
+	}
+	t.writeJSONSQL(fields)
+}
+
+// writeUndiscriminatedUnionSQL writes the Value and Scan methods for an undiscriminated union.
+func (t *typeVisitor) writeUndiscriminatedUnionSQL(union *ir.UndiscriminatedUnionTypeDeclaration) {
+	fields := make([]string, 0, len(union.Members))
+	for _, member := range union.Members {
+		fields = append(fields, typeReferenceToUndiscriminatedUnionField(member.Type, t.writer.types))
+	}
+	t.writeJSONSQL(fields)
+}
+
+// writeJSONSQL writes the Value and Scan methods for a type that's stored as JSON.
+//
+// The methods are skipped if one of the given fields has the same name as a method,
+// which would otherwise fail to compile.
+func (t *typeVisitor) writeJSONSQL(fields []string) {
+	for _, field := range fields {
+		if field != "Value" && field != "Scan" {
+			continue
+		}
+		// It's OK if we fail to send the warning - it's purely informational.
+		_ = t.writer.coordinator.Log(
+			generatorexec.LogLevelWarn,
+			fmt.Sprintf(
+				"The database/sql methods are not generated for %s because its %s field conflicts with the %s method.",
+				t.typeName,
+				field,
+				field,
+			),
+		)
+		return
+	}
+	driver := t.writer.scope.AddImport("database/sql/driver")
+	receiver := typeNameToReceiver(t.typeName)
+	t.writer.P("func (", receiver, " *", t.typeName, ") Value() (", driver, ".Value, error) {")
+	t.writer.P("if ", receiver, " == nil {")
+	t.writer.P("return nil, nil")
+	t.writer.P("}")
+	t.writer.P("return core.JSONValue(", receiver, ")")
+	t.writer.P("}")
+	t.writer.P()
+
+	t.writer.P("func (", receiver, " *", t.typeName, ") Scan(src interface{}) error {")
+	t.writer.P("*", receiver, " = ", t.typeName, "{}")
+	t.writer.P("return core.ScanJSON(src, ", receiver, ")")
+	t.writer.P("}")
+	t.writer.P()
+}
@@ -0,0 +1,490 @@
+package generator
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/fern-api/fern-go/ir"
+	generatorexec "github.com/fern-api/generator-exec-go"
+)
+
+// This file implements strict decoding for undiscriminated unions (see
+// enableStrictUndiscriminatedUnions).
+//
+// By default, each member is deserialized with json.Unmarshal in the order
+// they're declared, and the first one that succeeds is selected. Unknown
+// and missing fields are silently ignored, so JSON is often deserialized
+// as the wrong object. With strict decoding, a member is only selected if
+// the JSON matches it exactly, and every rejected member is recorded with
+// a core.UnionDecoder so that a failure explains itself.
+
+// writeStrictUndiscriminatedUnionMember writes the statements that attempt to deserialize
+// data as the given member with the core.UnionDecoder named decoder. The onMatch callback
+// writes the statements that return the member, given the expression that holds its value.
+func (t *typeVisitor) writeStrictUndiscriminatedUnionMember(
+	member *ir.UndiscriminatedUnionMember,
+	caseName string,
+	variable string,
+	value string,
+	onMatch func(value string),
+) {
+	if member.Type.Container != nil && member.Type.Container.Literal != nil {
+		literal := literalToValue(member.Type.Container.Literal)
+		t.writer.P("if decoder.DecodeLiteral(\"", caseName, "\", ", literal, ") {")
+		onMatch(literal)
+		t.writer.P("}")
+		return
+	}
+	if containsInterfaceUnion(member.Type, t.writer.types, t.writer.encoding) {
+		// Unions represented with an interface can't be deserialized
+		// with json.Unmarshal, so we use their Unmarshal function.
+		t.writer.P(variable, ", err := func() (", value, ", error) {")
+		t.writer.P("var value ", value)
+		t.writer.writeInterfaceUnionDecoder("value", "data", member.Type, t.importPath, "return nil, err")
+		t.writer.P("return value, nil")
+		t.writer.P("}()")
+		t.writer.P("if err == nil {")
+		onMatch(variable)
+		t.writer.P("}")
+		t.writer.P("decoder.Reject(\"", caseName, "\", err)")
+		return
+	}
+	if member.Type.Named != nil && isPointer(t.writer.types[member.Type.Named.TypeId], t.writer.encoding) {
+		t.writer.P(variable, " := new(", strings.TrimLeft(value, "*"), ")")
+	} else {
+		t.writer.P("var ", variable, " ", value)
+	}
+	if fields := objectFieldsForTypeReference(member.Type, t.writer.types); fields != nil {
+		t.writer.P("if decoder.DecodeObject(\"", caseName, "\", &", variable, ", ", fields.String(), ") {")
+	} else {
+		t.writer.P("if decoder.Decode(\"", caseName, "\", &", variable, ") {")
+	}
+	if enum := resolveNamedTypeReference(member.Type, t.writer.types); enum != nil && t.writer.types[enum.TypeId].Shape.Enum != nil && !t.enableForwardCompatibility {
+		// Enums are deserialized as plain strings, so we need to
+		// verify that the value is actually recognized.
+		enumType := typeReferenceToGoType(&ir.TypeReference{Type: "named", Named: enum}, t.writer.types, t.writer.scope, t.baseImportPath, t.importPath, false, t.writer.encoding)
+		constructor := "New" + enumType + "FromString"
+		if i := strings.LastIndex(enumType, "."); i >= 0 {
+			constructor = enumType[:i+1] + "New" + enumType[i+1:] + "FromString"
+		}
+		t.writer.P("_, err := ", constructor, "(string(", variable, "))")
+		t.writer.P("if err == nil {")
+		onMatch(variable)
+		t.writer.P("}")
+		t.writer.P("decoder.Reject(\"", caseName, "\", err)")
+		t.writer.P("}")
+		return
+	}
+	onMatch(variable)
+	t.writer.P("}")
+}
+
+// warnAmbiguousUndiscriminatedUnionMembers logs a warning for every pair of members in
+// the given undiscriminated union that match some of the same JSON values, even when
+// they're decoded strictly. The first member always wins, so the second is never
+// selected for those values.
+//
+// Members that are strictly narrower than a later member (e.g. a literal followed
+// by a string) are intentional, so they don't produce a warning.
+func (t *typeVisitor) warnAmbiguousUndiscriminatedUnionMembers(union *ir.UndiscriminatedUnionTypeDeclaration) {
+	shapes := make([][]*jsonShape, len(union.Members))
+	for i, member := range union.Members {
+		shapes[i] = jsonShapesForTypeReference(member.Type, t.writer.types, t.writer.encoding, t.enableForwardCompatibility, nil)
+	}
+	for i := range union.Members {
+		for j := i + 1; j < len(union.Members); j++ {
+			if !jsonShapesOverlap(shapes[i], shapes[j]) {
+				continue
+			}
+			if jsonShapesContain(shapes[j], shapes[i]) && !jsonShapesContain(shapes[i], shapes[j]) {
+				continue
+			}
+			var (
+				first  = firstLetterToLower(typeReferenceToUndiscriminatedUnionField(union.Members[i].Type, t.writer.types))
+				second = firstLetterToLower(typeReferenceToUndiscriminatedUnionField(union.Members[j].Type, t.writer.types))
+			)
+			// It's OK if we fail to send the warning - it's purely informational.
+			_ = t.writer.coordinator.Log(
+				generatorexec.LogLevelWarn,
+				fmt.Sprintf(
+					"The %q and %q members of undiscriminated union %s are ambiguous; JSON that matches both is always deserialized as %q.",
+					first,
+					second,
+					t.typeName,
+					first,
+				),
+			)
+		}
+	}
+}
+
+// objectFields describes the fields of an object, which are validated before
+// an undiscriminated union member is deserialized (see core.ObjectFields).
+type objectFields struct {
+	known    []string
+	required []string
+
+	// Map from wire value to the literal's Go value (e.g. "v1" or true).
+	literals map[string]string
+}
+
+// String returns the *core.ObjectFields expression for these fields.
+func (o *objectFields) String() string {
+	var b strings.Builder
+	b.WriteString("&core.ObjectFields{\n")
+	b.WriteString("Known: " + quotedStringSlice(o.known) + ",\n")
+	if len(o.required) > 0 {
+		b.WriteString("Required: " + quotedStringSlice(o.required) + ",\n")
+	}
+	if len(o.literals) > 0 {
+		b.WriteString("Literals: map[string]interface{}{")
+		var literals []string
+		for _, field := range o.known {
+			if literal, ok := o.literals[field]; ok {
+				literals = append(literals, strconv.Quote(field)+": "+literal)
+			}
+		}
+		b.WriteString(strings.Join(literals, ", ") + "},\n")
+	}
+	b.WriteString("}")
+	return b.String()
+}
+
+// objectFieldsForTypeReference returns the fields of the object the given type reference
+// refers to (following any aliases), or nil if it doesn't refer to an object.
+func objectFieldsForTypeReference(typeReference *ir.TypeReference, types map[ir.TypeId]*ir.TypeDeclaration) *objectFields {
+	named := resolveNamedTypeReference(typeReference, types)
+	if named == nil || types[named.TypeId].Shape.Object == nil {
+		return nil
+	}
+	fields := &objectFields{literals: make(map[string]string)}
+	fields.add(types[named.TypeId].Shape.Object, types)
+	return fields
+}
+
+// add adds the properties of the given object (and those it extends).
+func (o *objectFields) add(object *ir.ObjectTypeDeclaration, types map[ir.TypeId]*ir.TypeDeclaration) {
+	for _, extend := range object.Extends {
+		// You can only extend other objects.
+		o.add(types[extend.TypeId].Shape.Object, types)
+	}
+	for _, property := range object.Properties {
+		wireValue := property.Name.WireValue
+		o.known = append(o.known, wireValue)
+		if !isOptionalTypeReference(property.ValueType, types) {
+			o.required = append(o.required, wireValue)
+		}
+		if property.ValueType.Container != nil && property.ValueType.Container.Literal != nil {
+			o.literals[wireValue] = literalToValue(property.ValueType.Container.Literal)
+		}
+	}
+}
+
+// isOptionalTypeReference returns true if the given type reference can be omitted,
+// i.e. it's optional (following any aliases) or unknown.
+func isOptionalTypeReference(typeReference *ir.TypeReference, types map[ir.TypeId]*ir.TypeDeclaration) bool {
+	switch {
+	case typeReference.Type == "unknown":
+		return true
+	case typeReference.Container != nil:
+		return typeReference.Container.Optional != nil
+	case typeReference.Named != nil:
+		if alias := types[typeReference.Named.TypeId].Shape.Alias; alias != nil {
+			return isOptionalTypeReference(alias.AliasOf, types)
+		}
+	}
+	return false
+}
+
+// resolveNamedTypeReference returns the named type the given type reference refers
+// to after following any aliases, or nil if it isn't a named type.
+func resolveNamedTypeReference(typeReference *ir.TypeReference, types map[ir.TypeId]*ir.TypeDeclaration) *ir.DeclaredTypeName {
+	if typeReference.Named == nil {
+		return nil
+	}
+	if alias := types[typeReference.Named.TypeId].Shape.Alias; alias != nil {
+		return resolveNamedTypeReference(alias.AliasOf, types)
+	}
+	return typeReference.Named
+}
+
+// jsonKind is the kind of JSON value a type is deserialized from.
+type jsonKind uint
+
+const (
+	jsonKindAny jsonKind = iota
+	jsonKindString
+	jsonKindNumber
+	jsonKindBoolean
+	jsonKindArray
+	jsonKindObject
+)
+
+// jsonShape describes the JSON values a type can be deserialized from, which is used to
+// detect undiscriminated union members that can't be distinguished from one another.
+type jsonShape struct {
+	kind jsonKind
+
+	// integer is set for numbers that don't accept fractions.
+	integer bool
+
+	// literal is set for string and boolean literals.
+	literal string
+
+	// enumValues is set for enums that only accept the given values.
+	enumValues []string
+
+	// fields is set for objects whose fields are known. Maps, unions,
+	// and unknown types are represented as objects without any fields.
+	fields *objectFields
+}
+
+// jsonShapesForTypeReference returns the shapes of the JSON values the given type reference
+// can be deserialized from. Nested undiscriminated unions include the shapes of every member.
+func jsonShapesForTypeReference(
+	typeReference *ir.TypeReference,
+	types map[ir.TypeId]*ir.TypeDeclaration,
+	encoding *EncodingConfig,
+	enableForwardCompatibility bool,
+	visited map[ir.TypeId]struct{},
+) []*jsonShape {
+	switch {
+	case typeReference.Container != nil:
+		container := typeReference.Container
+		switch {
+		case container.List != nil, container.Set != nil:
+			return []*jsonShape{{kind: jsonKindArray}}
+		case container.Map != nil:
+			return []*jsonShape{{kind: jsonKindObject}}
+		case container.Optional != nil:
+			// We ignore null because it's never the only value a member accepts.
+			return jsonShapesForTypeReference(container.Optional, types, encoding, enableForwardCompatibility, visited)
+		case container.Literal != nil:
+			if container.Literal.Type == "boolean" {
+				return []*jsonShape{{kind: jsonKindBoolean, literal: literalToValue(container.Literal)}}
+			}
+			return []*jsonShape{{kind: jsonKindString, literal: literalToValue(container.Literal)}}
+		}
+	case typeReference.Named != nil:
+		typeDeclaration := types[typeReference.Named.TypeId]
+		switch {
+		case typeDeclaration.Shape.Alias != nil:
+			return jsonShapesForTypeReference(typeDeclaration.Shape.Alias.AliasOf, types, encoding, enableForwardCompatibility, visited)
+		case typeDeclaration.Shape.Enum != nil:
+			if enableForwardCompatibility {
+				// Forward-compatible enums accept any string.
+				return []*jsonShape{{kind: jsonKindString}}
+			}
+			enumValues := make([]string, len(typeDeclaration.Shape.Enum.Values))
+			for i, enumValue := range typeDeclaration.Shape.Enum.Values {
+				enumValues[i] = strconv.Quote(enumValue.Name.WireValue)
+			}
+			return []*jsonShape{{kind: jsonKindString, enumValues: enumValues}}
+		case typeDeclaration.Shape.Object != nil:
+			return []*jsonShape{{kind: jsonKindObject, fields: objectFieldsForTypeReference(typeReference, types)}}
+		case typeDeclaration.Shape.UndiscriminatedUnion != nil:
+			if _, ok := visited[typeReference.Named.TypeId]; ok {
+				// Recursive unions don't add any new shapes.
+				return nil
+			}
+			if visited == nil {
+				visited = make(map[ir.TypeId]struct{})
+			}
+			visited[typeReference.Named.TypeId] = struct{}{}
+			var shapes []*jsonShape
+			for _, member := range typeDeclaration.Shape.UndiscriminatedUnion.Members {
+				shapes = append(shapes, jsonShapesForTypeReference(member.Type, types, encoding, enableForwardCompatibility, visited)...)
+			}
+			return shapes
+		}
+		return []*jsonShape{{kind: jsonKindObject}}
+	case typeReference.Type == "primitive":
+		kind := primitiveToJSONKind(typeReference.Primitive, encoding)
+		integer := kind == jsonKindNumber && typeReference.Primitive != ir.PrimitiveTypeDouble
+		return []*jsonShape{{kind: kind, integer: integer}}
+	}
+	return []*jsonShape{{kind: jsonKindAny}}
+}
+
+// primitiveToJSONKind returns the kind of JSON value the given primitive is
+// deserialized from with the configured encoding.
+func primitiveToJSONKind(primitive ir.PrimitiveType, encoding *EncodingConfig) jsonKind {
+	switch primitive {
+	case ir.PrimitiveTypeInteger, ir.PrimitiveTypeDouble:
+		return jsonKindNumber
+	case ir.PrimitiveTypeBoolean:
+		return jsonKindBoolean
+	case ir.PrimitiveTypeLong:
+		if encoding != nil && encoding.Long == LongEncodingString {
+			return jsonKindString
+		}
+		return jsonKindNumber
+	case ir.PrimitiveTypeDateTime:
+		if encoding != nil && (encoding.DateTime == DateTimeEncodingUnixSeconds || encoding.DateTime == DateTimeEncodingUnixMillis) {
+			return jsonKindNumber
+		}
+		return jsonKindString
+	}
+	return jsonKindString
+}
+
+// jsonShapesOverlap returns true if any JSON value matches one of the shapes in both a and b.
+func jsonShapesOverlap(a []*jsonShape, b []*jsonShape) bool {
+	for _, x := range a {
+		for _, y := range b {
+			if jsonShapeOverlaps(x, y) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func jsonShapeOverlaps(a *jsonShape, b *jsonShape) bool {
+	if a.kind == jsonKindAny || b.kind == jsonKindAny {
+		return true
+	}
+	if a.kind != b.kind {
+		return false
+	}
+	switch a.kind {
+	case jsonKindString:
+		switch {
+		case a.literal != "" && b.literal != "":
+			return a.literal == b.literal
+		case a.literal != "" && b.enumValues != nil:
+			return containsString(b.enumValues, a.literal)
+		case b.literal != "" && a.enumValues != nil:
+			return containsString(a.enumValues, b.literal)
+		case a.enumValues != nil && b.enumValues != nil:
+			for _, value := range a.enumValues {
+				if containsString(b.enumValues, value) {
+					return true
+				}
+			}
+			return false
+		}
+	case jsonKindBoolean:
+		if a.literal != "" && b.literal != "" {
+			return a.literal == b.literal
+		}
+	case jsonKindObject:
+		if a.fields != nil && b.fields != nil {
+			return objectFieldsOverlap(a.fields, b.fields)
+		}
+	}
+	return true
+}
+
+// jsonShapesContain returns true if every JSON value that matches one of the specific shapes
+// also matches one of the general shapes.
+func jsonShapesContain(general []*jsonShape, specific []*jsonShape) bool {
+	for _, y := range specific {
+		var contained bool
+		for _, x := range general {
+			if jsonShapeContains(x, y) {
+				contained = true
+				break
+			}
+		}
+		if !contained {
+			return false
+		}
+	}
+	return true
+}
+
+func jsonShapeContains(general *jsonShape, specific *jsonShape) bool {
+	if general.kind == jsonKindAny {
+		return true
+	}
+	if general.kind != specific.kind {
+		return false
+	}
+	switch general.kind {
+	case jsonKindString:
+		switch {
+		case general.literal != "":
+			return specific.literal == general.literal
+		case general.enumValues != nil && specific.literal != "":
+			return containsString(general.enumValues, specific.literal)
+		case general.enumValues != nil:
+			if specific.enumValues == nil {
+				return false
+			}
+			for _, value := range specific.enumValues {
+				if !containsString(general.enumValues, value) {
+					return false
+				}
+			}
+		}
+		return true
+	case jsonKindNumber:
+		return !general.integer || specific.integer
+	case jsonKindBoolean:
+		return general.literal == "" || specific.literal == general.literal
+	case jsonKindObject:
+		if general.fields == nil {
+			// Maps, unions, and unknown types are treated as any object.
+			return true
+		}
+		if specific.fields == nil {
+			return false
+		}
+		for _, field := range specific.fields.known {
+			if !containsString(general.fields.known, field) {
+				return false
+			}
+		}
+		for _, field := range general.fields.required {
+			if !containsString(specific.fields.required, field) {
+				return false
+			}
+		}
+		for field, literal := range general.fields.literals {
+			if specific.fields.literals[field] != literal {
+				return false
+			}
+		}
+		return true
+	}
+	// We can't tell which elements an array accepts.
+	return false
+}
+
+// objectFieldsOverlap returns true if an object exists that includes every required
+// field of both a and b, only includes fields known to both, and sets every literal
+// field to a value that's compatible with both.
+func objectFieldsOverlap(a *objectFields, b *objectFields) bool {
+	for _, required := range [][]string{a.required, b.required} {
+		for _, field := range required {
+			if !containsString(a.known, field) || !containsString(b.known, field) {
+				return false
+			}
+		}
+	}
+	for field, literal := range a.literals {
+		if other, ok := b.literals[field]; ok && other != literal {
+			return false
+		}
+	}
+	return true
+}
+
+func quotedStringSlice(values []string) string {
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = strconv.Quote(value)
+	}
+	return "[]string{" + strings.Join(quoted, ", ") + "}"
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -3,17 +3,159 @@ package goexec
 import (
 	"bytes"
 	"errors"
+	"fmt"
+	"io/fs"
+	"os"
 	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/fern-api/fern-go/internal/fernignore"
 )
 
+// tidyFilenames are the files written by 'go mod tidy'.
+var tidyFilenames = []string{"go.mod", "go.sum"}
+
 // RunTidy runs the 'go mod tidy' command from the given path.
+//
+// Any of the files written by the command that match a pattern in the
+// path's .fernignore file are restored to their original content.
 func RunTidy(path string) error {
+	ignore, err := fernignore.Read(path)
+	if err != nil {
+		return err
+	}
+	// The content of every ignored file, where a nil value means that the
+	// file didn't exist.
+	ignored := make(map[string][]byte)
+	for _, filename := range tidyFilenames {
+		if !ignore.Match(filename) {
+			continue
+		}
+		content, err := os.ReadFile(filepath.Join(path, filename))
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+		ignored[filename] = content
+	}
+
 	cmd := exec.Command("go", "mod", "tidy")
 	stderr := bytes.NewBuffer(nil)
 	cmd.Stderr = stderr
 	cmd.Dir = path
-	if err := cmd.Run(); err != nil {
+	runErr := cmd.Run()
+
+	for filename, content := range ignored {
+		if err := restoreFile(filepath.Join(path, filename), content); err != nil {
+			return err
+		}
+	}
+	if runErr != nil {
 		return errors.New(stderr.String())
 	}
 	return nil
 }
+
+// RunModInit runs the 'go mod init' command from the given path, which
+// writes a go.mod with the given module path.
+func RunModInit(path string, modulePath string) error {
+	_, err := run(path, "mod", "init", modulePath)
+	return err
+}
+
+// Diagnostic is an error reported by the compiler or 'go vet' for a
+// position in a file.
+type Diagnostic struct {
+	Filename string
+	Line     int
+	Column   int
+	Message  string
+}
+
+// String returns the diagnostic in the same format as the Go command,
+// e.g. "client/client.go:12:3: undefined: core".
+func (d *Diagnostic) String() string {
+	if d.Column == 0 {
+		return fmt.Sprintf("%s:%d: %s", d.Filename, d.Line, d.Message)
+	}
+	return fmt.Sprintf("%s:%d:%d: %s", d.Filename, d.Line, d.Column, d.Message)
+}
+
+// diagnosticRegexp matches a diagnostic reported by 'go build' or 'go vet',
+// e.g. "./client.go:12:3: undefined: core", where the filename is relative
+// to the directory the command was run from.
+var diagnosticRegexp = regexp.MustCompile(`^(?:vet: )?(?:\./)?([^\s:]+\.go):(\d+):(?:(\d+):)? (.+)$`)
+
+// RunBuildAndVet runs the 'go build' and 'go vet' commands on every package
+// from the given path, and returns the diagnostics reported for the module's
+// files. 'go vet' only runs if the packages compile, so that every compiler
+// error isn't reported twice.
+func RunBuildAndVet(path string) ([]*Diagnostic, error) {
+	for _, args := range [][]string{{"build", "./..."}, {"vet", "./..."}} {
+		output, err := run(path, args...)
+		if err == nil {
+			continue
+		}
+		if diagnostics := parseDiagnostics(output); len(diagnostics) > 0 {
+			return diagnostics, nil
+		}
+		return nil, err
+	}
+	return nil, nil
+}
+
+// parseDiagnostics returns the diagnostics found in the given output, and
+// skips every other line (e.g. the "# <package>" headers).
+func parseDiagnostics(output string) []*Diagnostic {
+	var diagnostics []*Diagnostic
+	for _, line := range strings.Split(output, "\n") {
+		match := diagnosticRegexp.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+		lineNumber, _ := strconv.Atoi(match[2])
+		column, _ := strconv.Atoi(match[3])
+		diagnostics = append(
+			diagnostics,
+			&Diagnostic{
+				Filename: match[1],
+				Line:     lineNumber,
+				Column:   column,
+				Message:  match[4],
+			},
+		)
+	}
+	return diagnostics
+}
+
+// run runs the Go command with the given arguments from the given path, and
+// returns its stderr. The command always runs outside of a workspace so that
+// the module at the given path is used on its own.
+func run(path string, args ...string) (string, error) {
+	cmd := exec.Command("go", args...)
+	stderr := bytes.NewBuffer(nil)
+	cmd.Stderr = stderr
+	cmd.Dir = path
+	cmd.Env = append(os.Environ(), "GOWORK=off")
+	if err := cmd.Run(); err != nil {
+		if stderr.Len() == 0 {
+			return "", err
+		}
+		return stderr.String(), errors.New(stderr.String())
+	}
+	return stderr.String(), nil
+}
+
+// restoreFile restores the given file to its original content, or removes
+// it if it didn't exist.
+func restoreFile(filename string, content []byte) error {
+	if content == nil {
+		if err := os.Remove(filename); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+		return nil
+	}
+	return os.WriteFile(filename, content, 0644)
+}
@@ -0,0 +1,77 @@
+package goexec
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunBuildAndVet(t *testing.T) {
+	tests := []struct {
+		description string
+		files       map[string]string
+		want        []*Diagnostic
+	}{
+		{
+			description: "valid",
+			files: map[string]string{
+				"sdk.go":        "package sdk\n\nfunc Name() string { return \"sdk\" }\n",
+				"client/get.go": "package client\n\nimport \"example.com/sdk\"\n\nfunc Get() string { return sdk.Name() }\n",
+			},
+		},
+		{
+			description: "compile error",
+			files: map[string]string{
+				"sdk.go":        "package sdk\n\nfunc Name() string { return 1 }\n",
+				"client/get.go": "package client\n\nfunc Get() int { return undefined }\n",
+			},
+			want: []*Diagnostic{
+				{Filename: "sdk.go", Line: 3, Message: `cannot use 1 (untyped int constant) as string value in return statement`},
+				{Filename: "client/get.go", Line: 3, Message: "undefined: undefined"},
+			},
+		},
+		{
+			description: "vet error",
+			files: map[string]string{
+				"client/get.go": "package client\n\nimport \"fmt\"\n\nfunc Get() string { return fmt.Sprintf(\"%d\", \"id\") }\n",
+			},
+			want: []*Diagnostic{
+				{Filename: "client/get.go", Line: 5, Message: `fmt.Sprintf format %d has arg "id" of wrong type string`},
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			dir := t.TempDir()
+			for filename, content := range test.files {
+				filename = filepath.Join(dir, filename)
+				require.NoError(t, os.MkdirAll(filepath.Dir(filename), 0755))
+				require.NoError(t, os.WriteFile(filename, []byte(content), 0644))
+			}
+			require.NoError(t, RunModInit(dir, "example.com/sdk"))
+			diagnostics, err := RunBuildAndVet(dir)
+			require.NoError(t, err)
+			for _, diagnostic := range diagnostics {
+				// The reported column depends on the Go version.
+				assert.NotZero(t, diagnostic.Column)
+				diagnostic.Column = 0
+			}
+			assert.ElementsMatch(t, test.want, diagnostics)
+		})
+	}
+}
+
+func TestParseDiagnostics(t *testing.T) {
+	output := `# example.com/sdk
+vet: ./sdk.go:3:29: cannot use 1 (untyped int constant) as string value in return statement
+client/get.go:5: unreachable code
+go: downloading github.com/google/uuid v1.4.0
+`
+	diagnostics := parseDiagnostics(output)
+	require.Len(t, diagnostics, 2)
+	assert.Equal(t, "sdk.go:3:29: cannot use 1 (untyped int constant) as string value in return statement", diagnostics[0].String())
+	assert.Equal(t, "client/get.go:5: unreachable code", diagnostics[1].String())
+}
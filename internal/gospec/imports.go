@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"go/format"
 	"sort"
+	"strings"
 )
 
 // Imports is a map from import paths to aliases.
@@ -11,19 +12,25 @@ import (
 // to prevent naming collisions with identifiers
 // used within the same scope.
 //
+// Imports can also be registered ahead of time, in
+// which case they're only included in the Values
+// once they're used.
+//
 // Note this type should primarily be used via the
 // Scope type; it's public so that it's easy to
 // access the import values directly.
 type Imports struct {
 	Values map[string]string
 
-	reserved map[string]struct{}
+	reserved   map[string]struct{}
+	registered map[string]string // Map from alias to import path.
 }
 
 func NewImports() *Imports {
 	return &Imports{
-		Values:   make(map[string]string),
-		reserved: make(map[string]struct{}),
+		Values:     make(map[string]string),
+		reserved:   make(map[string]struct{}),
+		registered: make(map[string]string),
 	}
 }
 
@@ -40,6 +47,122 @@ func (i *Imports) InUse(alias string) bool {
 	return false
 }
 
+// Register registers the alias for the given import path, which is only added
+// to the Values once it's used (see Use and UseReferences). This lets code
+// reference a package with its alias directly (e.g. json.Marshal).
+//
+// The alias isn't reserved until the import is used, so it's free to be used
+// by an identifier in the meantime.
+func (i *Imports) Register(alias string, path string) {
+	i.registered[alias] = path
+}
+
+// Use adds the import registered with the given alias, if any, and returns the
+// alias it's imported with. If the alias is already taken by an identifier or
+// another import when the import is first used, it's imported with a unique
+// alias instead (e.g. _json).
+func (i *Imports) Use(alias string) (string, bool) {
+	path, ok := i.registered[alias]
+	if !ok {
+		return "", false
+	}
+	if used, ok := i.Values[path]; ok {
+		return used, true
+	}
+	used := alias
+	for i.Exists(used) {
+		used = fmt.Sprintf("_%s", used)
+	}
+	i.Values[path] = used
+	return used, true
+}
+
+// UseReferences adds every registered import referenced by a qualified
+// identifier in the given Go source (e.g. json.Marshal). Comments and
+// literals are skipped, as are selectors of other values (e.g. value.json.Foo).
+//
+// The source is returned with every reference to an import that uses a
+// different alias (see Use) rewritten to that alias.
+func (i *Imports) UseReferences(src string) string {
+	var (
+		b    strings.Builder
+		last int  // The end of the source that's already written to b.
+		prev byte // The last byte of the previous token, if any.
+	)
+	for j := 0; j < len(src); {
+		c := src[j]
+		switch {
+		case c == '/' && j+1 < len(src) && src[j+1] == '/':
+			j = skipUntil(src, j+2, "\n")
+			continue
+		case c == '/' && j+1 < len(src) && src[j+1] == '*':
+			j = skipUntil(src, j+2, "*/")
+		case c == '`':
+			j = skipUntil(src, j+1, "`")
+		case c == '"' || c == '\'':
+			j = skipQuoted(src, j+1, c)
+		case isIdentByte(c) && (c < '0' || c > '9'):
+			start := j
+			for j < len(src) && isIdentByte(src[j]) {
+				j++
+			}
+			if j < len(src) && src[j] == '.' && prev != '.' {
+				if alias, ok := i.Use(src[start:j]); ok && alias != src[start:j] {
+					b.WriteString(src[last:start])
+					b.WriteString(alias)
+					last = j
+				}
+			}
+		case c >= '0' && c <= '9':
+			// Skip number literals (e.g. 1.5e3) so that they're not
+			// mistaken for identifiers.
+			for j < len(src) && (isIdentByte(src[j]) || src[j] == '.') {
+				j++
+			}
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			j++
+			continue
+		default:
+			j++
+		}
+		prev = src[j-1]
+	}
+	if last == 0 {
+		return src
+	}
+	b.WriteString(src[last:])
+	return b.String()
+}
+
+// skipUntil returns the index after the first occurrence of the
+// given terminator at or after start, or the end of the source.
+func skipUntil(src string, start int, terminator string) int {
+	if k := strings.Index(src[start:], terminator); k >= 0 {
+		return start + k + len(terminator)
+	}
+	return len(src)
+}
+
+// skipQuoted returns the index after the closing quote of the
+// interpreted string or rune literal that starts at start.
+func skipQuoted(src string, start int, quote byte) int {
+	for j := start; j < len(src); j++ {
+		switch src[j] {
+		case '\\':
+			j++
+		case quote, '\n':
+			return j + 1
+		}
+	}
+	return len(src)
+}
+
+// isIdentByte returns true if the given byte can be used in an identifier.
+// Every byte of a multi-byte rune is included.
+func isIdentByte(c byte) bool {
+	return c == '_' || c >= 0x80 || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
 func (i *Imports) Reserve(alias string) {
 	i.reserved[alias] = struct{}{}
 }
@@ -74,6 +74,12 @@ func (s *Scope) AddImport(path string) string {
 	if alias, ok := s.Imports.Values[path]; ok {
 		return alias
 	}
+	for alias, registeredPath := range s.Imports.registered {
+		if registeredPath == path {
+			alias, _ = s.Imports.Use(alias)
+			return alias
+		}
+	}
 	var (
 		alias string
 		elems = strings.Split(path, "/")
@@ -54,3 +54,80 @@ func TestScope(t *testing.T) {
 		assert.True(t, scope.Imports.Exists("os"))
 	})
 }
+
+func TestImports(t *testing.T) {
+	t.Run("registered", func(t *testing.T) {
+		scope := NewScope()
+		scope.Imports.Register("json", "encoding/json")
+		scope.Imports.Register("core", "example.io/core")
+		assert.Empty(t, scope.Imports.Values)
+
+		// Registered aliases aren't reserved until they're used, so a clash is
+		// resolved when the import is first used.
+		assert.Equal(t, "json", scope.Add("json"))
+		assert.Equal(t, "core", scope.AddImport("example.io/other/core"))
+
+		assert.Equal(t, "_json", scope.AddImport("encoding/json"))
+		assert.Equal(t, map[string]string{"encoding/json": "_json", "example.io/other/core": "core"}, scope.Imports.Values)
+
+		alias, ok := scope.Imports.Use("core")
+		assert.True(t, ok)
+		assert.Equal(t, "_core", alias)
+		assert.Equal(t, "_core", scope.Imports.Values["example.io/core"])
+
+		_, ok = scope.Imports.Use("fmt")
+		assert.False(t, ok)
+
+		// An alias that isn't taken is used as-is, and is reserved from then on.
+		scope.Imports.Register("fmt", "fmt")
+		alias, ok = scope.Imports.Use("fmt")
+		assert.True(t, ok)
+		assert.Equal(t, "fmt", alias)
+		assert.Equal(t, "_fmt", scope.Add("fmt"))
+	})
+	t.Run("references", func(t *testing.T) {
+		tests := []struct {
+			src  string
+			want []string
+		}{
+			{src: "bytes, err := json.Marshal(value)", want: []string{"encoding/json"}},
+			{src: "return fmt.Errorf(\"%w\", core.ErrNotFound)", want: []string{"fmt", "example.io/core"}},
+			{src: "Name string `json:\"name\"`", want: nil},
+			{src: `return "json.Marshal"`, want: nil},
+			{src: "return 'f', `fmt.Sprintf`", want: nil},
+			{src: "// Use json.Marshal.", want: nil},
+			{src: "/* fmt.Println */ x := value.json.Field", want: nil},
+			{src: "x := 1.5e3", want: nil},
+			{src: `x := "a\"json.b" + fmt.Sprint(y)`, want: []string{"fmt"}},
+			{src: "return core.\n\tNew()", want: []string{"example.io/core"}},
+		}
+		for _, test := range tests {
+			imports := NewImports()
+			imports.Register("json", "encoding/json")
+			imports.Register("fmt", "fmt")
+			imports.Register("core", "example.io/core")
+			assert.Equal(t, test.src, imports.UseReferences(test.src))
+
+			var got []string
+			for path := range imports.Values {
+				got = append(got, path)
+			}
+			assert.ElementsMatch(t, test.want, got, test.src)
+		}
+	})
+	t.Run("rewritten references", func(t *testing.T) {
+		scope := NewScope()
+		scope.Imports.Register("json", "encoding/json")
+		scope.Imports.Register("fmt", "fmt")
+
+		// The json identifier is added before the import is used, so the
+		// references are rewritten to the import's unique alias.
+		assert.Equal(t, "json", scope.Add("json"))
+		assert.Equal(
+			t,
+			`bytes, err := _json.Marshal(json) // json.Marshal`+"\n"+`return fmt.Sprint(_json.Valid(bytes))`,
+			scope.Imports.UseReferences(`bytes, err := json.Marshal(json) // json.Marshal`+"\n"+`return fmt.Sprint(json.Valid(bytes))`),
+		)
+		assert.Equal(t, map[string]string{"encoding/json": "_json", "fmt": "fmt"}, scope.Imports.Values)
+	})
+}
@@ -0,0 +1,4 @@
+// Package irmigrate detects the version of an IR document, and migrates
+// older versions to the version of the ir package so that the generator
+// can run with IR produced by older versions of the Fern CLI.
+package irmigrate
@@ -0,0 +1,181 @@
+package irmigrate
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/fern-api/fern-go/ir"
+)
+
+const (
+	// CurrentVersion is the IR version of the ir package, which every
+	// document is migrated to.
+	CurrentVersion = 28
+
+	// MinimumVersion is the oldest IR version that can be migrated.
+	MinimumVersion = 26
+)
+
+// document is a decoded IR document, which is migrated in place.
+type document map[string]any
+
+// Unmarshal detects the version of the given IR document, migrates it to the
+// CurrentVersion (if necessary), and unmarshals it. The detected version is
+// returned alongside the IR.
+func Unmarshal(data []byte) (*ir.IntermediateRepresentation, int, error) {
+	document, err := decode(data)
+	if err != nil {
+		return nil, 0, err
+	}
+	version, err := detectVersion(document)
+	if err != nil {
+		return nil, 0, err
+	}
+	if version < CurrentVersion {
+		for _, migration := range migrations[version-MinimumVersion:] {
+			if err := migration.migrate(document); err != nil {
+				return nil, 0, fmt.Errorf("failed to migrate the IR to v%d: %v", migration.version, err)
+			}
+		}
+		if data, err = json.Marshal(document); err != nil {
+			return nil, 0, err
+		}
+	}
+	ir := new(ir.IntermediateRepresentation)
+	if err := json.Unmarshal(data, ir); err != nil {
+		return nil, 0, fmt.Errorf("failed to unmarshal intermediate representation (v%d): %v", version, err)
+	}
+	return ir, version, nil
+}
+
+// DetectVersion returns the IR version of the given document, or an error if
+// the version isn't supported.
+func DetectVersion(data []byte) (int, error) {
+	document, err := decode(data)
+	if err != nil {
+		return 0, err
+	}
+	return detectVersion(document)
+}
+
+// decode decodes the given IR document. Numbers are preserved as json.Number
+// so that they're unchanged by the migrations.
+func decode(data []byte) (document, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var document document
+	if err := decoder.Decode(&document); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal intermediate representation: %v", err)
+	}
+	if document == nil {
+		return nil, errors.New("failed to unmarshal intermediate representation: expected a JSON object")
+	}
+	return document, nil
+}
+
+// detectVersion returns the version of the given document, which is the version
+// of the last migration that's already applied. The fields introduced after the
+// CurrentVersion aren't known, so any unrecognized field is treated as a newer
+// version.
+func detectVersion(document document) (int, error) {
+	sdkConfig, _ := document["sdkConfig"].(map[string]any)
+	unknown := append(
+		unknownFields("", document, reflect.TypeOf(ir.IntermediateRepresentation{})),
+		unknownFields("sdkConfig.", sdkConfig, reflect.TypeOf(ir.SdkConfig{}))...,
+	)
+	if len(unknown) > 0 {
+		return 0, fmt.Errorf(
+			"unsupported IR version: the IR includes %s, which %s newer than v%d; %s",
+			fieldList(unknown),
+			pluralize(len(unknown), "is", "are"),
+			CurrentVersion,
+			supportedVersions(),
+		)
+	}
+	var missing []string
+	for _, field := range baselineFields {
+		if !hasField(document, field) {
+			missing = append(missing, field)
+		}
+	}
+	if len(missing) > 0 {
+		return 0, fmt.Errorf(
+			"unsupported IR version: the IR doesn't include %s, so it's older than v%d; %s",
+			fieldList(missing),
+			MinimumVersion,
+			supportedVersions(),
+		)
+	}
+	version := MinimumVersion
+	for _, migration := range migrations {
+		if !migration.applied(document) {
+			break
+		}
+		version = migration.version
+	}
+	return version, nil
+}
+
+// unknownFields returns the fields in the given object that aren't recognized
+// by the given struct type.
+func unknownFields(prefix string, object map[string]any, structType reflect.Type) []string {
+	known := make(map[string]struct{}, structType.NumField())
+	for i := 0; i < structType.NumField(); i++ {
+		name, _, _ := strings.Cut(structType.Field(i).Tag.Get("json"), ",")
+		known[name] = struct{}{}
+	}
+	var unknown []string
+	for field := range object {
+		if _, ok := known[field]; !ok {
+			unknown = append(unknown, prefix+field)
+		}
+	}
+	sort.Strings(unknown)
+	return unknown
+}
+
+// hasField returns true if the document includes the given field, which
+// is a dot-separated path (e.g. sdkConfig.platformHeaders).
+func hasField(document document, field string) bool {
+	var value any = map[string]any(document)
+	for _, name := range strings.Split(field, ".") {
+		object, ok := value.(map[string]any)
+		if !ok {
+			return false
+		}
+		if value, ok = object[name]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// supportedVersions describes the range of supported versions.
+func supportedVersions() string {
+	return fmt.Sprintf("this generator supports IR v%d through v%d", MinimumVersion, CurrentVersion)
+}
+
+// fieldList formats the given fields, e.g. the "a" and "b" fields.
+func fieldList(fields []string) string {
+	quoted := make([]string, 0, len(fields))
+	for _, field := range fields {
+		quoted = append(quoted, fmt.Sprintf("%q", field))
+	}
+	if len(quoted) == 1 {
+		return fmt.Sprintf("the %s field", quoted[0])
+	}
+	return fmt.Sprintf("the %s and %s fields", strings.Join(quoted[:len(quoted)-1], ", "), quoted[len(quoted)-1])
+}
+
+// pluralize returns the singular form if n is 1, and the plural form otherwise.
+func pluralize(n int, singular, plural string) string {
+	if n == 1 {
+		return singular
+	}
+	return plural
+}
@@ -0,0 +1,87 @@
+package irmigrate
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/fern-api/fern-go/ir"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testdataPath contains the same IR in each supported version, along with
+// unsupported versions on either side (v25 and v29).
+const testdataPath = "testdata"
+
+func TestUnmarshal(t *testing.T) {
+	want := new(ir.IntermediateRepresentation)
+	require.NoError(t, json.Unmarshal(readFixture(t, CurrentVersion), want))
+
+	for version := MinimumVersion; version <= CurrentVersion; version++ {
+		t.Run(fmt.Sprintf("v%d", version), func(t *testing.T) {
+			got, detected, err := Unmarshal(readFixture(t, version))
+			require.NoError(t, err)
+			assert.Equal(t, version, detected)
+			assert.Equal(t, want, got)
+		})
+	}
+}
+
+func TestUnmarshalUnsupported(t *testing.T) {
+	_, _, err := Unmarshal(readFixture(t, MinimumVersion-1))
+	assert.EqualError(
+		t,
+		err,
+		`unsupported IR version: the IR doesn't include the "variables" and "serviceTypeReferenceInfo" fields, so it's older than v26; this generator supports IR v26 through v28`,
+	)
+
+	_, _, err = Unmarshal(readFixture(t, CurrentVersion+1))
+	assert.EqualError(
+		t,
+		err,
+		`unsupported IR version: the IR includes the "idempotencyHeaders" and "sdkConfig.hasFileUploadEndpoints" fields, which are newer than v28; this generator supports IR v26 through v28`,
+	)
+
+	_, _, err = Unmarshal([]byte(`[]`))
+	assert.Error(t, err)
+
+	_, _, err = Unmarshal([]byte(`null`))
+	assert.EqualError(t, err, "failed to unmarshal intermediate representation: expected a JSON object")
+}
+
+func TestDetectVersion(t *testing.T) {
+	for version := MinimumVersion; version <= CurrentVersion; version++ {
+		detected, err := DetectVersion(readFixture(t, version))
+		require.NoError(t, err)
+		assert.Equal(t, version, detected)
+	}
+	_, err := DetectVersion(readFixture(t, CurrentVersion+1))
+	assert.Error(t, err)
+}
+
+func TestMigrations(t *testing.T) {
+	// Every version between the minimum and current version has exactly one migration.
+	require.Len(t, migrations, CurrentVersion-MinimumVersion)
+	for i, migration := range migrations {
+		assert.Equal(t, MinimumVersion+i+1, migration.version)
+	}
+
+	t.Run("hasFileDownloadEndpoints", func(t *testing.T) {
+		document, err := decode(readFixture(t, MinimumVersion))
+		require.NoError(t, err)
+		assert.True(t, hasFileDownloadEndpoints(document))
+
+		document, err = decode([]byte(`{"services": {"service_": {"endpoints": [{"response": {"type": "json"}}, {"response": null}]}}}`))
+		require.NoError(t, err)
+		assert.False(t, hasFileDownloadEndpoints(document))
+	})
+}
+
+func readFixture(t *testing.T, version int) []byte {
+	data, err := os.ReadFile(filepath.Join(testdataPath, fmt.Sprintf("v%d.json", version)))
+	require.NoError(t, err)
+	return data
+}
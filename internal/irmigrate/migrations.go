@@ -0,0 +1,87 @@
+package irmigrate
+
+// baselineFields are included in every document from the MinimumVersion onwards,
+// so a document without any of them is too old to migrate.
+var baselineFields = []string{
+	"apiName",
+	"auth",
+	"headers",
+	"types",
+	"services",
+	"webhookGroups",
+	"errors",
+	"subpackages",
+	"rootPackage",
+	"constants",
+	"environments",
+	"basePath",
+	"pathParameters",
+	"errorDiscriminationStrategy",
+	"sdkConfig.isAuthMandatory",
+	"sdkConfig.hasStreamingEndpoints",
+	"variables",
+	"serviceTypeReferenceInfo",
+}
+
+// migration upgrades a document from the previous version to the migration's version.
+type migration struct {
+	version int
+
+	// applied returns true if the document already includes the migration's
+	// changes, which is used to detect the document's version.
+	applied func(document) bool
+
+	migrate func(document) error
+}
+
+// migrations upgrade a document from the MinimumVersion to the CurrentVersion,
+// in order.
+var migrations = []*migration{
+	{
+		// v27 reports whether any endpoint downloads a file.
+		version: 27,
+		applied: func(document document) bool {
+			return hasField(document, "sdkConfig.hasFileDownloadEndpoints")
+		},
+		migrate: func(document document) error {
+			sdkConfig := document["sdkConfig"].(map[string]any)
+			sdkConfig["hasFileDownloadEndpoints"] = hasFileDownloadEndpoints(document)
+			return nil
+		},
+	},
+	{
+		// v28 specifies the headers used to report the SDK's platform,
+		// which are otherwise omitted from the generated client.
+		version: 28,
+		applied: func(document document) bool {
+			return hasField(document, "sdkConfig.platformHeaders")
+		},
+		migrate: func(document document) error {
+			sdkConfig := document["sdkConfig"].(map[string]any)
+			sdkConfig["platformHeaders"] = map[string]any{
+				"language":   "X-Fern-Language",
+				"sdkName":    "X-Fern-SDK-Name",
+				"sdkVersion": "X-Fern-SDK-Version",
+			}
+			return nil
+		},
+	},
+}
+
+// hasFileDownloadEndpoints returns true if any of the document's endpoints
+// respond with a file download.
+func hasFileDownloadEndpoints(document document) bool {
+	services, _ := document["services"].(map[string]any)
+	for _, service := range services {
+		service, _ := service.(map[string]any)
+		endpoints, _ := service["endpoints"].([]any)
+		for _, endpoint := range endpoints {
+			endpoint, _ := endpoint.(map[string]any)
+			response, _ := endpoint["response"].(map[string]any)
+			if response["type"] == "fileDownload" {
+				return true
+			}
+		}
+	}
+	return false
+}
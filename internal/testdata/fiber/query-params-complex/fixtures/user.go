@@ -20,11 +20,81 @@ type GetUsersRequest struct {
 	OptionalBytes    *[]byte    `query:"optionalBytes"`
 }
 
+func (g *GetUsersRequest) GetId() uuid.UUID {
+	if g == nil {
+		return uuid.Nil
+	}
+	return g.Id
+}
+
+func (g *GetUsersRequest) GetDate() time.Time {
+	if g == nil {
+		return time.Time{}
+	}
+	return g.Date
+}
+
+func (g *GetUsersRequest) GetDeadline() time.Time {
+	if g == nil {
+		return time.Time{}
+	}
+	return g.Deadline
+}
+
+func (g *GetUsersRequest) GetBytes() []byte {
+	if g == nil {
+		return nil
+	}
+	return g.Bytes
+}
+
+func (g *GetUsersRequest) GetOptionalId() uuid.UUID {
+	if g == nil || g.OptionalId == nil {
+		return uuid.Nil
+	}
+	return *g.OptionalId
+}
+
+func (g *GetUsersRequest) GetOptionalDate() time.Time {
+	if g == nil || g.OptionalDate == nil {
+		return time.Time{}
+	}
+	return *g.OptionalDate
+}
+
+func (g *GetUsersRequest) GetOptionalDeadline() time.Time {
+	if g == nil || g.OptionalDeadline == nil {
+		return time.Time{}
+	}
+	return *g.OptionalDeadline
+}
+
+func (g *GetUsersRequest) GetOptionalBytes() []byte {
+	if g == nil || g.OptionalBytes == nil {
+		return nil
+	}
+	return *g.OptionalBytes
+}
+
 type User struct {
 	Name string   `json:"name"`
 	Tags []string `json:"tags,omitempty"`
 }
 
+func (u *User) GetName() string {
+	if u == nil {
+		return ""
+	}
+	return u.Name
+}
+
+func (u *User) GetTags() []string {
+	if u == nil {
+		return nil
+	}
+	return u.Tags
+}
+
 func (u *User) String() string {
 	if value, err := core.StringifyJSON(u); err == nil {
 		return value
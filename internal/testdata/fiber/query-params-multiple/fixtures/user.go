@@ -9,3 +9,38 @@ type GetAllUsersRequest struct {
 	Filter          *string  `query:"filter"`
 	Series          []string `query:"series"`
 }
+
+func (g *GetAllUsersRequest) GetXEndpointHeader() string {
+	if g == nil {
+		return ""
+	}
+	return g.XEndpointHeader
+}
+
+func (g *GetAllUsersRequest) GetTag() int {
+	if g == nil {
+		return 0
+	}
+	return g.Tag
+}
+
+func (g *GetAllUsersRequest) GetLimit() []*int {
+	if g == nil {
+		return nil
+	}
+	return g.Limit
+}
+
+func (g *GetAllUsersRequest) GetFilter() string {
+	if g == nil || g.Filter == nil {
+		return ""
+	}
+	return *g.Filter
+}
+
+func (g *GetAllUsersRequest) GetSeries() []string {
+	if g == nil {
+		return nil
+	}
+	return g.Series
+}
@@ -0,0 +1,103 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package api
+
+import (
+	fmt "fmt"
+	core "github.com/fern-api/fern-go/internal/testdata/fiber/struct-tags/fixtures/core"
+	uuid "github.com/google/uuid"
+	time "time"
+)
+
+type GetUsersRequest struct {
+	Id               uuid.UUID  `query:"id" yaml:"id" bson:"id"`
+	Date             time.Time  `query:"date" yaml:"date" bson:"date"`
+	Deadline         time.Time  `query:"deadline" yaml:"deadline" bson:"deadline"`
+	Bytes            []byte     `query:"bytes" yaml:"bytes" bson:"bytes"`
+	OptionalId       *uuid.UUID `query:"optionalId" yaml:"optionalId,omitempty" bson:"optional_id,omitempty"`
+	OptionalDate     *time.Time `query:"optionalDate" yaml:"optionalDate,omitempty" bson:"optional_date,omitempty"`
+	OptionalDeadline *time.Time `query:"optionalDeadline" yaml:"optionalDeadline,omitempty" bson:"optional_deadline,omitempty"`
+	OptionalBytes    *[]byte    `query:"optionalBytes" yaml:"optionalBytes,omitempty" bson:"optional_bytes,omitempty"`
+}
+
+func (g *GetUsersRequest) GetId() uuid.UUID {
+	if g == nil {
+		return uuid.Nil
+	}
+	return g.Id
+}
+
+func (g *GetUsersRequest) GetDate() time.Time {
+	if g == nil {
+		return time.Time{}
+	}
+	return g.Date
+}
+
+func (g *GetUsersRequest) GetDeadline() time.Time {
+	if g == nil {
+		return time.Time{}
+	}
+	return g.Deadline
+}
+
+func (g *GetUsersRequest) GetBytes() []byte {
+	if g == nil {
+		return nil
+	}
+	return g.Bytes
+}
+
+func (g *GetUsersRequest) GetOptionalId() uuid.UUID {
+	if g == nil || g.OptionalId == nil {
+		return uuid.Nil
+	}
+	return *g.OptionalId
+}
+
+func (g *GetUsersRequest) GetOptionalDate() time.Time {
+	if g == nil || g.OptionalDate == nil {
+		return time.Time{}
+	}
+	return *g.OptionalDate
+}
+
+func (g *GetUsersRequest) GetOptionalDeadline() time.Time {
+	if g == nil || g.OptionalDeadline == nil {
+		return time.Time{}
+	}
+	return *g.OptionalDeadline
+}
+
+func (g *GetUsersRequest) GetOptionalBytes() []byte {
+	if g == nil || g.OptionalBytes == nil {
+		return nil
+	}
+	return *g.OptionalBytes
+}
+
+type User struct {
+	Name string   `json:"name" yaml:"name" bson:"name"`
+	Tags []string `json:"tags,omitempty" yaml:"tags" bson:"tags"`
+}
+
+func (u *User) GetName() string {
+	if u == nil {
+		return ""
+	}
+	return u.Name
+}
+
+func (u *User) GetTags() []string {
+	if u == nil {
+		return nil
+	}
+	return u.Tags
+}
+
+func (u *User) String() string {
+	if value, err := core.StringifyJSON(u); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", u)
+}
@@ -14,6 +14,13 @@ type Bar struct {
 	Foo *Foo `json:"foo,omitempty"`
 }
 
+func (b *Bar) GetFoo() *Foo {
+	if b == nil {
+		return nil
+	}
+	return b.Foo
+}
+
 func (b *Bar) String() string {
 	if value, err := core.StringifyJSON(b); err == nil {
 		return value
@@ -41,6 +48,27 @@ type Foo struct {
 	StringAlias String    `json:"stringAlias"`
 }
 
+func (f *Foo) GetId() uuid.UUID {
+	if f == nil {
+		return uuid.Nil
+	}
+	return f.Id
+}
+
+func (f *Foo) GetName() string {
+	if f == nil {
+		return ""
+	}
+	return f.Name
+}
+
+func (f *Foo) GetStringAlias() String {
+	if f == nil {
+		return ""
+	}
+	return f.StringAlias
+}
+
 func (f *Foo) String() string {
 	if value, err := core.StringifyJSON(f); err == nil {
 		return value
@@ -87,6 +115,34 @@ func NewUnionFromDoubleAlias(value Double) *Union {
 	return &Union{Type: "doubleAlias", DoubleAlias: value}
 }
 
+func (u *Union) GetType() string {
+	if u == nil {
+		return ""
+	}
+	return u.Type
+}
+
+func (u *Union) GetFooAlias() *Foo {
+	if u == nil {
+		return nil
+	}
+	return u.FooAlias
+}
+
+func (u *Union) GetBarAlias() BarAlias {
+	if u == nil {
+		return nil
+	}
+	return u.BarAlias
+}
+
+func (u *Union) GetDoubleAlias() Double {
+	if u == nil {
+		return 0
+	}
+	return u.DoubleAlias
+}
+
 func (u *Union) UnmarshalJSON(data []byte) error {
 	var unmarshaler struct {
 		Type string `json:"type"`
@@ -17,7 +17,7 @@ type Type struct {
 	Four      bool             `json:"four"`
 	Five      int64            `json:"five"`
 	Six       time.Time        `json:"six"`
-	Seven     time.Time        `json:"seven"`
+	Seven     core.Date        `json:"seven"`
 	Eight     uuid.UUID        `json:"eight"`
 	Nine      []byte           `json:"nine"`
 	Ten       []int            `json:"ten,omitempty"`
@@ -35,6 +35,125 @@ func (t *Type) Eighteen() string {
 	return t.eighteen
 }
 
+func (t *Type) GetOne() int {
+	if t == nil {
+		return 0
+	}
+	return t.One
+}
+
+func (t *Type) GetTwo() float64 {
+	if t == nil {
+		return 0
+	}
+	return t.Two
+}
+
+func (t *Type) GetThree() string {
+	if t == nil {
+		return ""
+	}
+	return t.Three
+}
+
+func (t *Type) GetFour() bool {
+	if t == nil {
+		return false
+	}
+	return t.Four
+}
+
+func (t *Type) GetFive() int64 {
+	if t == nil {
+		return 0
+	}
+	return t.Five
+}
+
+func (t *Type) GetSix() time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return t.Six
+}
+
+func (t *Type) GetSeven() core.Date {
+	if t == nil {
+		return core.Date{}
+	}
+	return t.Seven
+}
+
+func (t *Type) GetEight() uuid.UUID {
+	if t == nil {
+		return uuid.Nil
+	}
+	return t.Eight
+}
+
+func (t *Type) GetNine() []byte {
+	if t == nil {
+		return nil
+	}
+	return t.Nine
+}
+
+func (t *Type) GetTen() []int {
+	if t == nil {
+		return nil
+	}
+	return t.Ten
+}
+
+func (t *Type) GetEleven() []float64 {
+	if t == nil {
+		return nil
+	}
+	return t.Eleven
+}
+
+func (t *Type) GetTwelve() map[string]bool {
+	if t == nil {
+		return nil
+	}
+	return t.Twelve
+}
+
+func (t *Type) GetThirteen() int64 {
+	if t == nil || t.Thirteen == nil {
+		return 0
+	}
+	return *t.Thirteen
+}
+
+func (t *Type) GetFourteen() interface{} {
+	if t == nil {
+		return nil
+	}
+	return t.Fourteen
+}
+
+func (t *Type) GetFifteen() [][]int {
+	if t == nil {
+		return nil
+	}
+	return t.Fifteen
+}
+
+func (t *Type) GetSixteen() []map[string]int {
+	if t == nil {
+		return nil
+	}
+	return t.Sixteen
+}
+
+func (t *Type) GetSeventeen() []*uuid.UUID {
+	if t == nil {
+		return nil
+	}
+	return t.Seventeen
+}
+
 func (t *Type) UnmarshalJSON(data []byte) error {
 	type unmarshaler Type
 	var value unmarshaler
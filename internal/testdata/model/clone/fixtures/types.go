@@ -0,0 +1,1459 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package api
+
+import (
+	bytes "bytes"
+	json "encoding/json"
+	fmt "fmt"
+	core "github.com/fern-api/fern-go/internal/testdata/model/clone/fixtures/core"
+	uuid "github.com/google/uuid"
+	reflect "reflect"
+	time "time"
+)
+
+type Account struct {
+	Id        AccountId                 `json:"id"`
+	Name      string                    `json:"name"`
+	CreatedAt time.Time                 `json:"createdAt"`
+	LastSeen  *core.Optional[time.Time] `json:"lastSeen,omitempty"`
+	Birthday  *core.Optional[core.Date] `json:"birthday,omitempty"`
+	Avatar    *core.Optional[[]byte]    `json:"avatar,omitempty"`
+	Status    Status                    `json:"status,omitempty"`
+	Nickname  *core.Optional[string]    `json:"nickname,omitempty"`
+	Tags      Tags                      `json:"tags,omitempty"`
+	Labels    *core.Set[string]         `json:"labels,omitempty"`
+	Scores    map[string]float64        `json:"scores,omitempty"`
+	Groups    map[string][]string       `json:"groups,omitempty"`
+	Address   *core.Optional[Address]   `json:"address,omitempty"`
+	Addresses []*Address                `json:"addresses,omitempty"`
+	History   []*Event                  `json:"history,omitempty"`
+	Union     *Union                    `json:"union,omitempty"`
+	Metadata  interface{}               `json:"metadata,omitempty"`
+	kind      string
+}
+
+func (a *Account) Kind() string {
+	return a.kind
+}
+
+func (a *Account) GetId() AccountId {
+	if a == nil {
+		return uuid.Nil
+	}
+	return a.Id
+}
+
+func (a *Account) GetName() string {
+	if a == nil {
+		return ""
+	}
+	return a.Name
+}
+
+func (a *Account) GetCreatedAt() time.Time {
+	if a == nil {
+		return time.Time{}
+	}
+	return a.CreatedAt
+}
+
+func (a *Account) GetLastSeen() time.Time {
+	if a == nil || a.LastSeen == nil {
+		return time.Time{}
+	}
+	return a.LastSeen.Value
+}
+
+func (a *Account) GetBirthday() core.Date {
+	if a == nil || a.Birthday == nil {
+		return core.Date{}
+	}
+	return a.Birthday.Value
+}
+
+func (a *Account) GetAvatar() []byte {
+	if a == nil || a.Avatar == nil {
+		return nil
+	}
+	return a.Avatar.Value
+}
+
+func (a *Account) GetStatus() Status {
+	if a == nil {
+		return ""
+	}
+	return a.Status
+}
+
+func (a *Account) GetNickname() string {
+	if a == nil || a.Nickname == nil {
+		return ""
+	}
+	return a.Nickname.Value
+}
+
+func (a *Account) GetTags() Tags {
+	if a == nil {
+		return nil
+	}
+	return a.Tags
+}
+
+func (a *Account) GetLabels() *core.Set[string] {
+	if a == nil {
+		return nil
+	}
+	return a.Labels
+}
+
+func (a *Account) GetScores() map[string]float64 {
+	if a == nil {
+		return nil
+	}
+	return a.Scores
+}
+
+func (a *Account) GetGroups() map[string][]string {
+	if a == nil {
+		return nil
+	}
+	return a.Groups
+}
+
+func (a *Account) GetAddress() *Address {
+	if a == nil || a.Address == nil {
+		return nil
+	}
+	return &a.Address.Value
+}
+
+func (a *Account) GetAddresses() []*Address {
+	if a == nil {
+		return nil
+	}
+	return a.Addresses
+}
+
+func (a *Account) GetHistory() []*Event {
+	if a == nil {
+		return nil
+	}
+	return a.History
+}
+
+func (a *Account) GetUnion() *Union {
+	if a == nil {
+		return nil
+	}
+	return a.Union
+}
+
+func (a *Account) GetMetadata() interface{} {
+	if a == nil {
+		return nil
+	}
+	return a.Metadata
+}
+
+func (a *Account) UnmarshalJSON(data []byte) error {
+	type embed Account
+	var unmarshaler = struct {
+		embed
+		LastSeen json.RawMessage `json:"lastSeen"`
+		Birthday json.RawMessage `json:"birthday"`
+		Avatar   json.RawMessage `json:"avatar"`
+		Nickname json.RawMessage `json:"nickname"`
+		Address  json.RawMessage `json:"address"`
+	}{}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	*a = Account(unmarshaler.embed)
+	if unmarshaler.LastSeen != nil {
+		a.LastSeen = new(core.Optional[time.Time])
+		if err := json.Unmarshal(unmarshaler.LastSeen, a.LastSeen); err != nil {
+			return err
+		}
+	}
+	if unmarshaler.Birthday != nil {
+		a.Birthday = new(core.Optional[core.Date])
+		if err := json.Unmarshal(unmarshaler.Birthday, a.Birthday); err != nil {
+			return err
+		}
+	}
+	if unmarshaler.Avatar != nil {
+		a.Avatar = new(core.Optional[[]byte])
+		if err := json.Unmarshal(unmarshaler.Avatar, a.Avatar); err != nil {
+			return err
+		}
+	}
+	if unmarshaler.Nickname != nil {
+		a.Nickname = new(core.Optional[string])
+		if err := json.Unmarshal(unmarshaler.Nickname, a.Nickname); err != nil {
+			return err
+		}
+	}
+	if unmarshaler.Address != nil {
+		a.Address = new(core.Optional[Address])
+		if err := json.Unmarshal(unmarshaler.Address, a.Address); err != nil {
+			return err
+		}
+	}
+	a.kind = "account"
+	return nil
+}
+
+func (a *Account) MarshalJSON() ([]byte, error) {
+	type embed Account
+	var marshaler = struct {
+		embed
+		Kind string `json:"kind"`
+	}{
+		embed: embed(*a),
+		Kind:  "account",
+	}
+	return json.Marshal(marshaler)
+}
+
+func (a *Account) String() string {
+	if value, err := core.StringifyJSON(a); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", a)
+}
+
+// Clone returns a deep copy of the Account.
+func (a *Account) Clone() *Account {
+	if a == nil {
+		return nil
+	}
+	clone := *a
+	if a.LastSeen != nil {
+		value := *a.LastSeen
+		clone.LastSeen = &value
+	}
+	if a.Birthday != nil {
+		value := *a.Birthday
+		clone.Birthday = &value
+	}
+	if a.Avatar != nil {
+		value := *a.Avatar
+		if a.Avatar.Value != nil {
+			value.Value = make([]byte, len(a.Avatar.Value))
+			copy(value.Value, a.Avatar.Value)
+		}
+		clone.Avatar = &value
+	}
+	if a.Nickname != nil {
+		value := *a.Nickname
+		clone.Nickname = &value
+	}
+	if a.Tags != nil {
+		clone.Tags = make(Tags, len(a.Tags))
+		copy(clone.Tags, a.Tags)
+	}
+	clone.Labels = a.Labels.Clone()
+	if a.Scores != nil {
+		clone.Scores = make(map[string]float64, len(a.Scores))
+		for key, value := range a.Scores {
+			clone.Scores[key] = value
+		}
+	}
+	if a.Groups != nil {
+		clone.Groups = make(map[string][]string, len(a.Groups))
+		for key, value := range a.Groups {
+			if value != nil {
+				clone.Groups[key] = make([]string, len(value))
+				copy(clone.Groups[key], value)
+			}
+		}
+	}
+	if a.Address != nil {
+		value := *a.Address
+		value.Value = *a.Address.Value.Clone()
+		clone.Address = &value
+	}
+	if a.Addresses != nil {
+		clone.Addresses = make([]*Address, len(a.Addresses))
+		for index, value := range a.Addresses {
+			clone.Addresses[index] = value.Clone()
+		}
+	}
+	if a.History != nil {
+		clone.History = make([]*Event, len(a.History))
+		for index, value := range a.History {
+			clone.History[index] = value.Clone()
+		}
+	}
+	clone.Union = a.Union.Clone()
+	return &clone
+}
+
+// Equal reports whether the Account is equal to the other Account.
+func (a *Account) Equal(other *Account) bool {
+	if a == nil || other == nil {
+		return a == other
+	}
+	if a.Id != other.Id {
+		return false
+	}
+	if a.Name != other.Name {
+		return false
+	}
+	if !a.CreatedAt.Equal(other.CreatedAt) {
+		return false
+	}
+	if (a.LastSeen == nil) != (other.LastSeen == nil) {
+		return false
+	}
+	if a.LastSeen != nil {
+		if a.LastSeen.Null != other.LastSeen.Null {
+			return false
+		}
+		if !a.LastSeen.Value.Equal(other.LastSeen.Value) {
+			return false
+		}
+	}
+	if (a.Birthday == nil) != (other.Birthday == nil) {
+		return false
+	}
+	if a.Birthday != nil {
+		if a.Birthday.Null != other.Birthday.Null {
+			return false
+		}
+		if a.Birthday.Value != other.Birthday.Value {
+			return false
+		}
+	}
+	if (a.Avatar == nil) != (other.Avatar == nil) {
+		return false
+	}
+	if a.Avatar != nil {
+		if a.Avatar.Null != other.Avatar.Null {
+			return false
+		}
+		if !bytes.Equal(a.Avatar.Value, other.Avatar.Value) {
+			return false
+		}
+	}
+	if a.Status != other.Status {
+		return false
+	}
+	if (a.Nickname == nil) != (other.Nickname == nil) {
+		return false
+	}
+	if a.Nickname != nil {
+		if a.Nickname.Null != other.Nickname.Null {
+			return false
+		}
+		if a.Nickname.Value != other.Nickname.Value {
+			return false
+		}
+	}
+	if len(a.Tags) != len(other.Tags) {
+		return false
+	}
+	for index := range a.Tags {
+		if a.Tags[index] != other.Tags[index] {
+			return false
+		}
+	}
+	if !a.Labels.Equal(other.Labels) {
+		return false
+	}
+	if len(a.Scores) != len(other.Scores) {
+		return false
+	}
+	for key, value := range a.Scores {
+		otherValue, ok := other.Scores[key]
+		if !ok {
+			return false
+		}
+		if value != otherValue {
+			return false
+		}
+	}
+	if len(a.Groups) != len(other.Groups) {
+		return false
+	}
+	for key, value := range a.Groups {
+		otherValue, ok := other.Groups[key]
+		if !ok {
+			return false
+		}
+		if len(value) != len(otherValue) {
+			return false
+		}
+		for index1 := range value {
+			if value[index1] != otherValue[index1] {
+				return false
+			}
+		}
+	}
+	if (a.Address == nil) != (other.Address == nil) {
+		return false
+	}
+	if a.Address != nil {
+		if a.Address.Null != other.Address.Null {
+			return false
+		}
+		if !a.Address.Value.Equal(&other.Address.Value) {
+			return false
+		}
+	}
+	if len(a.Addresses) != len(other.Addresses) {
+		return false
+	}
+	for index := range a.Addresses {
+		if !a.Addresses[index].Equal(other.Addresses[index]) {
+			return false
+		}
+	}
+	if len(a.History) != len(other.History) {
+		return false
+	}
+	for index := range a.History {
+		if !a.History[index].Equal(other.History[index]) {
+			return false
+		}
+	}
+	if !a.Union.Equal(other.Union) {
+		return false
+	}
+	if !reflect.DeepEqual(a.Metadata, other.Metadata) {
+		return false
+	}
+	return true
+}
+
+type AccountId = uuid.UUID
+
+type Address struct {
+	Street string                 `json:"street"`
+	City   *core.Optional[string] `json:"city,omitempty"`
+}
+
+func (a *Address) GetStreet() string {
+	if a == nil {
+		return ""
+	}
+	return a.Street
+}
+
+func (a *Address) GetCity() string {
+	if a == nil || a.City == nil {
+		return ""
+	}
+	return a.City.Value
+}
+
+func (a *Address) UnmarshalJSON(data []byte) error {
+	type embed Address
+	var unmarshaler = struct {
+		embed
+		City json.RawMessage `json:"city"`
+	}{}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	*a = Address(unmarshaler.embed)
+	if unmarshaler.City != nil {
+		a.City = new(core.Optional[string])
+		if err := json.Unmarshal(unmarshaler.City, a.City); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *Address) String() string {
+	if value, err := core.StringifyJSON(a); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", a)
+}
+
+// Clone returns a deep copy of the Address.
+func (a *Address) Clone() *Address {
+	if a == nil {
+		return nil
+	}
+	clone := *a
+	if a.City != nil {
+		value := *a.City
+		clone.City = &value
+	}
+	return &clone
+}
+
+// Equal reports whether the Address is equal to the other Address.
+func (a *Address) Equal(other *Address) bool {
+	if a == nil || other == nil {
+		return a == other
+	}
+	if a.Street != other.Street {
+		return false
+	}
+	if (a.City == nil) != (other.City == nil) {
+		return false
+	}
+	if a.City != nil {
+		if a.City.Null != other.City.Null {
+			return false
+		}
+		if a.City.Value != other.City.Value {
+			return false
+		}
+	}
+	return true
+}
+
+type AnotherUnion struct {
+	typeName      string
+	String        string
+	stringLiteral string
+	Foo           *Foo
+}
+
+func NewAnotherUnionFromString(value string) *AnotherUnion {
+	return &AnotherUnion{typeName: "string", String: value}
+}
+
+func NewAnotherUnionWithStringLiteral() *AnotherUnion {
+	return &AnotherUnion{typeName: "stringLiteral", stringLiteral: "fern"}
+}
+
+func NewAnotherUnionFromFoo(value *Foo) *AnotherUnion {
+	return &AnotherUnion{typeName: "foo", Foo: value}
+}
+
+func (a *AnotherUnion) StringLiteral() string {
+	return a.stringLiteral
+}
+
+func (a *AnotherUnion) GetString() string {
+	if a == nil {
+		return ""
+	}
+	return a.String
+}
+
+func (a *AnotherUnion) GetFoo() *Foo {
+	if a == nil {
+		return nil
+	}
+	return a.Foo
+}
+
+func (a *AnotherUnion) UnmarshalJSON(data []byte) error {
+	var valueString string
+	if err := json.Unmarshal(data, &valueString); err == nil {
+		a.typeName = "string"
+		a.String = valueString
+		return nil
+	}
+	var valueStringLiteral string
+	if err := json.Unmarshal(data, &valueStringLiteral); err == nil {
+		if valueStringLiteral == "fern" {
+			a.typeName = "stringLiteral"
+			a.stringLiteral = valueStringLiteral
+			return nil
+		}
+	}
+	valueFoo := new(Foo)
+	if err := json.Unmarshal(data, &valueFoo); err == nil {
+		a.typeName = "foo"
+		a.Foo = valueFoo
+		return nil
+	}
+	return fmt.Errorf("%s cannot be deserialized as a %T", data, a)
+}
+
+func (a AnotherUnion) MarshalJSON() ([]byte, error) {
+	switch a.typeName {
+	default:
+		return nil, fmt.Errorf("invalid type %s in %T", a.typeName, a)
+	case "string":
+		return json.Marshal(a.String)
+	case "stringLiteral":
+		return json.Marshal("fern")
+	case "foo":
+		return json.Marshal(a.Foo)
+	}
+}
+
+type AnotherUnionVisitor interface {
+	VisitString(string) error
+	VisitStringLiteral(string) error
+	VisitFoo(*Foo) error
+}
+
+func (a *AnotherUnion) Accept(visitor AnotherUnionVisitor) error {
+	switch a.typeName {
+	default:
+		return fmt.Errorf("invalid type %s in %T", a.typeName, a)
+	case "string":
+		return visitor.VisitString(a.String)
+	case "stringLiteral":
+		return visitor.VisitStringLiteral(a.stringLiteral)
+	case "foo":
+		return visitor.VisitFoo(a.Foo)
+	}
+}
+
+// Clone returns a deep copy of the AnotherUnion.
+func (a *AnotherUnion) Clone() *AnotherUnion {
+	if a == nil {
+		return nil
+	}
+	clone := *a
+	clone.Foo = a.Foo.Clone()
+	return &clone
+}
+
+// Equal reports whether the AnotherUnion is equal to the other AnotherUnion.
+func (a *AnotherUnion) Equal(other *AnotherUnion) bool {
+	if a == nil || other == nil {
+		return a == other
+	}
+	if a.typeName != other.typeName {
+		return false
+	}
+	if a.String != other.String {
+		return false
+	}
+	if a.stringLiteral != other.stringLiteral {
+		return false
+	}
+	if !a.Foo.Equal(other.Foo) {
+		return false
+	}
+	return true
+}
+
+type Bar struct {
+	Name string `json:"name"`
+}
+
+func (b *Bar) GetName() string {
+	if b == nil {
+		return ""
+	}
+	return b.Name
+}
+
+func (b *Bar) String() string {
+	if value, err := core.StringifyJSON(b); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", b)
+}
+
+// Clone returns a deep copy of the Bar.
+func (b *Bar) Clone() *Bar {
+	if b == nil {
+		return nil
+	}
+	clone := *b
+	return &clone
+}
+
+// Equal reports whether the Bar is equal to the other Bar.
+func (b *Bar) Equal(other *Bar) bool {
+	if b == nil || other == nil {
+		return b == other
+	}
+	if b.Name != other.Name {
+		return false
+	}
+	return true
+}
+
+type Baz struct {
+	Id string `json:"id"`
+}
+
+func (b *Baz) GetId() string {
+	if b == nil {
+		return ""
+	}
+	return b.Id
+}
+
+func (b *Baz) String() string {
+	if value, err := core.StringifyJSON(b); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", b)
+}
+
+// Clone returns a deep copy of the Baz.
+func (b *Baz) Clone() *Baz {
+	if b == nil {
+		return nil
+	}
+	clone := *b
+	return &clone
+}
+
+// Equal reports whether the Baz is equal to the other Baz.
+func (b *Baz) Equal(other *Baz) bool {
+	if b == nil || other == nil {
+		return b == other
+	}
+	if b.Id != other.Id {
+		return false
+	}
+	return true
+}
+
+type Event struct {
+	Type    string
+	At      time.Time
+	Note    *string
+	Created Tags
+	Moved   *Address
+	Deleted interface{}
+}
+
+func NewEventFromCreated(value Tags) *Event {
+	return &Event{Type: "created", Created: value}
+}
+
+func NewEventFromMoved(value *Address) *Event {
+	return &Event{Type: "moved", Moved: value}
+}
+
+func NewEventFromDeleted(value interface{}) *Event {
+	return &Event{Type: "deleted", Deleted: value}
+}
+
+func (e *Event) GetType() string {
+	if e == nil {
+		return ""
+	}
+	return e.Type
+}
+
+func (e *Event) GetAt() time.Time {
+	if e == nil {
+		return time.Time{}
+	}
+	return e.At
+}
+
+func (e *Event) GetNote() string {
+	if e == nil || e.Note == nil {
+		return ""
+	}
+	return *e.Note
+}
+
+func (e *Event) GetCreated() Tags {
+	if e == nil {
+		return nil
+	}
+	return e.Created
+}
+
+func (e *Event) GetMoved() *Address {
+	if e == nil {
+		return nil
+	}
+	return e.Moved
+}
+
+func (e *Event) UnmarshalJSON(data []byte) error {
+	var unmarshaler struct {
+		Type string    `json:"type"`
+		At   time.Time `json:"at"`
+		Note *string   `json:"note,omitempty"`
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	e.Type = unmarshaler.Type
+	e.At = unmarshaler.At
+	e.Note = unmarshaler.Note
+	switch unmarshaler.Type {
+	case "created":
+		var valueUnmarshaler struct {
+			Created Tags `json:"tags,omitempty"`
+		}
+		if err := json.Unmarshal(data, &valueUnmarshaler); err != nil {
+			return err
+		}
+		e.Created = valueUnmarshaler.Created
+	case "moved":
+		value := new(Address)
+		if err := json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+		e.Moved = value
+	case "deleted":
+		value := make(map[string]interface{})
+		if err := json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+		e.Deleted = value
+	}
+	return nil
+}
+
+func (e Event) MarshalJSON() ([]byte, error) {
+	switch e.Type {
+	default:
+		return nil, fmt.Errorf("invalid type %s in %T", e.Type, e)
+	case "created":
+		var marshaler = struct {
+			Type    string    `json:"type"`
+			At      time.Time `json:"at"`
+			Note    *string   `json:"note,omitempty"`
+			Created Tags      `json:"tags,omitempty"`
+		}{
+			Type:    e.Type,
+			At:      e.At,
+			Note:    e.Note,
+			Created: e.Created,
+		}
+		return json.Marshal(marshaler)
+	case "moved":
+		var marshaler = struct {
+			Type string    `json:"type"`
+			At   time.Time `json:"at"`
+			Note *string   `json:"note,omitempty"`
+			*Address
+		}{
+			Type:    e.Type,
+			At:      e.At,
+			Note:    e.Note,
+			Address: e.Moved,
+		}
+		return json.Marshal(marshaler)
+	case "deleted":
+		var marshaler = struct {
+			Type    string      `json:"type"`
+			At      time.Time   `json:"at"`
+			Note    *string     `json:"note,omitempty"`
+			Deleted interface{} `json:"deleted,omitempty"`
+		}{
+			Type:    e.Type,
+			At:      e.At,
+			Note:    e.Note,
+			Deleted: e.Deleted,
+		}
+		return json.Marshal(marshaler)
+	}
+}
+
+type EventVisitor interface {
+	VisitCreated(Tags) error
+	VisitMoved(*Address) error
+	VisitDeleted(interface{}) error
+}
+
+func (e *Event) Accept(visitor EventVisitor) error {
+	switch e.Type {
+	default:
+		return fmt.Errorf("invalid type %s in %T", e.Type, e)
+	case "created":
+		return visitor.VisitCreated(e.Created)
+	case "moved":
+		return visitor.VisitMoved(e.Moved)
+	case "deleted":
+		return visitor.VisitDeleted(e.Deleted)
+	}
+}
+
+// Clone returns a deep copy of the Event.
+func (e *Event) Clone() *Event {
+	if e == nil {
+		return nil
+	}
+	clone := *e
+	if e.Note != nil {
+		value := *e.Note
+		clone.Note = &value
+	}
+	if e.Created != nil {
+		clone.Created = make(Tags, len(e.Created))
+		copy(clone.Created, e.Created)
+	}
+	clone.Moved = e.Moved.Clone()
+	return &clone
+}
+
+// Equal reports whether the Event is equal to the other Event.
+func (e *Event) Equal(other *Event) bool {
+	if e == nil || other == nil {
+		return e == other
+	}
+	if e.Type != other.Type {
+		return false
+	}
+	if !e.At.Equal(other.At) {
+		return false
+	}
+	if (e.Note == nil) != (other.Note == nil) {
+		return false
+	}
+	if e.Note != nil {
+		if *e.Note != *other.Note {
+			return false
+		}
+	}
+	if len(e.Created) != len(other.Created) {
+		return false
+	}
+	for index := range e.Created {
+		if e.Created[index] != other.Created[index] {
+			return false
+		}
+	}
+	if !e.Moved.Equal(other.Moved) {
+		return false
+	}
+	return true
+}
+
+type Foo struct {
+	Name string `json:"name"`
+}
+
+func (f *Foo) GetName() string {
+	if f == nil {
+		return ""
+	}
+	return f.Name
+}
+
+func (f *Foo) String() string {
+	if value, err := core.StringifyJSON(f); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", f)
+}
+
+// Clone returns a deep copy of the Foo.
+func (f *Foo) Clone() *Foo {
+	if f == nil {
+		return nil
+	}
+	clone := *f
+	return &clone
+}
+
+// Equal reports whether the Foo is equal to the other Foo.
+func (f *Foo) Equal(other *Foo) bool {
+	if f == nil || other == nil {
+		return f == other
+	}
+	if f.Name != other.Name {
+		return false
+	}
+	return true
+}
+
+type Status string
+
+const (
+	StatusActive   Status = "ACTIVE"
+	StatusInactive Status = "INACTIVE"
+)
+
+func NewStatusFromString(s string) (Status, error) {
+	switch s {
+	case "ACTIVE":
+		return StatusActive, nil
+	case "INACTIVE":
+		return StatusInactive, nil
+	}
+	var t Status
+	return "", fmt.Errorf("%s is not a valid %T", s, t)
+}
+
+func (s Status) Ptr() *Status {
+	return &s
+}
+
+type Tags = []string
+
+// CloneTags returns a deep copy of the given Tags.
+func CloneTags(t Tags) Tags {
+	var clone Tags
+	if t != nil {
+		clone = make([]string, len(t))
+		copy(clone, t)
+	}
+	return clone
+}
+
+// EqualTags reports whether the given Tags values are equal.
+func EqualTags(a, b Tags) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for index := range a {
+		if a[index] != b[index] {
+			return false
+		}
+	}
+	return true
+}
+
+type Union struct {
+	typeName         string
+	Foo              *Foo
+	Bar              *Bar
+	Baz              *Baz
+	String           string
+	IntegerOptional  *int
+	StringBooleanMap map[string]bool
+	StringList       []string
+	StringListList   [][]string
+	DoubleSet        *core.Set[float64]
+	stringLiteral    string
+}
+
+func NewUnionFromFoo(value *Foo) *Union {
+	return &Union{typeName: "foo", Foo: value}
+}
+
+func NewUnionFromBar(value *Bar) *Union {
+	return &Union{typeName: "bar", Bar: value}
+}
+
+func NewUnionFromBaz(value *Baz) *Union {
+	return &Union{typeName: "baz", Baz: value}
+}
+
+func NewUnionFromString(value string) *Union {
+	return &Union{typeName: "string", String: value}
+}
+
+func NewUnionFromIntegerOptional(value *int) *Union {
+	return &Union{typeName: "integerOptional", IntegerOptional: value}
+}
+
+func NewUnionFromStringBooleanMap(value map[string]bool) *Union {
+	return &Union{typeName: "stringBooleanMap", StringBooleanMap: value}
+}
+
+func NewUnionFromStringList(value []string) *Union {
+	return &Union{typeName: "stringList", StringList: value}
+}
+
+func NewUnionFromStringListList(value [][]string) *Union {
+	return &Union{typeName: "stringListList", StringListList: value}
+}
+
+func NewUnionFromDoubleSet(value *core.Set[float64]) *Union {
+	return &Union{typeName: "doubleSet", DoubleSet: value}
+}
+
+func NewUnionWithStringLiteral() *Union {
+	return &Union{typeName: "stringLiteral", stringLiteral: "fern"}
+}
+
+func (u *Union) StringLiteral() string {
+	return u.stringLiteral
+}
+
+func (u *Union) GetFoo() *Foo {
+	if u == nil {
+		return nil
+	}
+	return u.Foo
+}
+
+func (u *Union) GetBar() *Bar {
+	if u == nil {
+		return nil
+	}
+	return u.Bar
+}
+
+func (u *Union) GetBaz() *Baz {
+	if u == nil {
+		return nil
+	}
+	return u.Baz
+}
+
+func (u *Union) GetString() string {
+	if u == nil {
+		return ""
+	}
+	return u.String
+}
+
+func (u *Union) GetIntegerOptional() int {
+	if u == nil || u.IntegerOptional == nil {
+		return 0
+	}
+	return *u.IntegerOptional
+}
+
+func (u *Union) GetStringBooleanMap() map[string]bool {
+	if u == nil {
+		return nil
+	}
+	return u.StringBooleanMap
+}
+
+func (u *Union) GetStringList() []string {
+	if u == nil {
+		return nil
+	}
+	return u.StringList
+}
+
+func (u *Union) GetStringListList() [][]string {
+	if u == nil {
+		return nil
+	}
+	return u.StringListList
+}
+
+func (u *Union) GetDoubleSet() *core.Set[float64] {
+	if u == nil {
+		return nil
+	}
+	return u.DoubleSet
+}
+
+func (u *Union) UnmarshalJSON(data []byte) error {
+	valueFoo := new(Foo)
+	if err := json.Unmarshal(data, &valueFoo); err == nil {
+		u.typeName = "foo"
+		u.Foo = valueFoo
+		return nil
+	}
+	valueBar := new(Bar)
+	if err := json.Unmarshal(data, &valueBar); err == nil {
+		u.typeName = "bar"
+		u.Bar = valueBar
+		return nil
+	}
+	valueBaz := new(Baz)
+	if err := json.Unmarshal(data, &valueBaz); err == nil {
+		u.typeName = "baz"
+		u.Baz = valueBaz
+		return nil
+	}
+	var valueString string
+	if err := json.Unmarshal(data, &valueString); err == nil {
+		u.typeName = "string"
+		u.String = valueString
+		return nil
+	}
+	var valueIntegerOptional *int
+	if err := json.Unmarshal(data, &valueIntegerOptional); err == nil {
+		u.typeName = "integerOptional"
+		u.IntegerOptional = valueIntegerOptional
+		return nil
+	}
+	var valueStringBooleanMap map[string]bool
+	if err := json.Unmarshal(data, &valueStringBooleanMap); err == nil {
+		u.typeName = "stringBooleanMap"
+		u.StringBooleanMap = valueStringBooleanMap
+		return nil
+	}
+	var valueStringList []string
+	if err := json.Unmarshal(data, &valueStringList); err == nil {
+		u.typeName = "stringList"
+		u.StringList = valueStringList
+		return nil
+	}
+	var valueStringListList [][]string
+	if err := json.Unmarshal(data, &valueStringListList); err == nil {
+		u.typeName = "stringListList"
+		u.StringListList = valueStringListList
+		return nil
+	}
+	var valueDoubleSet *core.Set[float64]
+	if err := json.Unmarshal(data, &valueDoubleSet); err == nil {
+		u.typeName = "doubleSet"
+		u.DoubleSet = valueDoubleSet
+		return nil
+	}
+	var valueStringLiteral string
+	if err := json.Unmarshal(data, &valueStringLiteral); err == nil {
+		if valueStringLiteral == "fern" {
+			u.typeName = "stringLiteral"
+			u.stringLiteral = valueStringLiteral
+			return nil
+		}
+	}
+	return fmt.Errorf("%s cannot be deserialized as a %T", data, u)
+}
+
+func (u Union) MarshalJSON() ([]byte, error) {
+	switch u.typeName {
+	default:
+		return nil, fmt.Errorf("invalid type %s in %T", u.typeName, u)
+	case "foo":
+		return json.Marshal(u.Foo)
+	case "bar":
+		return json.Marshal(u.Bar)
+	case "baz":
+		return json.Marshal(u.Baz)
+	case "string":
+		return json.Marshal(u.String)
+	case "integerOptional":
+		return json.Marshal(u.IntegerOptional)
+	case "stringBooleanMap":
+		return json.Marshal(u.StringBooleanMap)
+	case "stringList":
+		return json.Marshal(u.StringList)
+	case "stringListList":
+		return json.Marshal(u.StringListList)
+	case "doubleSet":
+		return json.Marshal(u.DoubleSet)
+	case "stringLiteral":
+		return json.Marshal("fern")
+	}
+}
+
+type UnionVisitor interface {
+	VisitFoo(*Foo) error
+	VisitBar(*Bar) error
+	VisitBaz(*Baz) error
+	VisitString(string) error
+	VisitIntegerOptional(*int) error
+	VisitStringBooleanMap(map[string]bool) error
+	VisitStringList([]string) error
+	VisitStringListList([][]string) error
+	VisitDoubleSet(*core.Set[float64]) error
+	VisitStringLiteral(string) error
+}
+
+func (u *Union) Accept(visitor UnionVisitor) error {
+	switch u.typeName {
+	default:
+		return fmt.Errorf("invalid type %s in %T", u.typeName, u)
+	case "foo":
+		return visitor.VisitFoo(u.Foo)
+	case "bar":
+		return visitor.VisitBar(u.Bar)
+	case "baz":
+		return visitor.VisitBaz(u.Baz)
+	case "string":
+		return visitor.VisitString(u.String)
+	case "integerOptional":
+		return visitor.VisitIntegerOptional(u.IntegerOptional)
+	case "stringBooleanMap":
+		return visitor.VisitStringBooleanMap(u.StringBooleanMap)
+	case "stringList":
+		return visitor.VisitStringList(u.StringList)
+	case "stringListList":
+		return visitor.VisitStringListList(u.StringListList)
+	case "doubleSet":
+		return visitor.VisitDoubleSet(u.DoubleSet)
+	case "stringLiteral":
+		return visitor.VisitStringLiteral(u.stringLiteral)
+	}
+}
+
+// Clone returns a deep copy of the Union.
+func (u *Union) Clone() *Union {
+	if u == nil {
+		return nil
+	}
+	clone := *u
+	clone.Foo = u.Foo.Clone()
+	clone.Bar = u.Bar.Clone()
+	clone.Baz = u.Baz.Clone()
+	if u.IntegerOptional != nil {
+		value := *u.IntegerOptional
+		clone.IntegerOptional = &value
+	}
+	if u.StringBooleanMap != nil {
+		clone.StringBooleanMap = make(map[string]bool, len(u.StringBooleanMap))
+		for key, value := range u.StringBooleanMap {
+			clone.StringBooleanMap[key] = value
+		}
+	}
+	if u.StringList != nil {
+		clone.StringList = make([]string, len(u.StringList))
+		copy(clone.StringList, u.StringList)
+	}
+	if u.StringListList != nil {
+		clone.StringListList = make([][]string, len(u.StringListList))
+		for index, value := range u.StringListList {
+			if value != nil {
+				clone.StringListList[index] = make([]string, len(value))
+				copy(clone.StringListList[index], value)
+			}
+		}
+	}
+	clone.DoubleSet = u.DoubleSet.Clone()
+	return &clone
+}
+
+// Equal reports whether the Union is equal to the other Union.
+func (u *Union) Equal(other *Union) bool {
+	if u == nil || other == nil {
+		return u == other
+	}
+	if u.typeName != other.typeName {
+		return false
+	}
+	if !u.Foo.Equal(other.Foo) {
+		return false
+	}
+	if !u.Bar.Equal(other.Bar) {
+		return false
+	}
+	if !u.Baz.Equal(other.Baz) {
+		return false
+	}
+	if u.String != other.String {
+		return false
+	}
+	if (u.IntegerOptional == nil) != (other.IntegerOptional == nil) {
+		return false
+	}
+	if u.IntegerOptional != nil {
+		if *u.IntegerOptional != *other.IntegerOptional {
+			return false
+		}
+	}
+	if len(u.StringBooleanMap) != len(other.StringBooleanMap) {
+		return false
+	}
+	for key, value := range u.StringBooleanMap {
+		otherValue, ok := other.StringBooleanMap[key]
+		if !ok {
+			return false
+		}
+		if value != otherValue {
+			return false
+		}
+	}
+	if len(u.StringList) != len(other.StringList) {
+		return false
+	}
+	for index := range u.StringList {
+		if u.StringList[index] != other.StringList[index] {
+			return false
+		}
+	}
+	if len(u.StringListList) != len(other.StringListList) {
+		return false
+	}
+	for index := range u.StringListList {
+		if len(u.StringListList[index]) != len(other.StringListList[index]) {
+			return false
+		}
+		for index1 := range u.StringListList[index] {
+			if u.StringListList[index][index1] != other.StringListList[index][index1] {
+				return false
+			}
+		}
+	}
+	if !u.DoubleSet.Equal(other.DoubleSet) {
+		return false
+	}
+	if u.stringLiteral != other.stringLiteral {
+		return false
+	}
+	return true
+}
+
+type UnionWithLiteral struct {
+	typeName      string
+	stringLiteral string
+	String        string
+}
+
+func NewUnionWithLiteralWithStringLiteral() *UnionWithLiteral {
+	return &UnionWithLiteral{typeName: "stringLiteral", stringLiteral: "fern"}
+}
+
+func NewUnionWithLiteralFromString(value string) *UnionWithLiteral {
+	return &UnionWithLiteral{typeName: "string", String: value}
+}
+
+func (u *UnionWithLiteral) StringLiteral() string {
+	return u.stringLiteral
+}
+
+func (u *UnionWithLiteral) GetString() string {
+	if u == nil {
+		return ""
+	}
+	return u.String
+}
+
+func (u *UnionWithLiteral) UnmarshalJSON(data []byte) error {
+	var valueStringLiteral string
+	if err := json.Unmarshal(data, &valueStringLiteral); err == nil {
+		if valueStringLiteral == "fern" {
+			u.typeName = "stringLiteral"
+			u.stringLiteral = valueStringLiteral
+			return nil
+		}
+	}
+	var valueString string
+	if err := json.Unmarshal(data, &valueString); err == nil {
+		u.typeName = "string"
+		u.String = valueString
+		return nil
+	}
+	return fmt.Errorf("%s cannot be deserialized as a %T", data, u)
+}
+
+func (u UnionWithLiteral) MarshalJSON() ([]byte, error) {
+	switch u.typeName {
+	default:
+		return nil, fmt.Errorf("invalid type %s in %T", u.typeName, u)
+	case "stringLiteral":
+		return json.Marshal("fern")
+	case "string":
+		return json.Marshal(u.String)
+	}
+}
+
+type UnionWithLiteralVisitor interface {
+	VisitStringLiteral(string) error
+	VisitString(string) error
+}
+
+func (u *UnionWithLiteral) Accept(visitor UnionWithLiteralVisitor) error {
+	switch u.typeName {
+	default:
+		return fmt.Errorf("invalid type %s in %T", u.typeName, u)
+	case "stringLiteral":
+		return visitor.VisitStringLiteral(u.stringLiteral)
+	case "string":
+		return visitor.VisitString(u.String)
+	}
+}
+
+// Clone returns a deep copy of the UnionWithLiteral.
+func (u *UnionWithLiteral) Clone() *UnionWithLiteral {
+	if u == nil {
+		return nil
+	}
+	clone := *u
+	return &clone
+}
+
+// Equal reports whether the UnionWithLiteral is equal to the other UnionWithLiteral.
+func (u *UnionWithLiteral) Equal(other *UnionWithLiteral) bool {
+	if u == nil || other == nil {
+		return u == other
+	}
+	if u.typeName != other.typeName {
+		return false
+	}
+	if u.stringLiteral != other.stringLiteral {
+		return false
+	}
+	if u.String != other.String {
+		return false
+	}
+	return true
+}
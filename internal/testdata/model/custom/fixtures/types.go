@@ -13,6 +13,13 @@ type Bar struct {
 	Foo *Foo `json:"foo,omitempty"`
 }
 
+func (b *Bar) GetFoo() *Foo {
+	if b == nil {
+		return nil
+	}
+	return b.Foo
+}
+
 func (b *Bar) String() string {
 	if value, err := core.StringifyJSON(b); err == nil {
 		return value
@@ -26,6 +33,20 @@ type Foo struct {
 	Name string    `json:"name"`
 }
 
+func (f *Foo) GetId() uuid.UUID {
+	if f == nil {
+		return uuid.Nil
+	}
+	return f.Id
+}
+
+func (f *Foo) GetName() string {
+	if f == nil {
+		return ""
+	}
+	return f.Name
+}
+
 func (f *Foo) String() string {
 	if value, err := core.StringifyJSON(f); err == nil {
 		return value
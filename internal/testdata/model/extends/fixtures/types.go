@@ -12,6 +12,13 @@ type Docs struct {
 	Docs string `json:"docs"`
 }
 
+func (d *Docs) GetDocs() string {
+	if d == nil {
+		return ""
+	}
+	return d.Docs
+}
+
 func (d *Docs) String() string {
 	if value, err := core.StringifyJSON(d); err == nil {
 		return value
@@ -24,6 +31,20 @@ type ExampleType struct {
 	Name string `json:"name"`
 }
 
+func (e *ExampleType) GetDocs() string {
+	if e == nil {
+		return ""
+	}
+	return e.Docs
+}
+
+func (e *ExampleType) GetName() string {
+	if e == nil {
+		return ""
+	}
+	return e.Name
+}
+
 func (e *ExampleType) String() string {
 	if value, err := core.StringifyJSON(e); err == nil {
 		return value
@@ -36,6 +57,20 @@ type Json struct {
 	Raw  string `json:"raw"`
 }
 
+func (j *Json) GetDocs() string {
+	if j == nil {
+		return ""
+	}
+	return j.Docs
+}
+
+func (j *Json) GetRaw() string {
+	if j == nil {
+		return ""
+	}
+	return j.Raw
+}
+
 func (j *Json) String() string {
 	if value, err := core.StringifyJSON(j); err == nil {
 		return value
@@ -49,6 +84,27 @@ type NestedType struct {
 	Name string `json:"name"`
 }
 
+func (n *NestedType) GetDocs() string {
+	if n == nil {
+		return ""
+	}
+	return n.Docs
+}
+
+func (n *NestedType) GetRaw() string {
+	if n == nil {
+		return ""
+	}
+	return n.Raw
+}
+
+func (n *NestedType) GetName() string {
+	if n == nil {
+		return ""
+	}
+	return n.Name
+}
+
 func (n *NestedType) String() string {
 	if value, err := core.StringifyJSON(n); err == nil {
 		return value
@@ -67,6 +123,34 @@ func NewNestedUnionFromOne(value *ExampleType) *NestedUnion {
 	return &NestedUnion{Type: "one", One: value}
 }
 
+func (n *NestedUnion) GetType() string {
+	if n == nil {
+		return ""
+	}
+	return n.Type
+}
+
+func (n *NestedUnion) GetDocs() string {
+	if n == nil {
+		return ""
+	}
+	return n.Docs
+}
+
+func (n *NestedUnion) GetRaw() string {
+	if n == nil {
+		return ""
+	}
+	return n.Raw
+}
+
+func (n *NestedUnion) GetOne() *ExampleType {
+	if n == nil {
+		return nil
+	}
+	return n.One
+}
+
 func (n *NestedUnion) UnmarshalJSON(data []byte) error {
 	var unmarshaler struct {
 		Type string `json:"type"`
@@ -133,6 +217,27 @@ func NewUnionFromOne(value *ExampleType) *Union {
 	return &Union{Type: "one", One: value}
 }
 
+func (u *Union) GetType() string {
+	if u == nil {
+		return ""
+	}
+	return u.Type
+}
+
+func (u *Union) GetDocs() string {
+	if u == nil {
+		return ""
+	}
+	return u.Docs
+}
+
+func (u *Union) GetOne() *ExampleType {
+	if u == nil {
+		return nil
+	}
+	return u.One
+}
+
 func (u *Union) UnmarshalJSON(data []byte) error {
 	var unmarshaler struct {
 		Type string `json:"type"`
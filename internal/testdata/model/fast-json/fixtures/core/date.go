@@ -0,0 +1,86 @@
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// dateLayout is the RFC 3339 full-date layout used to represent dates on the wire.
+const dateLayout = "2006-01-02"
+
+// Date represents a calendar date (e.g. 2006-01-02) without a time or location.
+//
+// Dates are [de]serialized in the YYYY-MM-DD format wherever they appear, so
+// they should be used in favor of time.Time for date-only values.
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// NewDate returns the Date that the given time.Time falls on,
+// in the time's location.
+func NewDate(t time.Time) Date {
+	year, month, day := t.Date()
+	return Date{
+		Year:  year,
+		Month: month,
+		Day:   day,
+	}
+}
+
+// ParseDate parses the given string in the YYYY-MM-DD format.
+func ParseDate(s string) (Date, error) {
+	t, err := time.Parse(dateLayout, s)
+	if err != nil {
+		return Date{}, fmt.Errorf("invalid date %q: %w", s, err)
+	}
+	return NewDate(t), nil
+}
+
+// Time returns the time.Time at midnight UTC on the given date.
+func (d Date) Time() time.Time {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, time.UTC)
+}
+
+// IsZero reports whether the date is the zero value.
+func (d Date) IsZero() bool {
+	return d.Year == 0 && d.Month == 0 && d.Day == 0
+}
+
+func (d Date) Ptr() *Date {
+	return &d
+}
+
+func (d Date) String() string {
+	return fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day)
+}
+
+func (d Date) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *Date) UnmarshalText(data []byte) error {
+	date, err := ParseDate(string(data))
+	if err != nil {
+		return err
+	}
+	*d = date
+	return nil
+}
+
+func (d Date) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.String())
+}
+
+func (d *Date) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	return d.UnmarshalText([]byte(s))
+}
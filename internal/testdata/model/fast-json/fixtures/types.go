@@ -0,0 +1,2115 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package api
+
+import (
+	json "encoding/json"
+	fmt "fmt"
+	core "github.com/fern-api/fern-go/internal/testdata/model/fast-json/fixtures/core"
+	uuid "github.com/google/uuid"
+	sort "sort"
+	strings "strings"
+	time "time"
+)
+
+type Bar struct {
+	Name string `json:"name"`
+}
+
+func (b *Bar) GetName() string {
+	if b == nil {
+		return ""
+	}
+	return b.Name
+}
+
+func (b *Bar) UnmarshalJSON(data []byte) error {
+	reader := core.NewJSONReader(data)
+	b.UnmarshalJSONFrom(reader)
+	return reader.Done()
+}
+
+func (b *Bar) UnmarshalJSONFrom(reader *core.JSONReader) {
+	if reader.IsNull() {
+		return
+	}
+	*b = Bar{}
+	reader.ObjectStart()
+	for reader.More() {
+		key := reader.KeyBytes()
+		switch string(key) {
+		case "name":
+			b.Name = reader.String()
+		default:
+			switch {
+			case strings.EqualFold(string(key), "name"):
+				b.Name = reader.String()
+			default:
+				reader.Skip()
+			}
+		}
+	}
+	reader.ObjectEnd()
+}
+
+func (b *Bar) MarshalJSON() ([]byte, error) {
+	writer := core.NewJSONWriter()
+	b.MarshalJSONTo(writer)
+	return writer.Bytes()
+}
+
+func (b *Bar) MarshalJSONTo(writer *core.JSONWriter) {
+	if b == nil {
+		writer.Null()
+		return
+	}
+	writer.ObjectStart()
+	writer.Key("name")
+	writer.String(b.Name)
+	writer.ObjectEnd()
+}
+
+func (b *Bar) String() string {
+	if value, err := core.StringifyJSON(b); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", b)
+}
+
+type Baz struct {
+	extended string
+}
+
+func (b *Baz) Extended() string {
+	return b.extended
+}
+
+func (b *Baz) UnmarshalJSON(data []byte) error {
+	reader := core.NewJSONReader(data)
+	b.UnmarshalJSONFrom(reader)
+	return reader.Done()
+}
+
+func (b *Baz) UnmarshalJSONFrom(reader *core.JSONReader) {
+	if reader.IsNull() {
+		return
+	}
+	*b = Baz{}
+	reader.ObjectStart()
+	for reader.More() {
+		reader.KeyBytes()
+		reader.Skip()
+	}
+	reader.ObjectEnd()
+	b.extended = "extended"
+}
+
+func (b *Baz) MarshalJSON() ([]byte, error) {
+	writer := core.NewJSONWriter()
+	b.MarshalJSONTo(writer)
+	return writer.Bytes()
+}
+
+func (b *Baz) MarshalJSONTo(writer *core.JSONWriter) {
+	if b == nil {
+		writer.Null()
+		return
+	}
+	writer.ObjectStart()
+	writer.Key("extended")
+	writer.String("extended")
+	writer.ObjectEnd()
+}
+
+func (b *Baz) String() string {
+	if value, err := core.StringifyJSON(b); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", b)
+}
+
+type Enum string
+
+const (
+	// The first enum value.
+	EnumOne   Enum = "ONE"
+	EnumTwo   Enum = "TWO"
+	EnumThree Enum = "THREE"
+)
+
+func NewEnumFromString(s string) (Enum, error) {
+	switch s {
+	case "ONE":
+		return EnumOne, nil
+	case "TWO":
+		return EnumTwo, nil
+	case "THREE":
+		return EnumThree, nil
+	}
+	var t Enum
+	return "", fmt.Errorf("%s is not a valid %T", s, t)
+}
+
+func (e Enum) Ptr() *Enum {
+	return &e
+}
+
+func (e Enum) MarshalJSON() ([]byte, error) {
+	writer := core.NewJSONWriter()
+	writer.String(string(e))
+	return writer.Bytes()
+}
+
+func (e *Enum) UnmarshalJSON(data []byte) error {
+	reader := core.NewJSONReader(data)
+	if !reader.IsNull() {
+		*e = Enum(reader.String())
+	}
+	return reader.Done()
+}
+
+type Foo struct {
+	Name string `json:"name"`
+}
+
+func (f *Foo) GetName() string {
+	if f == nil {
+		return ""
+	}
+	return f.Name
+}
+
+func (f *Foo) UnmarshalJSON(data []byte) error {
+	reader := core.NewJSONReader(data)
+	f.UnmarshalJSONFrom(reader)
+	return reader.Done()
+}
+
+func (f *Foo) UnmarshalJSONFrom(reader *core.JSONReader) {
+	if reader.IsNull() {
+		return
+	}
+	*f = Foo{}
+	reader.ObjectStart()
+	for reader.More() {
+		key := reader.KeyBytes()
+		switch string(key) {
+		case "name":
+			f.Name = reader.String()
+		default:
+			switch {
+			case strings.EqualFold(string(key), "name"):
+				f.Name = reader.String()
+			default:
+				reader.Skip()
+			}
+		}
+	}
+	reader.ObjectEnd()
+}
+
+func (f *Foo) MarshalJSON() ([]byte, error) {
+	writer := core.NewJSONWriter()
+	f.MarshalJSONTo(writer)
+	return writer.Bytes()
+}
+
+func (f *Foo) MarshalJSONTo(writer *core.JSONWriter) {
+	if f == nil {
+		writer.Null()
+		return
+	}
+	writer.ObjectStart()
+	writer.Key("name")
+	writer.String(f.Name)
+	writer.ObjectEnd()
+}
+
+func (f *Foo) String() string {
+	if value, err := core.StringifyJSON(f); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", f)
+}
+
+type Movie struct {
+	Title        string                   `json:"title"`
+	Rating       float64                  `json:"rating"`
+	Enum         Enum                     `json:"enum,omitempty"`
+	OptionalEnum *Enum                    `json:"optionalEnum,omitempty"`
+	Foo          *Foo                     `json:"foo,omitempty"`
+	OptionalFoo  *Foo                     `json:"optionalFoo,omitempty"`
+	Union        *Union                   `json:"union,omitempty"`
+	Unions       []*UnionWithDiscriminant `json:"unions,omitempty"`
+	Bars         map[string]*Bar          `json:"bars,omitempty"`
+	Counts       map[Enum]int             `json:"counts,omitempty"`
+	Tags         []string                 `json:"tags,omitempty"`
+	Metadata     map[string]interface{}   `json:"metadata,omitempty"`
+}
+
+func (m *Movie) GetTitle() string {
+	if m == nil {
+		return ""
+	}
+	return m.Title
+}
+
+func (m *Movie) GetRating() float64 {
+	if m == nil {
+		return 0
+	}
+	return m.Rating
+}
+
+func (m *Movie) GetEnum() Enum {
+	if m == nil {
+		return ""
+	}
+	return m.Enum
+}
+
+func (m *Movie) GetOptionalEnum() Enum {
+	if m == nil || m.OptionalEnum == nil {
+		return ""
+	}
+	return *m.OptionalEnum
+}
+
+func (m *Movie) GetFoo() *Foo {
+	if m == nil {
+		return nil
+	}
+	return m.Foo
+}
+
+func (m *Movie) GetOptionalFoo() *Foo {
+	if m == nil {
+		return nil
+	}
+	return m.OptionalFoo
+}
+
+func (m *Movie) GetUnion() *Union {
+	if m == nil {
+		return nil
+	}
+	return m.Union
+}
+
+func (m *Movie) GetUnions() []*UnionWithDiscriminant {
+	if m == nil {
+		return nil
+	}
+	return m.Unions
+}
+
+func (m *Movie) GetBars() map[string]*Bar {
+	if m == nil {
+		return nil
+	}
+	return m.Bars
+}
+
+func (m *Movie) GetCounts() map[Enum]int {
+	if m == nil {
+		return nil
+	}
+	return m.Counts
+}
+
+func (m *Movie) GetTags() []string {
+	if m == nil {
+		return nil
+	}
+	return m.Tags
+}
+
+func (m *Movie) GetMetadata() map[string]interface{} {
+	if m == nil {
+		return nil
+	}
+	return m.Metadata
+}
+
+func (m *Movie) UnmarshalJSON(data []byte) error {
+	reader := core.NewJSONReader(data)
+	m.UnmarshalJSONFrom(reader)
+	return reader.Done()
+}
+
+func (m *Movie) UnmarshalJSONFrom(reader *core.JSONReader) {
+	if reader.IsNull() {
+		return
+	}
+	*m = Movie{}
+	reader.ObjectStart()
+	for reader.More() {
+		key := reader.KeyBytes()
+		switch string(key) {
+		case "title":
+			m.Title = reader.String()
+		case "rating":
+			m.Rating = reader.Float64()
+		case "enum":
+			m.Enum = Enum(reader.String())
+		case "optionalEnum":
+			if reader.IsNull() {
+				m.OptionalEnum = nil
+			} else {
+				m.OptionalEnum = new(Enum)
+				*m.OptionalEnum = Enum(reader.String())
+			}
+		case "foo":
+			if reader.IsNull() {
+				m.Foo = nil
+			} else {
+				m.Foo = new(Foo)
+				m.Foo.UnmarshalJSONFrom(reader)
+			}
+		case "optionalFoo":
+			if reader.IsNull() {
+				m.OptionalFoo = nil
+			} else {
+				m.OptionalFoo = new(Foo)
+				m.OptionalFoo.UnmarshalJSONFrom(reader)
+			}
+		case "union":
+			if reader.IsNull() {
+				m.Union = nil
+			} else {
+				m.Union = new(Union)
+				m.Union.UnmarshalJSONFrom(reader)
+			}
+		case "unions":
+			if reader.IsNull() {
+				m.Unions = nil
+			} else {
+				m.Unions = make([]*UnionWithDiscriminant, 0)
+				reader.ArrayStart()
+				for reader.More() {
+					var elem0 *UnionWithDiscriminant
+					if reader.IsNull() {
+						elem0 = nil
+					} else {
+						elem0 = new(UnionWithDiscriminant)
+						elem0.UnmarshalJSONFrom(reader)
+					}
+					m.Unions = append(m.Unions, elem0)
+				}
+				reader.ArrayEnd()
+			}
+		case "bars":
+			if reader.IsNull() {
+				m.Bars = nil
+			} else {
+				m.Bars = make(map[string]*Bar)
+				reader.ObjectStart()
+				for reader.More() {
+					key0 := reader.Key()
+					var value0 *Bar
+					if reader.IsNull() {
+						value0 = nil
+					} else {
+						value0 = new(Bar)
+						value0.UnmarshalJSONFrom(reader)
+					}
+					m.Bars[key0] = value0
+				}
+				reader.ObjectEnd()
+			}
+		case "counts":
+			if reader.IsNull() {
+				m.Counts = nil
+			} else {
+				m.Counts = make(map[Enum]int)
+				reader.ObjectStart()
+				for reader.More() {
+					key0 := Enum(reader.Key())
+					var value0 int
+					value0 = reader.Int()
+					m.Counts[key0] = value0
+				}
+				reader.ObjectEnd()
+			}
+		case "tags":
+			if reader.IsNull() {
+				m.Tags = nil
+			} else {
+				m.Tags = make([]string, 0)
+				reader.ArrayStart()
+				for reader.More() {
+					var elem0 string
+					elem0 = reader.String()
+					m.Tags = append(m.Tags, elem0)
+				}
+				reader.ArrayEnd()
+			}
+		case "metadata":
+			if reader.IsNull() {
+				m.Metadata = nil
+			} else {
+				m.Metadata = make(map[string]interface{})
+				reader.ObjectStart()
+				for reader.More() {
+					key0 := reader.Key()
+					var value0 interface{}
+					reader.Value(&value0)
+					m.Metadata[key0] = value0
+				}
+				reader.ObjectEnd()
+			}
+		default:
+			switch {
+			case strings.EqualFold(string(key), "title"):
+				m.Title = reader.String()
+			case strings.EqualFold(string(key), "rating"):
+				m.Rating = reader.Float64()
+			case strings.EqualFold(string(key), "enum"):
+				m.Enum = Enum(reader.String())
+			case strings.EqualFold(string(key), "optionalEnum"):
+				if reader.IsNull() {
+					m.OptionalEnum = nil
+				} else {
+					m.OptionalEnum = new(Enum)
+					*m.OptionalEnum = Enum(reader.String())
+				}
+			case strings.EqualFold(string(key), "foo"):
+				if reader.IsNull() {
+					m.Foo = nil
+				} else {
+					m.Foo = new(Foo)
+					m.Foo.UnmarshalJSONFrom(reader)
+				}
+			case strings.EqualFold(string(key), "optionalFoo"):
+				if reader.IsNull() {
+					m.OptionalFoo = nil
+				} else {
+					m.OptionalFoo = new(Foo)
+					m.OptionalFoo.UnmarshalJSONFrom(reader)
+				}
+			case strings.EqualFold(string(key), "union"):
+				if reader.IsNull() {
+					m.Union = nil
+				} else {
+					m.Union = new(Union)
+					m.Union.UnmarshalJSONFrom(reader)
+				}
+			case strings.EqualFold(string(key), "unions"):
+				if reader.IsNull() {
+					m.Unions = nil
+				} else {
+					m.Unions = make([]*UnionWithDiscriminant, 0)
+					reader.ArrayStart()
+					for reader.More() {
+						var elem0 *UnionWithDiscriminant
+						if reader.IsNull() {
+							elem0 = nil
+						} else {
+							elem0 = new(UnionWithDiscriminant)
+							elem0.UnmarshalJSONFrom(reader)
+						}
+						m.Unions = append(m.Unions, elem0)
+					}
+					reader.ArrayEnd()
+				}
+			case strings.EqualFold(string(key), "bars"):
+				if reader.IsNull() {
+					m.Bars = nil
+				} else {
+					m.Bars = make(map[string]*Bar)
+					reader.ObjectStart()
+					for reader.More() {
+						key0 := reader.Key()
+						var value0 *Bar
+						if reader.IsNull() {
+							value0 = nil
+						} else {
+							value0 = new(Bar)
+							value0.UnmarshalJSONFrom(reader)
+						}
+						m.Bars[key0] = value0
+					}
+					reader.ObjectEnd()
+				}
+			case strings.EqualFold(string(key), "counts"):
+				if reader.IsNull() {
+					m.Counts = nil
+				} else {
+					m.Counts = make(map[Enum]int)
+					reader.ObjectStart()
+					for reader.More() {
+						key0 := Enum(reader.Key())
+						var value0 int
+						value0 = reader.Int()
+						m.Counts[key0] = value0
+					}
+					reader.ObjectEnd()
+				}
+			case strings.EqualFold(string(key), "tags"):
+				if reader.IsNull() {
+					m.Tags = nil
+				} else {
+					m.Tags = make([]string, 0)
+					reader.ArrayStart()
+					for reader.More() {
+						var elem0 string
+						elem0 = reader.String()
+						m.Tags = append(m.Tags, elem0)
+					}
+					reader.ArrayEnd()
+				}
+			case strings.EqualFold(string(key), "metadata"):
+				if reader.IsNull() {
+					m.Metadata = nil
+				} else {
+					m.Metadata = make(map[string]interface{})
+					reader.ObjectStart()
+					for reader.More() {
+						key0 := reader.Key()
+						var value0 interface{}
+						reader.Value(&value0)
+						m.Metadata[key0] = value0
+					}
+					reader.ObjectEnd()
+				}
+			default:
+				reader.Skip()
+			}
+		}
+	}
+	reader.ObjectEnd()
+}
+
+func (m *Movie) MarshalJSON() ([]byte, error) {
+	writer := core.NewJSONWriter()
+	m.MarshalJSONTo(writer)
+	return writer.Bytes()
+}
+
+func (m *Movie) MarshalJSONTo(writer *core.JSONWriter) {
+	if m == nil {
+		writer.Null()
+		return
+	}
+	writer.ObjectStart()
+	writer.Key("title")
+	writer.String(m.Title)
+	writer.Key("rating")
+	writer.Float64(m.Rating)
+	if m.Enum != "" {
+		writer.Key("enum")
+		writer.String(string(m.Enum))
+	}
+	if m.OptionalEnum != nil {
+		writer.Key("optionalEnum")
+		writer.String(string(*m.OptionalEnum))
+	}
+	if m.Foo != nil {
+		writer.Key("foo")
+		m.Foo.MarshalJSONTo(writer)
+	}
+	if m.OptionalFoo != nil {
+		writer.Key("optionalFoo")
+		m.OptionalFoo.MarshalJSONTo(writer)
+	}
+	if m.Union != nil {
+		writer.Key("union")
+		m.Union.MarshalJSONTo(writer)
+	}
+	if len(m.Unions) > 0 {
+		writer.Key("unions")
+		writer.ArrayStart()
+		for _, elem0 := range m.Unions {
+			if elem0 == nil {
+				writer.Null()
+			} else {
+				elem0.MarshalJSONTo(writer)
+			}
+		}
+		writer.ArrayEnd()
+	}
+	if len(m.Bars) > 0 {
+		writer.Key("bars")
+		keys0 := make([]string, 0, len(m.Bars))
+		for key0 := range m.Bars {
+			keys0 = append(keys0, key0)
+		}
+		sort.Strings(keys0)
+		writer.ObjectStart()
+		for _, key0 := range keys0 {
+			writer.Key(key0)
+			if m.Bars[key0] == nil {
+				writer.Null()
+			} else {
+				m.Bars[key0].MarshalJSONTo(writer)
+			}
+		}
+		writer.ObjectEnd()
+	}
+	if len(m.Counts) > 0 {
+		writer.Key("counts")
+		keys0 := make([]string, 0, len(m.Counts))
+		for key0 := range m.Counts {
+			keys0 = append(keys0, string(key0))
+		}
+		sort.Strings(keys0)
+		writer.ObjectStart()
+		for _, key0 := range keys0 {
+			writer.Key(key0)
+			writer.Int(m.Counts[Enum(key0)])
+		}
+		writer.ObjectEnd()
+	}
+	if len(m.Tags) > 0 {
+		writer.Key("tags")
+		writer.ArrayStart()
+		for _, elem0 := range m.Tags {
+			writer.String(elem0)
+		}
+		writer.ArrayEnd()
+	}
+	if len(m.Metadata) > 0 {
+		writer.Key("metadata")
+		keys0 := make([]string, 0, len(m.Metadata))
+		for key0 := range m.Metadata {
+			keys0 = append(keys0, key0)
+		}
+		sort.Strings(keys0)
+		writer.ObjectStart()
+		for _, key0 := range keys0 {
+			writer.Key(key0)
+			writer.Value(m.Metadata[key0])
+		}
+		writer.ObjectEnd()
+	}
+	writer.ObjectEnd()
+}
+
+func (m *Movie) String() string {
+	if value, err := core.StringifyJSON(m); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", m)
+}
+
+type Something string
+
+const (
+	Somethingone Something = "one"
+	SomethingOne Something = "One"
+	SomethingONe Something = "ONe"
+	SomethingONE Something = "ONE"
+)
+
+func NewSomethingFromString(s string) (Something, error) {
+	switch s {
+	case "one":
+		return Somethingone, nil
+	case "One":
+		return SomethingOne, nil
+	case "ONe":
+		return SomethingONe, nil
+	case "ONE":
+		return SomethingONE, nil
+	}
+	var t Something
+	return "", fmt.Errorf("%s is not a valid %T", s, t)
+}
+
+func (s Something) Ptr() *Something {
+	return &s
+}
+
+func (s Something) MarshalJSON() ([]byte, error) {
+	writer := core.NewJSONWriter()
+	writer.String(string(s))
+	return writer.Bytes()
+}
+
+func (s *Something) UnmarshalJSON(data []byte) error {
+	reader := core.NewJSONReader(data)
+	if !reader.IsNull() {
+		*s = Something(reader.String())
+	}
+	return reader.Done()
+}
+
+type Type struct {
+	One       int              `json:"one"`
+	Two       float64          `json:"two"`
+	Three     string           `json:"three"`
+	Four      bool             `json:"four"`
+	Five      int64            `json:"five"`
+	Six       time.Time        `json:"six"`
+	Seven     core.Date        `json:"seven"`
+	Eight     uuid.UUID        `json:"eight"`
+	Nine      []byte           `json:"nine"`
+	Ten       []int            `json:"ten,omitempty"`
+	Eleven    []float64        `json:"eleven,omitempty"`
+	Twelve    map[string]bool  `json:"twelve,omitempty"`
+	Thirteen  *int64           `json:"thirteen,omitempty"`
+	Fourteen  interface{}      `json:"fourteen,omitempty"`
+	Fifteen   [][]int          `json:"fifteen,omitempty"`
+	Sixteen   []map[string]int `json:"sixteen,omitempty"`
+	Seventeen []*uuid.UUID     `json:"seventeen,omitempty"`
+	eighteen  string
+}
+
+func (t *Type) Eighteen() string {
+	return t.eighteen
+}
+
+func (t *Type) GetOne() int {
+	if t == nil {
+		return 0
+	}
+	return t.One
+}
+
+func (t *Type) GetTwo() float64 {
+	if t == nil {
+		return 0
+	}
+	return t.Two
+}
+
+func (t *Type) GetThree() string {
+	if t == nil {
+		return ""
+	}
+	return t.Three
+}
+
+func (t *Type) GetFour() bool {
+	if t == nil {
+		return false
+	}
+	return t.Four
+}
+
+func (t *Type) GetFive() int64 {
+	if t == nil {
+		return 0
+	}
+	return t.Five
+}
+
+func (t *Type) GetSix() time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return t.Six
+}
+
+func (t *Type) GetSeven() core.Date {
+	if t == nil {
+		return core.Date{}
+	}
+	return t.Seven
+}
+
+func (t *Type) GetEight() uuid.UUID {
+	if t == nil {
+		return uuid.Nil
+	}
+	return t.Eight
+}
+
+func (t *Type) GetNine() []byte {
+	if t == nil {
+		return nil
+	}
+	return t.Nine
+}
+
+func (t *Type) GetTen() []int {
+	if t == nil {
+		return nil
+	}
+	return t.Ten
+}
+
+func (t *Type) GetEleven() []float64 {
+	if t == nil {
+		return nil
+	}
+	return t.Eleven
+}
+
+func (t *Type) GetTwelve() map[string]bool {
+	if t == nil {
+		return nil
+	}
+	return t.Twelve
+}
+
+func (t *Type) GetThirteen() int64 {
+	if t == nil || t.Thirteen == nil {
+		return 0
+	}
+	return *t.Thirteen
+}
+
+func (t *Type) GetFourteen() interface{} {
+	if t == nil {
+		return nil
+	}
+	return t.Fourteen
+}
+
+func (t *Type) GetFifteen() [][]int {
+	if t == nil {
+		return nil
+	}
+	return t.Fifteen
+}
+
+func (t *Type) GetSixteen() []map[string]int {
+	if t == nil {
+		return nil
+	}
+	return t.Sixteen
+}
+
+func (t *Type) GetSeventeen() []*uuid.UUID {
+	if t == nil {
+		return nil
+	}
+	return t.Seventeen
+}
+
+func (t *Type) UnmarshalJSON(data []byte) error {
+	reader := core.NewJSONReader(data)
+	t.UnmarshalJSONFrom(reader)
+	return reader.Done()
+}
+
+func (t *Type) UnmarshalJSONFrom(reader *core.JSONReader) {
+	if reader.IsNull() {
+		return
+	}
+	*t = Type{}
+	reader.ObjectStart()
+	for reader.More() {
+		key := reader.KeyBytes()
+		switch string(key) {
+		case "one":
+			t.One = reader.Int()
+		case "two":
+			t.Two = reader.Float64()
+		case "three":
+			t.Three = reader.String()
+		case "four":
+			t.Four = reader.Bool()
+		case "five":
+			t.Five = reader.Int64()
+		case "six":
+			reader.Unmarshaler(&t.Six)
+		case "seven":
+			reader.Unmarshaler(&t.Seven)
+		case "eight":
+			reader.TextUnmarshaler(&t.Eight)
+		case "nine":
+			t.Nine = reader.Base64()
+		case "ten":
+			if reader.IsNull() {
+				t.Ten = nil
+			} else {
+				t.Ten = make([]int, 0)
+				reader.ArrayStart()
+				for reader.More() {
+					var elem0 int
+					elem0 = reader.Int()
+					t.Ten = append(t.Ten, elem0)
+				}
+				reader.ArrayEnd()
+			}
+		case "eleven":
+			if reader.IsNull() {
+				t.Eleven = nil
+			} else {
+				t.Eleven = make([]float64, 0)
+				reader.ArrayStart()
+				for reader.More() {
+					var elem0 float64
+					elem0 = reader.Float64()
+					t.Eleven = append(t.Eleven, elem0)
+				}
+				reader.ArrayEnd()
+			}
+		case "twelve":
+			if reader.IsNull() {
+				t.Twelve = nil
+			} else {
+				t.Twelve = make(map[string]bool)
+				reader.ObjectStart()
+				for reader.More() {
+					key0 := reader.Key()
+					var value0 bool
+					value0 = reader.Bool()
+					t.Twelve[key0] = value0
+				}
+				reader.ObjectEnd()
+			}
+		case "thirteen":
+			if reader.IsNull() {
+				t.Thirteen = nil
+			} else {
+				t.Thirteen = new(int64)
+				*t.Thirteen = reader.Int64()
+			}
+		case "fourteen":
+			reader.Value(&t.Fourteen)
+		case "fifteen":
+			if reader.IsNull() {
+				t.Fifteen = nil
+			} else {
+				t.Fifteen = make([][]int, 0)
+				reader.ArrayStart()
+				for reader.More() {
+					var elem0 []int
+					if reader.IsNull() {
+						elem0 = nil
+					} else {
+						elem0 = make([]int, 0)
+						reader.ArrayStart()
+						for reader.More() {
+							var elem1 int
+							elem1 = reader.Int()
+							elem0 = append(elem0, elem1)
+						}
+						reader.ArrayEnd()
+					}
+					t.Fifteen = append(t.Fifteen, elem0)
+				}
+				reader.ArrayEnd()
+			}
+		case "sixteen":
+			if reader.IsNull() {
+				t.Sixteen = nil
+			} else {
+				t.Sixteen = make([]map[string]int, 0)
+				reader.ArrayStart()
+				for reader.More() {
+					var elem0 map[string]int
+					if reader.IsNull() {
+						elem0 = nil
+					} else {
+						elem0 = make(map[string]int)
+						reader.ObjectStart()
+						for reader.More() {
+							key1 := reader.Key()
+							var value1 int
+							value1 = reader.Int()
+							elem0[key1] = value1
+						}
+						reader.ObjectEnd()
+					}
+					t.Sixteen = append(t.Sixteen, elem0)
+				}
+				reader.ArrayEnd()
+			}
+		case "seventeen":
+			if reader.IsNull() {
+				t.Seventeen = nil
+			} else {
+				t.Seventeen = make([]*uuid.UUID, 0)
+				reader.ArrayStart()
+				for reader.More() {
+					var elem0 *uuid.UUID
+					if reader.IsNull() {
+						elem0 = nil
+					} else {
+						elem0 = new(uuid.UUID)
+						reader.TextUnmarshaler(elem0)
+					}
+					t.Seventeen = append(t.Seventeen, elem0)
+				}
+				reader.ArrayEnd()
+			}
+		default:
+			switch {
+			case strings.EqualFold(string(key), "one"):
+				t.One = reader.Int()
+			case strings.EqualFold(string(key), "two"):
+				t.Two = reader.Float64()
+			case strings.EqualFold(string(key), "three"):
+				t.Three = reader.String()
+			case strings.EqualFold(string(key), "four"):
+				t.Four = reader.Bool()
+			case strings.EqualFold(string(key), "five"):
+				t.Five = reader.Int64()
+			case strings.EqualFold(string(key), "six"):
+				reader.Unmarshaler(&t.Six)
+			case strings.EqualFold(string(key), "seven"):
+				reader.Unmarshaler(&t.Seven)
+			case strings.EqualFold(string(key), "eight"):
+				reader.TextUnmarshaler(&t.Eight)
+			case strings.EqualFold(string(key), "nine"):
+				t.Nine = reader.Base64()
+			case strings.EqualFold(string(key), "ten"):
+				if reader.IsNull() {
+					t.Ten = nil
+				} else {
+					t.Ten = make([]int, 0)
+					reader.ArrayStart()
+					for reader.More() {
+						var elem0 int
+						elem0 = reader.Int()
+						t.Ten = append(t.Ten, elem0)
+					}
+					reader.ArrayEnd()
+				}
+			case strings.EqualFold(string(key), "eleven"):
+				if reader.IsNull() {
+					t.Eleven = nil
+				} else {
+					t.Eleven = make([]float64, 0)
+					reader.ArrayStart()
+					for reader.More() {
+						var elem0 float64
+						elem0 = reader.Float64()
+						t.Eleven = append(t.Eleven, elem0)
+					}
+					reader.ArrayEnd()
+				}
+			case strings.EqualFold(string(key), "twelve"):
+				if reader.IsNull() {
+					t.Twelve = nil
+				} else {
+					t.Twelve = make(map[string]bool)
+					reader.ObjectStart()
+					for reader.More() {
+						key0 := reader.Key()
+						var value0 bool
+						value0 = reader.Bool()
+						t.Twelve[key0] = value0
+					}
+					reader.ObjectEnd()
+				}
+			case strings.EqualFold(string(key), "thirteen"):
+				if reader.IsNull() {
+					t.Thirteen = nil
+				} else {
+					t.Thirteen = new(int64)
+					*t.Thirteen = reader.Int64()
+				}
+			case strings.EqualFold(string(key), "fourteen"):
+				reader.Value(&t.Fourteen)
+			case strings.EqualFold(string(key), "fifteen"):
+				if reader.IsNull() {
+					t.Fifteen = nil
+				} else {
+					t.Fifteen = make([][]int, 0)
+					reader.ArrayStart()
+					for reader.More() {
+						var elem0 []int
+						if reader.IsNull() {
+							elem0 = nil
+						} else {
+							elem0 = make([]int, 0)
+							reader.ArrayStart()
+							for reader.More() {
+								var elem1 int
+								elem1 = reader.Int()
+								elem0 = append(elem0, elem1)
+							}
+							reader.ArrayEnd()
+						}
+						t.Fifteen = append(t.Fifteen, elem0)
+					}
+					reader.ArrayEnd()
+				}
+			case strings.EqualFold(string(key), "sixteen"):
+				if reader.IsNull() {
+					t.Sixteen = nil
+				} else {
+					t.Sixteen = make([]map[string]int, 0)
+					reader.ArrayStart()
+					for reader.More() {
+						var elem0 map[string]int
+						if reader.IsNull() {
+							elem0 = nil
+						} else {
+							elem0 = make(map[string]int)
+							reader.ObjectStart()
+							for reader.More() {
+								key1 := reader.Key()
+								var value1 int
+								value1 = reader.Int()
+								elem0[key1] = value1
+							}
+							reader.ObjectEnd()
+						}
+						t.Sixteen = append(t.Sixteen, elem0)
+					}
+					reader.ArrayEnd()
+				}
+			case strings.EqualFold(string(key), "seventeen"):
+				if reader.IsNull() {
+					t.Seventeen = nil
+				} else {
+					t.Seventeen = make([]*uuid.UUID, 0)
+					reader.ArrayStart()
+					for reader.More() {
+						var elem0 *uuid.UUID
+						if reader.IsNull() {
+							elem0 = nil
+						} else {
+							elem0 = new(uuid.UUID)
+							reader.TextUnmarshaler(elem0)
+						}
+						t.Seventeen = append(t.Seventeen, elem0)
+					}
+					reader.ArrayEnd()
+				}
+			default:
+				reader.Skip()
+			}
+		}
+	}
+	reader.ObjectEnd()
+	t.eighteen = "fern"
+}
+
+func (t *Type) MarshalJSON() ([]byte, error) {
+	writer := core.NewJSONWriter()
+	t.MarshalJSONTo(writer)
+	return writer.Bytes()
+}
+
+func (t *Type) MarshalJSONTo(writer *core.JSONWriter) {
+	if t == nil {
+		writer.Null()
+		return
+	}
+	writer.ObjectStart()
+	writer.Key("one")
+	writer.Int(t.One)
+	writer.Key("two")
+	writer.Float64(t.Two)
+	writer.Key("three")
+	writer.String(t.Three)
+	writer.Key("four")
+	writer.Bool(t.Four)
+	writer.Key("five")
+	writer.Int64(t.Five)
+	writer.Key("six")
+	writer.Raw(t.Six.MarshalJSON())
+	writer.Key("seven")
+	writer.Raw(t.Seven.MarshalJSON())
+	writer.Key("eight")
+	writer.String(t.Eight.String())
+	writer.Key("nine")
+	writer.Base64(t.Nine)
+	if len(t.Ten) > 0 {
+		writer.Key("ten")
+		writer.ArrayStart()
+		for _, elem0 := range t.Ten {
+			writer.Int(elem0)
+		}
+		writer.ArrayEnd()
+	}
+	if len(t.Eleven) > 0 {
+		writer.Key("eleven")
+		writer.ArrayStart()
+		for _, elem0 := range t.Eleven {
+			writer.Float64(elem0)
+		}
+		writer.ArrayEnd()
+	}
+	if len(t.Twelve) > 0 {
+		writer.Key("twelve")
+		keys0 := make([]string, 0, len(t.Twelve))
+		for key0 := range t.Twelve {
+			keys0 = append(keys0, key0)
+		}
+		sort.Strings(keys0)
+		writer.ObjectStart()
+		for _, key0 := range keys0 {
+			writer.Key(key0)
+			writer.Bool(t.Twelve[key0])
+		}
+		writer.ObjectEnd()
+	}
+	if t.Thirteen != nil {
+		writer.Key("thirteen")
+		writer.Int64(*t.Thirteen)
+	}
+	if t.Fourteen != nil {
+		writer.Key("fourteen")
+		writer.Value(t.Fourteen)
+	}
+	if len(t.Fifteen) > 0 {
+		writer.Key("fifteen")
+		writer.ArrayStart()
+		for _, elem0 := range t.Fifteen {
+			if elem0 == nil {
+				writer.Null()
+			} else {
+				writer.ArrayStart()
+				for _, elem1 := range elem0 {
+					writer.Int(elem1)
+				}
+				writer.ArrayEnd()
+			}
+		}
+		writer.ArrayEnd()
+	}
+	if len(t.Sixteen) > 0 {
+		writer.Key("sixteen")
+		writer.ArrayStart()
+		for _, elem0 := range t.Sixteen {
+			if elem0 == nil {
+				writer.Null()
+			} else {
+				keys1 := make([]string, 0, len(elem0))
+				for key1 := range elem0 {
+					keys1 = append(keys1, key1)
+				}
+				sort.Strings(keys1)
+				writer.ObjectStart()
+				for _, key1 := range keys1 {
+					writer.Key(key1)
+					writer.Int(elem0[key1])
+				}
+				writer.ObjectEnd()
+			}
+		}
+		writer.ArrayEnd()
+	}
+	if len(t.Seventeen) > 0 {
+		writer.Key("seventeen")
+		writer.ArrayStart()
+		for _, elem0 := range t.Seventeen {
+			if elem0 == nil {
+				writer.Null()
+			} else {
+				writer.String((*elem0).String())
+			}
+		}
+		writer.ArrayEnd()
+	}
+	writer.Key("eighteen")
+	writer.String("fern")
+	writer.ObjectEnd()
+}
+
+func (t *Type) String() string {
+	if value, err := core.StringifyJSON(t); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", t)
+}
+
+// This is a simple union.
+type Union struct {
+	Type string
+	Foo  *Foo
+	Bar  *Bar
+}
+
+func NewUnionFromFoo(value *Foo) *Union {
+	return &Union{Type: "foo", Foo: value}
+}
+
+func NewUnionFromBar(value *Bar) *Union {
+	return &Union{Type: "bar", Bar: value}
+}
+
+func (u *Union) GetType() string {
+	if u == nil {
+		return ""
+	}
+	return u.Type
+}
+
+func (u *Union) GetFoo() *Foo {
+	if u == nil {
+		return nil
+	}
+	return u.Foo
+}
+
+func (u *Union) GetBar() *Bar {
+	if u == nil {
+		return nil
+	}
+	return u.Bar
+}
+
+func (u *Union) UnmarshalJSON(data []byte) error {
+	reader := core.NewJSONReader(data)
+	u.UnmarshalJSONFrom(reader)
+	return reader.Done()
+}
+
+func (u *Union) UnmarshalJSONFrom(reader *core.JSONReader) {
+	if reader.IsNull() {
+		return
+	}
+	data := reader.Raw()
+	if reader.Err() != nil {
+		return
+	}
+	unmarshaler := core.NewJSONReader(data)
+	unmarshaler.ObjectStart()
+	for unmarshaler.More() {
+		key := unmarshaler.KeyBytes()
+		switch string(key) {
+		case "type":
+			u.Type = unmarshaler.String()
+		default:
+			switch {
+			case strings.EqualFold(string(key), "type"):
+				u.Type = unmarshaler.String()
+			default:
+				unmarshaler.Skip()
+			}
+		}
+	}
+	unmarshaler.ObjectEnd()
+	reader.SetErr(unmarshaler.Err())
+	switch u.Type {
+	case "foo":
+		valueUnmarshaler := core.NewJSONReader(data)
+		valueUnmarshaler.ObjectStart()
+		for valueUnmarshaler.More() {
+			if !strings.EqualFold(string(valueUnmarshaler.KeyBytes()), "foo") {
+				valueUnmarshaler.Skip()
+				continue
+			}
+			if valueUnmarshaler.IsNull() {
+				u.Foo = nil
+			} else {
+				u.Foo = new(Foo)
+				u.Foo.UnmarshalJSONFrom(valueUnmarshaler)
+			}
+		}
+		valueUnmarshaler.ObjectEnd()
+		reader.SetErr(valueUnmarshaler.Err())
+	case "bar":
+		valueUnmarshaler := core.NewJSONReader(data)
+		valueUnmarshaler.ObjectStart()
+		for valueUnmarshaler.More() {
+			if !strings.EqualFold(string(valueUnmarshaler.KeyBytes()), "bar") {
+				valueUnmarshaler.Skip()
+				continue
+			}
+			if valueUnmarshaler.IsNull() {
+				u.Bar = nil
+			} else {
+				u.Bar = new(Bar)
+				u.Bar.UnmarshalJSONFrom(valueUnmarshaler)
+			}
+		}
+		valueUnmarshaler.ObjectEnd()
+		reader.SetErr(valueUnmarshaler.Err())
+	}
+}
+
+func (u Union) MarshalJSON() ([]byte, error) {
+	writer := core.NewJSONWriter()
+	u.MarshalJSONTo(writer)
+	return writer.Bytes()
+}
+
+func (u Union) MarshalJSONTo(writer *core.JSONWriter) {
+	switch u.Type {
+	default:
+		writer.SetErr(fmt.Errorf("invalid type %s in %T", u.Type, u))
+	case "foo":
+		writer.ObjectStart()
+		writer.Key("type")
+		writer.String(u.Type)
+		if u.Foo != nil {
+			writer.Key("foo")
+			u.Foo.MarshalJSONTo(writer)
+		}
+		writer.ObjectEnd()
+	case "bar":
+		writer.ObjectStart()
+		writer.Key("type")
+		writer.String(u.Type)
+		if u.Bar != nil {
+			writer.Key("bar")
+			u.Bar.MarshalJSONTo(writer)
+		}
+		writer.ObjectEnd()
+	}
+}
+
+type UnionVisitor interface {
+	VisitFoo(*Foo) error
+	VisitBar(*Bar) error
+}
+
+func (u *Union) Accept(visitor UnionVisitor) error {
+	switch u.Type {
+	default:
+		return fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "foo":
+		return visitor.VisitFoo(u.Foo)
+	case "bar":
+		return visitor.VisitBar(u.Bar)
+	}
+}
+
+type UnionWithDiscriminant struct {
+	Type string
+	// This is a Foo field.
+	Foo *Foo
+	Bar *Bar
+}
+
+func NewUnionWithDiscriminantFromFoo(value *Foo) *UnionWithDiscriminant {
+	return &UnionWithDiscriminant{Type: "foo", Foo: value}
+}
+
+func NewUnionWithDiscriminantFromBar(value *Bar) *UnionWithDiscriminant {
+	return &UnionWithDiscriminant{Type: "bar", Bar: value}
+}
+
+func (u *UnionWithDiscriminant) GetType() string {
+	if u == nil {
+		return ""
+	}
+	return u.Type
+}
+
+func (u *UnionWithDiscriminant) GetFoo() *Foo {
+	if u == nil {
+		return nil
+	}
+	return u.Foo
+}
+
+func (u *UnionWithDiscriminant) GetBar() *Bar {
+	if u == nil {
+		return nil
+	}
+	return u.Bar
+}
+
+func (u *UnionWithDiscriminant) UnmarshalJSON(data []byte) error {
+	reader := core.NewJSONReader(data)
+	u.UnmarshalJSONFrom(reader)
+	return reader.Done()
+}
+
+func (u *UnionWithDiscriminant) UnmarshalJSONFrom(reader *core.JSONReader) {
+	if reader.IsNull() {
+		return
+	}
+	data := reader.Raw()
+	if reader.Err() != nil {
+		return
+	}
+	unmarshaler := core.NewJSONReader(data)
+	unmarshaler.ObjectStart()
+	for unmarshaler.More() {
+		key := unmarshaler.KeyBytes()
+		switch string(key) {
+		case "_type":
+			u.Type = unmarshaler.String()
+		default:
+			switch {
+			case strings.EqualFold(string(key), "_type"):
+				u.Type = unmarshaler.String()
+			default:
+				unmarshaler.Skip()
+			}
+		}
+	}
+	unmarshaler.ObjectEnd()
+	reader.SetErr(unmarshaler.Err())
+	switch u.Type {
+	case "foo":
+		valueUnmarshaler := core.NewJSONReader(data)
+		valueUnmarshaler.ObjectStart()
+		for valueUnmarshaler.More() {
+			if !strings.EqualFold(string(valueUnmarshaler.KeyBytes()), "foo") {
+				valueUnmarshaler.Skip()
+				continue
+			}
+			if valueUnmarshaler.IsNull() {
+				u.Foo = nil
+			} else {
+				u.Foo = new(Foo)
+				u.Foo.UnmarshalJSONFrom(valueUnmarshaler)
+			}
+		}
+		valueUnmarshaler.ObjectEnd()
+		reader.SetErr(valueUnmarshaler.Err())
+	case "bar":
+		valueUnmarshaler := core.NewJSONReader(data)
+		valueUnmarshaler.ObjectStart()
+		for valueUnmarshaler.More() {
+			if !strings.EqualFold(string(valueUnmarshaler.KeyBytes()), "bar") {
+				valueUnmarshaler.Skip()
+				continue
+			}
+			if valueUnmarshaler.IsNull() {
+				u.Bar = nil
+			} else {
+				u.Bar = new(Bar)
+				u.Bar.UnmarshalJSONFrom(valueUnmarshaler)
+			}
+		}
+		valueUnmarshaler.ObjectEnd()
+		reader.SetErr(valueUnmarshaler.Err())
+	}
+}
+
+func (u UnionWithDiscriminant) MarshalJSON() ([]byte, error) {
+	writer := core.NewJSONWriter()
+	u.MarshalJSONTo(writer)
+	return writer.Bytes()
+}
+
+func (u UnionWithDiscriminant) MarshalJSONTo(writer *core.JSONWriter) {
+	switch u.Type {
+	default:
+		writer.SetErr(fmt.Errorf("invalid type %s in %T", u.Type, u))
+	case "foo":
+		writer.ObjectStart()
+		writer.Key("_type")
+		writer.String(u.Type)
+		if u.Foo != nil {
+			writer.Key("foo")
+			u.Foo.MarshalJSONTo(writer)
+		}
+		writer.ObjectEnd()
+	case "bar":
+		writer.ObjectStart()
+		writer.Key("_type")
+		writer.String(u.Type)
+		if u.Bar != nil {
+			writer.Key("bar")
+			u.Bar.MarshalJSONTo(writer)
+		}
+		writer.ObjectEnd()
+	}
+}
+
+type UnionWithDiscriminantVisitor interface {
+	VisitFoo(*Foo) error
+	VisitBar(*Bar) error
+}
+
+func (u *UnionWithDiscriminant) Accept(visitor UnionWithDiscriminantVisitor) error {
+	switch u.Type {
+	default:
+		return fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "foo":
+		return visitor.VisitFoo(u.Foo)
+	case "bar":
+		return visitor.VisitBar(u.Bar)
+	}
+}
+
+type UnionWithLiteral struct {
+	Type     string
+	fern     string
+	extended string
+	base     string
+}
+
+func NewUnionWithLiteralWithFern() *UnionWithLiteral {
+	return &UnionWithLiteral{Type: "fern", fern: "fern"}
+}
+
+func (u *UnionWithLiteral) Extended() string {
+	return u.extended
+}
+
+func (u *UnionWithLiteral) Base() string {
+	return u.base
+}
+
+func (u *UnionWithLiteral) Fern() string {
+	return u.fern
+}
+
+func (u *UnionWithLiteral) GetType() string {
+	if u == nil {
+		return ""
+	}
+	return u.Type
+}
+
+func (u *UnionWithLiteral) UnmarshalJSON(data []byte) error {
+	reader := core.NewJSONReader(data)
+	u.UnmarshalJSONFrom(reader)
+	return reader.Done()
+}
+
+func (u *UnionWithLiteral) UnmarshalJSONFrom(reader *core.JSONReader) {
+	if reader.IsNull() {
+		return
+	}
+	data := reader.Raw()
+	if reader.Err() != nil {
+		return
+	}
+	unmarshaler := core.NewJSONReader(data)
+	unmarshaler.ObjectStart()
+	for unmarshaler.More() {
+		key := unmarshaler.KeyBytes()
+		switch string(key) {
+		case "type":
+			u.Type = unmarshaler.String()
+		default:
+			switch {
+			case strings.EqualFold(string(key), "type"):
+				u.Type = unmarshaler.String()
+			default:
+				unmarshaler.Skip()
+			}
+		}
+	}
+	unmarshaler.ObjectEnd()
+	reader.SetErr(unmarshaler.Err())
+	u.extended = "extended"
+	u.base = "base"
+	switch u.Type {
+	case "fern":
+		u.fern = "fern"
+	}
+}
+
+func (u UnionWithLiteral) MarshalJSON() ([]byte, error) {
+	writer := core.NewJSONWriter()
+	u.MarshalJSONTo(writer)
+	return writer.Bytes()
+}
+
+func (u UnionWithLiteral) MarshalJSONTo(writer *core.JSONWriter) {
+	switch u.Type {
+	default:
+		writer.SetErr(fmt.Errorf("invalid type %s in %T", u.Type, u))
+	case "fern":
+		writer.ObjectStart()
+		writer.Key("type")
+		writer.String(u.Type)
+		writer.Key("extended")
+		writer.String("extended")
+		writer.Key("base")
+		writer.String("base")
+		writer.Key("value")
+		writer.String("fern")
+		writer.ObjectEnd()
+	}
+}
+
+type UnionWithLiteralVisitor interface {
+	VisitFern(string) error
+}
+
+func (u *UnionWithLiteral) Accept(visitor UnionWithLiteralVisitor) error {
+	switch u.Type {
+	default:
+		return fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "fern":
+		return visitor.VisitFern(u.fern)
+	}
+}
+
+type UnionWithPrimitive struct {
+	Type    string
+	Boolean bool
+	String  string
+}
+
+func NewUnionWithPrimitiveFromBoolean(value bool) *UnionWithPrimitive {
+	return &UnionWithPrimitive{Type: "boolean", Boolean: value}
+}
+
+func NewUnionWithPrimitiveFromString(value string) *UnionWithPrimitive {
+	return &UnionWithPrimitive{Type: "string", String: value}
+}
+
+func (u *UnionWithPrimitive) GetType() string {
+	if u == nil {
+		return ""
+	}
+	return u.Type
+}
+
+func (u *UnionWithPrimitive) GetBoolean() bool {
+	if u == nil {
+		return false
+	}
+	return u.Boolean
+}
+
+func (u *UnionWithPrimitive) GetString() string {
+	if u == nil {
+		return ""
+	}
+	return u.String
+}
+
+func (u *UnionWithPrimitive) UnmarshalJSON(data []byte) error {
+	reader := core.NewJSONReader(data)
+	u.UnmarshalJSONFrom(reader)
+	return reader.Done()
+}
+
+func (u *UnionWithPrimitive) UnmarshalJSONFrom(reader *core.JSONReader) {
+	if reader.IsNull() {
+		return
+	}
+	data := reader.Raw()
+	if reader.Err() != nil {
+		return
+	}
+	unmarshaler := core.NewJSONReader(data)
+	unmarshaler.ObjectStart()
+	for unmarshaler.More() {
+		key := unmarshaler.KeyBytes()
+		switch string(key) {
+		case "type":
+			u.Type = unmarshaler.String()
+		default:
+			switch {
+			case strings.EqualFold(string(key), "type"):
+				u.Type = unmarshaler.String()
+			default:
+				unmarshaler.Skip()
+			}
+		}
+	}
+	unmarshaler.ObjectEnd()
+	reader.SetErr(unmarshaler.Err())
+	switch u.Type {
+	case "boolean":
+		valueUnmarshaler := core.NewJSONReader(data)
+		valueUnmarshaler.ObjectStart()
+		for valueUnmarshaler.More() {
+			if !strings.EqualFold(string(valueUnmarshaler.KeyBytes()), "value") {
+				valueUnmarshaler.Skip()
+				continue
+			}
+			u.Boolean = valueUnmarshaler.Bool()
+		}
+		valueUnmarshaler.ObjectEnd()
+		reader.SetErr(valueUnmarshaler.Err())
+	case "string":
+		valueUnmarshaler := core.NewJSONReader(data)
+		valueUnmarshaler.ObjectStart()
+		for valueUnmarshaler.More() {
+			if !strings.EqualFold(string(valueUnmarshaler.KeyBytes()), "value") {
+				valueUnmarshaler.Skip()
+				continue
+			}
+			u.String = valueUnmarshaler.String()
+		}
+		valueUnmarshaler.ObjectEnd()
+		reader.SetErr(valueUnmarshaler.Err())
+	}
+}
+
+func (u UnionWithPrimitive) MarshalJSON() ([]byte, error) {
+	writer := core.NewJSONWriter()
+	u.MarshalJSONTo(writer)
+	return writer.Bytes()
+}
+
+func (u UnionWithPrimitive) MarshalJSONTo(writer *core.JSONWriter) {
+	switch u.Type {
+	default:
+		writer.SetErr(fmt.Errorf("invalid type %s in %T", u.Type, u))
+	case "boolean":
+		writer.ObjectStart()
+		writer.Key("type")
+		writer.String(u.Type)
+		writer.Key("value")
+		writer.Bool(u.Boolean)
+		writer.ObjectEnd()
+	case "string":
+		writer.ObjectStart()
+		writer.Key("type")
+		writer.String(u.Type)
+		writer.Key("value")
+		writer.String(u.String)
+		writer.ObjectEnd()
+	}
+}
+
+type UnionWithPrimitiveVisitor interface {
+	VisitBoolean(bool) error
+	VisitString(string) error
+}
+
+func (u *UnionWithPrimitive) Accept(visitor UnionWithPrimitiveVisitor) error {
+	switch u.Type {
+	default:
+		return fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "boolean":
+		return visitor.VisitBoolean(u.Boolean)
+	case "string":
+		return visitor.VisitString(u.String)
+	}
+}
+
+type UnionWithUnknown struct {
+	Type    string
+	Foo     *Foo
+	Unknown interface{}
+}
+
+func NewUnionWithUnknownFromFoo(value *Foo) *UnionWithUnknown {
+	return &UnionWithUnknown{Type: "foo", Foo: value}
+}
+
+func NewUnionWithUnknownFromUnknown(value interface{}) *UnionWithUnknown {
+	return &UnionWithUnknown{Type: "unknown", Unknown: value}
+}
+
+func (u *UnionWithUnknown) GetType() string {
+	if u == nil {
+		return ""
+	}
+	return u.Type
+}
+
+func (u *UnionWithUnknown) GetFoo() *Foo {
+	if u == nil {
+		return nil
+	}
+	return u.Foo
+}
+
+func (u *UnionWithUnknown) UnmarshalJSON(data []byte) error {
+	reader := core.NewJSONReader(data)
+	u.UnmarshalJSONFrom(reader)
+	return reader.Done()
+}
+
+func (u *UnionWithUnknown) UnmarshalJSONFrom(reader *core.JSONReader) {
+	if reader.IsNull() {
+		return
+	}
+	data := reader.Raw()
+	if reader.Err() != nil {
+		return
+	}
+	unmarshaler := core.NewJSONReader(data)
+	unmarshaler.ObjectStart()
+	for unmarshaler.More() {
+		key := unmarshaler.KeyBytes()
+		switch string(key) {
+		case "type":
+			u.Type = unmarshaler.String()
+		default:
+			switch {
+			case strings.EqualFold(string(key), "type"):
+				u.Type = unmarshaler.String()
+			default:
+				unmarshaler.Skip()
+			}
+		}
+	}
+	unmarshaler.ObjectEnd()
+	reader.SetErr(unmarshaler.Err())
+	switch u.Type {
+	case "foo":
+		valueUnmarshaler := core.NewJSONReader(data)
+		if valueUnmarshaler.IsNull() {
+			u.Foo = nil
+		} else {
+			u.Foo = new(Foo)
+			u.Foo.UnmarshalJSONFrom(valueUnmarshaler)
+		}
+		reader.SetErr(valueUnmarshaler.Err())
+	case "unknown":
+		value := make(map[string]interface{})
+		reader.SetErr(json.Unmarshal(data, &value))
+		u.Unknown = value
+	}
+}
+
+func (u UnionWithUnknown) MarshalJSON() ([]byte, error) {
+	writer := core.NewJSONWriter()
+	u.MarshalJSONTo(writer)
+	return writer.Bytes()
+}
+
+func (u UnionWithUnknown) MarshalJSONTo(writer *core.JSONWriter) {
+	switch u.Type {
+	default:
+		writer.SetErr(fmt.Errorf("invalid type %s in %T", u.Type, u))
+	case "foo":
+		writer.ObjectStart()
+		writer.Key("type")
+		writer.String(u.Type)
+		if u.Foo != nil {
+			writer.Inline()
+			u.Foo.MarshalJSONTo(writer)
+		}
+		writer.ObjectEnd()
+	case "unknown":
+		writer.ObjectStart()
+		writer.Key("type")
+		writer.String(u.Type)
+		if u.Unknown != nil {
+			writer.Key("unknown")
+			writer.Value(u.Unknown)
+		}
+		writer.ObjectEnd()
+	}
+}
+
+type UnionWithUnknownVisitor interface {
+	VisitFoo(*Foo) error
+	VisitUnknown(interface{}) error
+}
+
+func (u *UnionWithUnknown) Accept(visitor UnionWithUnknownVisitor) error {
+	switch u.Type {
+	default:
+		return fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "foo":
+		return visitor.VisitFoo(u.Foo)
+	case "unknown":
+		return visitor.VisitUnknown(u.Unknown)
+	}
+}
+
+type UnionWithoutKey struct {
+	Type string
+	Foo  *Foo
+	// This is a bar field.
+	Bar *Bar
+}
+
+func NewUnionWithoutKeyFromFoo(value *Foo) *UnionWithoutKey {
+	return &UnionWithoutKey{Type: "foo", Foo: value}
+}
+
+func NewUnionWithoutKeyFromBar(value *Bar) *UnionWithoutKey {
+	return &UnionWithoutKey{Type: "bar", Bar: value}
+}
+
+func (u *UnionWithoutKey) GetType() string {
+	if u == nil {
+		return ""
+	}
+	return u.Type
+}
+
+func (u *UnionWithoutKey) GetFoo() *Foo {
+	if u == nil {
+		return nil
+	}
+	return u.Foo
+}
+
+func (u *UnionWithoutKey) GetBar() *Bar {
+	if u == nil {
+		return nil
+	}
+	return u.Bar
+}
+
+func (u *UnionWithoutKey) UnmarshalJSON(data []byte) error {
+	reader := core.NewJSONReader(data)
+	u.UnmarshalJSONFrom(reader)
+	return reader.Done()
+}
+
+func (u *UnionWithoutKey) UnmarshalJSONFrom(reader *core.JSONReader) {
+	if reader.IsNull() {
+		return
+	}
+	data := reader.Raw()
+	if reader.Err() != nil {
+		return
+	}
+	unmarshaler := core.NewJSONReader(data)
+	unmarshaler.ObjectStart()
+	for unmarshaler.More() {
+		key := unmarshaler.KeyBytes()
+		switch string(key) {
+		case "type":
+			u.Type = unmarshaler.String()
+		default:
+			switch {
+			case strings.EqualFold(string(key), "type"):
+				u.Type = unmarshaler.String()
+			default:
+				unmarshaler.Skip()
+			}
+		}
+	}
+	unmarshaler.ObjectEnd()
+	reader.SetErr(unmarshaler.Err())
+	switch u.Type {
+	case "foo":
+		valueUnmarshaler := core.NewJSONReader(data)
+		if valueUnmarshaler.IsNull() {
+			u.Foo = nil
+		} else {
+			u.Foo = new(Foo)
+			u.Foo.UnmarshalJSONFrom(valueUnmarshaler)
+		}
+		reader.SetErr(valueUnmarshaler.Err())
+	case "bar":
+		valueUnmarshaler := core.NewJSONReader(data)
+		if valueUnmarshaler.IsNull() {
+			u.Bar = nil
+		} else {
+			u.Bar = new(Bar)
+			u.Bar.UnmarshalJSONFrom(valueUnmarshaler)
+		}
+		reader.SetErr(valueUnmarshaler.Err())
+	}
+}
+
+func (u UnionWithoutKey) MarshalJSON() ([]byte, error) {
+	writer := core.NewJSONWriter()
+	u.MarshalJSONTo(writer)
+	return writer.Bytes()
+}
+
+func (u UnionWithoutKey) MarshalJSONTo(writer *core.JSONWriter) {
+	switch u.Type {
+	default:
+		writer.SetErr(fmt.Errorf("invalid type %s in %T", u.Type, u))
+	case "foo":
+		writer.ObjectStart()
+		writer.Key("type")
+		writer.String(u.Type)
+		if u.Foo != nil {
+			writer.Inline()
+			u.Foo.MarshalJSONTo(writer)
+		}
+		writer.ObjectEnd()
+	case "bar":
+		writer.ObjectStart()
+		writer.Key("type")
+		writer.String(u.Type)
+		if u.Bar != nil {
+			writer.Inline()
+			u.Bar.MarshalJSONTo(writer)
+		}
+		writer.ObjectEnd()
+	}
+}
+
+type UnionWithoutKeyVisitor interface {
+	VisitFoo(*Foo) error
+	VisitBar(*Bar) error
+}
+
+func (u *UnionWithoutKey) Accept(visitor UnionWithoutKeyVisitor) error {
+	switch u.Type {
+	default:
+		return fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "foo":
+		return visitor.VisitFoo(u.Foo)
+	case "bar":
+		return visitor.VisitBar(u.Bar)
+	}
+}
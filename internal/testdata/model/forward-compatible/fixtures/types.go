@@ -0,0 +1,865 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package api
+
+import (
+	json "encoding/json"
+	fmt "fmt"
+	core "github.com/fern-api/fern-go/internal/testdata/model/forward-compatible/fixtures/core"
+)
+
+type Bar struct {
+	Name string `json:"name"`
+}
+
+func (b *Bar) GetName() string {
+	if b == nil {
+		return ""
+	}
+	return b.Name
+}
+
+func (b *Bar) String() string {
+	if value, err := core.StringifyJSON(b); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", b)
+}
+
+type Baz struct {
+	extended string
+}
+
+func (b *Baz) Extended() string {
+	return b.extended
+}
+
+func (b *Baz) UnmarshalJSON(data []byte) error {
+	type unmarshaler Baz
+	var value unmarshaler
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*b = Baz(value)
+	b.extended = "extended"
+	return nil
+}
+
+func (b *Baz) MarshalJSON() ([]byte, error) {
+	type embed Baz
+	var marshaler = struct {
+		embed
+		Extended string `json:"extended"`
+	}{
+		embed:    embed(*b),
+		Extended: "extended",
+	}
+	return json.Marshal(marshaler)
+}
+
+func (b *Baz) String() string {
+	if value, err := core.StringifyJSON(b); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", b)
+}
+
+type Enum string
+
+const (
+	// The first enum value.
+	EnumOne   Enum = "ONE"
+	EnumTwo   Enum = "TWO"
+	EnumThree Enum = "THREE"
+)
+
+func NewEnumFromString(s string) (Enum, error) {
+	switch s {
+	case "ONE":
+		return EnumOne, nil
+	case "TWO":
+		return EnumTwo, nil
+	case "THREE":
+		return EnumThree, nil
+	}
+	var t Enum
+	return "", fmt.Errorf("%s is not a valid %T", s, t)
+}
+
+func (e Enum) Ptr() *Enum {
+	return &e
+}
+
+func (e Enum) IsKnown() bool {
+	switch e {
+	case EnumOne, EnumTwo, EnumThree:
+		return true
+	}
+	return false
+}
+
+func (e Enum) Values() []Enum {
+	return []Enum{
+		EnumOne,
+		EnumTwo,
+		EnumThree,
+	}
+}
+
+type Foo struct {
+	Name string `json:"name"`
+}
+
+func (f *Foo) GetName() string {
+	if f == nil {
+		return ""
+	}
+	return f.Name
+}
+
+func (f *Foo) String() string {
+	if value, err := core.StringifyJSON(f); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", f)
+}
+
+type Something string
+
+const (
+	Somethingone Something = "one"
+	SomethingOne Something = "One"
+	SomethingONe Something = "ONe"
+	SomethingONE Something = "ONE"
+)
+
+func NewSomethingFromString(s string) (Something, error) {
+	switch s {
+	case "one":
+		return Somethingone, nil
+	case "One":
+		return SomethingOne, nil
+	case "ONe":
+		return SomethingONe, nil
+	case "ONE":
+		return SomethingONE, nil
+	}
+	var t Something
+	return "", fmt.Errorf("%s is not a valid %T", s, t)
+}
+
+func (s Something) Ptr() *Something {
+	return &s
+}
+
+func (s Something) IsKnown() bool {
+	switch s {
+	case Somethingone, SomethingOne, SomethingONe, SomethingONE:
+		return true
+	}
+	return false
+}
+
+func (s Something) Values() []Something {
+	return []Something{
+		Somethingone,
+		SomethingOne,
+		SomethingONe,
+		SomethingONE,
+	}
+}
+
+// This is a simple union.
+type Union struct {
+	Type    string
+	Foo     *Foo
+	Bar     *Bar
+	Unknown json.RawMessage
+}
+
+func NewUnionFromFoo(value *Foo) *Union {
+	return &Union{Type: "foo", Foo: value}
+}
+
+func NewUnionFromBar(value *Bar) *Union {
+	return &Union{Type: "bar", Bar: value}
+}
+
+func (u *Union) GetType() string {
+	if u == nil {
+		return ""
+	}
+	return u.Type
+}
+
+func (u *Union) GetFoo() *Foo {
+	if u == nil {
+		return nil
+	}
+	return u.Foo
+}
+
+func (u *Union) GetBar() *Bar {
+	if u == nil {
+		return nil
+	}
+	return u.Bar
+}
+
+func (u *Union) GetUnknown() json.RawMessage {
+	if u == nil {
+		return nil
+	}
+	return u.Unknown
+}
+
+func (u *Union) UnmarshalJSON(data []byte) error {
+	var unmarshaler struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	u.Type = unmarshaler.Type
+	switch unmarshaler.Type {
+	case "foo":
+		var valueUnmarshaler struct {
+			Foo *Foo `json:"foo,omitempty"`
+		}
+		if err := json.Unmarshal(data, &valueUnmarshaler); err != nil {
+			return err
+		}
+		u.Foo = valueUnmarshaler.Foo
+	case "bar":
+		var valueUnmarshaler struct {
+			Bar *Bar `json:"bar,omitempty"`
+		}
+		if err := json.Unmarshal(data, &valueUnmarshaler); err != nil {
+			return err
+		}
+		u.Bar = valueUnmarshaler.Bar
+	default:
+		u.Unknown = append(json.RawMessage(nil), data...)
+	}
+	return nil
+}
+
+func (u Union) MarshalJSON() ([]byte, error) {
+	switch u.Type {
+	default:
+		if u.Unknown != nil {
+			return u.Unknown, nil
+		}
+		return nil, fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "foo":
+		var marshaler = struct {
+			Type string `json:"type"`
+			Foo  *Foo   `json:"foo,omitempty"`
+		}{
+			Type: u.Type,
+			Foo:  u.Foo,
+		}
+		return json.Marshal(marshaler)
+	case "bar":
+		var marshaler = struct {
+			Type string `json:"type"`
+			Bar  *Bar   `json:"bar,omitempty"`
+		}{
+			Type: u.Type,
+			Bar:  u.Bar,
+		}
+		return json.Marshal(marshaler)
+	}
+}
+
+type UnionVisitor interface {
+	VisitFoo(*Foo) error
+	VisitBar(*Bar) error
+	VisitUnknown(string, json.RawMessage) error
+}
+
+func (u *Union) Accept(visitor UnionVisitor) error {
+	switch u.Type {
+	default:
+		if u.Unknown != nil {
+			return visitor.VisitUnknown(u.Type, u.Unknown)
+		}
+		return fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "foo":
+		return visitor.VisitFoo(u.Foo)
+	case "bar":
+		return visitor.VisitBar(u.Bar)
+	}
+}
+
+type UnionWithDiscriminant struct {
+	Type string
+	// This is a Foo field.
+	Foo     *Foo
+	Bar     *Bar
+	Unknown json.RawMessage
+}
+
+func NewUnionWithDiscriminantFromFoo(value *Foo) *UnionWithDiscriminant {
+	return &UnionWithDiscriminant{Type: "foo", Foo: value}
+}
+
+func NewUnionWithDiscriminantFromBar(value *Bar) *UnionWithDiscriminant {
+	return &UnionWithDiscriminant{Type: "bar", Bar: value}
+}
+
+func (u *UnionWithDiscriminant) GetType() string {
+	if u == nil {
+		return ""
+	}
+	return u.Type
+}
+
+func (u *UnionWithDiscriminant) GetFoo() *Foo {
+	if u == nil {
+		return nil
+	}
+	return u.Foo
+}
+
+func (u *UnionWithDiscriminant) GetBar() *Bar {
+	if u == nil {
+		return nil
+	}
+	return u.Bar
+}
+
+func (u *UnionWithDiscriminant) GetUnknown() json.RawMessage {
+	if u == nil {
+		return nil
+	}
+	return u.Unknown
+}
+
+func (u *UnionWithDiscriminant) UnmarshalJSON(data []byte) error {
+	var unmarshaler struct {
+		Type string `json:"_type"`
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	u.Type = unmarshaler.Type
+	switch unmarshaler.Type {
+	case "foo":
+		var valueUnmarshaler struct {
+			Foo *Foo `json:"foo,omitempty"`
+		}
+		if err := json.Unmarshal(data, &valueUnmarshaler); err != nil {
+			return err
+		}
+		u.Foo = valueUnmarshaler.Foo
+	case "bar":
+		var valueUnmarshaler struct {
+			Bar *Bar `json:"bar,omitempty"`
+		}
+		if err := json.Unmarshal(data, &valueUnmarshaler); err != nil {
+			return err
+		}
+		u.Bar = valueUnmarshaler.Bar
+	default:
+		u.Unknown = append(json.RawMessage(nil), data...)
+	}
+	return nil
+}
+
+func (u UnionWithDiscriminant) MarshalJSON() ([]byte, error) {
+	switch u.Type {
+	default:
+		if u.Unknown != nil {
+			return u.Unknown, nil
+		}
+		return nil, fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "foo":
+		var marshaler = struct {
+			Type string `json:"_type"`
+			Foo  *Foo   `json:"foo,omitempty"`
+		}{
+			Type: u.Type,
+			Foo:  u.Foo,
+		}
+		return json.Marshal(marshaler)
+	case "bar":
+		var marshaler = struct {
+			Type string `json:"_type"`
+			Bar  *Bar   `json:"bar,omitempty"`
+		}{
+			Type: u.Type,
+			Bar:  u.Bar,
+		}
+		return json.Marshal(marshaler)
+	}
+}
+
+type UnionWithDiscriminantVisitor interface {
+	VisitFoo(*Foo) error
+	VisitBar(*Bar) error
+	VisitUnknown(string, json.RawMessage) error
+}
+
+func (u *UnionWithDiscriminant) Accept(visitor UnionWithDiscriminantVisitor) error {
+	switch u.Type {
+	default:
+		if u.Unknown != nil {
+			return visitor.VisitUnknown(u.Type, u.Unknown)
+		}
+		return fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "foo":
+		return visitor.VisitFoo(u.Foo)
+	case "bar":
+		return visitor.VisitBar(u.Bar)
+	}
+}
+
+type UnionWithLiteral struct {
+	Type     string
+	fern     string
+	Unknown  json.RawMessage
+	extended string
+	base     string
+}
+
+func NewUnionWithLiteralWithFern() *UnionWithLiteral {
+	return &UnionWithLiteral{Type: "fern", fern: "fern"}
+}
+
+func (u *UnionWithLiteral) Extended() string {
+	return u.extended
+}
+
+func (u *UnionWithLiteral) Base() string {
+	return u.base
+}
+
+func (u *UnionWithLiteral) Fern() string {
+	return u.fern
+}
+
+func (u *UnionWithLiteral) GetType() string {
+	if u == nil {
+		return ""
+	}
+	return u.Type
+}
+
+func (u *UnionWithLiteral) GetUnknown() json.RawMessage {
+	if u == nil {
+		return nil
+	}
+	return u.Unknown
+}
+
+func (u *UnionWithLiteral) UnmarshalJSON(data []byte) error {
+	var unmarshaler struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	u.Type = unmarshaler.Type
+	u.extended = "extended"
+	u.base = "base"
+	switch unmarshaler.Type {
+	case "fern":
+		u.fern = "fern"
+	default:
+		u.Unknown = append(json.RawMessage(nil), data...)
+	}
+	return nil
+}
+
+func (u UnionWithLiteral) MarshalJSON() ([]byte, error) {
+	switch u.Type {
+	default:
+		if u.Unknown != nil {
+			return u.Unknown, nil
+		}
+		return nil, fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "fern":
+		var marshaler = struct {
+			Type     string `json:"type"`
+			Extended string `json:"extended"`
+			Base     string `json:"base"`
+			Fern     string `json:"value,omitempty"`
+		}{
+			Type:     u.Type,
+			Extended: "extended",
+			Base:     "base",
+			Fern:     "fern",
+		}
+		return json.Marshal(marshaler)
+	}
+}
+
+type UnionWithLiteralVisitor interface {
+	VisitFern(string) error
+	VisitUnknown(string, json.RawMessage) error
+}
+
+func (u *UnionWithLiteral) Accept(visitor UnionWithLiteralVisitor) error {
+	switch u.Type {
+	default:
+		if u.Unknown != nil {
+			return visitor.VisitUnknown(u.Type, u.Unknown)
+		}
+		return fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "fern":
+		return visitor.VisitFern(u.fern)
+	}
+}
+
+type UnionWithPrimitive struct {
+	Type    string
+	Boolean bool
+	String  string
+	Unknown json.RawMessage
+}
+
+func NewUnionWithPrimitiveFromBoolean(value bool) *UnionWithPrimitive {
+	return &UnionWithPrimitive{Type: "boolean", Boolean: value}
+}
+
+func NewUnionWithPrimitiveFromString(value string) *UnionWithPrimitive {
+	return &UnionWithPrimitive{Type: "string", String: value}
+}
+
+func (u *UnionWithPrimitive) GetType() string {
+	if u == nil {
+		return ""
+	}
+	return u.Type
+}
+
+func (u *UnionWithPrimitive) GetBoolean() bool {
+	if u == nil {
+		return false
+	}
+	return u.Boolean
+}
+
+func (u *UnionWithPrimitive) GetString() string {
+	if u == nil {
+		return ""
+	}
+	return u.String
+}
+
+func (u *UnionWithPrimitive) GetUnknown() json.RawMessage {
+	if u == nil {
+		return nil
+	}
+	return u.Unknown
+}
+
+func (u *UnionWithPrimitive) UnmarshalJSON(data []byte) error {
+	var unmarshaler struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	u.Type = unmarshaler.Type
+	switch unmarshaler.Type {
+	case "boolean":
+		var valueUnmarshaler struct {
+			Boolean bool `json:"value"`
+		}
+		if err := json.Unmarshal(data, &valueUnmarshaler); err != nil {
+			return err
+		}
+		u.Boolean = valueUnmarshaler.Boolean
+	case "string":
+		var valueUnmarshaler struct {
+			String string `json:"value"`
+		}
+		if err := json.Unmarshal(data, &valueUnmarshaler); err != nil {
+			return err
+		}
+		u.String = valueUnmarshaler.String
+	default:
+		u.Unknown = append(json.RawMessage(nil), data...)
+	}
+	return nil
+}
+
+func (u UnionWithPrimitive) MarshalJSON() ([]byte, error) {
+	switch u.Type {
+	default:
+		if u.Unknown != nil {
+			return u.Unknown, nil
+		}
+		return nil, fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "boolean":
+		var marshaler = struct {
+			Type    string `json:"type"`
+			Boolean bool   `json:"value"`
+		}{
+			Type:    u.Type,
+			Boolean: u.Boolean,
+		}
+		return json.Marshal(marshaler)
+	case "string":
+		var marshaler = struct {
+			Type   string `json:"type"`
+			String string `json:"value"`
+		}{
+			Type:   u.Type,
+			String: u.String,
+		}
+		return json.Marshal(marshaler)
+	}
+}
+
+type UnionWithPrimitiveVisitor interface {
+	VisitBoolean(bool) error
+	VisitString(string) error
+	VisitUnknown(string, json.RawMessage) error
+}
+
+func (u *UnionWithPrimitive) Accept(visitor UnionWithPrimitiveVisitor) error {
+	switch u.Type {
+	default:
+		if u.Unknown != nil {
+			return visitor.VisitUnknown(u.Type, u.Unknown)
+		}
+		return fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "boolean":
+		return visitor.VisitBoolean(u.Boolean)
+	case "string":
+		return visitor.VisitString(u.String)
+	}
+}
+
+type UnionWithUnknown struct {
+	Type           string
+	Foo            *Foo
+	Unknown        interface{}
+	UnknownVariant json.RawMessage
+}
+
+func NewUnionWithUnknownFromFoo(value *Foo) *UnionWithUnknown {
+	return &UnionWithUnknown{Type: "foo", Foo: value}
+}
+
+func NewUnionWithUnknownFromUnknown(value interface{}) *UnionWithUnknown {
+	return &UnionWithUnknown{Type: "unknown", Unknown: value}
+}
+
+func (u *UnionWithUnknown) GetType() string {
+	if u == nil {
+		return ""
+	}
+	return u.Type
+}
+
+func (u *UnionWithUnknown) GetFoo() *Foo {
+	if u == nil {
+		return nil
+	}
+	return u.Foo
+}
+
+func (u *UnionWithUnknown) GetUnknownVariant() json.RawMessage {
+	if u == nil {
+		return nil
+	}
+	return u.UnknownVariant
+}
+
+func (u *UnionWithUnknown) UnmarshalJSON(data []byte) error {
+	var unmarshaler struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	u.Type = unmarshaler.Type
+	switch unmarshaler.Type {
+	case "foo":
+		value := new(Foo)
+		if err := json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+		u.Foo = value
+	case "unknown":
+		value := make(map[string]interface{})
+		if err := json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+		u.Unknown = value
+	default:
+		u.UnknownVariant = append(json.RawMessage(nil), data...)
+	}
+	return nil
+}
+
+func (u UnionWithUnknown) MarshalJSON() ([]byte, error) {
+	switch u.Type {
+	default:
+		if u.UnknownVariant != nil {
+			return u.UnknownVariant, nil
+		}
+		return nil, fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "foo":
+		var marshaler = struct {
+			Type string `json:"type"`
+			*Foo
+		}{
+			Type: u.Type,
+			Foo:  u.Foo,
+		}
+		return json.Marshal(marshaler)
+	case "unknown":
+		var marshaler = struct {
+			Type    string      `json:"type"`
+			Unknown interface{} `json:"unknown,omitempty"`
+		}{
+			Type:    u.Type,
+			Unknown: u.Unknown,
+		}
+		return json.Marshal(marshaler)
+	}
+}
+
+type UnionWithUnknownVisitor interface {
+	VisitFoo(*Foo) error
+	VisitUnknown(interface{}) error
+	VisitUnknownVariant(string, json.RawMessage) error
+}
+
+func (u *UnionWithUnknown) Accept(visitor UnionWithUnknownVisitor) error {
+	switch u.Type {
+	default:
+		if u.UnknownVariant != nil {
+			return visitor.VisitUnknownVariant(u.Type, u.UnknownVariant)
+		}
+		return fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "foo":
+		return visitor.VisitFoo(u.Foo)
+	case "unknown":
+		return visitor.VisitUnknown(u.Unknown)
+	}
+}
+
+type UnionWithoutKey struct {
+	Type string
+	Foo  *Foo
+	// This is a bar field.
+	Bar     *Bar
+	Unknown json.RawMessage
+}
+
+func NewUnionWithoutKeyFromFoo(value *Foo) *UnionWithoutKey {
+	return &UnionWithoutKey{Type: "foo", Foo: value}
+}
+
+func NewUnionWithoutKeyFromBar(value *Bar) *UnionWithoutKey {
+	return &UnionWithoutKey{Type: "bar", Bar: value}
+}
+
+func (u *UnionWithoutKey) GetType() string {
+	if u == nil {
+		return ""
+	}
+	return u.Type
+}
+
+func (u *UnionWithoutKey) GetFoo() *Foo {
+	if u == nil {
+		return nil
+	}
+	return u.Foo
+}
+
+func (u *UnionWithoutKey) GetBar() *Bar {
+	if u == nil {
+		return nil
+	}
+	return u.Bar
+}
+
+func (u *UnionWithoutKey) GetUnknown() json.RawMessage {
+	if u == nil {
+		return nil
+	}
+	return u.Unknown
+}
+
+func (u *UnionWithoutKey) UnmarshalJSON(data []byte) error {
+	var unmarshaler struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	u.Type = unmarshaler.Type
+	switch unmarshaler.Type {
+	case "foo":
+		value := new(Foo)
+		if err := json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+		u.Foo = value
+	case "bar":
+		value := new(Bar)
+		if err := json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+		u.Bar = value
+	default:
+		u.Unknown = append(json.RawMessage(nil), data...)
+	}
+	return nil
+}
+
+func (u UnionWithoutKey) MarshalJSON() ([]byte, error) {
+	switch u.Type {
+	default:
+		if u.Unknown != nil {
+			return u.Unknown, nil
+		}
+		return nil, fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "foo":
+		var marshaler = struct {
+			Type string `json:"type"`
+			*Foo
+		}{
+			Type: u.Type,
+			Foo:  u.Foo,
+		}
+		return json.Marshal(marshaler)
+	case "bar":
+		var marshaler = struct {
+			Type string `json:"type"`
+			*Bar
+		}{
+			Type: u.Type,
+			Bar:  u.Bar,
+		}
+		return json.Marshal(marshaler)
+	}
+}
+
+type UnionWithoutKeyVisitor interface {
+	VisitFoo(*Foo) error
+	VisitBar(*Bar) error
+	VisitUnknown(string, json.RawMessage) error
+}
+
+func (u *UnionWithoutKey) Accept(visitor UnionWithoutKeyVisitor) error {
+	switch u.Type {
+	default:
+		if u.Unknown != nil {
+			return visitor.VisitUnknown(u.Type, u.Unknown)
+		}
+		return fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "foo":
+		return visitor.VisitFoo(u.Foo)
+	case "bar":
+		return visitor.VisitBar(u.Bar)
+	}
+}
@@ -15,6 +15,27 @@ type Foo struct {
 	Uuid uuid.UUID `json:"uuid"`
 }
 
+func (f *Foo) GetName() string {
+	if f == nil {
+		return ""
+	}
+	return f.Name
+}
+
+func (f *Foo) GetBar() *bar.Bar {
+	if f == nil {
+		return nil
+	}
+	return f.Bar
+}
+
+func (f *Foo) GetUuid() uuid.UUID {
+	if f == nil {
+		return uuid.Nil
+	}
+	return f.Uuid
+}
+
 func (f *Foo) String() string {
 	if value, err := core.StringifyJSON(f); err == nil {
 		return value
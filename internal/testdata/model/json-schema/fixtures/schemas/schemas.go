@@ -0,0 +1,19 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package schemas
+
+import (
+	embed "embed"
+)
+
+// FS contains the JSON Schema for every type, each of which is named
+// after the type and the package it's defined in (e.g. imdb.Movie.json).
+//
+//go:embed *.json
+var FS embed.FS
+
+// Schema returns the JSON Schema for the type with the given name, which
+// is qualified by the package it's defined in (e.g. imdb.Movie).
+func Schema(name string) ([]byte, error) {
+	return FS.ReadFile(name + ".json")
+}
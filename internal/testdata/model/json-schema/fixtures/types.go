@@ -0,0 +1,855 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package api
+
+import (
+	json "encoding/json"
+	fmt "fmt"
+	core "github.com/fern-api/fern-go/internal/testdata/model/json-schema/fixtures/core"
+	uuid "github.com/google/uuid"
+	time "time"
+)
+
+type Account struct {
+	Id        uuid.UUID            `json:"id"`
+	CreatedAt time.Time            `json:"createdAt"`
+	Name      string               `json:"name"`
+	Status    Status               `json:"status,omitempty"`
+	Tags      Tags                 `json:"tags,omitempty"`
+	Home      *core.Optional[Home] `json:"home,omitempty"`
+	History   []*Event             `json:"history,omitempty"`
+	Union     *Union               `json:"union,omitempty"`
+	Limits    map[Status]int       `json:"limits,omitempty"`
+	Metadata  interface{}          `json:"metadata,omitempty"`
+	kind      string
+}
+
+func (a *Account) Kind() string {
+	return a.kind
+}
+
+func (a *Account) GetId() uuid.UUID {
+	if a == nil {
+		return uuid.Nil
+	}
+	return a.Id
+}
+
+func (a *Account) GetCreatedAt() time.Time {
+	if a == nil {
+		return time.Time{}
+	}
+	return a.CreatedAt
+}
+
+func (a *Account) GetName() string {
+	if a == nil {
+		return ""
+	}
+	return a.Name
+}
+
+func (a *Account) GetStatus() Status {
+	if a == nil {
+		return ""
+	}
+	return a.Status
+}
+
+func (a *Account) GetTags() Tags {
+	if a == nil {
+		return nil
+	}
+	return a.Tags
+}
+
+func (a *Account) GetHome() Home {
+	if a == nil || a.Home == nil {
+		return nil
+	}
+	return a.Home.Value
+}
+
+func (a *Account) GetHistory() []*Event {
+	if a == nil {
+		return nil
+	}
+	return a.History
+}
+
+func (a *Account) GetUnion() *Union {
+	if a == nil {
+		return nil
+	}
+	return a.Union
+}
+
+func (a *Account) GetLimits() map[Status]int {
+	if a == nil {
+		return nil
+	}
+	return a.Limits
+}
+
+func (a *Account) GetMetadata() interface{} {
+	if a == nil {
+		return nil
+	}
+	return a.Metadata
+}
+
+func (a *Account) UnmarshalJSON(data []byte) error {
+	type embed Account
+	var unmarshaler = struct {
+		embed
+		Home json.RawMessage `json:"home"`
+	}{}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	*a = Account(unmarshaler.embed)
+	if unmarshaler.Home != nil {
+		a.Home = new(core.Optional[Home])
+		if err := json.Unmarshal(unmarshaler.Home, a.Home); err != nil {
+			return err
+		}
+	}
+	a.kind = "account"
+	return nil
+}
+
+func (a *Account) MarshalJSON() ([]byte, error) {
+	type embed Account
+	var marshaler = struct {
+		embed
+		Kind string `json:"kind"`
+	}{
+		embed: embed(*a),
+		Kind:  "account",
+	}
+	return json.Marshal(marshaler)
+}
+
+func (a *Account) String() string {
+	if value, err := core.StringifyJSON(a); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", a)
+}
+
+type Address struct {
+	Street string                 `json:"street"`
+	City   *core.Optional[string] `json:"city,omitempty"`
+}
+
+func (a *Address) GetStreet() string {
+	if a == nil {
+		return ""
+	}
+	return a.Street
+}
+
+func (a *Address) GetCity() string {
+	if a == nil || a.City == nil {
+		return ""
+	}
+	return a.City.Value
+}
+
+func (a *Address) UnmarshalJSON(data []byte) error {
+	type embed Address
+	var unmarshaler = struct {
+		embed
+		City json.RawMessage `json:"city"`
+	}{}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	*a = Address(unmarshaler.embed)
+	if unmarshaler.City != nil {
+		a.City = new(core.Optional[string])
+		if err := json.Unmarshal(unmarshaler.City, a.City); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *Address) String() string {
+	if value, err := core.StringifyJSON(a); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", a)
+}
+
+type AnotherUnion struct {
+	typeName      string
+	String        string
+	stringLiteral string
+	Foo           *Foo
+}
+
+func NewAnotherUnionFromString(value string) *AnotherUnion {
+	return &AnotherUnion{typeName: "string", String: value}
+}
+
+func NewAnotherUnionWithStringLiteral() *AnotherUnion {
+	return &AnotherUnion{typeName: "stringLiteral", stringLiteral: "fern"}
+}
+
+func NewAnotherUnionFromFoo(value *Foo) *AnotherUnion {
+	return &AnotherUnion{typeName: "foo", Foo: value}
+}
+
+func (a *AnotherUnion) StringLiteral() string {
+	return a.stringLiteral
+}
+
+func (a *AnotherUnion) GetString() string {
+	if a == nil {
+		return ""
+	}
+	return a.String
+}
+
+func (a *AnotherUnion) GetFoo() *Foo {
+	if a == nil {
+		return nil
+	}
+	return a.Foo
+}
+
+func (a *AnotherUnion) UnmarshalJSON(data []byte) error {
+	decoder := core.NewUnionDecoder(data)
+	var valueString string
+	if decoder.Decode("string", &valueString) {
+		a.typeName = "string"
+		a.String = valueString
+		return nil
+	}
+	if decoder.DecodeLiteral("stringLiteral", "fern") {
+		a.typeName = "stringLiteral"
+		a.stringLiteral = "fern"
+		return nil
+	}
+	valueFoo := new(Foo)
+	if decoder.DecodeObject("foo", &valueFoo, &core.ObjectFields{
+		Known:    []string{"name"},
+		Required: []string{"name"},
+	}) {
+		a.typeName = "foo"
+		a.Foo = valueFoo
+		return nil
+	}
+	return decoder.Error("AnotherUnion")
+}
+
+func (a AnotherUnion) MarshalJSON() ([]byte, error) {
+	switch a.typeName {
+	default:
+		return nil, fmt.Errorf("invalid type %s in %T", a.typeName, a)
+	case "string":
+		return json.Marshal(a.String)
+	case "stringLiteral":
+		return json.Marshal("fern")
+	case "foo":
+		return json.Marshal(a.Foo)
+	}
+}
+
+type AnotherUnionVisitor interface {
+	VisitString(string) error
+	VisitStringLiteral(string) error
+	VisitFoo(*Foo) error
+}
+
+func (a *AnotherUnion) Accept(visitor AnotherUnionVisitor) error {
+	switch a.typeName {
+	default:
+		return fmt.Errorf("invalid type %s in %T", a.typeName, a)
+	case "string":
+		return visitor.VisitString(a.String)
+	case "stringLiteral":
+		return visitor.VisitStringLiteral(a.stringLiteral)
+	case "foo":
+		return visitor.VisitFoo(a.Foo)
+	}
+}
+
+type Bar struct {
+	Name string `json:"name"`
+}
+
+func (b *Bar) GetName() string {
+	if b == nil {
+		return ""
+	}
+	return b.Name
+}
+
+func (b *Bar) String() string {
+	if value, err := core.StringifyJSON(b); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", b)
+}
+
+type Baz struct {
+	Id string `json:"id"`
+}
+
+func (b *Baz) GetId() string {
+	if b == nil {
+		return ""
+	}
+	return b.Id
+}
+
+func (b *Baz) String() string {
+	if value, err := core.StringifyJSON(b); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", b)
+}
+
+// Entity is extended by every stored type.
+type Entity struct {
+	Id        uuid.UUID `json:"id"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func (e *Entity) GetId() uuid.UUID {
+	if e == nil {
+		return uuid.Nil
+	}
+	return e.Id
+}
+
+func (e *Entity) GetCreatedAt() time.Time {
+	if e == nil {
+		return time.Time{}
+	}
+	return e.CreatedAt
+}
+
+func (e *Entity) String() string {
+	if value, err := core.StringifyJSON(e); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", e)
+}
+
+type Event struct {
+	Type    string
+	At      time.Time
+	Created Tags
+	Moved   *Address
+	Deleted interface{}
+}
+
+func NewEventFromCreated(value Tags) *Event {
+	return &Event{Type: "created", Created: value}
+}
+
+func NewEventFromMoved(value *Address) *Event {
+	return &Event{Type: "moved", Moved: value}
+}
+
+func NewEventFromDeleted(value interface{}) *Event {
+	return &Event{Type: "deleted", Deleted: value}
+}
+
+func (e *Event) GetType() string {
+	if e == nil {
+		return ""
+	}
+	return e.Type
+}
+
+func (e *Event) GetAt() time.Time {
+	if e == nil {
+		return time.Time{}
+	}
+	return e.At
+}
+
+func (e *Event) GetCreated() Tags {
+	if e == nil {
+		return nil
+	}
+	return e.Created
+}
+
+func (e *Event) GetMoved() *Address {
+	if e == nil {
+		return nil
+	}
+	return e.Moved
+}
+
+func (e *Event) UnmarshalJSON(data []byte) error {
+	var unmarshaler struct {
+		Type string    `json:"type"`
+		At   time.Time `json:"at"`
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	e.Type = unmarshaler.Type
+	e.At = unmarshaler.At
+	switch unmarshaler.Type {
+	case "created":
+		var valueUnmarshaler struct {
+			Created Tags `json:"tags,omitempty"`
+		}
+		if err := json.Unmarshal(data, &valueUnmarshaler); err != nil {
+			return err
+		}
+		e.Created = valueUnmarshaler.Created
+	case "moved":
+		value := new(Address)
+		if err := json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+		e.Moved = value
+	case "deleted":
+		value := make(map[string]interface{})
+		if err := json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+		e.Deleted = value
+	}
+	return nil
+}
+
+func (e Event) MarshalJSON() ([]byte, error) {
+	switch e.Type {
+	default:
+		return nil, fmt.Errorf("invalid type %s in %T", e.Type, e)
+	case "created":
+		var marshaler = struct {
+			Type    string    `json:"type"`
+			At      time.Time `json:"at"`
+			Created Tags      `json:"tags,omitempty"`
+		}{
+			Type:    e.Type,
+			At:      e.At,
+			Created: e.Created,
+		}
+		return json.Marshal(marshaler)
+	case "moved":
+		var marshaler = struct {
+			Type string    `json:"type"`
+			At   time.Time `json:"at"`
+			*Address
+		}{
+			Type:    e.Type,
+			At:      e.At,
+			Address: e.Moved,
+		}
+		return json.Marshal(marshaler)
+	case "deleted":
+		var marshaler = struct {
+			Type    string      `json:"type"`
+			At      time.Time   `json:"at"`
+			Deleted interface{} `json:"deleted,omitempty"`
+		}{
+			Type:    e.Type,
+			At:      e.At,
+			Deleted: e.Deleted,
+		}
+		return json.Marshal(marshaler)
+	}
+}
+
+type EventVisitor interface {
+	VisitCreated(Tags) error
+	VisitMoved(*Address) error
+	VisitDeleted(interface{}) error
+}
+
+func (e *Event) Accept(visitor EventVisitor) error {
+	switch e.Type {
+	default:
+		return fmt.Errorf("invalid type %s in %T", e.Type, e)
+	case "created":
+		return visitor.VisitCreated(e.Created)
+	case "moved":
+		return visitor.VisitMoved(e.Moved)
+	case "deleted":
+		return visitor.VisitDeleted(e.Deleted)
+	}
+}
+
+type Foo struct {
+	Name string `json:"name"`
+}
+
+func (f *Foo) GetName() string {
+	if f == nil {
+		return ""
+	}
+	return f.Name
+}
+
+func (f *Foo) String() string {
+	if value, err := core.StringifyJSON(f); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", f)
+}
+
+type Home = *Address
+
+type Status string
+
+const (
+	StatusActive   Status = "ACTIVE"
+	StatusInactive Status = "INACTIVE"
+)
+
+func NewStatusFromString(s string) (Status, error) {
+	switch s {
+	case "ACTIVE":
+		return StatusActive, nil
+	case "INACTIVE":
+		return StatusInactive, nil
+	}
+	var t Status
+	return "", fmt.Errorf("%s is not a valid %T", s, t)
+}
+
+func (s Status) Ptr() *Status {
+	return &s
+}
+
+type Tags = []string
+
+type Union struct {
+	typeName         string
+	Foo              *Foo
+	Bar              *Bar
+	Baz              *Baz
+	String           string
+	IntegerOptional  *int
+	StringBooleanMap map[string]bool
+	StringList       []string
+	StringListList   [][]string
+	DoubleSet        *core.Set[float64]
+	stringLiteral    string
+}
+
+func NewUnionFromFoo(value *Foo) *Union {
+	return &Union{typeName: "foo", Foo: value}
+}
+
+func NewUnionFromBar(value *Bar) *Union {
+	return &Union{typeName: "bar", Bar: value}
+}
+
+func NewUnionFromBaz(value *Baz) *Union {
+	return &Union{typeName: "baz", Baz: value}
+}
+
+func NewUnionFromString(value string) *Union {
+	return &Union{typeName: "string", String: value}
+}
+
+func NewUnionFromIntegerOptional(value *int) *Union {
+	return &Union{typeName: "integerOptional", IntegerOptional: value}
+}
+
+func NewUnionFromStringBooleanMap(value map[string]bool) *Union {
+	return &Union{typeName: "stringBooleanMap", StringBooleanMap: value}
+}
+
+func NewUnionFromStringList(value []string) *Union {
+	return &Union{typeName: "stringList", StringList: value}
+}
+
+func NewUnionFromStringListList(value [][]string) *Union {
+	return &Union{typeName: "stringListList", StringListList: value}
+}
+
+func NewUnionFromDoubleSet(value *core.Set[float64]) *Union {
+	return &Union{typeName: "doubleSet", DoubleSet: value}
+}
+
+func NewUnionWithStringLiteral() *Union {
+	return &Union{typeName: "stringLiteral", stringLiteral: "fern"}
+}
+
+func (u *Union) StringLiteral() string {
+	return u.stringLiteral
+}
+
+func (u *Union) GetFoo() *Foo {
+	if u == nil {
+		return nil
+	}
+	return u.Foo
+}
+
+func (u *Union) GetBar() *Bar {
+	if u == nil {
+		return nil
+	}
+	return u.Bar
+}
+
+func (u *Union) GetBaz() *Baz {
+	if u == nil {
+		return nil
+	}
+	return u.Baz
+}
+
+func (u *Union) GetString() string {
+	if u == nil {
+		return ""
+	}
+	return u.String
+}
+
+func (u *Union) GetIntegerOptional() int {
+	if u == nil || u.IntegerOptional == nil {
+		return 0
+	}
+	return *u.IntegerOptional
+}
+
+func (u *Union) GetStringBooleanMap() map[string]bool {
+	if u == nil {
+		return nil
+	}
+	return u.StringBooleanMap
+}
+
+func (u *Union) GetStringList() []string {
+	if u == nil {
+		return nil
+	}
+	return u.StringList
+}
+
+func (u *Union) GetStringListList() [][]string {
+	if u == nil {
+		return nil
+	}
+	return u.StringListList
+}
+
+func (u *Union) GetDoubleSet() *core.Set[float64] {
+	if u == nil {
+		return nil
+	}
+	return u.DoubleSet
+}
+
+func (u *Union) UnmarshalJSON(data []byte) error {
+	decoder := core.NewUnionDecoder(data)
+	valueFoo := new(Foo)
+	if decoder.DecodeObject("foo", &valueFoo, &core.ObjectFields{
+		Known:    []string{"name"},
+		Required: []string{"name"},
+	}) {
+		u.typeName = "foo"
+		u.Foo = valueFoo
+		return nil
+	}
+	valueBar := new(Bar)
+	if decoder.DecodeObject("bar", &valueBar, &core.ObjectFields{
+		Known:    []string{"name"},
+		Required: []string{"name"},
+	}) {
+		u.typeName = "bar"
+		u.Bar = valueBar
+		return nil
+	}
+	valueBaz := new(Baz)
+	if decoder.DecodeObject("baz", &valueBaz, &core.ObjectFields{
+		Known:    []string{"id"},
+		Required: []string{"id"},
+	}) {
+		u.typeName = "baz"
+		u.Baz = valueBaz
+		return nil
+	}
+	var valueString string
+	if decoder.Decode("string", &valueString) {
+		u.typeName = "string"
+		u.String = valueString
+		return nil
+	}
+	var valueIntegerOptional *int
+	if decoder.Decode("integerOptional", &valueIntegerOptional) {
+		u.typeName = "integerOptional"
+		u.IntegerOptional = valueIntegerOptional
+		return nil
+	}
+	var valueStringBooleanMap map[string]bool
+	if decoder.Decode("stringBooleanMap", &valueStringBooleanMap) {
+		u.typeName = "stringBooleanMap"
+		u.StringBooleanMap = valueStringBooleanMap
+		return nil
+	}
+	var valueStringList []string
+	if decoder.Decode("stringList", &valueStringList) {
+		u.typeName = "stringList"
+		u.StringList = valueStringList
+		return nil
+	}
+	var valueStringListList [][]string
+	if decoder.Decode("stringListList", &valueStringListList) {
+		u.typeName = "stringListList"
+		u.StringListList = valueStringListList
+		return nil
+	}
+	var valueDoubleSet *core.Set[float64]
+	if decoder.Decode("doubleSet", &valueDoubleSet) {
+		u.typeName = "doubleSet"
+		u.DoubleSet = valueDoubleSet
+		return nil
+	}
+	if decoder.DecodeLiteral("stringLiteral", "fern") {
+		u.typeName = "stringLiteral"
+		u.stringLiteral = "fern"
+		return nil
+	}
+	return decoder.Error("Union")
+}
+
+func (u Union) MarshalJSON() ([]byte, error) {
+	switch u.typeName {
+	default:
+		return nil, fmt.Errorf("invalid type %s in %T", u.typeName, u)
+	case "foo":
+		return json.Marshal(u.Foo)
+	case "bar":
+		return json.Marshal(u.Bar)
+	case "baz":
+		return json.Marshal(u.Baz)
+	case "string":
+		return json.Marshal(u.String)
+	case "integerOptional":
+		return json.Marshal(u.IntegerOptional)
+	case "stringBooleanMap":
+		return json.Marshal(u.StringBooleanMap)
+	case "stringList":
+		return json.Marshal(u.StringList)
+	case "stringListList":
+		return json.Marshal(u.StringListList)
+	case "doubleSet":
+		return json.Marshal(u.DoubleSet)
+	case "stringLiteral":
+		return json.Marshal("fern")
+	}
+}
+
+type UnionVisitor interface {
+	VisitFoo(*Foo) error
+	VisitBar(*Bar) error
+	VisitBaz(*Baz) error
+	VisitString(string) error
+	VisitIntegerOptional(*int) error
+	VisitStringBooleanMap(map[string]bool) error
+	VisitStringList([]string) error
+	VisitStringListList([][]string) error
+	VisitDoubleSet(*core.Set[float64]) error
+	VisitStringLiteral(string) error
+}
+
+func (u *Union) Accept(visitor UnionVisitor) error {
+	switch u.typeName {
+	default:
+		return fmt.Errorf("invalid type %s in %T", u.typeName, u)
+	case "foo":
+		return visitor.VisitFoo(u.Foo)
+	case "bar":
+		return visitor.VisitBar(u.Bar)
+	case "baz":
+		return visitor.VisitBaz(u.Baz)
+	case "string":
+		return visitor.VisitString(u.String)
+	case "integerOptional":
+		return visitor.VisitIntegerOptional(u.IntegerOptional)
+	case "stringBooleanMap":
+		return visitor.VisitStringBooleanMap(u.StringBooleanMap)
+	case "stringList":
+		return visitor.VisitStringList(u.StringList)
+	case "stringListList":
+		return visitor.VisitStringListList(u.StringListList)
+	case "doubleSet":
+		return visitor.VisitDoubleSet(u.DoubleSet)
+	case "stringLiteral":
+		return visitor.VisitStringLiteral(u.stringLiteral)
+	}
+}
+
+type UnionWithLiteral struct {
+	typeName      string
+	stringLiteral string
+	String        string
+}
+
+func NewUnionWithLiteralWithStringLiteral() *UnionWithLiteral {
+	return &UnionWithLiteral{typeName: "stringLiteral", stringLiteral: "fern"}
+}
+
+func NewUnionWithLiteralFromString(value string) *UnionWithLiteral {
+	return &UnionWithLiteral{typeName: "string", String: value}
+}
+
+func (u *UnionWithLiteral) StringLiteral() string {
+	return u.stringLiteral
+}
+
+func (u *UnionWithLiteral) GetString() string {
+	if u == nil {
+		return ""
+	}
+	return u.String
+}
+
+func (u *UnionWithLiteral) UnmarshalJSON(data []byte) error {
+	decoder := core.NewUnionDecoder(data)
+	if decoder.DecodeLiteral("stringLiteral", "fern") {
+		u.typeName = "stringLiteral"
+		u.stringLiteral = "fern"
+		return nil
+	}
+	var valueString string
+	if decoder.Decode("string", &valueString) {
+		u.typeName = "string"
+		u.String = valueString
+		return nil
+	}
+	return decoder.Error("UnionWithLiteral")
+}
+
+func (u UnionWithLiteral) MarshalJSON() ([]byte, error) {
+	switch u.typeName {
+	default:
+		return nil, fmt.Errorf("invalid type %s in %T", u.typeName, u)
+	case "stringLiteral":
+		return json.Marshal("fern")
+	case "string":
+		return json.Marshal(u.String)
+	}
+}
+
+type UnionWithLiteralVisitor interface {
+	VisitStringLiteral(string) error
+	VisitString(string) error
+}
+
+func (u *UnionWithLiteral) Accept(visitor UnionWithLiteralVisitor) error {
+	switch u.typeName {
+	default:
+		return fmt.Errorf("invalid type %s in %T", u.typeName, u)
+	case "stringLiteral":
+		return visitor.VisitStringLiteral(u.stringLiteral)
+	case "string":
+		return visitor.VisitString(u.String)
+	}
+}
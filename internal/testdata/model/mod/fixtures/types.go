@@ -13,6 +13,20 @@ type Foo struct {
 	Bar  *bar.Bar `json:"bar,omitempty"`
 }
 
+func (f *Foo) GetName() string {
+	if f == nil {
+		return ""
+	}
+	return f.Name
+}
+
+func (f *Foo) GetBar() *bar.Bar {
+	if f == nil {
+		return nil
+	}
+	return f.Bar
+}
+
 func (f *Foo) String() string {
 	if value, err := core.StringifyJSON(f); err == nil {
 		return value
@@ -0,0 +1,819 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package api
+
+import (
+	fmt "fmt"
+	core "github.com/fern-api/fern-go/internal/testdata/model/optional-fast-json/fixtures/core"
+	sort "sort"
+	strings "strings"
+)
+
+type Bar struct {
+	Name string `json:"name"`
+}
+
+func (b *Bar) GetName() string {
+	if b == nil {
+		return ""
+	}
+	return b.Name
+}
+
+func (b *Bar) UnmarshalJSON(data []byte) error {
+	reader := core.NewJSONReader(data)
+	b.UnmarshalJSONFrom(reader)
+	return reader.Done()
+}
+
+func (b *Bar) UnmarshalJSONFrom(reader *core.JSONReader) {
+	if reader.IsNull() {
+		return
+	}
+	*b = Bar{}
+	reader.ObjectStart()
+	for reader.More() {
+		key := reader.KeyBytes()
+		switch string(key) {
+		case "name":
+			b.Name = reader.String()
+		default:
+			switch {
+			case strings.EqualFold(string(key), "name"):
+				b.Name = reader.String()
+			default:
+				reader.Skip()
+			}
+		}
+	}
+	reader.ObjectEnd()
+}
+
+func (b *Bar) MarshalJSON() ([]byte, error) {
+	writer := core.NewJSONWriter()
+	b.MarshalJSONTo(writer)
+	return writer.Bytes()
+}
+
+func (b *Bar) MarshalJSONTo(writer *core.JSONWriter) {
+	if b == nil {
+		writer.Null()
+		return
+	}
+	writer.ObjectStart()
+	writer.Key("name")
+	writer.String(b.Name)
+	writer.ObjectEnd()
+}
+
+func (b *Bar) String() string {
+	if value, err := core.StringifyJSON(b); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", b)
+}
+
+type Base struct {
+	Id          string                 `json:"id"`
+	Description *core.Optional[string] `json:"description,omitempty"`
+}
+
+func (b *Base) GetId() string {
+	if b == nil {
+		return ""
+	}
+	return b.Id
+}
+
+func (b *Base) GetDescription() string {
+	if b == nil || b.Description == nil {
+		return ""
+	}
+	return b.Description.Value
+}
+
+func (b *Base) UnmarshalJSON(data []byte) error {
+	reader := core.NewJSONReader(data)
+	b.UnmarshalJSONFrom(reader)
+	return reader.Done()
+}
+
+func (b *Base) UnmarshalJSONFrom(reader *core.JSONReader) {
+	if reader.IsNull() {
+		return
+	}
+	*b = Base{}
+	reader.ObjectStart()
+	for reader.More() {
+		key := reader.KeyBytes()
+		switch string(key) {
+		case "id":
+			b.Id = reader.String()
+		case "description":
+			if reader.IsNull() {
+				b.Description = &core.Optional[string]{Null: true}
+			} else {
+				b.Description = new(core.Optional[string])
+				b.Description.Value = reader.String()
+			}
+		default:
+			switch {
+			case strings.EqualFold(string(key), "id"):
+				b.Id = reader.String()
+			case strings.EqualFold(string(key), "description"):
+				if reader.IsNull() {
+					b.Description = &core.Optional[string]{Null: true}
+				} else {
+					b.Description = new(core.Optional[string])
+					b.Description.Value = reader.String()
+				}
+			default:
+				reader.Skip()
+			}
+		}
+	}
+	reader.ObjectEnd()
+}
+
+func (b *Base) MarshalJSON() ([]byte, error) {
+	writer := core.NewJSONWriter()
+	b.MarshalJSONTo(writer)
+	return writer.Bytes()
+}
+
+func (b *Base) MarshalJSONTo(writer *core.JSONWriter) {
+	if b == nil {
+		writer.Null()
+		return
+	}
+	writer.ObjectStart()
+	writer.Key("id")
+	writer.String(b.Id)
+	if b.Description != nil {
+		writer.Key("description")
+		if b.Description.Null {
+			writer.Null()
+		} else {
+			writer.String(b.Description.Value)
+		}
+	}
+	writer.ObjectEnd()
+}
+
+func (b *Base) String() string {
+	if value, err := core.StringifyJSON(b); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", b)
+}
+
+type Enum string
+
+const (
+	// The first enum value.
+	EnumOne   Enum = "ONE"
+	EnumTwo   Enum = "TWO"
+	EnumThree Enum = "THREE"
+)
+
+func NewEnumFromString(s string) (Enum, error) {
+	switch s {
+	case "ONE":
+		return EnumOne, nil
+	case "TWO":
+		return EnumTwo, nil
+	case "THREE":
+		return EnumThree, nil
+	}
+	var t Enum
+	return "", fmt.Errorf("%s is not a valid %T", s, t)
+}
+
+func (e Enum) Ptr() *Enum {
+	return &e
+}
+
+func (e Enum) MarshalJSON() ([]byte, error) {
+	writer := core.NewJSONWriter()
+	writer.String(string(e))
+	return writer.Bytes()
+}
+
+func (e *Enum) UnmarshalJSON(data []byte) error {
+	reader := core.NewJSONReader(data)
+	if !reader.IsNull() {
+		*e = Enum(reader.String())
+	}
+	return reader.Done()
+}
+
+type Foo struct {
+	Name string `json:"name"`
+}
+
+func (f *Foo) GetName() string {
+	if f == nil {
+		return ""
+	}
+	return f.Name
+}
+
+func (f *Foo) UnmarshalJSON(data []byte) error {
+	reader := core.NewJSONReader(data)
+	f.UnmarshalJSONFrom(reader)
+	return reader.Done()
+}
+
+func (f *Foo) UnmarshalJSONFrom(reader *core.JSONReader) {
+	if reader.IsNull() {
+		return
+	}
+	*f = Foo{}
+	reader.ObjectStart()
+	for reader.More() {
+		key := reader.KeyBytes()
+		switch string(key) {
+		case "name":
+			f.Name = reader.String()
+		default:
+			switch {
+			case strings.EqualFold(string(key), "name"):
+				f.Name = reader.String()
+			default:
+				reader.Skip()
+			}
+		}
+	}
+	reader.ObjectEnd()
+}
+
+func (f *Foo) MarshalJSON() ([]byte, error) {
+	writer := core.NewJSONWriter()
+	f.MarshalJSONTo(writer)
+	return writer.Bytes()
+}
+
+func (f *Foo) MarshalJSONTo(writer *core.JSONWriter) {
+	if f == nil {
+		writer.Null()
+		return
+	}
+	writer.ObjectStart()
+	writer.Key("name")
+	writer.String(f.Name)
+	writer.ObjectEnd()
+}
+
+func (f *Foo) String() string {
+	if value, err := core.StringifyJSON(f); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", f)
+}
+
+type Profile struct {
+	Id          string                                 `json:"id"`
+	Description *core.Optional[string]                 `json:"description,omitempty"`
+	Name        string                                 `json:"name"`
+	Nickname    *core.Optional[string]                 `json:"nickname,omitempty"`
+	Age         *core.Optional[int]                    `json:"age,omitempty"`
+	Enum        *core.Optional[Enum]                   `json:"enum,omitempty"`
+	Foo         *core.Optional[Foo]                    `json:"foo,omitempty"`
+	Union       *core.Optional[Union]                  `json:"union,omitempty"`
+	Tags        *core.Optional[[]string]               `json:"tags,omitempty"`
+	Metadata    *core.Optional[map[string]interface{}] `json:"metadata,omitempty"`
+	kind        string
+}
+
+func (p *Profile) Kind() string {
+	return p.kind
+}
+
+func (p *Profile) GetId() string {
+	if p == nil {
+		return ""
+	}
+	return p.Id
+}
+
+func (p *Profile) GetDescription() string {
+	if p == nil || p.Description == nil {
+		return ""
+	}
+	return p.Description.Value
+}
+
+func (p *Profile) GetName() string {
+	if p == nil {
+		return ""
+	}
+	return p.Name
+}
+
+func (p *Profile) GetNickname() string {
+	if p == nil || p.Nickname == nil {
+		return ""
+	}
+	return p.Nickname.Value
+}
+
+func (p *Profile) GetAge() int {
+	if p == nil || p.Age == nil {
+		return 0
+	}
+	return p.Age.Value
+}
+
+func (p *Profile) GetEnum() Enum {
+	if p == nil || p.Enum == nil {
+		return ""
+	}
+	return p.Enum.Value
+}
+
+func (p *Profile) GetFoo() *Foo {
+	if p == nil || p.Foo == nil {
+		return nil
+	}
+	return &p.Foo.Value
+}
+
+func (p *Profile) GetUnion() *Union {
+	if p == nil || p.Union == nil {
+		return nil
+	}
+	return &p.Union.Value
+}
+
+func (p *Profile) GetTags() []string {
+	if p == nil || p.Tags == nil {
+		return nil
+	}
+	return p.Tags.Value
+}
+
+func (p *Profile) GetMetadata() map[string]interface{} {
+	if p == nil || p.Metadata == nil {
+		return nil
+	}
+	return p.Metadata.Value
+}
+
+func (p *Profile) UnmarshalJSON(data []byte) error {
+	reader := core.NewJSONReader(data)
+	p.UnmarshalJSONFrom(reader)
+	return reader.Done()
+}
+
+func (p *Profile) UnmarshalJSONFrom(reader *core.JSONReader) {
+	if reader.IsNull() {
+		return
+	}
+	*p = Profile{}
+	reader.ObjectStart()
+	for reader.More() {
+		key := reader.KeyBytes()
+		switch string(key) {
+		case "id":
+			p.Id = reader.String()
+		case "description":
+			if reader.IsNull() {
+				p.Description = &core.Optional[string]{Null: true}
+			} else {
+				p.Description = new(core.Optional[string])
+				p.Description.Value = reader.String()
+			}
+		case "name":
+			p.Name = reader.String()
+		case "nickname":
+			if reader.IsNull() {
+				p.Nickname = &core.Optional[string]{Null: true}
+			} else {
+				p.Nickname = new(core.Optional[string])
+				p.Nickname.Value = reader.String()
+			}
+		case "age":
+			if reader.IsNull() {
+				p.Age = &core.Optional[int]{Null: true}
+			} else {
+				p.Age = new(core.Optional[int])
+				p.Age.Value = reader.Int()
+			}
+		case "enum":
+			if reader.IsNull() {
+				p.Enum = &core.Optional[Enum]{Null: true}
+			} else {
+				p.Enum = new(core.Optional[Enum])
+				p.Enum.Value = Enum(reader.String())
+			}
+		case "foo":
+			if reader.IsNull() {
+				p.Foo = &core.Optional[Foo]{Null: true}
+			} else {
+				p.Foo = new(core.Optional[Foo])
+				p.Foo.Value.UnmarshalJSONFrom(reader)
+			}
+		case "union":
+			if reader.IsNull() {
+				p.Union = &core.Optional[Union]{Null: true}
+			} else {
+				p.Union = new(core.Optional[Union])
+				p.Union.Value.UnmarshalJSONFrom(reader)
+			}
+		case "tags":
+			if reader.IsNull() {
+				p.Tags = &core.Optional[[]string]{Null: true}
+			} else {
+				p.Tags = new(core.Optional[[]string])
+				if reader.IsNull() {
+					p.Tags.Value = nil
+				} else {
+					p.Tags.Value = make([]string, 0)
+					reader.ArrayStart()
+					for reader.More() {
+						var elem0 string
+						elem0 = reader.String()
+						p.Tags.Value = append(p.Tags.Value, elem0)
+					}
+					reader.ArrayEnd()
+				}
+			}
+		case "metadata":
+			if reader.IsNull() {
+				p.Metadata = &core.Optional[map[string]interface{}]{Null: true}
+			} else {
+				p.Metadata = new(core.Optional[map[string]interface{}])
+				if reader.IsNull() {
+					p.Metadata.Value = nil
+				} else {
+					p.Metadata.Value = make(map[string]interface{})
+					reader.ObjectStart()
+					for reader.More() {
+						key0 := reader.Key()
+						var value0 interface{}
+						reader.Value(&value0)
+						p.Metadata.Value[key0] = value0
+					}
+					reader.ObjectEnd()
+				}
+			}
+		default:
+			switch {
+			case strings.EqualFold(string(key), "id"):
+				p.Id = reader.String()
+			case strings.EqualFold(string(key), "description"):
+				if reader.IsNull() {
+					p.Description = &core.Optional[string]{Null: true}
+				} else {
+					p.Description = new(core.Optional[string])
+					p.Description.Value = reader.String()
+				}
+			case strings.EqualFold(string(key), "name"):
+				p.Name = reader.String()
+			case strings.EqualFold(string(key), "nickname"):
+				if reader.IsNull() {
+					p.Nickname = &core.Optional[string]{Null: true}
+				} else {
+					p.Nickname = new(core.Optional[string])
+					p.Nickname.Value = reader.String()
+				}
+			case strings.EqualFold(string(key), "age"):
+				if reader.IsNull() {
+					p.Age = &core.Optional[int]{Null: true}
+				} else {
+					p.Age = new(core.Optional[int])
+					p.Age.Value = reader.Int()
+				}
+			case strings.EqualFold(string(key), "enum"):
+				if reader.IsNull() {
+					p.Enum = &core.Optional[Enum]{Null: true}
+				} else {
+					p.Enum = new(core.Optional[Enum])
+					p.Enum.Value = Enum(reader.String())
+				}
+			case strings.EqualFold(string(key), "foo"):
+				if reader.IsNull() {
+					p.Foo = &core.Optional[Foo]{Null: true}
+				} else {
+					p.Foo = new(core.Optional[Foo])
+					p.Foo.Value.UnmarshalJSONFrom(reader)
+				}
+			case strings.EqualFold(string(key), "union"):
+				if reader.IsNull() {
+					p.Union = &core.Optional[Union]{Null: true}
+				} else {
+					p.Union = new(core.Optional[Union])
+					p.Union.Value.UnmarshalJSONFrom(reader)
+				}
+			case strings.EqualFold(string(key), "tags"):
+				if reader.IsNull() {
+					p.Tags = &core.Optional[[]string]{Null: true}
+				} else {
+					p.Tags = new(core.Optional[[]string])
+					if reader.IsNull() {
+						p.Tags.Value = nil
+					} else {
+						p.Tags.Value = make([]string, 0)
+						reader.ArrayStart()
+						for reader.More() {
+							var elem0 string
+							elem0 = reader.String()
+							p.Tags.Value = append(p.Tags.Value, elem0)
+						}
+						reader.ArrayEnd()
+					}
+				}
+			case strings.EqualFold(string(key), "metadata"):
+				if reader.IsNull() {
+					p.Metadata = &core.Optional[map[string]interface{}]{Null: true}
+				} else {
+					p.Metadata = new(core.Optional[map[string]interface{}])
+					if reader.IsNull() {
+						p.Metadata.Value = nil
+					} else {
+						p.Metadata.Value = make(map[string]interface{})
+						reader.ObjectStart()
+						for reader.More() {
+							key0 := reader.Key()
+							var value0 interface{}
+							reader.Value(&value0)
+							p.Metadata.Value[key0] = value0
+						}
+						reader.ObjectEnd()
+					}
+				}
+			default:
+				reader.Skip()
+			}
+		}
+	}
+	reader.ObjectEnd()
+	p.kind = "profile"
+}
+
+func (p *Profile) MarshalJSON() ([]byte, error) {
+	writer := core.NewJSONWriter()
+	p.MarshalJSONTo(writer)
+	return writer.Bytes()
+}
+
+func (p *Profile) MarshalJSONTo(writer *core.JSONWriter) {
+	if p == nil {
+		writer.Null()
+		return
+	}
+	writer.ObjectStart()
+	writer.Key("id")
+	writer.String(p.Id)
+	if p.Description != nil {
+		writer.Key("description")
+		if p.Description.Null {
+			writer.Null()
+		} else {
+			writer.String(p.Description.Value)
+		}
+	}
+	writer.Key("name")
+	writer.String(p.Name)
+	if p.Nickname != nil {
+		writer.Key("nickname")
+		if p.Nickname.Null {
+			writer.Null()
+		} else {
+			writer.String(p.Nickname.Value)
+		}
+	}
+	if p.Age != nil {
+		writer.Key("age")
+		if p.Age.Null {
+			writer.Null()
+		} else {
+			writer.Int(p.Age.Value)
+		}
+	}
+	if p.Enum != nil {
+		writer.Key("enum")
+		if p.Enum.Null {
+			writer.Null()
+		} else {
+			writer.String(string(p.Enum.Value))
+		}
+	}
+	if p.Foo != nil {
+		writer.Key("foo")
+		if p.Foo.Null {
+			writer.Null()
+		} else {
+			p.Foo.Value.MarshalJSONTo(writer)
+		}
+	}
+	if p.Union != nil {
+		writer.Key("union")
+		if p.Union.Null {
+			writer.Null()
+		} else {
+			p.Union.Value.MarshalJSONTo(writer)
+		}
+	}
+	if p.Tags != nil {
+		writer.Key("tags")
+		if p.Tags.Null {
+			writer.Null()
+		} else {
+			if p.Tags.Value == nil {
+				writer.Null()
+			} else {
+				writer.ArrayStart()
+				for _, elem0 := range p.Tags.Value {
+					writer.String(elem0)
+				}
+				writer.ArrayEnd()
+			}
+		}
+	}
+	if p.Metadata != nil {
+		writer.Key("metadata")
+		if p.Metadata.Null {
+			writer.Null()
+		} else {
+			if p.Metadata.Value == nil {
+				writer.Null()
+			} else {
+				keys0 := make([]string, 0, len(p.Metadata.Value))
+				for key0 := range p.Metadata.Value {
+					keys0 = append(keys0, key0)
+				}
+				sort.Strings(keys0)
+				writer.ObjectStart()
+				for _, key0 := range keys0 {
+					writer.Key(key0)
+					writer.Value(p.Metadata.Value[key0])
+				}
+				writer.ObjectEnd()
+			}
+		}
+	}
+	writer.Key("kind")
+	writer.String("profile")
+	writer.ObjectEnd()
+}
+
+func (p *Profile) String() string {
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// This is a simple union.
+type Union struct {
+	Type string
+	Foo  *Foo
+	Bar  *Bar
+}
+
+func NewUnionFromFoo(value *Foo) *Union {
+	return &Union{Type: "foo", Foo: value}
+}
+
+func NewUnionFromBar(value *Bar) *Union {
+	return &Union{Type: "bar", Bar: value}
+}
+
+func (u *Union) GetType() string {
+	if u == nil {
+		return ""
+	}
+	return u.Type
+}
+
+func (u *Union) GetFoo() *Foo {
+	if u == nil {
+		return nil
+	}
+	return u.Foo
+}
+
+func (u *Union) GetBar() *Bar {
+	if u == nil {
+		return nil
+	}
+	return u.Bar
+}
+
+func (u *Union) UnmarshalJSON(data []byte) error {
+	reader := core.NewJSONReader(data)
+	u.UnmarshalJSONFrom(reader)
+	return reader.Done()
+}
+
+func (u *Union) UnmarshalJSONFrom(reader *core.JSONReader) {
+	if reader.IsNull() {
+		return
+	}
+	data := reader.Raw()
+	if reader.Err() != nil {
+		return
+	}
+	unmarshaler := core.NewJSONReader(data)
+	unmarshaler.ObjectStart()
+	for unmarshaler.More() {
+		key := unmarshaler.KeyBytes()
+		switch string(key) {
+		case "type":
+			u.Type = unmarshaler.String()
+		default:
+			switch {
+			case strings.EqualFold(string(key), "type"):
+				u.Type = unmarshaler.String()
+			default:
+				unmarshaler.Skip()
+			}
+		}
+	}
+	unmarshaler.ObjectEnd()
+	reader.SetErr(unmarshaler.Err())
+	switch u.Type {
+	case "foo":
+		valueUnmarshaler := core.NewJSONReader(data)
+		valueUnmarshaler.ObjectStart()
+		for valueUnmarshaler.More() {
+			if !strings.EqualFold(string(valueUnmarshaler.KeyBytes()), "foo") {
+				valueUnmarshaler.Skip()
+				continue
+			}
+			if valueUnmarshaler.IsNull() {
+				u.Foo = nil
+			} else {
+				u.Foo = new(Foo)
+				u.Foo.UnmarshalJSONFrom(valueUnmarshaler)
+			}
+		}
+		valueUnmarshaler.ObjectEnd()
+		reader.SetErr(valueUnmarshaler.Err())
+	case "bar":
+		valueUnmarshaler := core.NewJSONReader(data)
+		valueUnmarshaler.ObjectStart()
+		for valueUnmarshaler.More() {
+			if !strings.EqualFold(string(valueUnmarshaler.KeyBytes()), "bar") {
+				valueUnmarshaler.Skip()
+				continue
+			}
+			if valueUnmarshaler.IsNull() {
+				u.Bar = nil
+			} else {
+				u.Bar = new(Bar)
+				u.Bar.UnmarshalJSONFrom(valueUnmarshaler)
+			}
+		}
+		valueUnmarshaler.ObjectEnd()
+		reader.SetErr(valueUnmarshaler.Err())
+	}
+}
+
+func (u Union) MarshalJSON() ([]byte, error) {
+	writer := core.NewJSONWriter()
+	u.MarshalJSONTo(writer)
+	return writer.Bytes()
+}
+
+func (u Union) MarshalJSONTo(writer *core.JSONWriter) {
+	switch u.Type {
+	default:
+		writer.SetErr(fmt.Errorf("invalid type %s in %T", u.Type, u))
+	case "foo":
+		writer.ObjectStart()
+		writer.Key("type")
+		writer.String(u.Type)
+		if u.Foo != nil {
+			writer.Key("foo")
+			u.Foo.MarshalJSONTo(writer)
+		}
+		writer.ObjectEnd()
+	case "bar":
+		writer.ObjectStart()
+		writer.Key("type")
+		writer.String(u.Type)
+		if u.Bar != nil {
+			writer.Key("bar")
+			u.Bar.MarshalJSONTo(writer)
+		}
+		writer.ObjectEnd()
+	}
+}
+
+type UnionVisitor interface {
+	VisitFoo(*Foo) error
+	VisitBar(*Bar) error
+}
+
+func (u *Union) Accept(visitor UnionVisitor) error {
+	switch u.Type {
+	default:
+		return fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "foo":
+		return visitor.VisitFoo(u.Foo)
+	case "bar":
+		return visitor.VisitBar(u.Bar)
+	}
+}
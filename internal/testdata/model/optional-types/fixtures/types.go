@@ -0,0 +1,398 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package api
+
+import (
+	json "encoding/json"
+	fmt "fmt"
+	core "github.com/fern-api/fern-go/internal/testdata/model/optional-types/fixtures/core"
+)
+
+type Bar struct {
+	Name string `json:"name"`
+}
+
+func (b *Bar) GetName() string {
+	if b == nil {
+		return ""
+	}
+	return b.Name
+}
+
+func (b *Bar) String() string {
+	if value, err := core.StringifyJSON(b); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", b)
+}
+
+type Base struct {
+	Id          string                 `json:"id"`
+	Description *core.Optional[string] `json:"description,omitempty"`
+}
+
+func (b *Base) GetId() string {
+	if b == nil {
+		return ""
+	}
+	return b.Id
+}
+
+func (b *Base) GetDescription() string {
+	if b == nil || b.Description == nil {
+		return ""
+	}
+	return b.Description.Value
+}
+
+func (b *Base) UnmarshalJSON(data []byte) error {
+	type embed Base
+	var unmarshaler = struct {
+		embed
+		Description json.RawMessage `json:"description"`
+	}{}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	*b = Base(unmarshaler.embed)
+	if unmarshaler.Description != nil {
+		b.Description = new(core.Optional[string])
+		if err := json.Unmarshal(unmarshaler.Description, b.Description); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *Base) String() string {
+	if value, err := core.StringifyJSON(b); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", b)
+}
+
+type Enum string
+
+const (
+	// The first enum value.
+	EnumOne   Enum = "ONE"
+	EnumTwo   Enum = "TWO"
+	EnumThree Enum = "THREE"
+)
+
+func NewEnumFromString(s string) (Enum, error) {
+	switch s {
+	case "ONE":
+		return EnumOne, nil
+	case "TWO":
+		return EnumTwo, nil
+	case "THREE":
+		return EnumThree, nil
+	}
+	var t Enum
+	return "", fmt.Errorf("%s is not a valid %T", s, t)
+}
+
+func (e Enum) Ptr() *Enum {
+	return &e
+}
+
+type Foo struct {
+	Name string `json:"name"`
+}
+
+func (f *Foo) GetName() string {
+	if f == nil {
+		return ""
+	}
+	return f.Name
+}
+
+func (f *Foo) String() string {
+	if value, err := core.StringifyJSON(f); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", f)
+}
+
+type Profile struct {
+	Id          string                                 `json:"id"`
+	Description *core.Optional[string]                 `json:"description,omitempty"`
+	Name        string                                 `json:"name"`
+	Nickname    *core.Optional[string]                 `json:"nickname,omitempty"`
+	Age         *core.Optional[int]                    `json:"age,omitempty"`
+	Enum        *core.Optional[Enum]                   `json:"enum,omitempty"`
+	Foo         *core.Optional[Foo]                    `json:"foo,omitempty"`
+	Union       *core.Optional[Union]                  `json:"union,omitempty"`
+	Tags        *core.Optional[[]string]               `json:"tags,omitempty"`
+	Metadata    *core.Optional[map[string]interface{}] `json:"metadata,omitempty"`
+	kind        string
+}
+
+func (p *Profile) Kind() string {
+	return p.kind
+}
+
+func (p *Profile) GetId() string {
+	if p == nil {
+		return ""
+	}
+	return p.Id
+}
+
+func (p *Profile) GetDescription() string {
+	if p == nil || p.Description == nil {
+		return ""
+	}
+	return p.Description.Value
+}
+
+func (p *Profile) GetName() string {
+	if p == nil {
+		return ""
+	}
+	return p.Name
+}
+
+func (p *Profile) GetNickname() string {
+	if p == nil || p.Nickname == nil {
+		return ""
+	}
+	return p.Nickname.Value
+}
+
+func (p *Profile) GetAge() int {
+	if p == nil || p.Age == nil {
+		return 0
+	}
+	return p.Age.Value
+}
+
+func (p *Profile) GetEnum() Enum {
+	if p == nil || p.Enum == nil {
+		return ""
+	}
+	return p.Enum.Value
+}
+
+func (p *Profile) GetFoo() *Foo {
+	if p == nil || p.Foo == nil {
+		return nil
+	}
+	return &p.Foo.Value
+}
+
+func (p *Profile) GetUnion() *Union {
+	if p == nil || p.Union == nil {
+		return nil
+	}
+	return &p.Union.Value
+}
+
+func (p *Profile) GetTags() []string {
+	if p == nil || p.Tags == nil {
+		return nil
+	}
+	return p.Tags.Value
+}
+
+func (p *Profile) GetMetadata() map[string]interface{} {
+	if p == nil || p.Metadata == nil {
+		return nil
+	}
+	return p.Metadata.Value
+}
+
+func (p *Profile) UnmarshalJSON(data []byte) error {
+	type embed Profile
+	var unmarshaler = struct {
+		embed
+		Description json.RawMessage `json:"description"`
+		Nickname    json.RawMessage `json:"nickname"`
+		Age         json.RawMessage `json:"age"`
+		Enum        json.RawMessage `json:"enum"`
+		Foo         json.RawMessage `json:"foo"`
+		Union       json.RawMessage `json:"union"`
+		Tags        json.RawMessage `json:"tags"`
+		Metadata    json.RawMessage `json:"metadata"`
+	}{}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	*p = Profile(unmarshaler.embed)
+	if unmarshaler.Description != nil {
+		p.Description = new(core.Optional[string])
+		if err := json.Unmarshal(unmarshaler.Description, p.Description); err != nil {
+			return err
+		}
+	}
+	if unmarshaler.Nickname != nil {
+		p.Nickname = new(core.Optional[string])
+		if err := json.Unmarshal(unmarshaler.Nickname, p.Nickname); err != nil {
+			return err
+		}
+	}
+	if unmarshaler.Age != nil {
+		p.Age = new(core.Optional[int])
+		if err := json.Unmarshal(unmarshaler.Age, p.Age); err != nil {
+			return err
+		}
+	}
+	if unmarshaler.Enum != nil {
+		p.Enum = new(core.Optional[Enum])
+		if err := json.Unmarshal(unmarshaler.Enum, p.Enum); err != nil {
+			return err
+		}
+	}
+	if unmarshaler.Foo != nil {
+		p.Foo = new(core.Optional[Foo])
+		if err := json.Unmarshal(unmarshaler.Foo, p.Foo); err != nil {
+			return err
+		}
+	}
+	if unmarshaler.Union != nil {
+		p.Union = new(core.Optional[Union])
+		if err := json.Unmarshal(unmarshaler.Union, p.Union); err != nil {
+			return err
+		}
+	}
+	if unmarshaler.Tags != nil {
+		p.Tags = new(core.Optional[[]string])
+		if err := json.Unmarshal(unmarshaler.Tags, p.Tags); err != nil {
+			return err
+		}
+	}
+	if unmarshaler.Metadata != nil {
+		p.Metadata = new(core.Optional[map[string]interface{}])
+		if err := json.Unmarshal(unmarshaler.Metadata, p.Metadata); err != nil {
+			return err
+		}
+	}
+	p.kind = "profile"
+	return nil
+}
+
+func (p *Profile) MarshalJSON() ([]byte, error) {
+	type embed Profile
+	var marshaler = struct {
+		embed
+		Kind string `json:"kind"`
+	}{
+		embed: embed(*p),
+		Kind:  "profile",
+	}
+	return json.Marshal(marshaler)
+}
+
+func (p *Profile) String() string {
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// This is a simple union.
+type Union struct {
+	Type string
+	Foo  *Foo
+	Bar  *Bar
+}
+
+func NewUnionFromFoo(value *Foo) *Union {
+	return &Union{Type: "foo", Foo: value}
+}
+
+func NewUnionFromBar(value *Bar) *Union {
+	return &Union{Type: "bar", Bar: value}
+}
+
+func (u *Union) GetType() string {
+	if u == nil {
+		return ""
+	}
+	return u.Type
+}
+
+func (u *Union) GetFoo() *Foo {
+	if u == nil {
+		return nil
+	}
+	return u.Foo
+}
+
+func (u *Union) GetBar() *Bar {
+	if u == nil {
+		return nil
+	}
+	return u.Bar
+}
+
+func (u *Union) UnmarshalJSON(data []byte) error {
+	var unmarshaler struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	u.Type = unmarshaler.Type
+	switch unmarshaler.Type {
+	case "foo":
+		var valueUnmarshaler struct {
+			Foo *Foo `json:"foo,omitempty"`
+		}
+		if err := json.Unmarshal(data, &valueUnmarshaler); err != nil {
+			return err
+		}
+		u.Foo = valueUnmarshaler.Foo
+	case "bar":
+		var valueUnmarshaler struct {
+			Bar *Bar `json:"bar,omitempty"`
+		}
+		if err := json.Unmarshal(data, &valueUnmarshaler); err != nil {
+			return err
+		}
+		u.Bar = valueUnmarshaler.Bar
+	}
+	return nil
+}
+
+func (u Union) MarshalJSON() ([]byte, error) {
+	switch u.Type {
+	default:
+		return nil, fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "foo":
+		var marshaler = struct {
+			Type string `json:"type"`
+			Foo  *Foo   `json:"foo,omitempty"`
+		}{
+			Type: u.Type,
+			Foo:  u.Foo,
+		}
+		return json.Marshal(marshaler)
+	case "bar":
+		var marshaler = struct {
+			Type string `json:"type"`
+			Bar  *Bar   `json:"bar,omitempty"`
+		}{
+			Type: u.Type,
+			Bar:  u.Bar,
+		}
+		return json.Marshal(marshaler)
+	}
+}
+
+type UnionVisitor interface {
+	VisitFoo(*Foo) error
+	VisitBar(*Bar) error
+}
+
+func (u *Union) Accept(visitor UnionVisitor) error {
+	switch u.Type {
+	default:
+		return fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "foo":
+		return visitor.VisitFoo(u.Foo)
+	case "bar":
+		return visitor.VisitBar(u.Bar)
+	}
+}
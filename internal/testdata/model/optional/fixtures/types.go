@@ -12,6 +12,20 @@ type AnotherType struct {
 	Type   *Type   `json:"type,omitempty"`
 }
 
+func (a *AnotherType) GetString() string {
+	if a == nil || a.String == nil {
+		return ""
+	}
+	return *a.String
+}
+
+func (a *AnotherType) GetType() *Type {
+	if a == nil {
+		return nil
+	}
+	return a.Type
+}
+
 func (a *AnotherType) String() string {
 	if value, err := core.StringifyJSON(a); err == nil {
 		return value
@@ -23,6 +37,13 @@ type Type struct {
 	Name string `json:"name"`
 }
 
+func (t *Type) GetName() string {
+	if t == nil {
+		return ""
+	}
+	return t.Name
+}
+
 func (t *Type) String() string {
 	if value, err := core.StringifyJSON(t); err == nil {
 		return value
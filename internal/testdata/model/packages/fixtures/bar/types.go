@@ -11,6 +11,13 @@ type Bar struct {
 	Name string `json:"name"`
 }
 
+func (b *Bar) GetName() string {
+	if b == nil {
+		return ""
+	}
+	return b.Name
+}
+
 func (b *Bar) String() string {
 	if value, err := core.StringifyJSON(b); err == nil {
 		return value
@@ -11,6 +11,13 @@ type Baz struct {
 	Name string `json:"name"`
 }
 
+func (b *Baz) GetName() string {
+	if b == nil {
+		return ""
+	}
+	return b.Name
+}
+
 func (b *Baz) String() string {
 	if value, err := core.StringifyJSON(b); err == nil {
 		return value
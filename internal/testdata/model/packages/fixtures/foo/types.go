@@ -12,6 +12,13 @@ type Foo struct {
 	Foo *fixtures.Foo `json:"foo,omitempty"`
 }
 
+func (f *Foo) GetFoo() *fixtures.Foo {
+	if f == nil {
+		return nil
+	}
+	return f.Foo
+}
+
 func (f *Foo) String() string {
 	if value, err := core.StringifyJSON(f); err == nil {
 		return value
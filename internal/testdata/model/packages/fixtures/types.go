@@ -13,6 +13,13 @@ type Base struct {
 	Name string `json:"name"`
 }
 
+func (b *Base) GetName() string {
+	if b == nil {
+		return ""
+	}
+	return b.Name
+}
+
 func (b *Base) String() string {
 	if value, err := core.StringifyJSON(b); err == nil {
 		return value
@@ -24,6 +31,13 @@ type Value struct {
 	Name string `json:"name"`
 }
 
+func (v *Value) GetName() string {
+	if v == nil {
+		return ""
+	}
+	return v.Name
+}
+
 func (v *Value) String() string {
 	if value, err := core.StringifyJSON(v); err == nil {
 		return value
@@ -37,6 +51,27 @@ type Foo struct {
 	Bar   *bar.Bar `json:"bar,omitempty"`
 }
 
+func (f *Foo) GetName() string {
+	if f == nil {
+		return ""
+	}
+	return f.Name
+}
+
+func (f *Foo) GetValue() *Value {
+	if f == nil {
+		return nil
+	}
+	return f.Value
+}
+
+func (f *Foo) GetBar() *bar.Bar {
+	if f == nil {
+		return nil
+	}
+	return f.Bar
+}
+
 func (f *Foo) String() string {
 	if value, err := core.StringifyJSON(f); err == nil {
 		return value
@@ -68,6 +103,41 @@ func NewUnionFromAnotherBar(value *bar.Bar) *Union {
 	return &Union{Type: "anotherBar", AnotherBar: value}
 }
 
+func (u *Union) GetType() string {
+	if u == nil {
+		return ""
+	}
+	return u.Type
+}
+
+func (u *Union) GetValue() *Value {
+	if u == nil {
+		return nil
+	}
+	return u.Value
+}
+
+func (u *Union) GetAnotherValue() *Value {
+	if u == nil {
+		return nil
+	}
+	return u.AnotherValue
+}
+
+func (u *Union) GetBar() *bar.Bar {
+	if u == nil {
+		return nil
+	}
+	return u.Bar
+}
+
+func (u *Union) GetAnotherBar() *bar.Bar {
+	if u == nil {
+		return nil
+	}
+	return u.AnotherBar
+}
+
 func (u *Union) UnmarshalJSON(data []byte) error {
 	var unmarshaler struct {
 		Type string `json:"type"`
@@ -0,0 +1,1308 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package api
+
+import (
+	json "encoding/json"
+	fmt "fmt"
+	core "github.com/fern-api/fern-go/internal/testdata/model/patch/fixtures/core"
+	time "time"
+)
+
+type Address struct {
+	Street string  `json:"street"`
+	City   *string `json:"city,omitempty"`
+}
+
+func (a *Address) GetStreet() string {
+	if a == nil {
+		return ""
+	}
+	return a.Street
+}
+
+func (a *Address) GetCity() string {
+	if a == nil || a.City == nil {
+		return ""
+	}
+	return *a.City
+}
+
+func (a *Address) String() string {
+	if value, err := core.StringifyJSON(a); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", a)
+}
+
+// AddressPatch is a partial update that's merged into a *Address with Apply.
+// A nil field is left unchanged, a null field is cleared, a nested object is
+// merged with its own patch, and any other field is replaced.
+type AddressPatch struct {
+	Street *core.Optional[string] `json:"street,omitempty"`
+	City   *core.Optional[string] `json:"city,omitempty"`
+}
+
+// Apply merges the patch into the given *Address.
+func (a *AddressPatch) Apply(target *Address) {
+	if a == nil || target == nil {
+		return
+	}
+	if a.Street != nil {
+		if a.Street.Null {
+			target.Street = ""
+		} else {
+			target.Street = a.Street.Value
+		}
+	}
+	if a.City != nil {
+		if a.City.Null {
+			target.City = nil
+		} else {
+			value := a.City.Value
+			target.City = &value
+		}
+	}
+}
+
+func (a *AddressPatch) UnmarshalJSON(data []byte) error {
+	var unmarshaler struct {
+		Street json.RawMessage `json:"street"`
+		City   json.RawMessage `json:"city"`
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	*a = AddressPatch{}
+	if unmarshaler.Street != nil {
+		a.Street = new(core.Optional[string])
+		if err := json.Unmarshal(unmarshaler.Street, a.Street); err != nil {
+			return err
+		}
+	}
+	if unmarshaler.City != nil {
+		a.City = new(core.Optional[string])
+		if err := json.Unmarshal(unmarshaler.City, a.City); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (a *AddressPatch) String() string {
+	if value, err := core.StringifyJSON(a); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", a)
+}
+
+type AnotherUnion struct {
+	typeName      string
+	String        string
+	stringLiteral string
+	Foo           *Foo
+}
+
+func NewAnotherUnionFromString(value string) *AnotherUnion {
+	return &AnotherUnion{typeName: "string", String: value}
+}
+
+func NewAnotherUnionWithStringLiteral() *AnotherUnion {
+	return &AnotherUnion{typeName: "stringLiteral", stringLiteral: "fern"}
+}
+
+func NewAnotherUnionFromFoo(value *Foo) *AnotherUnion {
+	return &AnotherUnion{typeName: "foo", Foo: value}
+}
+
+func (a *AnotherUnion) StringLiteral() string {
+	return a.stringLiteral
+}
+
+func (a *AnotherUnion) GetString() string {
+	if a == nil {
+		return ""
+	}
+	return a.String
+}
+
+func (a *AnotherUnion) GetFoo() *Foo {
+	if a == nil {
+		return nil
+	}
+	return a.Foo
+}
+
+func (a *AnotherUnion) UnmarshalJSON(data []byte) error {
+	var valueString string
+	if err := json.Unmarshal(data, &valueString); err == nil {
+		a.typeName = "string"
+		a.String = valueString
+		return nil
+	}
+	var valueStringLiteral string
+	if err := json.Unmarshal(data, &valueStringLiteral); err == nil {
+		if valueStringLiteral == "fern" {
+			a.typeName = "stringLiteral"
+			a.stringLiteral = valueStringLiteral
+			return nil
+		}
+	}
+	valueFoo := new(Foo)
+	if err := json.Unmarshal(data, &valueFoo); err == nil {
+		a.typeName = "foo"
+		a.Foo = valueFoo
+		return nil
+	}
+	return fmt.Errorf("%s cannot be deserialized as a %T", data, a)
+}
+
+func (a AnotherUnion) MarshalJSON() ([]byte, error) {
+	switch a.typeName {
+	default:
+		return nil, fmt.Errorf("invalid type %s in %T", a.typeName, a)
+	case "string":
+		return json.Marshal(a.String)
+	case "stringLiteral":
+		return json.Marshal("fern")
+	case "foo":
+		return json.Marshal(a.Foo)
+	}
+}
+
+type AnotherUnionVisitor interface {
+	VisitString(string) error
+	VisitStringLiteral(string) error
+	VisitFoo(*Foo) error
+}
+
+func (a *AnotherUnion) Accept(visitor AnotherUnionVisitor) error {
+	switch a.typeName {
+	default:
+		return fmt.Errorf("invalid type %s in %T", a.typeName, a)
+	case "string":
+		return visitor.VisitString(a.String)
+	case "stringLiteral":
+		return visitor.VisitStringLiteral(a.stringLiteral)
+	case "foo":
+		return visitor.VisitFoo(a.Foo)
+	}
+}
+
+type Bar struct {
+	Name string `json:"name"`
+}
+
+func (b *Bar) GetName() string {
+	if b == nil {
+		return ""
+	}
+	return b.Name
+}
+
+func (b *Bar) String() string {
+	if value, err := core.StringifyJSON(b); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", b)
+}
+
+// BarPatch is a partial update that's merged into a *Bar with Apply.
+// A nil field is left unchanged, a null field is cleared, a nested object is
+// merged with its own patch, and any other field is replaced.
+type BarPatch struct {
+	Name *core.Optional[string] `json:"name,omitempty"`
+}
+
+// Apply merges the patch into the given *Bar.
+func (b *BarPatch) Apply(target *Bar) {
+	if b == nil || target == nil {
+		return
+	}
+	if b.Name != nil {
+		if b.Name.Null {
+			target.Name = ""
+		} else {
+			target.Name = b.Name.Value
+		}
+	}
+}
+
+func (b *BarPatch) UnmarshalJSON(data []byte) error {
+	var unmarshaler struct {
+		Name json.RawMessage `json:"name"`
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	*b = BarPatch{}
+	if unmarshaler.Name != nil {
+		b.Name = new(core.Optional[string])
+		if err := json.Unmarshal(unmarshaler.Name, b.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *BarPatch) String() string {
+	if value, err := core.StringifyJSON(b); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", b)
+}
+
+type Baz struct {
+	Id string `json:"id"`
+}
+
+func (b *Baz) GetId() string {
+	if b == nil {
+		return ""
+	}
+	return b.Id
+}
+
+func (b *Baz) String() string {
+	if value, err := core.StringifyJSON(b); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", b)
+}
+
+// BazPatch is a partial update that's merged into a *Baz with Apply.
+// A nil field is left unchanged, a null field is cleared, a nested object is
+// merged with its own patch, and any other field is replaced.
+type BazPatch struct {
+	Id *core.Optional[string] `json:"id,omitempty"`
+}
+
+// Apply merges the patch into the given *Baz.
+func (b *BazPatch) Apply(target *Baz) {
+	if b == nil || target == nil {
+		return
+	}
+	if b.Id != nil {
+		if b.Id.Null {
+			target.Id = ""
+		} else {
+			target.Id = b.Id.Value
+		}
+	}
+}
+
+func (b *BazPatch) UnmarshalJSON(data []byte) error {
+	var unmarshaler struct {
+		Id json.RawMessage `json:"id"`
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	*b = BazPatch{}
+	if unmarshaler.Id != nil {
+		b.Id = new(core.Optional[string])
+		if err := json.Unmarshal(unmarshaler.Id, b.Id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *BazPatch) String() string {
+	if value, err := core.StringifyJSON(b); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", b)
+}
+
+type Event struct {
+	Type    string
+	At      time.Time
+	Created Tags
+	Moved   *Address
+}
+
+func NewEventFromCreated(value Tags) *Event {
+	return &Event{Type: "created", Created: value}
+}
+
+func NewEventFromMoved(value *Address) *Event {
+	return &Event{Type: "moved", Moved: value}
+}
+
+func (e *Event) GetType() string {
+	if e == nil {
+		return ""
+	}
+	return e.Type
+}
+
+func (e *Event) GetAt() time.Time {
+	if e == nil {
+		return time.Time{}
+	}
+	return e.At
+}
+
+func (e *Event) GetCreated() Tags {
+	if e == nil {
+		return nil
+	}
+	return e.Created
+}
+
+func (e *Event) GetMoved() *Address {
+	if e == nil {
+		return nil
+	}
+	return e.Moved
+}
+
+func (e *Event) UnmarshalJSON(data []byte) error {
+	var unmarshaler struct {
+		Type string    `json:"type"`
+		At   time.Time `json:"at"`
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	e.Type = unmarshaler.Type
+	e.At = unmarshaler.At
+	switch unmarshaler.Type {
+	case "created":
+		var valueUnmarshaler struct {
+			Created Tags `json:"tags,omitempty"`
+		}
+		if err := json.Unmarshal(data, &valueUnmarshaler); err != nil {
+			return err
+		}
+		e.Created = valueUnmarshaler.Created
+	case "moved":
+		value := new(Address)
+		if err := json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+		e.Moved = value
+	}
+	return nil
+}
+
+func (e Event) MarshalJSON() ([]byte, error) {
+	switch e.Type {
+	default:
+		return nil, fmt.Errorf("invalid type %s in %T", e.Type, e)
+	case "created":
+		var marshaler = struct {
+			Type    string    `json:"type"`
+			At      time.Time `json:"at"`
+			Created Tags      `json:"tags,omitempty"`
+		}{
+			Type:    e.Type,
+			At:      e.At,
+			Created: e.Created,
+		}
+		return json.Marshal(marshaler)
+	case "moved":
+		var marshaler = struct {
+			Type string    `json:"type"`
+			At   time.Time `json:"at"`
+			*Address
+		}{
+			Type:    e.Type,
+			At:      e.At,
+			Address: e.Moved,
+		}
+		return json.Marshal(marshaler)
+	}
+}
+
+type EventVisitor interface {
+	VisitCreated(Tags) error
+	VisitMoved(*Address) error
+}
+
+func (e *Event) Accept(visitor EventVisitor) error {
+	switch e.Type {
+	default:
+		return fmt.Errorf("invalid type %s in %T", e.Type, e)
+	case "created":
+		return visitor.VisitCreated(e.Created)
+	case "moved":
+		return visitor.VisitMoved(e.Moved)
+	}
+}
+
+type Foo struct {
+	Name string `json:"name"`
+}
+
+func (f *Foo) GetName() string {
+	if f == nil {
+		return ""
+	}
+	return f.Name
+}
+
+func (f *Foo) String() string {
+	if value, err := core.StringifyJSON(f); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", f)
+}
+
+// FooPatch is a partial update that's merged into a *Foo with Apply.
+// A nil field is left unchanged, a null field is cleared, a nested object is
+// merged with its own patch, and any other field is replaced.
+type FooPatch struct {
+	Name *core.Optional[string] `json:"name,omitempty"`
+}
+
+// Apply merges the patch into the given *Foo.
+func (f *FooPatch) Apply(target *Foo) {
+	if f == nil || target == nil {
+		return
+	}
+	if f.Name != nil {
+		if f.Name.Null {
+			target.Name = ""
+		} else {
+			target.Name = f.Name.Value
+		}
+	}
+}
+
+func (f *FooPatch) UnmarshalJSON(data []byte) error {
+	var unmarshaler struct {
+		Name json.RawMessage `json:"name"`
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	*f = FooPatch{}
+	if unmarshaler.Name != nil {
+		f.Name = new(core.Optional[string])
+		if err := json.Unmarshal(unmarshaler.Name, f.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *FooPatch) String() string {
+	if value, err := core.StringifyJSON(f); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", f)
+}
+
+type Resource struct {
+	Id        string    `json:"id"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+func (r *Resource) GetId() string {
+	if r == nil {
+		return ""
+	}
+	return r.Id
+}
+
+func (r *Resource) GetCreatedAt() time.Time {
+	if r == nil {
+		return time.Time{}
+	}
+	return r.CreatedAt
+}
+
+func (r *Resource) String() string {
+	if value, err := core.StringifyJSON(r); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", r)
+}
+
+// ResourcePatch is a partial update that's merged into a *Resource with Apply.
+// A nil field is left unchanged, a null field is cleared, a nested object is
+// merged with its own patch, and any other field is replaced.
+type ResourcePatch struct {
+	Id        *core.Optional[string]    `json:"id,omitempty"`
+	CreatedAt *core.Optional[time.Time] `json:"createdAt,omitempty"`
+}
+
+// Apply merges the patch into the given *Resource.
+func (r *ResourcePatch) Apply(target *Resource) {
+	if r == nil || target == nil {
+		return
+	}
+	if r.Id != nil {
+		if r.Id.Null {
+			target.Id = ""
+		} else {
+			target.Id = r.Id.Value
+		}
+	}
+	if r.CreatedAt != nil {
+		if r.CreatedAt.Null {
+			target.CreatedAt = time.Time{}
+		} else {
+			target.CreatedAt = r.CreatedAt.Value
+		}
+	}
+}
+
+func (r *ResourcePatch) UnmarshalJSON(data []byte) error {
+	var unmarshaler struct {
+		Id        json.RawMessage `json:"id"`
+		CreatedAt json.RawMessage `json:"createdAt"`
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	*r = ResourcePatch{}
+	if unmarshaler.Id != nil {
+		r.Id = new(core.Optional[string])
+		if err := json.Unmarshal(unmarshaler.Id, r.Id); err != nil {
+			return err
+		}
+	}
+	if unmarshaler.CreatedAt != nil {
+		r.CreatedAt = new(core.Optional[time.Time])
+		if err := json.Unmarshal(unmarshaler.CreatedAt, r.CreatedAt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *ResourcePatch) String() string {
+	if value, err := core.StringifyJSON(r); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", r)
+}
+
+type Status string
+
+const (
+	StatusActive   Status = "ACTIVE"
+	StatusInactive Status = "INACTIVE"
+)
+
+func NewStatusFromString(s string) (Status, error) {
+	switch s {
+	case "ACTIVE":
+		return StatusActive, nil
+	case "INACTIVE":
+		return StatusInactive, nil
+	}
+	var t Status
+	return "", fmt.Errorf("%s is not a valid %T", s, t)
+}
+
+func (s Status) Ptr() *Status {
+	return &s
+}
+
+type Tags = []string
+
+type Union struct {
+	typeName         string
+	Foo              *Foo
+	Bar              *Bar
+	Baz              *Baz
+	String           string
+	IntegerOptional  *int
+	StringBooleanMap map[string]bool
+	StringList       []string
+	StringListList   [][]string
+	DoubleSet        *core.Set[float64]
+	stringLiteral    string
+}
+
+func NewUnionFromFoo(value *Foo) *Union {
+	return &Union{typeName: "foo", Foo: value}
+}
+
+func NewUnionFromBar(value *Bar) *Union {
+	return &Union{typeName: "bar", Bar: value}
+}
+
+func NewUnionFromBaz(value *Baz) *Union {
+	return &Union{typeName: "baz", Baz: value}
+}
+
+func NewUnionFromString(value string) *Union {
+	return &Union{typeName: "string", String: value}
+}
+
+func NewUnionFromIntegerOptional(value *int) *Union {
+	return &Union{typeName: "integerOptional", IntegerOptional: value}
+}
+
+func NewUnionFromStringBooleanMap(value map[string]bool) *Union {
+	return &Union{typeName: "stringBooleanMap", StringBooleanMap: value}
+}
+
+func NewUnionFromStringList(value []string) *Union {
+	return &Union{typeName: "stringList", StringList: value}
+}
+
+func NewUnionFromStringListList(value [][]string) *Union {
+	return &Union{typeName: "stringListList", StringListList: value}
+}
+
+func NewUnionFromDoubleSet(value *core.Set[float64]) *Union {
+	return &Union{typeName: "doubleSet", DoubleSet: value}
+}
+
+func NewUnionWithStringLiteral() *Union {
+	return &Union{typeName: "stringLiteral", stringLiteral: "fern"}
+}
+
+func (u *Union) StringLiteral() string {
+	return u.stringLiteral
+}
+
+func (u *Union) GetFoo() *Foo {
+	if u == nil {
+		return nil
+	}
+	return u.Foo
+}
+
+func (u *Union) GetBar() *Bar {
+	if u == nil {
+		return nil
+	}
+	return u.Bar
+}
+
+func (u *Union) GetBaz() *Baz {
+	if u == nil {
+		return nil
+	}
+	return u.Baz
+}
+
+func (u *Union) GetString() string {
+	if u == nil {
+		return ""
+	}
+	return u.String
+}
+
+func (u *Union) GetIntegerOptional() int {
+	if u == nil || u.IntegerOptional == nil {
+		return 0
+	}
+	return *u.IntegerOptional
+}
+
+func (u *Union) GetStringBooleanMap() map[string]bool {
+	if u == nil {
+		return nil
+	}
+	return u.StringBooleanMap
+}
+
+func (u *Union) GetStringList() []string {
+	if u == nil {
+		return nil
+	}
+	return u.StringList
+}
+
+func (u *Union) GetStringListList() [][]string {
+	if u == nil {
+		return nil
+	}
+	return u.StringListList
+}
+
+func (u *Union) GetDoubleSet() *core.Set[float64] {
+	if u == nil {
+		return nil
+	}
+	return u.DoubleSet
+}
+
+func (u *Union) UnmarshalJSON(data []byte) error {
+	valueFoo := new(Foo)
+	if err := json.Unmarshal(data, &valueFoo); err == nil {
+		u.typeName = "foo"
+		u.Foo = valueFoo
+		return nil
+	}
+	valueBar := new(Bar)
+	if err := json.Unmarshal(data, &valueBar); err == nil {
+		u.typeName = "bar"
+		u.Bar = valueBar
+		return nil
+	}
+	valueBaz := new(Baz)
+	if err := json.Unmarshal(data, &valueBaz); err == nil {
+		u.typeName = "baz"
+		u.Baz = valueBaz
+		return nil
+	}
+	var valueString string
+	if err := json.Unmarshal(data, &valueString); err == nil {
+		u.typeName = "string"
+		u.String = valueString
+		return nil
+	}
+	var valueIntegerOptional *int
+	if err := json.Unmarshal(data, &valueIntegerOptional); err == nil {
+		u.typeName = "integerOptional"
+		u.IntegerOptional = valueIntegerOptional
+		return nil
+	}
+	var valueStringBooleanMap map[string]bool
+	if err := json.Unmarshal(data, &valueStringBooleanMap); err == nil {
+		u.typeName = "stringBooleanMap"
+		u.StringBooleanMap = valueStringBooleanMap
+		return nil
+	}
+	var valueStringList []string
+	if err := json.Unmarshal(data, &valueStringList); err == nil {
+		u.typeName = "stringList"
+		u.StringList = valueStringList
+		return nil
+	}
+	var valueStringListList [][]string
+	if err := json.Unmarshal(data, &valueStringListList); err == nil {
+		u.typeName = "stringListList"
+		u.StringListList = valueStringListList
+		return nil
+	}
+	var valueDoubleSet *core.Set[float64]
+	if err := json.Unmarshal(data, &valueDoubleSet); err == nil {
+		u.typeName = "doubleSet"
+		u.DoubleSet = valueDoubleSet
+		return nil
+	}
+	var valueStringLiteral string
+	if err := json.Unmarshal(data, &valueStringLiteral); err == nil {
+		if valueStringLiteral == "fern" {
+			u.typeName = "stringLiteral"
+			u.stringLiteral = valueStringLiteral
+			return nil
+		}
+	}
+	return fmt.Errorf("%s cannot be deserialized as a %T", data, u)
+}
+
+func (u Union) MarshalJSON() ([]byte, error) {
+	switch u.typeName {
+	default:
+		return nil, fmt.Errorf("invalid type %s in %T", u.typeName, u)
+	case "foo":
+		return json.Marshal(u.Foo)
+	case "bar":
+		return json.Marshal(u.Bar)
+	case "baz":
+		return json.Marshal(u.Baz)
+	case "string":
+		return json.Marshal(u.String)
+	case "integerOptional":
+		return json.Marshal(u.IntegerOptional)
+	case "stringBooleanMap":
+		return json.Marshal(u.StringBooleanMap)
+	case "stringList":
+		return json.Marshal(u.StringList)
+	case "stringListList":
+		return json.Marshal(u.StringListList)
+	case "doubleSet":
+		return json.Marshal(u.DoubleSet)
+	case "stringLiteral":
+		return json.Marshal("fern")
+	}
+}
+
+type UnionVisitor interface {
+	VisitFoo(*Foo) error
+	VisitBar(*Bar) error
+	VisitBaz(*Baz) error
+	VisitString(string) error
+	VisitIntegerOptional(*int) error
+	VisitStringBooleanMap(map[string]bool) error
+	VisitStringList([]string) error
+	VisitStringListList([][]string) error
+	VisitDoubleSet(*core.Set[float64]) error
+	VisitStringLiteral(string) error
+}
+
+func (u *Union) Accept(visitor UnionVisitor) error {
+	switch u.typeName {
+	default:
+		return fmt.Errorf("invalid type %s in %T", u.typeName, u)
+	case "foo":
+		return visitor.VisitFoo(u.Foo)
+	case "bar":
+		return visitor.VisitBar(u.Bar)
+	case "baz":
+		return visitor.VisitBaz(u.Baz)
+	case "string":
+		return visitor.VisitString(u.String)
+	case "integerOptional":
+		return visitor.VisitIntegerOptional(u.IntegerOptional)
+	case "stringBooleanMap":
+		return visitor.VisitStringBooleanMap(u.StringBooleanMap)
+	case "stringList":
+		return visitor.VisitStringList(u.StringList)
+	case "stringListList":
+		return visitor.VisitStringListList(u.StringListList)
+	case "doubleSet":
+		return visitor.VisitDoubleSet(u.DoubleSet)
+	case "stringLiteral":
+		return visitor.VisitStringLiteral(u.stringLiteral)
+	}
+}
+
+type UnionWithLiteral struct {
+	typeName      string
+	stringLiteral string
+	String        string
+}
+
+func NewUnionWithLiteralWithStringLiteral() *UnionWithLiteral {
+	return &UnionWithLiteral{typeName: "stringLiteral", stringLiteral: "fern"}
+}
+
+func NewUnionWithLiteralFromString(value string) *UnionWithLiteral {
+	return &UnionWithLiteral{typeName: "string", String: value}
+}
+
+func (u *UnionWithLiteral) StringLiteral() string {
+	return u.stringLiteral
+}
+
+func (u *UnionWithLiteral) GetString() string {
+	if u == nil {
+		return ""
+	}
+	return u.String
+}
+
+func (u *UnionWithLiteral) UnmarshalJSON(data []byte) error {
+	var valueStringLiteral string
+	if err := json.Unmarshal(data, &valueStringLiteral); err == nil {
+		if valueStringLiteral == "fern" {
+			u.typeName = "stringLiteral"
+			u.stringLiteral = valueStringLiteral
+			return nil
+		}
+	}
+	var valueString string
+	if err := json.Unmarshal(data, &valueString); err == nil {
+		u.typeName = "string"
+		u.String = valueString
+		return nil
+	}
+	return fmt.Errorf("%s cannot be deserialized as a %T", data, u)
+}
+
+func (u UnionWithLiteral) MarshalJSON() ([]byte, error) {
+	switch u.typeName {
+	default:
+		return nil, fmt.Errorf("invalid type %s in %T", u.typeName, u)
+	case "stringLiteral":
+		return json.Marshal("fern")
+	case "string":
+		return json.Marshal(u.String)
+	}
+}
+
+type UnionWithLiteralVisitor interface {
+	VisitStringLiteral(string) error
+	VisitString(string) error
+}
+
+func (u *UnionWithLiteral) Accept(visitor UnionWithLiteralVisitor) error {
+	switch u.typeName {
+	default:
+		return fmt.Errorf("invalid type %s in %T", u.typeName, u)
+	case "stringLiteral":
+		return visitor.VisitStringLiteral(u.stringLiteral)
+	case "string":
+		return visitor.VisitString(u.String)
+	}
+}
+
+// A user of the API.
+type User struct {
+	Id              string             `json:"id"`
+	CreatedAt       time.Time          `json:"createdAt"`
+	Name            string             `json:"name"`
+	Nickname        *string            `json:"nickname,omitempty"`
+	Age             *int               `json:"age,omitempty"`
+	Status          Status             `json:"status,omitempty"`
+	Tags            Tags               `json:"tags,omitempty"`
+	Labels          *core.Set[string]  `json:"labels,omitempty"`
+	Scores          map[string]float64 `json:"scores,omitempty"`
+	Address         *Address           `json:"address,omitempty"`
+	PreviousAddress *Address           `json:"previousAddress,omitempty"`
+	LastEvent       *Event             `json:"lastEvent,omitempty"`
+	Metadata        interface{}        `json:"metadata,omitempty"`
+	kind            string
+}
+
+func (u *User) Kind() string {
+	return u.kind
+}
+
+func (u *User) GetId() string {
+	if u == nil {
+		return ""
+	}
+	return u.Id
+}
+
+func (u *User) GetCreatedAt() time.Time {
+	if u == nil {
+		return time.Time{}
+	}
+	return u.CreatedAt
+}
+
+func (u *User) GetName() string {
+	if u == nil {
+		return ""
+	}
+	return u.Name
+}
+
+func (u *User) GetNickname() string {
+	if u == nil || u.Nickname == nil {
+		return ""
+	}
+	return *u.Nickname
+}
+
+func (u *User) GetAge() int {
+	if u == nil || u.Age == nil {
+		return 0
+	}
+	return *u.Age
+}
+
+func (u *User) GetStatus() Status {
+	if u == nil {
+		return ""
+	}
+	return u.Status
+}
+
+func (u *User) GetTags() Tags {
+	if u == nil {
+		return nil
+	}
+	return u.Tags
+}
+
+func (u *User) GetLabels() *core.Set[string] {
+	if u == nil {
+		return nil
+	}
+	return u.Labels
+}
+
+func (u *User) GetScores() map[string]float64 {
+	if u == nil {
+		return nil
+	}
+	return u.Scores
+}
+
+func (u *User) GetAddress() *Address {
+	if u == nil {
+		return nil
+	}
+	return u.Address
+}
+
+func (u *User) GetPreviousAddress() *Address {
+	if u == nil {
+		return nil
+	}
+	return u.PreviousAddress
+}
+
+func (u *User) GetLastEvent() *Event {
+	if u == nil {
+		return nil
+	}
+	return u.LastEvent
+}
+
+func (u *User) GetMetadata() interface{} {
+	if u == nil {
+		return nil
+	}
+	return u.Metadata
+}
+
+func (u *User) UnmarshalJSON(data []byte) error {
+	type unmarshaler User
+	var value unmarshaler
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*u = User(value)
+	u.kind = "user"
+	return nil
+}
+
+func (u *User) MarshalJSON() ([]byte, error) {
+	type embed User
+	var marshaler = struct {
+		embed
+		Kind string `json:"kind"`
+	}{
+		embed: embed(*u),
+		Kind:  "user",
+	}
+	return json.Marshal(marshaler)
+}
+
+func (u *User) String() string {
+	if value, err := core.StringifyJSON(u); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", u)
+}
+
+// UserPatch is a partial update that's merged into a *User with Apply.
+// A nil field is left unchanged, a null field is cleared, a nested object is
+// merged with its own patch, and any other field is replaced.
+type UserPatch struct {
+	Id              *core.Optional[string]             `json:"id,omitempty"`
+	CreatedAt       *core.Optional[time.Time]          `json:"createdAt,omitempty"`
+	Name            *core.Optional[string]             `json:"name,omitempty"`
+	Nickname        *core.Optional[string]             `json:"nickname,omitempty"`
+	Age             *core.Optional[int]                `json:"age,omitempty"`
+	Status          *core.Optional[Status]             `json:"status,omitempty"`
+	Tags            *core.Optional[Tags]               `json:"tags,omitempty"`
+	Labels          *core.Optional[core.Set[string]]   `json:"labels,omitempty"`
+	Scores          *core.Optional[map[string]float64] `json:"scores,omitempty"`
+	Address         *core.Optional[AddressPatch]       `json:"address,omitempty"`
+	PreviousAddress *core.Optional[AddressPatch]       `json:"previousAddress,omitempty"`
+	LastEvent       *core.Optional[Event]              `json:"lastEvent,omitempty"`
+	Metadata        *core.Optional[interface{}]        `json:"metadata,omitempty"`
+}
+
+// Apply merges the patch into the given *User.
+func (u *UserPatch) Apply(target *User) {
+	if u == nil || target == nil {
+		return
+	}
+	if u.Id != nil {
+		if u.Id.Null {
+			target.Id = ""
+		} else {
+			target.Id = u.Id.Value
+		}
+	}
+	if u.CreatedAt != nil {
+		if u.CreatedAt.Null {
+			target.CreatedAt = time.Time{}
+		} else {
+			target.CreatedAt = u.CreatedAt.Value
+		}
+	}
+	if u.Name != nil {
+		if u.Name.Null {
+			target.Name = ""
+		} else {
+			target.Name = u.Name.Value
+		}
+	}
+	if u.Nickname != nil {
+		if u.Nickname.Null {
+			target.Nickname = nil
+		} else {
+			value := u.Nickname.Value
+			target.Nickname = &value
+		}
+	}
+	if u.Age != nil {
+		if u.Age.Null {
+			target.Age = nil
+		} else {
+			value := u.Age.Value
+			target.Age = &value
+		}
+	}
+	if u.Status != nil {
+		if u.Status.Null {
+			target.Status = ""
+		} else {
+			target.Status = u.Status.Value
+		}
+	}
+	if u.Tags != nil {
+		if u.Tags.Null {
+			target.Tags = nil
+		} else {
+			target.Tags = u.Tags.Value
+		}
+	}
+	if u.Labels != nil {
+		if u.Labels.Null {
+			target.Labels = nil
+		} else {
+			value := u.Labels.Value
+			target.Labels = &value
+		}
+	}
+	if u.Scores != nil {
+		if u.Scores.Null {
+			target.Scores = nil
+		} else {
+			target.Scores = u.Scores.Value
+		}
+	}
+	if u.Address != nil {
+		if u.Address.Null {
+			target.Address = nil
+		} else {
+			if target.Address == nil {
+				target.Address = new(Address)
+			}
+			u.Address.Value.Apply(target.Address)
+		}
+	}
+	if u.PreviousAddress != nil {
+		if u.PreviousAddress.Null {
+			target.PreviousAddress = nil
+		} else {
+			if target.PreviousAddress == nil {
+				target.PreviousAddress = new(Address)
+			}
+			u.PreviousAddress.Value.Apply(target.PreviousAddress)
+		}
+	}
+	if u.LastEvent != nil {
+		if u.LastEvent.Null {
+			target.LastEvent = nil
+		} else {
+			value := u.LastEvent.Value
+			target.LastEvent = &value
+		}
+	}
+	if u.Metadata != nil {
+		if u.Metadata.Null {
+			target.Metadata = nil
+		} else {
+			target.Metadata = u.Metadata.Value
+		}
+	}
+}
+
+func (u *UserPatch) UnmarshalJSON(data []byte) error {
+	var unmarshaler struct {
+		Id              json.RawMessage `json:"id"`
+		CreatedAt       json.RawMessage `json:"createdAt"`
+		Name            json.RawMessage `json:"name"`
+		Nickname        json.RawMessage `json:"nickname"`
+		Age             json.RawMessage `json:"age"`
+		Status          json.RawMessage `json:"status"`
+		Tags            json.RawMessage `json:"tags"`
+		Labels          json.RawMessage `json:"labels"`
+		Scores          json.RawMessage `json:"scores"`
+		Address         json.RawMessage `json:"address"`
+		PreviousAddress json.RawMessage `json:"previousAddress"`
+		LastEvent       json.RawMessage `json:"lastEvent"`
+		Metadata        json.RawMessage `json:"metadata"`
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	*u = UserPatch{}
+	if unmarshaler.Id != nil {
+		u.Id = new(core.Optional[string])
+		if err := json.Unmarshal(unmarshaler.Id, u.Id); err != nil {
+			return err
+		}
+	}
+	if unmarshaler.CreatedAt != nil {
+		u.CreatedAt = new(core.Optional[time.Time])
+		if err := json.Unmarshal(unmarshaler.CreatedAt, u.CreatedAt); err != nil {
+			return err
+		}
+	}
+	if unmarshaler.Name != nil {
+		u.Name = new(core.Optional[string])
+		if err := json.Unmarshal(unmarshaler.Name, u.Name); err != nil {
+			return err
+		}
+	}
+	if unmarshaler.Nickname != nil {
+		u.Nickname = new(core.Optional[string])
+		if err := json.Unmarshal(unmarshaler.Nickname, u.Nickname); err != nil {
+			return err
+		}
+	}
+	if unmarshaler.Age != nil {
+		u.Age = new(core.Optional[int])
+		if err := json.Unmarshal(unmarshaler.Age, u.Age); err != nil {
+			return err
+		}
+	}
+	if unmarshaler.Status != nil {
+		u.Status = new(core.Optional[Status])
+		if err := json.Unmarshal(unmarshaler.Status, u.Status); err != nil {
+			return err
+		}
+	}
+	if unmarshaler.Tags != nil {
+		u.Tags = new(core.Optional[Tags])
+		if err := json.Unmarshal(unmarshaler.Tags, u.Tags); err != nil {
+			return err
+		}
+	}
+	if unmarshaler.Labels != nil {
+		u.Labels = new(core.Optional[core.Set[string]])
+		if err := json.Unmarshal(unmarshaler.Labels, u.Labels); err != nil {
+			return err
+		}
+	}
+	if unmarshaler.Scores != nil {
+		u.Scores = new(core.Optional[map[string]float64])
+		if err := json.Unmarshal(unmarshaler.Scores, u.Scores); err != nil {
+			return err
+		}
+	}
+	if unmarshaler.Address != nil {
+		u.Address = new(core.Optional[AddressPatch])
+		if err := json.Unmarshal(unmarshaler.Address, u.Address); err != nil {
+			return err
+		}
+	}
+	if unmarshaler.PreviousAddress != nil {
+		u.PreviousAddress = new(core.Optional[AddressPatch])
+		if err := json.Unmarshal(unmarshaler.PreviousAddress, u.PreviousAddress); err != nil {
+			return err
+		}
+	}
+	if unmarshaler.LastEvent != nil {
+		u.LastEvent = new(core.Optional[Event])
+		if err := json.Unmarshal(unmarshaler.LastEvent, u.LastEvent); err != nil {
+			return err
+		}
+	}
+	if unmarshaler.Metadata != nil {
+		u.Metadata = new(core.Optional[interface{}])
+		if err := json.Unmarshal(unmarshaler.Metadata, u.Metadata); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (u *UserPatch) String() string {
+	if value, err := core.StringifyJSON(u); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", u)
+}
@@ -0,0 +1,118 @@
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Set is an ordered collection of unique values, used to represent
+// sets of comparable elements (e.g. strings, numbers, and enums).
+//
+// Elements are kept in the order they were first added, and any
+// duplicates are collapsed when a Set is added to or deserialized.
+// Sets of non-comparable elements (e.g. objects) are represented
+// with a plain slice instead.
+//
+// The zero value is an empty Set ready to use.
+type Set[T comparable] struct {
+	values []T
+	index  map[T]struct{}
+}
+
+// NewSet returns a new Set that contains the given values.
+func NewSet[T comparable](values ...T) *Set[T] {
+	s := new(Set[T])
+	for _, value := range values {
+		s.Add(value)
+	}
+	return s
+}
+
+// Add adds the given value to the Set, and returns
+// false if the value was already present.
+func (s *Set[T]) Add(value T) bool {
+	if s.index == nil {
+		s.index = make(map[T]struct{})
+	}
+	if _, ok := s.index[value]; ok {
+		return false
+	}
+	s.index[value] = struct{}{}
+	s.values = append(s.values, value)
+	return true
+}
+
+// Has returns true if the given value is in the Set.
+func (s *Set[T]) Has(value T) bool {
+	if s == nil {
+		return false
+	}
+	_, ok := s.index[value]
+	return ok
+}
+
+// Len returns the number of values in the Set.
+func (s *Set[T]) Len() int {
+	if s == nil {
+		return 0
+	}
+	return len(s.values)
+}
+
+// Values returns the values in the Set in the order they were
+// added. The returned slice must not be modified.
+func (s *Set[T]) Values() []T {
+	if s == nil {
+		return nil
+	}
+	return s.values
+}
+
+// Clone returns a copy of the Set.
+func (s *Set[T]) Clone() *Set[T] {
+	if s == nil {
+		return nil
+	}
+	return NewSet(s.values...)
+}
+
+// Equal reports whether both Sets contain the same values,
+// regardless of the order they were added in.
+func (s *Set[T]) Equal(other *Set[T]) bool {
+	if s.Len() != other.Len() {
+		return false
+	}
+	for _, value := range s.Values() {
+		if !other.Has(value) {
+			return false
+		}
+	}
+	return true
+}
+
+// String formats the Set just like a slice of its values so that
+// it's serialized consistently (e.g. in query parameters).
+func (s *Set[T]) String() string {
+	return fmt.Sprint(s.Values())
+}
+
+func (s *Set[T]) MarshalJSON() ([]byte, error) {
+	values := s.Values()
+	if values == nil {
+		// Empty sets are always written as an array.
+		values = []T{}
+	}
+	return json.Marshal(values)
+}
+
+func (s *Set[T]) UnmarshalJSON(data []byte) error {
+	var values []T
+	if err := json.Unmarshal(data, &values); err != nil {
+		return err
+	}
+	*s = Set[T]{}
+	for _, value := range values {
+		s.Add(value)
+	}
+	return nil
+}
@@ -0,0 +1,427 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package api
+
+import (
+	fmt "fmt"
+	core "github.com/fern-api/fern-go/internal/testdata/model/set/fixtures/core"
+	strings "strings"
+)
+
+type Friend struct {
+	Name string `json:"name"`
+}
+
+func (f *Friend) GetName() string {
+	if f == nil {
+		return ""
+	}
+	return f.Name
+}
+
+func (f *Friend) UnmarshalJSON(data []byte) error {
+	reader := core.NewJSONReader(data)
+	f.UnmarshalJSONFrom(reader)
+	return reader.Done()
+}
+
+func (f *Friend) UnmarshalJSONFrom(reader *core.JSONReader) {
+	if reader.IsNull() {
+		return
+	}
+	*f = Friend{}
+	reader.ObjectStart()
+	for reader.More() {
+		key := reader.KeyBytes()
+		switch string(key) {
+		case "name":
+			f.Name = reader.String()
+		default:
+			switch {
+			case strings.EqualFold(string(key), "name"):
+				f.Name = reader.String()
+			default:
+				reader.Skip()
+			}
+		}
+	}
+	reader.ObjectEnd()
+}
+
+func (f *Friend) MarshalJSON() ([]byte, error) {
+	writer := core.NewJSONWriter()
+	f.MarshalJSONTo(writer)
+	return writer.Bytes()
+}
+
+func (f *Friend) MarshalJSONTo(writer *core.JSONWriter) {
+	if f == nil {
+		writer.Null()
+		return
+	}
+	writer.ObjectStart()
+	writer.Key("name")
+	writer.String(f.Name)
+	writer.ObjectEnd()
+}
+
+func (f *Friend) String() string {
+	if value, err := core.StringifyJSON(f); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", f)
+}
+
+type Role string
+
+const (
+	RoleAdmin  Role = "ADMIN"
+	RoleMember Role = "MEMBER"
+)
+
+func NewRoleFromString(s string) (Role, error) {
+	switch s {
+	case "ADMIN":
+		return RoleAdmin, nil
+	case "MEMBER":
+		return RoleMember, nil
+	}
+	var t Role
+	return "", fmt.Errorf("%s is not a valid %T", s, t)
+}
+
+func (r Role) Ptr() *Role {
+	return &r
+}
+
+func (r Role) MarshalJSON() ([]byte, error) {
+	writer := core.NewJSONWriter()
+	writer.String(string(r))
+	return writer.Bytes()
+}
+
+func (r *Role) UnmarshalJSON(data []byte) error {
+	reader := core.NewJSONReader(data)
+	if !reader.IsNull() {
+		*r = Role(reader.String())
+	}
+	return reader.Done()
+}
+
+type User struct {
+	Name      string            `json:"name"`
+	Tags      *core.Set[string] `json:"tags,omitempty"`
+	Roles     *core.Set[Role]   `json:"roles,omitempty"`
+	Nicknames *core.Set[string] `json:"nicknames,omitempty"`
+	Scores    []*core.Set[int]  `json:"scores,omitempty"`
+	Friends   []*Friend         `json:"friends,omitempty"`
+}
+
+func (u *User) GetName() string {
+	if u == nil {
+		return ""
+	}
+	return u.Name
+}
+
+func (u *User) GetTags() *core.Set[string] {
+	if u == nil {
+		return nil
+	}
+	return u.Tags
+}
+
+func (u *User) GetRoles() *core.Set[Role] {
+	if u == nil {
+		return nil
+	}
+	return u.Roles
+}
+
+func (u *User) GetNicknames() *core.Set[string] {
+	if u == nil {
+		return nil
+	}
+	return u.Nicknames
+}
+
+func (u *User) GetScores() []*core.Set[int] {
+	if u == nil {
+		return nil
+	}
+	return u.Scores
+}
+
+func (u *User) GetFriends() []*Friend {
+	if u == nil {
+		return nil
+	}
+	return u.Friends
+}
+
+func (u *User) UnmarshalJSON(data []byte) error {
+	reader := core.NewJSONReader(data)
+	u.UnmarshalJSONFrom(reader)
+	return reader.Done()
+}
+
+func (u *User) UnmarshalJSONFrom(reader *core.JSONReader) {
+	if reader.IsNull() {
+		return
+	}
+	*u = User{}
+	reader.ObjectStart()
+	for reader.More() {
+		key := reader.KeyBytes()
+		switch string(key) {
+		case "name":
+			u.Name = reader.String()
+		case "tags":
+			if reader.IsNull() {
+				u.Tags = nil
+			} else {
+				u.Tags = new(core.Set[string])
+				reader.ArrayStart()
+				for reader.More() {
+					var elem0 string
+					elem0 = reader.String()
+					u.Tags.Add(elem0)
+				}
+				reader.ArrayEnd()
+			}
+		case "roles":
+			if reader.IsNull() {
+				u.Roles = nil
+			} else {
+				u.Roles = new(core.Set[Role])
+				reader.ArrayStart()
+				for reader.More() {
+					var elem0 Role
+					elem0 = Role(reader.String())
+					u.Roles.Add(elem0)
+				}
+				reader.ArrayEnd()
+			}
+		case "nicknames":
+			if reader.IsNull() {
+				u.Nicknames = nil
+			} else {
+				u.Nicknames = new(core.Set[string])
+				reader.ArrayStart()
+				for reader.More() {
+					var elem0 string
+					elem0 = reader.String()
+					u.Nicknames.Add(elem0)
+				}
+				reader.ArrayEnd()
+			}
+		case "scores":
+			if reader.IsNull() {
+				u.Scores = nil
+			} else {
+				u.Scores = make([]*core.Set[int], 0)
+				reader.ArrayStart()
+				for reader.More() {
+					var elem0 *core.Set[int]
+					if reader.IsNull() {
+						elem0 = nil
+					} else {
+						elem0 = new(core.Set[int])
+						reader.ArrayStart()
+						for reader.More() {
+							var elem1 int
+							elem1 = reader.Int()
+							elem0.Add(elem1)
+						}
+						reader.ArrayEnd()
+					}
+					u.Scores = append(u.Scores, elem0)
+				}
+				reader.ArrayEnd()
+			}
+		case "friends":
+			if reader.IsNull() {
+				u.Friends = nil
+			} else {
+				u.Friends = make([]*Friend, 0)
+				reader.ArrayStart()
+				for reader.More() {
+					var elem0 *Friend
+					if reader.IsNull() {
+						elem0 = nil
+					} else {
+						elem0 = new(Friend)
+						elem0.UnmarshalJSONFrom(reader)
+					}
+					u.Friends = append(u.Friends, elem0)
+				}
+				reader.ArrayEnd()
+			}
+		default:
+			switch {
+			case strings.EqualFold(string(key), "name"):
+				u.Name = reader.String()
+			case strings.EqualFold(string(key), "tags"):
+				if reader.IsNull() {
+					u.Tags = nil
+				} else {
+					u.Tags = new(core.Set[string])
+					reader.ArrayStart()
+					for reader.More() {
+						var elem0 string
+						elem0 = reader.String()
+						u.Tags.Add(elem0)
+					}
+					reader.ArrayEnd()
+				}
+			case strings.EqualFold(string(key), "roles"):
+				if reader.IsNull() {
+					u.Roles = nil
+				} else {
+					u.Roles = new(core.Set[Role])
+					reader.ArrayStart()
+					for reader.More() {
+						var elem0 Role
+						elem0 = Role(reader.String())
+						u.Roles.Add(elem0)
+					}
+					reader.ArrayEnd()
+				}
+			case strings.EqualFold(string(key), "nicknames"):
+				if reader.IsNull() {
+					u.Nicknames = nil
+				} else {
+					u.Nicknames = new(core.Set[string])
+					reader.ArrayStart()
+					for reader.More() {
+						var elem0 string
+						elem0 = reader.String()
+						u.Nicknames.Add(elem0)
+					}
+					reader.ArrayEnd()
+				}
+			case strings.EqualFold(string(key), "scores"):
+				if reader.IsNull() {
+					u.Scores = nil
+				} else {
+					u.Scores = make([]*core.Set[int], 0)
+					reader.ArrayStart()
+					for reader.More() {
+						var elem0 *core.Set[int]
+						if reader.IsNull() {
+							elem0 = nil
+						} else {
+							elem0 = new(core.Set[int])
+							reader.ArrayStart()
+							for reader.More() {
+								var elem1 int
+								elem1 = reader.Int()
+								elem0.Add(elem1)
+							}
+							reader.ArrayEnd()
+						}
+						u.Scores = append(u.Scores, elem0)
+					}
+					reader.ArrayEnd()
+				}
+			case strings.EqualFold(string(key), "friends"):
+				if reader.IsNull() {
+					u.Friends = nil
+				} else {
+					u.Friends = make([]*Friend, 0)
+					reader.ArrayStart()
+					for reader.More() {
+						var elem0 *Friend
+						if reader.IsNull() {
+							elem0 = nil
+						} else {
+							elem0 = new(Friend)
+							elem0.UnmarshalJSONFrom(reader)
+						}
+						u.Friends = append(u.Friends, elem0)
+					}
+					reader.ArrayEnd()
+				}
+			default:
+				reader.Skip()
+			}
+		}
+	}
+	reader.ObjectEnd()
+}
+
+func (u *User) MarshalJSON() ([]byte, error) {
+	writer := core.NewJSONWriter()
+	u.MarshalJSONTo(writer)
+	return writer.Bytes()
+}
+
+func (u *User) MarshalJSONTo(writer *core.JSONWriter) {
+	if u == nil {
+		writer.Null()
+		return
+	}
+	writer.ObjectStart()
+	writer.Key("name")
+	writer.String(u.Name)
+	if u.Tags != nil {
+		writer.Key("tags")
+		writer.ArrayStart()
+		for _, elem0 := range u.Tags.Values() {
+			writer.String(elem0)
+		}
+		writer.ArrayEnd()
+	}
+	if u.Roles != nil {
+		writer.Key("roles")
+		writer.ArrayStart()
+		for _, elem0 := range u.Roles.Values() {
+			writer.String(string(elem0))
+		}
+		writer.ArrayEnd()
+	}
+	if u.Nicknames != nil {
+		writer.Key("nicknames")
+		writer.ArrayStart()
+		for _, elem0 := range u.Nicknames.Values() {
+			writer.String(elem0)
+		}
+		writer.ArrayEnd()
+	}
+	if len(u.Scores) > 0 {
+		writer.Key("scores")
+		writer.ArrayStart()
+		for _, elem0 := range u.Scores {
+			if elem0 == nil {
+				writer.Null()
+			} else {
+				writer.ArrayStart()
+				for _, elem1 := range elem0.Values() {
+					writer.Int(elem1)
+				}
+				writer.ArrayEnd()
+			}
+		}
+		writer.ArrayEnd()
+	}
+	if len(u.Friends) > 0 {
+		writer.Key("friends")
+		writer.ArrayStart()
+		for _, elem0 := range u.Friends {
+			if elem0 == nil {
+				writer.Null()
+			} else {
+				elem0.MarshalJSONTo(writer)
+			}
+		}
+		writer.ArrayEnd()
+	}
+	writer.ObjectEnd()
+}
+
+func (u *User) String() string {
+	if value, err := core.StringifyJSON(u); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", u)
+}
@@ -0,0 +1,77 @@
+package core
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// JSONValue returns the JSON representation of the given value so that
+// it can be stored in a JSON (or JSONB) column.
+func JSONValue(value interface{}) (driver.Value, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// ScanJSON deserializes the JSON read from a database column into the
+// given value. A NULL column leaves the value as-is.
+func ScanJSON(src interface{}, value interface{}) error {
+	if src == nil {
+		return nil
+	}
+	data, err := scanBytes(src, value)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, value)
+}
+
+// ScanString returns the text read from a database column into the
+// given value.
+func ScanString(src interface{}, value interface{}) (string, error) {
+	data, err := scanBytes(src, value)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// JSONColumn stores any value (e.g. a list or map) as JSON, and is
+// used for the types that can't implement driver.Valuer and sql.Scanner
+// themselves, e.g.
+//
+//	var tags []string
+//	err := row.Scan(core.NewJSONColumn(&tags))
+type JSONColumn struct {
+	value interface{}
+}
+
+// NewJSONColumn returns a new *JSONColumn for the given value, which
+// must be a pointer if the column is scanned.
+func NewJSONColumn(value interface{}) *JSONColumn {
+	return &JSONColumn{value: value}
+}
+
+// Value implements the driver.Valuer interface.
+func (j *JSONColumn) Value() (driver.Value, error) {
+	return JSONValue(j.value)
+}
+
+// Scan implements the sql.Scanner interface.
+func (j *JSONColumn) Scan(src interface{}) error {
+	return ScanJSON(src, j.value)
+}
+
+func scanBytes(src interface{}, value interface{}) ([]byte, error) {
+	switch src := src.(type) {
+	case []byte:
+		return src, nil
+	case string:
+		return []byte(src), nil
+	default:
+		return nil, fmt.Errorf("cannot scan %T into %T", src, value)
+	}
+}
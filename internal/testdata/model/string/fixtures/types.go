@@ -12,6 +12,20 @@ type Movie struct {
 	Title string `json:"title"`
 }
 
+func (m *Movie) GetId() string {
+	if m == nil {
+		return ""
+	}
+	return m.Id
+}
+
+func (m *Movie) GetTitle() string {
+	if m == nil {
+		return ""
+	}
+	return m.Title
+}
+
 func (m *Movie) String() string {
 	if value, err := core.StringifyJSON(m); err == nil {
 		return value
@@ -0,0 +1,781 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package api
+
+import (
+	json "encoding/json"
+	fmt "fmt"
+	core "github.com/fern-api/fern-go/internal/testdata/model/struct-tags/fixtures/core"
+	time "time"
+)
+
+type Account struct {
+	FirstName string     `json:"firstName" yaml:"firstName" bson:"first_name"`
+	LastSeen  *time.Time `json:"lastSeen,omitempty" yaml:"lastSeen,omitempty" bson:"last_seen,omitempty"`
+	Status    Status     `json:"status,omitempty" yaml:"status" bson:"status"`
+	Tags      Tags       `json:"tags,omitempty" yaml:"tags" bson:"tags"`
+	Home      *Home      `json:"home,omitempty" yaml:"home,omitempty" bson:"home,omitempty"`
+	History   []*Event   `json:"history,omitempty" yaml:"history" bson:"history"`
+	Union     *Union     `json:"union,omitempty" yaml:"union" bson:"union"`
+	kind      string
+}
+
+func (a *Account) Kind() string {
+	return a.kind
+}
+
+func (a *Account) GetFirstName() string {
+	if a == nil {
+		return ""
+	}
+	return a.FirstName
+}
+
+func (a *Account) GetLastSeen() time.Time {
+	if a == nil || a.LastSeen == nil {
+		return time.Time{}
+	}
+	return *a.LastSeen
+}
+
+func (a *Account) GetStatus() Status {
+	if a == nil {
+		return ""
+	}
+	return a.Status
+}
+
+func (a *Account) GetTags() Tags {
+	if a == nil {
+		return nil
+	}
+	return a.Tags
+}
+
+func (a *Account) GetHome() Home {
+	if a == nil || a.Home == nil {
+		return nil
+	}
+	return *a.Home
+}
+
+func (a *Account) GetHistory() []*Event {
+	if a == nil {
+		return nil
+	}
+	return a.History
+}
+
+func (a *Account) GetUnion() *Union {
+	if a == nil {
+		return nil
+	}
+	return a.Union
+}
+
+func (a *Account) UnmarshalJSON(data []byte) error {
+	type unmarshaler Account
+	var value unmarshaler
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*a = Account(value)
+	a.kind = "account"
+	return nil
+}
+
+func (a *Account) MarshalJSON() ([]byte, error) {
+	type embed Account
+	var marshaler = struct {
+		embed
+		Kind string `json:"kind"`
+	}{
+		embed: embed(*a),
+		Kind:  "account",
+	}
+	return json.Marshal(marshaler)
+}
+
+func (a *Account) String() string {
+	if value, err := core.StringifyJSON(a); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", a)
+}
+
+type Address struct {
+	Street string  `json:"street" yaml:"street" bson:"street"`
+	City   *string `json:"city,omitempty" yaml:"city,omitempty" bson:"city,omitempty"`
+}
+
+func (a *Address) GetStreet() string {
+	if a == nil {
+		return ""
+	}
+	return a.Street
+}
+
+func (a *Address) GetCity() string {
+	if a == nil || a.City == nil {
+		return ""
+	}
+	return *a.City
+}
+
+func (a *Address) String() string {
+	if value, err := core.StringifyJSON(a); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", a)
+}
+
+type AnotherUnion struct {
+	typeName      string
+	String        string
+	stringLiteral string
+	Foo           *Foo
+}
+
+func NewAnotherUnionFromString(value string) *AnotherUnion {
+	return &AnotherUnion{typeName: "string", String: value}
+}
+
+func NewAnotherUnionWithStringLiteral() *AnotherUnion {
+	return &AnotherUnion{typeName: "stringLiteral", stringLiteral: "fern"}
+}
+
+func NewAnotherUnionFromFoo(value *Foo) *AnotherUnion {
+	return &AnotherUnion{typeName: "foo", Foo: value}
+}
+
+func (a *AnotherUnion) StringLiteral() string {
+	return a.stringLiteral
+}
+
+func (a *AnotherUnion) GetString() string {
+	if a == nil {
+		return ""
+	}
+	return a.String
+}
+
+func (a *AnotherUnion) GetFoo() *Foo {
+	if a == nil {
+		return nil
+	}
+	return a.Foo
+}
+
+func (a *AnotherUnion) UnmarshalJSON(data []byte) error {
+	var valueString string
+	if err := json.Unmarshal(data, &valueString); err == nil {
+		a.typeName = "string"
+		a.String = valueString
+		return nil
+	}
+	var valueStringLiteral string
+	if err := json.Unmarshal(data, &valueStringLiteral); err == nil {
+		if valueStringLiteral == "fern" {
+			a.typeName = "stringLiteral"
+			a.stringLiteral = valueStringLiteral
+			return nil
+		}
+	}
+	valueFoo := new(Foo)
+	if err := json.Unmarshal(data, &valueFoo); err == nil {
+		a.typeName = "foo"
+		a.Foo = valueFoo
+		return nil
+	}
+	return fmt.Errorf("%s cannot be deserialized as a %T", data, a)
+}
+
+func (a AnotherUnion) MarshalJSON() ([]byte, error) {
+	switch a.typeName {
+	default:
+		return nil, fmt.Errorf("invalid type %s in %T", a.typeName, a)
+	case "string":
+		return json.Marshal(a.String)
+	case "stringLiteral":
+		return json.Marshal("fern")
+	case "foo":
+		return json.Marshal(a.Foo)
+	}
+}
+
+type AnotherUnionVisitor interface {
+	VisitString(string) error
+	VisitStringLiteral(string) error
+	VisitFoo(*Foo) error
+}
+
+func (a *AnotherUnion) Accept(visitor AnotherUnionVisitor) error {
+	switch a.typeName {
+	default:
+		return fmt.Errorf("invalid type %s in %T", a.typeName, a)
+	case "string":
+		return visitor.VisitString(a.String)
+	case "stringLiteral":
+		return visitor.VisitStringLiteral(a.stringLiteral)
+	case "foo":
+		return visitor.VisitFoo(a.Foo)
+	}
+}
+
+type Bar struct {
+	Name string `json:"name" yaml:"name" bson:"name"`
+}
+
+func (b *Bar) GetName() string {
+	if b == nil {
+		return ""
+	}
+	return b.Name
+}
+
+func (b *Bar) String() string {
+	if value, err := core.StringifyJSON(b); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", b)
+}
+
+type Baz struct {
+	Id string `json:"id" yaml:"id" bson:"id"`
+}
+
+func (b *Baz) GetId() string {
+	if b == nil {
+		return ""
+	}
+	return b.Id
+}
+
+func (b *Baz) String() string {
+	if value, err := core.StringifyJSON(b); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", b)
+}
+
+type Event struct {
+	Type       string
+	OccurredAt time.Time `yaml:"occurredAt" bson:"occurred_at"`
+	Created    Tags
+	Moved      *Address
+	Deleted    interface{}
+	source     string
+}
+
+func NewEventFromCreated(value Tags) *Event {
+	return &Event{Type: "created", Created: value}
+}
+
+func NewEventFromMoved(value *Address) *Event {
+	return &Event{Type: "moved", Moved: value}
+}
+
+func NewEventFromDeleted(value interface{}) *Event {
+	return &Event{Type: "deleted", Deleted: value}
+}
+
+func (e *Event) Source() string {
+	return e.source
+}
+
+func (e *Event) GetType() string {
+	if e == nil {
+		return ""
+	}
+	return e.Type
+}
+
+func (e *Event) GetOccurredAt() time.Time {
+	if e == nil {
+		return time.Time{}
+	}
+	return e.OccurredAt
+}
+
+func (e *Event) GetCreated() Tags {
+	if e == nil {
+		return nil
+	}
+	return e.Created
+}
+
+func (e *Event) GetMoved() *Address {
+	if e == nil {
+		return nil
+	}
+	return e.Moved
+}
+
+func (e *Event) UnmarshalJSON(data []byte) error {
+	var unmarshaler struct {
+		Type       string    `json:"type"`
+		OccurredAt time.Time `json:"occurredAt"`
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	e.Type = unmarshaler.Type
+	e.OccurredAt = unmarshaler.OccurredAt
+	e.source = "api"
+	switch unmarshaler.Type {
+	case "created":
+		var valueUnmarshaler struct {
+			Created Tags `json:"tags,omitempty"`
+		}
+		if err := json.Unmarshal(data, &valueUnmarshaler); err != nil {
+			return err
+		}
+		e.Created = valueUnmarshaler.Created
+	case "moved":
+		value := new(Address)
+		if err := json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+		e.Moved = value
+	case "deleted":
+		value := make(map[string]interface{})
+		if err := json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+		e.Deleted = value
+	}
+	return nil
+}
+
+func (e Event) MarshalJSON() ([]byte, error) {
+	switch e.Type {
+	default:
+		return nil, fmt.Errorf("invalid type %s in %T", e.Type, e)
+	case "created":
+		var marshaler = struct {
+			Type       string    `json:"type"`
+			OccurredAt time.Time `json:"occurredAt"`
+			Source     string    `json:"source"`
+			Created    Tags      `json:"tags,omitempty"`
+		}{
+			Type:       e.Type,
+			OccurredAt: e.OccurredAt,
+			Source:     "api",
+			Created:    e.Created,
+		}
+		return json.Marshal(marshaler)
+	case "moved":
+		var marshaler = struct {
+			Type       string    `json:"type"`
+			OccurredAt time.Time `json:"occurredAt"`
+			Source     string    `json:"source"`
+			*Address
+		}{
+			Type:       e.Type,
+			OccurredAt: e.OccurredAt,
+			Source:     "api",
+			Address:    e.Moved,
+		}
+		return json.Marshal(marshaler)
+	case "deleted":
+		var marshaler = struct {
+			Type       string      `json:"type"`
+			OccurredAt time.Time   `json:"occurredAt"`
+			Source     string      `json:"source"`
+			Deleted    interface{} `json:"deleted,omitempty"`
+		}{
+			Type:       e.Type,
+			OccurredAt: e.OccurredAt,
+			Source:     "api",
+			Deleted:    e.Deleted,
+		}
+		return json.Marshal(marshaler)
+	}
+}
+
+type EventVisitor interface {
+	VisitCreated(Tags) error
+	VisitMoved(*Address) error
+	VisitDeleted(interface{}) error
+}
+
+func (e *Event) Accept(visitor EventVisitor) error {
+	switch e.Type {
+	default:
+		return fmt.Errorf("invalid type %s in %T", e.Type, e)
+	case "created":
+		return visitor.VisitCreated(e.Created)
+	case "moved":
+		return visitor.VisitMoved(e.Moved)
+	case "deleted":
+		return visitor.VisitDeleted(e.Deleted)
+	}
+}
+
+type Foo struct {
+	Name string `json:"name" yaml:"name" bson:"name"`
+}
+
+func (f *Foo) GetName() string {
+	if f == nil {
+		return ""
+	}
+	return f.Name
+}
+
+func (f *Foo) String() string {
+	if value, err := core.StringifyJSON(f); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", f)
+}
+
+type Home = *Address
+
+type Status string
+
+const (
+	StatusActive   Status = "ACTIVE"
+	StatusInactive Status = "INACTIVE"
+)
+
+func NewStatusFromString(s string) (Status, error) {
+	switch s {
+	case "ACTIVE":
+		return StatusActive, nil
+	case "INACTIVE":
+		return StatusInactive, nil
+	}
+	var t Status
+	return "", fmt.Errorf("%s is not a valid %T", s, t)
+}
+
+func (s Status) Ptr() *Status {
+	return &s
+}
+
+type Tags = []string
+
+type Union struct {
+	typeName         string
+	Foo              *Foo
+	Bar              *Bar
+	Baz              *Baz
+	String           string
+	IntegerOptional  *int
+	StringBooleanMap map[string]bool
+	StringList       []string
+	StringListList   [][]string
+	DoubleSet        []float64
+	stringLiteral    string
+}
+
+func NewUnionFromFoo(value *Foo) *Union {
+	return &Union{typeName: "foo", Foo: value}
+}
+
+func NewUnionFromBar(value *Bar) *Union {
+	return &Union{typeName: "bar", Bar: value}
+}
+
+func NewUnionFromBaz(value *Baz) *Union {
+	return &Union{typeName: "baz", Baz: value}
+}
+
+func NewUnionFromString(value string) *Union {
+	return &Union{typeName: "string", String: value}
+}
+
+func NewUnionFromIntegerOptional(value *int) *Union {
+	return &Union{typeName: "integerOptional", IntegerOptional: value}
+}
+
+func NewUnionFromStringBooleanMap(value map[string]bool) *Union {
+	return &Union{typeName: "stringBooleanMap", StringBooleanMap: value}
+}
+
+func NewUnionFromStringList(value []string) *Union {
+	return &Union{typeName: "stringList", StringList: value}
+}
+
+func NewUnionFromStringListList(value [][]string) *Union {
+	return &Union{typeName: "stringListList", StringListList: value}
+}
+
+func NewUnionFromDoubleSet(value []float64) *Union {
+	return &Union{typeName: "doubleSet", DoubleSet: value}
+}
+
+func NewUnionWithStringLiteral() *Union {
+	return &Union{typeName: "stringLiteral", stringLiteral: "fern"}
+}
+
+func (u *Union) StringLiteral() string {
+	return u.stringLiteral
+}
+
+func (u *Union) GetFoo() *Foo {
+	if u == nil {
+		return nil
+	}
+	return u.Foo
+}
+
+func (u *Union) GetBar() *Bar {
+	if u == nil {
+		return nil
+	}
+	return u.Bar
+}
+
+func (u *Union) GetBaz() *Baz {
+	if u == nil {
+		return nil
+	}
+	return u.Baz
+}
+
+func (u *Union) GetString() string {
+	if u == nil {
+		return ""
+	}
+	return u.String
+}
+
+func (u *Union) GetIntegerOptional() int {
+	if u == nil || u.IntegerOptional == nil {
+		return 0
+	}
+	return *u.IntegerOptional
+}
+
+func (u *Union) GetStringBooleanMap() map[string]bool {
+	if u == nil {
+		return nil
+	}
+	return u.StringBooleanMap
+}
+
+func (u *Union) GetStringList() []string {
+	if u == nil {
+		return nil
+	}
+	return u.StringList
+}
+
+func (u *Union) GetStringListList() [][]string {
+	if u == nil {
+		return nil
+	}
+	return u.StringListList
+}
+
+func (u *Union) GetDoubleSet() []float64 {
+	if u == nil {
+		return nil
+	}
+	return u.DoubleSet
+}
+
+func (u *Union) UnmarshalJSON(data []byte) error {
+	valueFoo := new(Foo)
+	if err := json.Unmarshal(data, &valueFoo); err == nil {
+		u.typeName = "foo"
+		u.Foo = valueFoo
+		return nil
+	}
+	valueBar := new(Bar)
+	if err := json.Unmarshal(data, &valueBar); err == nil {
+		u.typeName = "bar"
+		u.Bar = valueBar
+		return nil
+	}
+	valueBaz := new(Baz)
+	if err := json.Unmarshal(data, &valueBaz); err == nil {
+		u.typeName = "baz"
+		u.Baz = valueBaz
+		return nil
+	}
+	var valueString string
+	if err := json.Unmarshal(data, &valueString); err == nil {
+		u.typeName = "string"
+		u.String = valueString
+		return nil
+	}
+	var valueIntegerOptional *int
+	if err := json.Unmarshal(data, &valueIntegerOptional); err == nil {
+		u.typeName = "integerOptional"
+		u.IntegerOptional = valueIntegerOptional
+		return nil
+	}
+	var valueStringBooleanMap map[string]bool
+	if err := json.Unmarshal(data, &valueStringBooleanMap); err == nil {
+		u.typeName = "stringBooleanMap"
+		u.StringBooleanMap = valueStringBooleanMap
+		return nil
+	}
+	var valueStringList []string
+	if err := json.Unmarshal(data, &valueStringList); err == nil {
+		u.typeName = "stringList"
+		u.StringList = valueStringList
+		return nil
+	}
+	var valueStringListList [][]string
+	if err := json.Unmarshal(data, &valueStringListList); err == nil {
+		u.typeName = "stringListList"
+		u.StringListList = valueStringListList
+		return nil
+	}
+	var valueDoubleSet []float64
+	if err := json.Unmarshal(data, &valueDoubleSet); err == nil {
+		u.typeName = "doubleSet"
+		u.DoubleSet = valueDoubleSet
+		return nil
+	}
+	var valueStringLiteral string
+	if err := json.Unmarshal(data, &valueStringLiteral); err == nil {
+		if valueStringLiteral == "fern" {
+			u.typeName = "stringLiteral"
+			u.stringLiteral = valueStringLiteral
+			return nil
+		}
+	}
+	return fmt.Errorf("%s cannot be deserialized as a %T", data, u)
+}
+
+func (u Union) MarshalJSON() ([]byte, error) {
+	switch u.typeName {
+	default:
+		return nil, fmt.Errorf("invalid type %s in %T", u.typeName, u)
+	case "foo":
+		return json.Marshal(u.Foo)
+	case "bar":
+		return json.Marshal(u.Bar)
+	case "baz":
+		return json.Marshal(u.Baz)
+	case "string":
+		return json.Marshal(u.String)
+	case "integerOptional":
+		return json.Marshal(u.IntegerOptional)
+	case "stringBooleanMap":
+		return json.Marshal(u.StringBooleanMap)
+	case "stringList":
+		return json.Marshal(u.StringList)
+	case "stringListList":
+		return json.Marshal(u.StringListList)
+	case "doubleSet":
+		return json.Marshal(u.DoubleSet)
+	case "stringLiteral":
+		return json.Marshal("fern")
+	}
+}
+
+type UnionVisitor interface {
+	VisitFoo(*Foo) error
+	VisitBar(*Bar) error
+	VisitBaz(*Baz) error
+	VisitString(string) error
+	VisitIntegerOptional(*int) error
+	VisitStringBooleanMap(map[string]bool) error
+	VisitStringList([]string) error
+	VisitStringListList([][]string) error
+	VisitDoubleSet([]float64) error
+	VisitStringLiteral(string) error
+}
+
+func (u *Union) Accept(visitor UnionVisitor) error {
+	switch u.typeName {
+	default:
+		return fmt.Errorf("invalid type %s in %T", u.typeName, u)
+	case "foo":
+		return visitor.VisitFoo(u.Foo)
+	case "bar":
+		return visitor.VisitBar(u.Bar)
+	case "baz":
+		return visitor.VisitBaz(u.Baz)
+	case "string":
+		return visitor.VisitString(u.String)
+	case "integerOptional":
+		return visitor.VisitIntegerOptional(u.IntegerOptional)
+	case "stringBooleanMap":
+		return visitor.VisitStringBooleanMap(u.StringBooleanMap)
+	case "stringList":
+		return visitor.VisitStringList(u.StringList)
+	case "stringListList":
+		return visitor.VisitStringListList(u.StringListList)
+	case "doubleSet":
+		return visitor.VisitDoubleSet(u.DoubleSet)
+	case "stringLiteral":
+		return visitor.VisitStringLiteral(u.stringLiteral)
+	}
+}
+
+type UnionWithLiteral struct {
+	typeName      string
+	stringLiteral string
+	String        string
+}
+
+func NewUnionWithLiteralWithStringLiteral() *UnionWithLiteral {
+	return &UnionWithLiteral{typeName: "stringLiteral", stringLiteral: "fern"}
+}
+
+func NewUnionWithLiteralFromString(value string) *UnionWithLiteral {
+	return &UnionWithLiteral{typeName: "string", String: value}
+}
+
+func (u *UnionWithLiteral) StringLiteral() string {
+	return u.stringLiteral
+}
+
+func (u *UnionWithLiteral) GetString() string {
+	if u == nil {
+		return ""
+	}
+	return u.String
+}
+
+func (u *UnionWithLiteral) UnmarshalJSON(data []byte) error {
+	var valueStringLiteral string
+	if err := json.Unmarshal(data, &valueStringLiteral); err == nil {
+		if valueStringLiteral == "fern" {
+			u.typeName = "stringLiteral"
+			u.stringLiteral = valueStringLiteral
+			return nil
+		}
+	}
+	var valueString string
+	if err := json.Unmarshal(data, &valueString); err == nil {
+		u.typeName = "string"
+		u.String = valueString
+		return nil
+	}
+	return fmt.Errorf("%s cannot be deserialized as a %T", data, u)
+}
+
+func (u UnionWithLiteral) MarshalJSON() ([]byte, error) {
+	switch u.typeName {
+	default:
+		return nil, fmt.Errorf("invalid type %s in %T", u.typeName, u)
+	case "stringLiteral":
+		return json.Marshal("fern")
+	case "string":
+		return json.Marshal(u.String)
+	}
+}
+
+type UnionWithLiteralVisitor interface {
+	VisitStringLiteral(string) error
+	VisitString(string) error
+}
+
+func (u *UnionWithLiteral) Accept(visitor UnionWithLiteralVisitor) error {
+	switch u.typeName {
+	default:
+		return fmt.Errorf("invalid type %s in %T", u.typeName, u)
+	case "stringLiteral":
+		return visitor.VisitStringLiteral(u.stringLiteral)
+	case "string":
+		return visitor.VisitString(u.String)
+	}
+}
@@ -0,0 +1,167 @@
+package core
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// UnionDecoder deserializes JSON into the members of an undiscriminated
+// union, and records why each member was rejected so that a failure can
+// be explained in a single UnionError.
+//
+// Every attempt rejects unknown fields, so a member only matches when the
+// JSON doesn't include anything it can't represent.
+type UnionDecoder struct {
+	data   []byte
+	errors []*UnionMemberError
+}
+
+// ObjectFields describes the fields of an object member, which are
+// validated before the member is deserialized.
+type ObjectFields struct {
+	// Known lists the wire names of every field in the object.
+	Known []string
+	// Required lists the fields that must be present.
+	Required []string
+	// Literals maps each literal field to the value it must have.
+	Literals map[string]interface{}
+}
+
+// NewUnionDecoder returns a new *UnionDecoder for the given JSON.
+func NewUnionDecoder(data []byte) *UnionDecoder {
+	return &UnionDecoder{data: data}
+}
+
+// Decode deserializes the JSON into the given value, and returns true if
+// it succeeded. Otherwise, the error is recorded for the given member.
+func (d *UnionDecoder) Decode(member string, value interface{}) bool {
+	decoder := json.NewDecoder(bytes.NewReader(d.data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(value); err != nil {
+		d.Reject(member, err)
+		return false
+	}
+	return true
+}
+
+// DecodeObject is like Decode, but first verifies that the JSON is an object
+// that only includes known fields, includes every required field, and sets
+// each literal field to its expected value.
+//
+// The fields are validated separately because object types often implement
+// json.Unmarshaler, which isn't subject to the decoder's DisallowUnknownFields.
+func (d *UnionDecoder) DecodeObject(member string, value interface{}, fields *ObjectFields) bool {
+	if err := fields.validate(d.data); err != nil {
+		d.Reject(member, err)
+		return false
+	}
+	return d.Decode(member, value)
+}
+
+// DecodeLiteral returns true if the JSON is exactly equal to the given
+// literal value (i.e. a string or boolean).
+func (d *UnionDecoder) DecodeLiteral(member string, literal interface{}) bool {
+	var value interface{}
+	if !d.Decode(member, &value) {
+		return false
+	}
+	if value != literal {
+		d.Reject(member, fmt.Errorf("expected literal %s, got %s", formatLiteral(literal), d.data))
+		return false
+	}
+	return true
+}
+
+// Reject records the reason the given member was rejected.
+func (d *UnionDecoder) Reject(member string, err error) {
+	d.errors = append(d.errors, &UnionMemberError{Member: member, Err: err})
+}
+
+// Error returns a *UnionError that explains why every member was rejected.
+func (d *UnionDecoder) Error(typeName string) error {
+	return &UnionError{
+		Type:    typeName,
+		Data:    d.data,
+		Members: d.errors,
+	}
+}
+
+// UnionError is returned when JSON can't be deserialized as any member
+// of an undiscriminated union.
+type UnionError struct {
+	Type    string
+	Data    []byte
+	Members []*UnionMemberError
+}
+
+func (u *UnionError) Error() string {
+	reasons := make([]string, len(u.Members))
+	for i, member := range u.Members {
+		reasons[i] = member.Error()
+	}
+	return fmt.Sprintf("%s cannot be deserialized as a %s (%s)", u.Data, u.Type, strings.Join(reasons, "; "))
+}
+
+// UnionMemberError describes why a single member of an undiscriminated
+// union was rejected.
+type UnionMemberError struct {
+	Member string
+	Err    error
+}
+
+func (u *UnionMemberError) Error() string {
+	return fmt.Sprintf("%s: %v", u.Member, u.Err)
+}
+
+func (u *UnionMemberError) Unwrap() error {
+	return u.Err
+}
+
+func (o *ObjectFields) validate(data []byte) error {
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(data, &object); err != nil || object == nil {
+		return fmt.Errorf("expected a JSON object, got %s", data)
+	}
+	known := make(map[string]struct{}, len(o.Known))
+	for _, field := range o.Known {
+		known[field] = struct{}{}
+	}
+	var unknown []string
+	for field := range object {
+		if _, ok := known[field]; !ok {
+			unknown = append(unknown, field)
+		}
+	}
+	if len(unknown) > 0 {
+		// Sort the fields so that the error is deterministic.
+		sort.Strings(unknown)
+		return fmt.Errorf("unknown field %q", unknown[0])
+	}
+	for _, field := range o.Required {
+		if _, ok := object[field]; !ok {
+			return fmt.Errorf("missing required field %q", field)
+		}
+	}
+	for _, field := range o.Known {
+		literal, ok := o.Literals[field]
+		if !ok {
+			continue
+		}
+		var value interface{}
+		if err := json.Unmarshal(object[field], &value); err != nil || value != literal {
+			return fmt.Errorf("expected literal %s for field %q, got %s", formatLiteral(literal), field, object[field])
+		}
+	}
+	return nil
+}
+
+func formatLiteral(literal interface{}) string {
+	encoded, err := json.Marshal(literal)
+	if err != nil {
+		return fmt.Sprint(literal)
+	}
+	return string(encoded)
+}
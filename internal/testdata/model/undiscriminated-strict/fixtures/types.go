@@ -0,0 +1,783 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package api
+
+import (
+	json "encoding/json"
+	fmt "fmt"
+	core "github.com/fern-api/fern-go/internal/testdata/model/undiscriminated-strict/fixtures/core"
+)
+
+type AnotherUnion struct {
+	typeName      string
+	String        string
+	stringLiteral string
+	Foo           *Foo
+}
+
+func NewAnotherUnionFromString(value string) *AnotherUnion {
+	return &AnotherUnion{typeName: "string", String: value}
+}
+
+func NewAnotherUnionWithStringLiteral() *AnotherUnion {
+	return &AnotherUnion{typeName: "stringLiteral", stringLiteral: "fern"}
+}
+
+func NewAnotherUnionFromFoo(value *Foo) *AnotherUnion {
+	return &AnotherUnion{typeName: "foo", Foo: value}
+}
+
+func (a *AnotherUnion) StringLiteral() string {
+	return a.stringLiteral
+}
+
+func (a *AnotherUnion) GetString() string {
+	if a == nil {
+		return ""
+	}
+	return a.String
+}
+
+func (a *AnotherUnion) GetFoo() *Foo {
+	if a == nil {
+		return nil
+	}
+	return a.Foo
+}
+
+func (a *AnotherUnion) UnmarshalJSON(data []byte) error {
+	decoder := core.NewUnionDecoder(data)
+	var valueString string
+	if decoder.Decode("string", &valueString) {
+		a.typeName = "string"
+		a.String = valueString
+		return nil
+	}
+	if decoder.DecodeLiteral("stringLiteral", "fern") {
+		a.typeName = "stringLiteral"
+		a.stringLiteral = "fern"
+		return nil
+	}
+	valueFoo := new(Foo)
+	if decoder.DecodeObject("foo", &valueFoo, &core.ObjectFields{
+		Known:    []string{"name"},
+		Required: []string{"name"},
+	}) {
+		a.typeName = "foo"
+		a.Foo = valueFoo
+		return nil
+	}
+	return decoder.Error("AnotherUnion")
+}
+
+func (a AnotherUnion) MarshalJSON() ([]byte, error) {
+	switch a.typeName {
+	default:
+		return nil, fmt.Errorf("invalid type %s in %T", a.typeName, a)
+	case "string":
+		return json.Marshal(a.String)
+	case "stringLiteral":
+		return json.Marshal("fern")
+	case "foo":
+		return json.Marshal(a.Foo)
+	}
+}
+
+type AnotherUnionVisitor interface {
+	VisitString(string) error
+	VisitStringLiteral(string) error
+	VisitFoo(*Foo) error
+}
+
+func (a *AnotherUnion) Accept(visitor AnotherUnionVisitor) error {
+	switch a.typeName {
+	default:
+		return fmt.Errorf("invalid type %s in %T", a.typeName, a)
+	case "string":
+		return visitor.VisitString(a.String)
+	case "stringLiteral":
+		return visitor.VisitStringLiteral(a.stringLiteral)
+	case "foo":
+		return visitor.VisitFoo(a.Foo)
+	}
+}
+
+type Bar struct {
+	Name string `json:"name"`
+}
+
+func (b *Bar) GetName() string {
+	if b == nil {
+		return ""
+	}
+	return b.Name
+}
+
+func (b *Bar) String() string {
+	if value, err := core.StringifyJSON(b); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", b)
+}
+
+type Baz struct {
+	Id string `json:"id"`
+}
+
+func (b *Baz) GetId() string {
+	if b == nil {
+		return ""
+	}
+	return b.Id
+}
+
+func (b *Baz) String() string {
+	if value, err := core.StringifyJSON(b); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", b)
+}
+
+type Cat struct {
+	Name string `json:"name"`
+	kind string
+}
+
+func (c *Cat) Kind() string {
+	return c.kind
+}
+
+func (c *Cat) GetName() string {
+	if c == nil {
+		return ""
+	}
+	return c.Name
+}
+
+func (c *Cat) UnmarshalJSON(data []byte) error {
+	type unmarshaler Cat
+	var value unmarshaler
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*c = Cat(value)
+	c.kind = "cat"
+	return nil
+}
+
+func (c *Cat) MarshalJSON() ([]byte, error) {
+	type embed Cat
+	var marshaler = struct {
+		embed
+		Kind string `json:"kind"`
+	}{
+		embed: embed(*c),
+		Kind:  "cat",
+	}
+	return json.Marshal(marshaler)
+}
+
+func (c *Cat) String() string {
+	if value, err := core.StringifyJSON(c); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", c)
+}
+
+type Color string
+
+const (
+	ColorRed   Color = "RED"
+	ColorGreen Color = "GREEN"
+)
+
+func NewColorFromString(s string) (Color, error) {
+	switch s {
+	case "RED":
+		return ColorRed, nil
+	case "GREEN":
+		return ColorGreen, nil
+	}
+	var t Color
+	return "", fmt.Errorf("%s is not a valid %T", s, t)
+}
+
+func (c Color) Ptr() *Color {
+	return &c
+}
+
+type Dog struct {
+	Name    string `json:"name"`
+	GoodBoy *bool  `json:"goodBoy,omitempty"`
+	kind    string
+}
+
+func (d *Dog) Kind() string {
+	return d.kind
+}
+
+func (d *Dog) GetName() string {
+	if d == nil {
+		return ""
+	}
+	return d.Name
+}
+
+func (d *Dog) GetGoodBoy() bool {
+	if d == nil || d.GoodBoy == nil {
+		return false
+	}
+	return *d.GoodBoy
+}
+
+func (d *Dog) UnmarshalJSON(data []byte) error {
+	type unmarshaler Dog
+	var value unmarshaler
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*d = Dog(value)
+	d.kind = "dog"
+	return nil
+}
+
+func (d *Dog) MarshalJSON() ([]byte, error) {
+	type embed Dog
+	var marshaler = struct {
+		embed
+		Kind string `json:"kind"`
+	}{
+		embed: embed(*d),
+		Kind:  "dog",
+	}
+	return json.Marshal(marshaler)
+}
+
+func (d *Dog) String() string {
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+type DogAlias = *Dog
+
+type Foo struct {
+	Name string `json:"name"`
+}
+
+func (f *Foo) GetName() string {
+	if f == nil {
+		return ""
+	}
+	return f.Name
+}
+
+func (f *Foo) String() string {
+	if value, err := core.StringifyJSON(f); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", f)
+}
+
+// Pet is decoded strictly, so each member only matches its own JSON.
+type Pet struct {
+	typeName      string
+	Dog           *Dog
+	Cat           *Cat
+	Color         Color
+	stringLiteral string
+	String        string
+	DogAliasList  []DogAlias
+}
+
+func NewPetFromDog(value *Dog) *Pet {
+	return &Pet{typeName: "dog", Dog: value}
+}
+
+func NewPetFromCat(value *Cat) *Pet {
+	return &Pet{typeName: "cat", Cat: value}
+}
+
+func NewPetFromColor(value Color) *Pet {
+	return &Pet{typeName: "color", Color: value}
+}
+
+func NewPetWithStringLiteral() *Pet {
+	return &Pet{typeName: "stringLiteral", stringLiteral: "none"}
+}
+
+func NewPetFromString(value string) *Pet {
+	return &Pet{typeName: "string", String: value}
+}
+
+func NewPetFromDogAliasList(value []DogAlias) *Pet {
+	return &Pet{typeName: "dogAliasList", DogAliasList: value}
+}
+
+func (p *Pet) StringLiteral() string {
+	return p.stringLiteral
+}
+
+func (p *Pet) GetDog() *Dog {
+	if p == nil {
+		return nil
+	}
+	return p.Dog
+}
+
+func (p *Pet) GetCat() *Cat {
+	if p == nil {
+		return nil
+	}
+	return p.Cat
+}
+
+func (p *Pet) GetColor() Color {
+	if p == nil {
+		return ""
+	}
+	return p.Color
+}
+
+func (p *Pet) GetString() string {
+	if p == nil {
+		return ""
+	}
+	return p.String
+}
+
+func (p *Pet) GetDogAliasList() []DogAlias {
+	if p == nil {
+		return nil
+	}
+	return p.DogAliasList
+}
+
+func (p *Pet) UnmarshalJSON(data []byte) error {
+	decoder := core.NewUnionDecoder(data)
+	valueDog := new(Dog)
+	if decoder.DecodeObject("dog", &valueDog, &core.ObjectFields{
+		Known:    []string{"kind", "name", "goodBoy"},
+		Required: []string{"kind", "name"},
+		Literals: map[string]interface{}{"kind": "dog"},
+	}) {
+		p.typeName = "dog"
+		p.Dog = valueDog
+		return nil
+	}
+	valueCat := new(Cat)
+	if decoder.DecodeObject("cat", &valueCat, &core.ObjectFields{
+		Known:    []string{"kind", "name"},
+		Required: []string{"kind", "name"},
+		Literals: map[string]interface{}{"kind": "cat"},
+	}) {
+		p.typeName = "cat"
+		p.Cat = valueCat
+		return nil
+	}
+	var valueColor Color
+	if decoder.Decode("color", &valueColor) {
+		_, err := NewColorFromString(string(valueColor))
+		if err == nil {
+			p.typeName = "color"
+			p.Color = valueColor
+			return nil
+		}
+		decoder.Reject("color", err)
+	}
+	if decoder.DecodeLiteral("stringLiteral", "none") {
+		p.typeName = "stringLiteral"
+		p.stringLiteral = "none"
+		return nil
+	}
+	var valueString string
+	if decoder.Decode("string", &valueString) {
+		p.typeName = "string"
+		p.String = valueString
+		return nil
+	}
+	var valueDogAliasList []DogAlias
+	if decoder.Decode("dogAliasList", &valueDogAliasList) {
+		p.typeName = "dogAliasList"
+		p.DogAliasList = valueDogAliasList
+		return nil
+	}
+	return decoder.Error("Pet")
+}
+
+func (p Pet) MarshalJSON() ([]byte, error) {
+	switch p.typeName {
+	default:
+		return nil, fmt.Errorf("invalid type %s in %T", p.typeName, p)
+	case "dog":
+		return json.Marshal(p.Dog)
+	case "cat":
+		return json.Marshal(p.Cat)
+	case "color":
+		return json.Marshal(p.Color)
+	case "stringLiteral":
+		return json.Marshal("none")
+	case "string":
+		return json.Marshal(p.String)
+	case "dogAliasList":
+		return json.Marshal(p.DogAliasList)
+	}
+}
+
+type PetVisitor interface {
+	VisitDog(*Dog) error
+	VisitCat(*Cat) error
+	VisitColor(Color) error
+	VisitStringLiteral(string) error
+	VisitString(string) error
+	VisitDogAliasList([]DogAlias) error
+}
+
+func (p *Pet) Accept(visitor PetVisitor) error {
+	switch p.typeName {
+	default:
+		return fmt.Errorf("invalid type %s in %T", p.typeName, p)
+	case "dog":
+		return visitor.VisitDog(p.Dog)
+	case "cat":
+		return visitor.VisitCat(p.Cat)
+	case "color":
+		return visitor.VisitColor(p.Color)
+	case "stringLiteral":
+		return visitor.VisitStringLiteral(p.stringLiteral)
+	case "string":
+		return visitor.VisitString(p.String)
+	case "dogAliasList":
+		return visitor.VisitDogAliasList(p.DogAliasList)
+	}
+}
+
+type Union struct {
+	typeName         string
+	Foo              *Foo
+	Bar              *Bar
+	Baz              *Baz
+	String           string
+	IntegerOptional  *int
+	StringBooleanMap map[string]bool
+	StringList       []string
+	StringListList   [][]string
+	DoubleSet        []float64
+	stringLiteral    string
+}
+
+func NewUnionFromFoo(value *Foo) *Union {
+	return &Union{typeName: "foo", Foo: value}
+}
+
+func NewUnionFromBar(value *Bar) *Union {
+	return &Union{typeName: "bar", Bar: value}
+}
+
+func NewUnionFromBaz(value *Baz) *Union {
+	return &Union{typeName: "baz", Baz: value}
+}
+
+func NewUnionFromString(value string) *Union {
+	return &Union{typeName: "string", String: value}
+}
+
+func NewUnionFromIntegerOptional(value *int) *Union {
+	return &Union{typeName: "integerOptional", IntegerOptional: value}
+}
+
+func NewUnionFromStringBooleanMap(value map[string]bool) *Union {
+	return &Union{typeName: "stringBooleanMap", StringBooleanMap: value}
+}
+
+func NewUnionFromStringList(value []string) *Union {
+	return &Union{typeName: "stringList", StringList: value}
+}
+
+func NewUnionFromStringListList(value [][]string) *Union {
+	return &Union{typeName: "stringListList", StringListList: value}
+}
+
+func NewUnionFromDoubleSet(value []float64) *Union {
+	return &Union{typeName: "doubleSet", DoubleSet: value}
+}
+
+func NewUnionWithStringLiteral() *Union {
+	return &Union{typeName: "stringLiteral", stringLiteral: "fern"}
+}
+
+func (u *Union) StringLiteral() string {
+	return u.stringLiteral
+}
+
+func (u *Union) GetFoo() *Foo {
+	if u == nil {
+		return nil
+	}
+	return u.Foo
+}
+
+func (u *Union) GetBar() *Bar {
+	if u == nil {
+		return nil
+	}
+	return u.Bar
+}
+
+func (u *Union) GetBaz() *Baz {
+	if u == nil {
+		return nil
+	}
+	return u.Baz
+}
+
+func (u *Union) GetString() string {
+	if u == nil {
+		return ""
+	}
+	return u.String
+}
+
+func (u *Union) GetIntegerOptional() int {
+	if u == nil || u.IntegerOptional == nil {
+		return 0
+	}
+	return *u.IntegerOptional
+}
+
+func (u *Union) GetStringBooleanMap() map[string]bool {
+	if u == nil {
+		return nil
+	}
+	return u.StringBooleanMap
+}
+
+func (u *Union) GetStringList() []string {
+	if u == nil {
+		return nil
+	}
+	return u.StringList
+}
+
+func (u *Union) GetStringListList() [][]string {
+	if u == nil {
+		return nil
+	}
+	return u.StringListList
+}
+
+func (u *Union) GetDoubleSet() []float64 {
+	if u == nil {
+		return nil
+	}
+	return u.DoubleSet
+}
+
+func (u *Union) UnmarshalJSON(data []byte) error {
+	decoder := core.NewUnionDecoder(data)
+	valueFoo := new(Foo)
+	if decoder.DecodeObject("foo", &valueFoo, &core.ObjectFields{
+		Known:    []string{"name"},
+		Required: []string{"name"},
+	}) {
+		u.typeName = "foo"
+		u.Foo = valueFoo
+		return nil
+	}
+	valueBar := new(Bar)
+	if decoder.DecodeObject("bar", &valueBar, &core.ObjectFields{
+		Known:    []string{"name"},
+		Required: []string{"name"},
+	}) {
+		u.typeName = "bar"
+		u.Bar = valueBar
+		return nil
+	}
+	valueBaz := new(Baz)
+	if decoder.DecodeObject("baz", &valueBaz, &core.ObjectFields{
+		Known:    []string{"id"},
+		Required: []string{"id"},
+	}) {
+		u.typeName = "baz"
+		u.Baz = valueBaz
+		return nil
+	}
+	var valueString string
+	if decoder.Decode("string", &valueString) {
+		u.typeName = "string"
+		u.String = valueString
+		return nil
+	}
+	var valueIntegerOptional *int
+	if decoder.Decode("integerOptional", &valueIntegerOptional) {
+		u.typeName = "integerOptional"
+		u.IntegerOptional = valueIntegerOptional
+		return nil
+	}
+	var valueStringBooleanMap map[string]bool
+	if decoder.Decode("stringBooleanMap", &valueStringBooleanMap) {
+		u.typeName = "stringBooleanMap"
+		u.StringBooleanMap = valueStringBooleanMap
+		return nil
+	}
+	var valueStringList []string
+	if decoder.Decode("stringList", &valueStringList) {
+		u.typeName = "stringList"
+		u.StringList = valueStringList
+		return nil
+	}
+	var valueStringListList [][]string
+	if decoder.Decode("stringListList", &valueStringListList) {
+		u.typeName = "stringListList"
+		u.StringListList = valueStringListList
+		return nil
+	}
+	var valueDoubleSet []float64
+	if decoder.Decode("doubleSet", &valueDoubleSet) {
+		u.typeName = "doubleSet"
+		u.DoubleSet = valueDoubleSet
+		return nil
+	}
+	if decoder.DecodeLiteral("stringLiteral", "fern") {
+		u.typeName = "stringLiteral"
+		u.stringLiteral = "fern"
+		return nil
+	}
+	return decoder.Error("Union")
+}
+
+func (u Union) MarshalJSON() ([]byte, error) {
+	switch u.typeName {
+	default:
+		return nil, fmt.Errorf("invalid type %s in %T", u.typeName, u)
+	case "foo":
+		return json.Marshal(u.Foo)
+	case "bar":
+		return json.Marshal(u.Bar)
+	case "baz":
+		return json.Marshal(u.Baz)
+	case "string":
+		return json.Marshal(u.String)
+	case "integerOptional":
+		return json.Marshal(u.IntegerOptional)
+	case "stringBooleanMap":
+		return json.Marshal(u.StringBooleanMap)
+	case "stringList":
+		return json.Marshal(u.StringList)
+	case "stringListList":
+		return json.Marshal(u.StringListList)
+	case "doubleSet":
+		return json.Marshal(u.DoubleSet)
+	case "stringLiteral":
+		return json.Marshal("fern")
+	}
+}
+
+type UnionVisitor interface {
+	VisitFoo(*Foo) error
+	VisitBar(*Bar) error
+	VisitBaz(*Baz) error
+	VisitString(string) error
+	VisitIntegerOptional(*int) error
+	VisitStringBooleanMap(map[string]bool) error
+	VisitStringList([]string) error
+	VisitStringListList([][]string) error
+	VisitDoubleSet([]float64) error
+	VisitStringLiteral(string) error
+}
+
+func (u *Union) Accept(visitor UnionVisitor) error {
+	switch u.typeName {
+	default:
+		return fmt.Errorf("invalid type %s in %T", u.typeName, u)
+	case "foo":
+		return visitor.VisitFoo(u.Foo)
+	case "bar":
+		return visitor.VisitBar(u.Bar)
+	case "baz":
+		return visitor.VisitBaz(u.Baz)
+	case "string":
+		return visitor.VisitString(u.String)
+	case "integerOptional":
+		return visitor.VisitIntegerOptional(u.IntegerOptional)
+	case "stringBooleanMap":
+		return visitor.VisitStringBooleanMap(u.StringBooleanMap)
+	case "stringList":
+		return visitor.VisitStringList(u.StringList)
+	case "stringListList":
+		return visitor.VisitStringListList(u.StringListList)
+	case "doubleSet":
+		return visitor.VisitDoubleSet(u.DoubleSet)
+	case "stringLiteral":
+		return visitor.VisitStringLiteral(u.stringLiteral)
+	}
+}
+
+type UnionWithLiteral struct {
+	typeName      string
+	stringLiteral string
+	String        string
+}
+
+func NewUnionWithLiteralWithStringLiteral() *UnionWithLiteral {
+	return &UnionWithLiteral{typeName: "stringLiteral", stringLiteral: "fern"}
+}
+
+func NewUnionWithLiteralFromString(value string) *UnionWithLiteral {
+	return &UnionWithLiteral{typeName: "string", String: value}
+}
+
+func (u *UnionWithLiteral) StringLiteral() string {
+	return u.stringLiteral
+}
+
+func (u *UnionWithLiteral) GetString() string {
+	if u == nil {
+		return ""
+	}
+	return u.String
+}
+
+func (u *UnionWithLiteral) UnmarshalJSON(data []byte) error {
+	decoder := core.NewUnionDecoder(data)
+	if decoder.DecodeLiteral("stringLiteral", "fern") {
+		u.typeName = "stringLiteral"
+		u.stringLiteral = "fern"
+		return nil
+	}
+	var valueString string
+	if decoder.Decode("string", &valueString) {
+		u.typeName = "string"
+		u.String = valueString
+		return nil
+	}
+	return decoder.Error("UnionWithLiteral")
+}
+
+func (u UnionWithLiteral) MarshalJSON() ([]byte, error) {
+	switch u.typeName {
+	default:
+		return nil, fmt.Errorf("invalid type %s in %T", u.typeName, u)
+	case "stringLiteral":
+		return json.Marshal("fern")
+	case "string":
+		return json.Marshal(u.String)
+	}
+}
+
+type UnionWithLiteralVisitor interface {
+	VisitStringLiteral(string) error
+	VisitString(string) error
+}
+
+func (u *UnionWithLiteral) Accept(visitor UnionWithLiteralVisitor) error {
+	switch u.typeName {
+	default:
+		return fmt.Errorf("invalid type %s in %T", u.typeName, u)
+	case "stringLiteral":
+		return visitor.VisitStringLiteral(u.stringLiteral)
+	case "string":
+		return visitor.VisitString(u.String)
+	}
+}
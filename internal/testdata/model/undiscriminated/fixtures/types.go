@@ -31,6 +31,20 @@ func (a *AnotherUnion) StringLiteral() string {
 	return a.stringLiteral
 }
 
+func (a *AnotherUnion) GetString() string {
+	if a == nil {
+		return ""
+	}
+	return a.String
+}
+
+func (a *AnotherUnion) GetFoo() *Foo {
+	if a == nil {
+		return nil
+	}
+	return a.Foo
+}
+
 func (a *AnotherUnion) UnmarshalJSON(data []byte) error {
 	var valueString string
 	if err := json.Unmarshal(data, &valueString); err == nil {
@@ -91,6 +105,13 @@ type Bar struct {
 	Name string `json:"name"`
 }
 
+func (b *Bar) GetName() string {
+	if b == nil {
+		return ""
+	}
+	return b.Name
+}
+
 func (b *Bar) String() string {
 	if value, err := core.StringifyJSON(b); err == nil {
 		return value
@@ -102,6 +123,13 @@ type Baz struct {
 	Id string `json:"id"`
 }
 
+func (b *Baz) GetId() string {
+	if b == nil {
+		return ""
+	}
+	return b.Id
+}
+
 func (b *Baz) String() string {
 	if value, err := core.StringifyJSON(b); err == nil {
 		return value
@@ -113,6 +141,13 @@ type Foo struct {
 	Name string `json:"name"`
 }
 
+func (f *Foo) GetName() string {
+	if f == nil {
+		return ""
+	}
+	return f.Name
+}
+
 func (f *Foo) String() string {
 	if value, err := core.StringifyJSON(f); err == nil {
 		return value
@@ -178,6 +213,69 @@ func (u *Union) StringLiteral() string {
 	return u.stringLiteral
 }
 
+func (u *Union) GetFoo() *Foo {
+	if u == nil {
+		return nil
+	}
+	return u.Foo
+}
+
+func (u *Union) GetBar() *Bar {
+	if u == nil {
+		return nil
+	}
+	return u.Bar
+}
+
+func (u *Union) GetBaz() *Baz {
+	if u == nil {
+		return nil
+	}
+	return u.Baz
+}
+
+func (u *Union) GetString() string {
+	if u == nil {
+		return ""
+	}
+	return u.String
+}
+
+func (u *Union) GetIntegerOptional() int {
+	if u == nil || u.IntegerOptional == nil {
+		return 0
+	}
+	return *u.IntegerOptional
+}
+
+func (u *Union) GetStringBooleanMap() map[string]bool {
+	if u == nil {
+		return nil
+	}
+	return u.StringBooleanMap
+}
+
+func (u *Union) GetStringList() []string {
+	if u == nil {
+		return nil
+	}
+	return u.StringList
+}
+
+func (u *Union) GetStringListList() [][]string {
+	if u == nil {
+		return nil
+	}
+	return u.StringListList
+}
+
+func (u *Union) GetDoubleSet() []float64 {
+	if u == nil {
+		return nil
+	}
+	return u.DoubleSet
+}
+
 func (u *Union) UnmarshalJSON(data []byte) error {
 	valueFoo := new(Foo)
 	if err := json.Unmarshal(data, &valueFoo); err == nil {
@@ -329,6 +427,13 @@ func (u *UnionWithLiteral) StringLiteral() string {
 	return u.stringLiteral
 }
 
+func (u *UnionWithLiteral) GetString() string {
+	if u == nil {
+		return ""
+	}
+	return u.String
+}
+
 func (u *UnionWithLiteral) UnmarshalJSON(data []byte) error {
 	var valueStringLiteral string
 	if err := json.Unmarshal(data, &valueStringLiteral); err == nil {
@@ -0,0 +1,1545 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package api
+
+import (
+	json "encoding/json"
+	fmt "fmt"
+	core "github.com/fern-api/fern-go/internal/testdata/model/union-interface/fixtures/core"
+)
+
+type Bar struct {
+	Name string `json:"name"`
+}
+
+func (b *Bar) GetName() string {
+	if b == nil {
+		return ""
+	}
+	return b.Name
+}
+
+func (b *Bar) String() string {
+	if value, err := core.StringifyJSON(b); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", b)
+}
+
+type Baz struct {
+	extended string
+}
+
+func (b *Baz) Extended() string {
+	return b.extended
+}
+
+func (b *Baz) UnmarshalJSON(data []byte) error {
+	type unmarshaler Baz
+	var value unmarshaler
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*b = Baz(value)
+	b.extended = "extended"
+	return nil
+}
+
+func (b *Baz) MarshalJSON() ([]byte, error) {
+	type embed Baz
+	var marshaler = struct {
+		embed
+		Extended string `json:"extended"`
+	}{
+		embed:    embed(*b),
+		Extended: "extended",
+	}
+	return json.Marshal(marshaler)
+}
+
+func (b *Baz) String() string {
+	if value, err := core.StringifyJSON(b); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", b)
+}
+
+type Circle struct {
+	Radius float64 `json:"radius"`
+}
+
+func (c *Circle) GetRadius() float64 {
+	if c == nil {
+		return 0
+	}
+	return c.Radius
+}
+
+func (c *Circle) String() string {
+	if value, err := core.StringifyJSON(c); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", c)
+}
+
+type Drawing struct {
+	Name          string                  `json:"name"`
+	Shape         Shape                   `json:"shape,omitempty"`
+	OptionalShape Shape                   `json:"optionalShape,omitempty"`
+	Shapes        []Shape                 `json:"shapes,omitempty"`
+	ShapesByName  map[string][]ShapeAlias `json:"shapesByName,omitempty"`
+	Alias         ShapeAlias              `json:"alias,omitempty"`
+	Value         Value                   `json:"value,omitempty"`
+	Values        []Value                 `json:"values,omitempty"`
+}
+
+func (d *Drawing) GetName() string {
+	if d == nil {
+		return ""
+	}
+	return d.Name
+}
+
+func (d *Drawing) GetShape() Shape {
+	if d == nil {
+		return nil
+	}
+	return d.Shape
+}
+
+func (d *Drawing) GetOptionalShape() Shape {
+	if d == nil {
+		return nil
+	}
+	return d.OptionalShape
+}
+
+func (d *Drawing) GetShapes() []Shape {
+	if d == nil {
+		return nil
+	}
+	return d.Shapes
+}
+
+func (d *Drawing) GetShapesByName() map[string][]ShapeAlias {
+	if d == nil {
+		return nil
+	}
+	return d.ShapesByName
+}
+
+func (d *Drawing) GetAlias() ShapeAlias {
+	if d == nil {
+		return nil
+	}
+	return d.Alias
+}
+
+func (d *Drawing) GetValue() Value {
+	if d == nil {
+		return nil
+	}
+	return d.Value
+}
+
+func (d *Drawing) GetValues() []Value {
+	if d == nil {
+		return nil
+	}
+	return d.Values
+}
+
+func (d *Drawing) UnmarshalJSON(data []byte) error {
+	type embed Drawing
+	var unmarshaler = struct {
+		embed
+		Shape         json.RawMessage `json:"shape"`
+		OptionalShape json.RawMessage `json:"optionalShape"`
+		Shapes        json.RawMessage `json:"shapes"`
+		ShapesByName  json.RawMessage `json:"shapesByName"`
+		Alias         json.RawMessage `json:"alias"`
+		Value         json.RawMessage `json:"value"`
+		Values        json.RawMessage `json:"values"`
+	}{}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	*d = Drawing(unmarshaler.embed)
+	if unmarshaler.Shape != nil {
+		value0, err := UnmarshalShape(unmarshaler.Shape)
+		if err != nil {
+			return err
+		}
+		d.Shape = value0
+	}
+	if unmarshaler.OptionalShape != nil {
+		value0, err := UnmarshalShape(unmarshaler.OptionalShape)
+		if err != nil {
+			return err
+		}
+		d.OptionalShape = value0
+	}
+	if unmarshaler.Shapes != nil {
+		var raws0 []json.RawMessage
+		if err := json.Unmarshal(unmarshaler.Shapes, &raws0); err != nil {
+			return err
+		}
+		if raws0 != nil {
+			d.Shapes = make([]Shape, len(raws0))
+			for i0, raw0 := range raws0 {
+				value1, err := UnmarshalShape(raw0)
+				if err != nil {
+					return err
+				}
+				d.Shapes[i0] = value1
+			}
+		}
+	}
+	if unmarshaler.ShapesByName != nil {
+		var raws0 map[string]json.RawMessage
+		if err := json.Unmarshal(unmarshaler.ShapesByName, &raws0); err != nil {
+			return err
+		}
+		if raws0 != nil {
+			d.ShapesByName = make(map[string][]ShapeAlias, len(raws0))
+			for key0, raw0 := range raws0 {
+				var raws1 []json.RawMessage
+				if err := json.Unmarshal(raw0, &raws1); err != nil {
+					return err
+				}
+				if raws1 != nil {
+					d.ShapesByName[key0] = make([]ShapeAlias, len(raws1))
+					for i1, raw1 := range raws1 {
+						value2, err := UnmarshalShape(raw1)
+						if err != nil {
+							return err
+						}
+						d.ShapesByName[key0][i1] = value2
+					}
+				}
+			}
+		}
+	}
+	if unmarshaler.Alias != nil {
+		value0, err := UnmarshalShape(unmarshaler.Alias)
+		if err != nil {
+			return err
+		}
+		d.Alias = value0
+	}
+	if unmarshaler.Value != nil {
+		value0, err := UnmarshalValue(unmarshaler.Value)
+		if err != nil {
+			return err
+		}
+		d.Value = value0
+	}
+	if unmarshaler.Values != nil {
+		var raws0 []json.RawMessage
+		if err := json.Unmarshal(unmarshaler.Values, &raws0); err != nil {
+			return err
+		}
+		if raws0 != nil {
+			d.Values = make([]Value, len(raws0))
+			for i0, raw0 := range raws0 {
+				value1, err := UnmarshalValue(raw0)
+				if err != nil {
+					return err
+				}
+				d.Values[i0] = value1
+			}
+		}
+	}
+	return nil
+}
+
+func (d *Drawing) String() string {
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+type Enum string
+
+const (
+	// The first enum value.
+	EnumOne   Enum = "ONE"
+	EnumTwo   Enum = "TWO"
+	EnumThree Enum = "THREE"
+)
+
+func NewEnumFromString(s string) (Enum, error) {
+	switch s {
+	case "ONE":
+		return EnumOne, nil
+	case "TWO":
+		return EnumTwo, nil
+	case "THREE":
+		return EnumThree, nil
+	}
+	var t Enum
+	return "", fmt.Errorf("%s is not a valid %T", s, t)
+}
+
+func (e Enum) Ptr() *Enum {
+	return &e
+}
+
+func (e Enum) IsKnown() bool {
+	switch e {
+	case EnumOne, EnumTwo, EnumThree:
+		return true
+	}
+	return false
+}
+
+func (e Enum) Values() []Enum {
+	return []Enum{
+		EnumOne,
+		EnumTwo,
+		EnumThree,
+	}
+}
+
+type Foo struct {
+	Name string `json:"name"`
+}
+
+func (f *Foo) GetName() string {
+	if f == nil {
+		return ""
+	}
+	return f.Name
+}
+
+func (f *Foo) String() string {
+	if value, err := core.StringifyJSON(f); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", f)
+}
+
+type Movie struct {
+	Title        string                  `json:"title"`
+	Rating       float64                 `json:"rating"`
+	Enum         Enum                    `json:"enum,omitempty"`
+	OptionalEnum *Enum                   `json:"optionalEnum,omitempty"`
+	Foo          *Foo                    `json:"foo,omitempty"`
+	OptionalFoo  *Foo                    `json:"optionalFoo,omitempty"`
+	Union        Union                   `json:"union,omitempty"`
+	Unions       []UnionWithDiscriminant `json:"unions,omitempty"`
+	Bars         map[string]*Bar         `json:"bars,omitempty"`
+	Counts       map[Enum]int            `json:"counts,omitempty"`
+	Tags         []string                `json:"tags,omitempty"`
+	Metadata     map[string]interface{}  `json:"metadata,omitempty"`
+}
+
+func (m *Movie) GetTitle() string {
+	if m == nil {
+		return ""
+	}
+	return m.Title
+}
+
+func (m *Movie) GetRating() float64 {
+	if m == nil {
+		return 0
+	}
+	return m.Rating
+}
+
+func (m *Movie) GetEnum() Enum {
+	if m == nil {
+		return ""
+	}
+	return m.Enum
+}
+
+func (m *Movie) GetOptionalEnum() Enum {
+	if m == nil || m.OptionalEnum == nil {
+		return ""
+	}
+	return *m.OptionalEnum
+}
+
+func (m *Movie) GetFoo() *Foo {
+	if m == nil {
+		return nil
+	}
+	return m.Foo
+}
+
+func (m *Movie) GetOptionalFoo() *Foo {
+	if m == nil {
+		return nil
+	}
+	return m.OptionalFoo
+}
+
+func (m *Movie) GetUnion() Union {
+	if m == nil {
+		return nil
+	}
+	return m.Union
+}
+
+func (m *Movie) GetUnions() []UnionWithDiscriminant {
+	if m == nil {
+		return nil
+	}
+	return m.Unions
+}
+
+func (m *Movie) GetBars() map[string]*Bar {
+	if m == nil {
+		return nil
+	}
+	return m.Bars
+}
+
+func (m *Movie) GetCounts() map[Enum]int {
+	if m == nil {
+		return nil
+	}
+	return m.Counts
+}
+
+func (m *Movie) GetTags() []string {
+	if m == nil {
+		return nil
+	}
+	return m.Tags
+}
+
+func (m *Movie) GetMetadata() map[string]interface{} {
+	if m == nil {
+		return nil
+	}
+	return m.Metadata
+}
+
+func (m *Movie) UnmarshalJSON(data []byte) error {
+	type embed Movie
+	var unmarshaler = struct {
+		embed
+		Union  json.RawMessage `json:"union"`
+		Unions json.RawMessage `json:"unions"`
+	}{}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	*m = Movie(unmarshaler.embed)
+	if unmarshaler.Union != nil {
+		value0, err := UnmarshalUnion(unmarshaler.Union)
+		if err != nil {
+			return err
+		}
+		m.Union = value0
+	}
+	if unmarshaler.Unions != nil {
+		var raws0 []json.RawMessage
+		if err := json.Unmarshal(unmarshaler.Unions, &raws0); err != nil {
+			return err
+		}
+		if raws0 != nil {
+			m.Unions = make([]UnionWithDiscriminant, len(raws0))
+			for i0, raw0 := range raws0 {
+				value1, err := UnmarshalUnionWithDiscriminant(raw0)
+				if err != nil {
+					return err
+				}
+				m.Unions[i0] = value1
+			}
+		}
+	}
+	return nil
+}
+
+func (m *Movie) String() string {
+	if value, err := core.StringifyJSON(m); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", m)
+}
+
+// A shape with a few kinds of variants.
+type Shape interface {
+	isShape()
+}
+
+// A circle with a radius.
+type ShapeCircle struct {
+	Id    string
+	Label *string
+	Value *Circle
+}
+
+func (*ShapeCircle) isShape() {}
+
+func (s *ShapeCircle) GetId() string {
+	if s == nil {
+		return ""
+	}
+	return s.Id
+}
+
+func (s *ShapeCircle) GetLabel() string {
+	if s == nil || s.Label == nil {
+		return ""
+	}
+	return *s.Label
+}
+
+func (s *ShapeCircle) GetValue() *Circle {
+	if s == nil {
+		return nil
+	}
+	return s.Value
+}
+
+func (s *ShapeCircle) UnmarshalJSON(data []byte) error {
+	var unmarshaler struct {
+		Id    string  `json:"id"`
+		Label *string `json:"label,omitempty"`
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	s.Id = unmarshaler.Id
+	s.Label = unmarshaler.Label
+	value := new(Circle)
+	if err := json.Unmarshal(data, value); err != nil {
+		return err
+	}
+	s.Value = value
+	return nil
+}
+
+func (s *ShapeCircle) MarshalJSON() ([]byte, error) {
+	var marshaler = struct {
+		Type    string  `json:"type"`
+		Id      string  `json:"id"`
+		Label   *string `json:"label,omitempty"`
+		Version string  `json:"version"`
+		*Circle
+	}{
+		Type:    "circle",
+		Id:      s.Id,
+		Label:   s.Label,
+		Version: "v1",
+		Circle:  s.Value,
+	}
+	return json.Marshal(marshaler)
+}
+
+type ShapeSquare struct {
+	Id    string
+	Label *string
+	Value float64
+}
+
+func (*ShapeSquare) isShape() {}
+
+func (s *ShapeSquare) GetId() string {
+	if s == nil {
+		return ""
+	}
+	return s.Id
+}
+
+func (s *ShapeSquare) GetLabel() string {
+	if s == nil || s.Label == nil {
+		return ""
+	}
+	return *s.Label
+}
+
+func (s *ShapeSquare) GetValue() float64 {
+	if s == nil {
+		return 0
+	}
+	return s.Value
+}
+
+func (s *ShapeSquare) UnmarshalJSON(data []byte) error {
+	var unmarshaler struct {
+		Id    string  `json:"id"`
+		Label *string `json:"label,omitempty"`
+		Value float64 `json:"length"`
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	s.Id = unmarshaler.Id
+	s.Label = unmarshaler.Label
+	s.Value = unmarshaler.Value
+	return nil
+}
+
+func (s *ShapeSquare) MarshalJSON() ([]byte, error) {
+	var marshaler = struct {
+		Type    string  `json:"type"`
+		Id      string  `json:"id"`
+		Label   *string `json:"label,omitempty"`
+		Version string  `json:"version"`
+		Value   float64 `json:"length"`
+	}{
+		Type:    "square",
+		Id:      s.Id,
+		Label:   s.Label,
+		Version: "v1",
+		Value:   s.Value,
+	}
+	return json.Marshal(marshaler)
+}
+
+type ShapeNested struct {
+	Id    string
+	Label *string
+	Value Union
+}
+
+func (*ShapeNested) isShape() {}
+
+func (s *ShapeNested) GetId() string {
+	if s == nil {
+		return ""
+	}
+	return s.Id
+}
+
+func (s *ShapeNested) GetLabel() string {
+	if s == nil || s.Label == nil {
+		return ""
+	}
+	return *s.Label
+}
+
+func (s *ShapeNested) GetValue() Union {
+	if s == nil {
+		return nil
+	}
+	return s.Value
+}
+
+func (s *ShapeNested) UnmarshalJSON(data []byte) error {
+	var unmarshaler struct {
+		Id    string          `json:"id"`
+		Label *string         `json:"label,omitempty"`
+		Value json.RawMessage `json:"value,omitempty"`
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	s.Id = unmarshaler.Id
+	s.Label = unmarshaler.Label
+	if unmarshaler.Value != nil {
+		value0, err := UnmarshalUnion(unmarshaler.Value)
+		if err != nil {
+			return err
+		}
+		s.Value = value0
+	}
+	return nil
+}
+
+func (s *ShapeNested) MarshalJSON() ([]byte, error) {
+	var marshaler = struct {
+		Type    string  `json:"type"`
+		Id      string  `json:"id"`
+		Label   *string `json:"label,omitempty"`
+		Version string  `json:"version"`
+		Value   Union   `json:"value,omitempty"`
+	}{
+		Type:    "nested",
+		Id:      s.Id,
+		Label:   s.Label,
+		Version: "v1",
+		Value:   s.Value,
+	}
+	return json.Marshal(marshaler)
+}
+
+type ShapeFixed struct {
+	Id    string
+	Label *string
+}
+
+func (*ShapeFixed) isShape() {}
+
+func (s *ShapeFixed) GetId() string {
+	if s == nil {
+		return ""
+	}
+	return s.Id
+}
+
+func (s *ShapeFixed) GetLabel() string {
+	if s == nil || s.Label == nil {
+		return ""
+	}
+	return *s.Label
+}
+
+func (s *ShapeFixed) UnmarshalJSON(data []byte) error {
+	var unmarshaler struct {
+		Id    string  `json:"id"`
+		Label *string `json:"label,omitempty"`
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	s.Id = unmarshaler.Id
+	s.Label = unmarshaler.Label
+	return nil
+}
+
+func (s *ShapeFixed) MarshalJSON() ([]byte, error) {
+	var marshaler = struct {
+		Type    string  `json:"type"`
+		Id      string  `json:"id"`
+		Label   *string `json:"label,omitempty"`
+		Version string  `json:"version"`
+		Value   string  `json:"value,omitempty"`
+	}{
+		Type:    "fixed",
+		Id:      s.Id,
+		Label:   s.Label,
+		Version: "v1",
+		Value:   "fixed",
+	}
+	return json.Marshal(marshaler)
+}
+
+type ShapeEmpty struct {
+	Id    string
+	Label *string
+}
+
+func (*ShapeEmpty) isShape() {}
+
+func (s *ShapeEmpty) GetId() string {
+	if s == nil {
+		return ""
+	}
+	return s.Id
+}
+
+func (s *ShapeEmpty) GetLabel() string {
+	if s == nil || s.Label == nil {
+		return ""
+	}
+	return *s.Label
+}
+
+func (s *ShapeEmpty) UnmarshalJSON(data []byte) error {
+	var unmarshaler struct {
+		Id    string  `json:"id"`
+		Label *string `json:"label,omitempty"`
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	s.Id = unmarshaler.Id
+	s.Label = unmarshaler.Label
+	return nil
+}
+
+func (s *ShapeEmpty) MarshalJSON() ([]byte, error) {
+	var marshaler = struct {
+		Type    string  `json:"type"`
+		Id      string  `json:"id"`
+		Label   *string `json:"label,omitempty"`
+		Version string  `json:"version"`
+	}{
+		Type:    "empty",
+		Id:      s.Id,
+		Label:   s.Label,
+		Version: "v1",
+	}
+	return json.Marshal(marshaler)
+}
+
+// ShapeUnknown is a variant that isn't recognized by this version of the SDK.
+type ShapeUnknown struct {
+	Type string
+	Raw  json.RawMessage
+}
+
+func (*ShapeUnknown) isShape() {}
+
+func (s *ShapeUnknown) MarshalJSON() ([]byte, error) {
+	if s.Raw == nil {
+		return nil, fmt.Errorf("invalid type %s in %T", s.Type, s)
+	}
+	return s.Raw, nil
+}
+
+// UnmarshalShape deserializes the given JSON into the Shape variant
+// identified by its "type" discriminant.
+func UnmarshalShape(data []byte) (Shape, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return nil, nil
+	}
+	var unmarshaler struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return nil, err
+	}
+	var value Shape
+	switch unmarshaler.Type {
+	case "circle":
+		value = new(ShapeCircle)
+	case "square":
+		value = new(ShapeSquare)
+	case "nested":
+		value = new(ShapeNested)
+	case "fixed":
+		value = new(ShapeFixed)
+	case "empty":
+		value = new(ShapeEmpty)
+	default:
+		return &ShapeUnknown{Type: unmarshaler.Type, Raw: append(json.RawMessage(nil), data...)}, nil
+	}
+	if err := json.Unmarshal(data, value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+type ShapeAlias = Shape
+
+// This is a simple union.
+type Union interface {
+	isUnion()
+}
+
+type UnionFoo struct {
+	Value *Foo
+}
+
+func (*UnionFoo) isUnion() {}
+
+func (u *UnionFoo) GetValue() *Foo {
+	if u == nil {
+		return nil
+	}
+	return u.Value
+}
+
+func (u *UnionFoo) UnmarshalJSON(data []byte) error {
+	var unmarshaler struct {
+		Value *Foo `json:"foo,omitempty"`
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	u.Value = unmarshaler.Value
+	return nil
+}
+
+func (u *UnionFoo) MarshalJSON() ([]byte, error) {
+	var marshaler = struct {
+		Type  string `json:"type"`
+		Value *Foo   `json:"foo,omitempty"`
+	}{
+		Type:  "foo",
+		Value: u.Value,
+	}
+	return json.Marshal(marshaler)
+}
+
+type UnionBar struct {
+	Value *Bar
+}
+
+func (*UnionBar) isUnion() {}
+
+func (u *UnionBar) GetValue() *Bar {
+	if u == nil {
+		return nil
+	}
+	return u.Value
+}
+
+func (u *UnionBar) UnmarshalJSON(data []byte) error {
+	var unmarshaler struct {
+		Value *Bar `json:"bar,omitempty"`
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	u.Value = unmarshaler.Value
+	return nil
+}
+
+func (u *UnionBar) MarshalJSON() ([]byte, error) {
+	var marshaler = struct {
+		Type  string `json:"type"`
+		Value *Bar   `json:"bar,omitempty"`
+	}{
+		Type:  "bar",
+		Value: u.Value,
+	}
+	return json.Marshal(marshaler)
+}
+
+// UnionUnknown is a variant that isn't recognized by this version of the SDK.
+type UnionUnknown struct {
+	Type string
+	Raw  json.RawMessage
+}
+
+func (*UnionUnknown) isUnion() {}
+
+func (u *UnionUnknown) MarshalJSON() ([]byte, error) {
+	if u.Raw == nil {
+		return nil, fmt.Errorf("invalid type %s in %T", u.Type, u)
+	}
+	return u.Raw, nil
+}
+
+// UnmarshalUnion deserializes the given JSON into the Union variant
+// identified by its "type" discriminant.
+func UnmarshalUnion(data []byte) (Union, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return nil, nil
+	}
+	var unmarshaler struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return nil, err
+	}
+	var value Union
+	switch unmarshaler.Type {
+	case "foo":
+		value = new(UnionFoo)
+	case "bar":
+		value = new(UnionBar)
+	default:
+		return &UnionUnknown{Type: unmarshaler.Type, Raw: append(json.RawMessage(nil), data...)}, nil
+	}
+	if err := json.Unmarshal(data, value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+type UnionWithDiscriminant interface {
+	isUnionWithDiscriminant()
+}
+
+// This is a Foo field.
+type UnionWithDiscriminantFoo struct {
+	Value *Foo
+}
+
+func (*UnionWithDiscriminantFoo) isUnionWithDiscriminant() {}
+
+func (u *UnionWithDiscriminantFoo) GetValue() *Foo {
+	if u == nil {
+		return nil
+	}
+	return u.Value
+}
+
+func (u *UnionWithDiscriminantFoo) UnmarshalJSON(data []byte) error {
+	var unmarshaler struct {
+		Value *Foo `json:"foo,omitempty"`
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	u.Value = unmarshaler.Value
+	return nil
+}
+
+func (u *UnionWithDiscriminantFoo) MarshalJSON() ([]byte, error) {
+	var marshaler = struct {
+		Type  string `json:"_type"`
+		Value *Foo   `json:"foo,omitempty"`
+	}{
+		Type:  "foo",
+		Value: u.Value,
+	}
+	return json.Marshal(marshaler)
+}
+
+type UnionWithDiscriminantBar struct {
+	Value *Bar
+}
+
+func (*UnionWithDiscriminantBar) isUnionWithDiscriminant() {}
+
+func (u *UnionWithDiscriminantBar) GetValue() *Bar {
+	if u == nil {
+		return nil
+	}
+	return u.Value
+}
+
+func (u *UnionWithDiscriminantBar) UnmarshalJSON(data []byte) error {
+	var unmarshaler struct {
+		Value *Bar `json:"bar,omitempty"`
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	u.Value = unmarshaler.Value
+	return nil
+}
+
+func (u *UnionWithDiscriminantBar) MarshalJSON() ([]byte, error) {
+	var marshaler = struct {
+		Type  string `json:"_type"`
+		Value *Bar   `json:"bar,omitempty"`
+	}{
+		Type:  "bar",
+		Value: u.Value,
+	}
+	return json.Marshal(marshaler)
+}
+
+// UnionWithDiscriminantUnknown is a variant that isn't recognized by this version of the SDK.
+type UnionWithDiscriminantUnknown struct {
+	Type string
+	Raw  json.RawMessage
+}
+
+func (*UnionWithDiscriminantUnknown) isUnionWithDiscriminant() {}
+
+func (u *UnionWithDiscriminantUnknown) MarshalJSON() ([]byte, error) {
+	if u.Raw == nil {
+		return nil, fmt.Errorf("invalid type %s in %T", u.Type, u)
+	}
+	return u.Raw, nil
+}
+
+// UnmarshalUnionWithDiscriminant deserializes the given JSON into the UnionWithDiscriminant variant
+// identified by its "_type" discriminant.
+func UnmarshalUnionWithDiscriminant(data []byte) (UnionWithDiscriminant, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return nil, nil
+	}
+	var unmarshaler struct {
+		Type string `json:"_type"`
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return nil, err
+	}
+	var value UnionWithDiscriminant
+	switch unmarshaler.Type {
+	case "foo":
+		value = new(UnionWithDiscriminantFoo)
+	case "bar":
+		value = new(UnionWithDiscriminantBar)
+	default:
+		return &UnionWithDiscriminantUnknown{Type: unmarshaler.Type, Raw: append(json.RawMessage(nil), data...)}, nil
+	}
+	if err := json.Unmarshal(data, value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+type UnionWithLiteral interface {
+	isUnionWithLiteral()
+}
+
+type UnionWithLiteralFern struct{}
+
+func (*UnionWithLiteralFern) isUnionWithLiteral() {}
+
+func (u *UnionWithLiteralFern) UnmarshalJSON(data []byte) error {
+	return nil
+}
+
+func (u *UnionWithLiteralFern) MarshalJSON() ([]byte, error) {
+	var marshaler = struct {
+		Type     string `json:"type"`
+		Extended string `json:"extended"`
+		Base     string `json:"base"`
+		Value    string `json:"value,omitempty"`
+	}{
+		Type:     "fern",
+		Extended: "extended",
+		Base:     "base",
+		Value:    "fern",
+	}
+	return json.Marshal(marshaler)
+}
+
+// UnionWithLiteralUnknown is a variant that isn't recognized by this version of the SDK.
+type UnionWithLiteralUnknown struct {
+	Type string
+	Raw  json.RawMessage
+}
+
+func (*UnionWithLiteralUnknown) isUnionWithLiteral() {}
+
+func (u *UnionWithLiteralUnknown) MarshalJSON() ([]byte, error) {
+	if u.Raw == nil {
+		return nil, fmt.Errorf("invalid type %s in %T", u.Type, u)
+	}
+	return u.Raw, nil
+}
+
+// UnmarshalUnionWithLiteral deserializes the given JSON into the UnionWithLiteral variant
+// identified by its "type" discriminant.
+func UnmarshalUnionWithLiteral(data []byte) (UnionWithLiteral, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return nil, nil
+	}
+	var unmarshaler struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return nil, err
+	}
+	var value UnionWithLiteral
+	switch unmarshaler.Type {
+	case "fern":
+		value = new(UnionWithLiteralFern)
+	default:
+		return &UnionWithLiteralUnknown{Type: unmarshaler.Type, Raw: append(json.RawMessage(nil), data...)}, nil
+	}
+	if err := json.Unmarshal(data, value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+type UnionWithPrimitive interface {
+	isUnionWithPrimitive()
+}
+
+type UnionWithPrimitiveBoolean struct {
+	Value bool
+}
+
+func (*UnionWithPrimitiveBoolean) isUnionWithPrimitive() {}
+
+func (u *UnionWithPrimitiveBoolean) GetValue() bool {
+	if u == nil {
+		return false
+	}
+	return u.Value
+}
+
+func (u *UnionWithPrimitiveBoolean) UnmarshalJSON(data []byte) error {
+	var unmarshaler struct {
+		Value bool `json:"value"`
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	u.Value = unmarshaler.Value
+	return nil
+}
+
+func (u *UnionWithPrimitiveBoolean) MarshalJSON() ([]byte, error) {
+	var marshaler = struct {
+		Type  string `json:"type"`
+		Value bool   `json:"value"`
+	}{
+		Type:  "boolean",
+		Value: u.Value,
+	}
+	return json.Marshal(marshaler)
+}
+
+type UnionWithPrimitiveString struct {
+	Value string
+}
+
+func (*UnionWithPrimitiveString) isUnionWithPrimitive() {}
+
+func (u *UnionWithPrimitiveString) GetValue() string {
+	if u == nil {
+		return ""
+	}
+	return u.Value
+}
+
+func (u *UnionWithPrimitiveString) UnmarshalJSON(data []byte) error {
+	var unmarshaler struct {
+		Value string `json:"value"`
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	u.Value = unmarshaler.Value
+	return nil
+}
+
+func (u *UnionWithPrimitiveString) MarshalJSON() ([]byte, error) {
+	var marshaler = struct {
+		Type  string `json:"type"`
+		Value string `json:"value"`
+	}{
+		Type:  "string",
+		Value: u.Value,
+	}
+	return json.Marshal(marshaler)
+}
+
+// UnionWithPrimitiveUnknown is a variant that isn't recognized by this version of the SDK.
+type UnionWithPrimitiveUnknown struct {
+	Type string
+	Raw  json.RawMessage
+}
+
+func (*UnionWithPrimitiveUnknown) isUnionWithPrimitive() {}
+
+func (u *UnionWithPrimitiveUnknown) MarshalJSON() ([]byte, error) {
+	if u.Raw == nil {
+		return nil, fmt.Errorf("invalid type %s in %T", u.Type, u)
+	}
+	return u.Raw, nil
+}
+
+// UnmarshalUnionWithPrimitive deserializes the given JSON into the UnionWithPrimitive variant
+// identified by its "type" discriminant.
+func UnmarshalUnionWithPrimitive(data []byte) (UnionWithPrimitive, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return nil, nil
+	}
+	var unmarshaler struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return nil, err
+	}
+	var value UnionWithPrimitive
+	switch unmarshaler.Type {
+	case "boolean":
+		value = new(UnionWithPrimitiveBoolean)
+	case "string":
+		value = new(UnionWithPrimitiveString)
+	default:
+		return &UnionWithPrimitiveUnknown{Type: unmarshaler.Type, Raw: append(json.RawMessage(nil), data...)}, nil
+	}
+	if err := json.Unmarshal(data, value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+type UnionWithUnknown interface {
+	isUnionWithUnknown()
+}
+
+type UnionWithUnknownFoo struct {
+	Value *Foo
+}
+
+func (*UnionWithUnknownFoo) isUnionWithUnknown() {}
+
+func (u *UnionWithUnknownFoo) GetValue() *Foo {
+	if u == nil {
+		return nil
+	}
+	return u.Value
+}
+
+func (u *UnionWithUnknownFoo) UnmarshalJSON(data []byte) error {
+	value := new(Foo)
+	if err := json.Unmarshal(data, value); err != nil {
+		return err
+	}
+	u.Value = value
+	return nil
+}
+
+func (u *UnionWithUnknownFoo) MarshalJSON() ([]byte, error) {
+	var marshaler = struct {
+		Type string `json:"type"`
+		*Foo
+	}{
+		Type: "foo",
+		Foo:  u.Value,
+	}
+	return json.Marshal(marshaler)
+}
+
+type UnionWithUnknownUnknown struct{}
+
+func (*UnionWithUnknownUnknown) isUnionWithUnknown() {}
+
+func (u *UnionWithUnknownUnknown) UnmarshalJSON(data []byte) error {
+	return nil
+}
+
+func (u *UnionWithUnknownUnknown) MarshalJSON() ([]byte, error) {
+	var marshaler = struct {
+		Type string `json:"type"`
+	}{
+		Type: "unknown",
+	}
+	return json.Marshal(marshaler)
+}
+
+// UnionWithUnknownUnknownVariant is a variant that isn't recognized by this version of the SDK.
+type UnionWithUnknownUnknownVariant struct {
+	Type string
+	Raw  json.RawMessage
+}
+
+func (*UnionWithUnknownUnknownVariant) isUnionWithUnknown() {}
+
+func (u *UnionWithUnknownUnknownVariant) MarshalJSON() ([]byte, error) {
+	if u.Raw == nil {
+		return nil, fmt.Errorf("invalid type %s in %T", u.Type, u)
+	}
+	return u.Raw, nil
+}
+
+// UnmarshalUnionWithUnknown deserializes the given JSON into the UnionWithUnknown variant
+// identified by its "type" discriminant.
+func UnmarshalUnionWithUnknown(data []byte) (UnionWithUnknown, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return nil, nil
+	}
+	var unmarshaler struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return nil, err
+	}
+	var value UnionWithUnknown
+	switch unmarshaler.Type {
+	case "foo":
+		value = new(UnionWithUnknownFoo)
+	case "unknown":
+		value = new(UnionWithUnknownUnknown)
+	default:
+		return &UnionWithUnknownUnknownVariant{Type: unmarshaler.Type, Raw: append(json.RawMessage(nil), data...)}, nil
+	}
+	if err := json.Unmarshal(data, value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+type UnionWithoutKey interface {
+	isUnionWithoutKey()
+}
+
+type UnionWithoutKeyFoo struct {
+	Value *Foo
+}
+
+func (*UnionWithoutKeyFoo) isUnionWithoutKey() {}
+
+func (u *UnionWithoutKeyFoo) GetValue() *Foo {
+	if u == nil {
+		return nil
+	}
+	return u.Value
+}
+
+func (u *UnionWithoutKeyFoo) UnmarshalJSON(data []byte) error {
+	value := new(Foo)
+	if err := json.Unmarshal(data, value); err != nil {
+		return err
+	}
+	u.Value = value
+	return nil
+}
+
+func (u *UnionWithoutKeyFoo) MarshalJSON() ([]byte, error) {
+	var marshaler = struct {
+		Type string `json:"type"`
+		*Foo
+	}{
+		Type: "foo",
+		Foo:  u.Value,
+	}
+	return json.Marshal(marshaler)
+}
+
+// This is a bar field.
+type UnionWithoutKeyBar struct {
+	Value *Bar
+}
+
+func (*UnionWithoutKeyBar) isUnionWithoutKey() {}
+
+func (u *UnionWithoutKeyBar) GetValue() *Bar {
+	if u == nil {
+		return nil
+	}
+	return u.Value
+}
+
+func (u *UnionWithoutKeyBar) UnmarshalJSON(data []byte) error {
+	value := new(Bar)
+	if err := json.Unmarshal(data, value); err != nil {
+		return err
+	}
+	u.Value = value
+	return nil
+}
+
+func (u *UnionWithoutKeyBar) MarshalJSON() ([]byte, error) {
+	var marshaler = struct {
+		Type string `json:"type"`
+		*Bar
+	}{
+		Type: "bar",
+		Bar:  u.Value,
+	}
+	return json.Marshal(marshaler)
+}
+
+// UnionWithoutKeyUnknown is a variant that isn't recognized by this version of the SDK.
+type UnionWithoutKeyUnknown struct {
+	Type string
+	Raw  json.RawMessage
+}
+
+func (*UnionWithoutKeyUnknown) isUnionWithoutKey() {}
+
+func (u *UnionWithoutKeyUnknown) MarshalJSON() ([]byte, error) {
+	if u.Raw == nil {
+		return nil, fmt.Errorf("invalid type %s in %T", u.Type, u)
+	}
+	return u.Raw, nil
+}
+
+// UnmarshalUnionWithoutKey deserializes the given JSON into the UnionWithoutKey variant
+// identified by its "type" discriminant.
+func UnmarshalUnionWithoutKey(data []byte) (UnionWithoutKey, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return nil, nil
+	}
+	var unmarshaler struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return nil, err
+	}
+	var value UnionWithoutKey
+	switch unmarshaler.Type {
+	case "foo":
+		value = new(UnionWithoutKeyFoo)
+	case "bar":
+		value = new(UnionWithoutKeyBar)
+	default:
+		return &UnionWithoutKeyUnknown{Type: unmarshaler.Type, Raw: append(json.RawMessage(nil), data...)}, nil
+	}
+	if err := json.Unmarshal(data, value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+type Value interface {
+	isValue()
+}
+
+// A plain string.
+type ValueString struct {
+	Value string
+}
+
+func (*ValueString) isValue() {}
+
+func (v *ValueString) GetValue() string {
+	if v == nil {
+		return ""
+	}
+	return v.Value
+}
+
+func (v *ValueString) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.Value)
+}
+
+type ValueFoo struct {
+	Value *Foo
+}
+
+func (*ValueFoo) isValue() {}
+
+func (v *ValueFoo) GetValue() *Foo {
+	if v == nil {
+		return nil
+	}
+	return v.Value
+}
+
+func (v *ValueFoo) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.Value)
+}
+
+type ValueShapeList struct {
+	Value []Shape
+}
+
+func (*ValueShapeList) isValue() {}
+
+func (v *ValueShapeList) GetValue() []Shape {
+	if v == nil {
+		return nil
+	}
+	return v.Value
+}
+
+func (v *ValueShapeList) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.Value)
+}
+
+type ValueStringLiteral struct{}
+
+func (*ValueStringLiteral) isValue() {}
+
+func (v *ValueStringLiteral) MarshalJSON() ([]byte, error) {
+	return json.Marshal("none")
+}
+
+// UnmarshalValue deserializes the given JSON into the first Value
+// member it's compatible with.
+func UnmarshalValue(data []byte) (Value, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return nil, nil
+	}
+	var valueString string
+	if err := json.Unmarshal(data, &valueString); err == nil {
+		return &ValueString{Value: valueString}, nil
+	}
+	valueFoo := new(Foo)
+	if err := json.Unmarshal(data, &valueFoo); err == nil {
+		return &ValueFoo{Value: valueFoo}, nil
+	}
+	if valueShapeList, err := func() ([]Shape, error) {
+		var value []Shape
+		var raws0 []json.RawMessage
+		if err := json.Unmarshal(data, &raws0); err != nil {
+			return nil, err
+		}
+		if raws0 != nil {
+			value = make([]Shape, len(raws0))
+			for i0, raw0 := range raws0 {
+				value1, err := UnmarshalShape(raw0)
+				if err != nil {
+					return nil, err
+				}
+				value[i0] = value1
+			}
+		}
+		return value, nil
+	}(); err == nil {
+		return &ValueShapeList{Value: valueShapeList}, nil
+	}
+	var valueStringLiteral string
+	if err := json.Unmarshal(data, &valueStringLiteral); err == nil {
+		if valueStringLiteral == "none" {
+			return &ValueStringLiteral{}, nil
+		}
+	}
+	return nil, fmt.Errorf("%s cannot be deserialized as a Value", data)
+}
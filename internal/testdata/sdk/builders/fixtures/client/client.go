@@ -0,0 +1,30 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package client
+
+import (
+	core "github.com/fern-api/fern-go/internal/testdata/sdk/builders/fixtures/core"
+	user "github.com/fern-api/fern-go/internal/testdata/sdk/builders/fixtures/user"
+	http "net/http"
+)
+
+type Client struct {
+	baseURL string
+	caller  *core.Caller
+	header  http.Header
+
+	User *user.Client
+}
+
+func NewClient(opts ...core.ClientOption) *Client {
+	options := core.NewClientOptions()
+	for _, opt := range opts {
+		opt(options)
+	}
+	return &Client{
+		baseURL: options.BaseURL,
+		caller:  core.NewCaller(options.HTTPClient),
+		header:  options.ToHeader(),
+		User:    user.NewClient(opts...),
+	}
+}
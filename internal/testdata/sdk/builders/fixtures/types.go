@@ -0,0 +1,147 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package api
+
+import (
+	json "encoding/json"
+	fmt "fmt"
+	core "github.com/fern-api/fern-go/internal/testdata/sdk/builders/fixtures/core"
+)
+
+type Bar struct {
+	Id string `json:"id"`
+
+	_rawJSON json.RawMessage
+}
+
+func (b *Bar) GetId() string {
+	if b == nil {
+		return ""
+	}
+	return b.Id
+}
+
+func (b *Bar) UnmarshalJSON(data []byte) error {
+	type unmarshaler Bar
+	var value unmarshaler
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*b = Bar(value)
+	b._rawJSON = json.RawMessage(data)
+	return nil
+}
+
+func (b *Bar) String() string {
+	if len(b._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(b._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(b); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", b)
+}
+
+// BarBuilder builds the *Bar returned by Build.
+type BarBuilder struct {
+	value Bar
+
+	hasId bool
+}
+
+// NewBarBuilder returns a new *BarBuilder.
+func NewBarBuilder() *BarBuilder {
+	return &BarBuilder{}
+}
+
+// WithId sets the Id field.
+func (b *BarBuilder) WithId(id string) *BarBuilder {
+	b.value.Id = id
+	b.hasId = true
+	return b
+}
+
+// Build returns the *Bar, or an error if any of its required
+// properties aren't set.
+func (b *BarBuilder) Build() (*Bar, error) {
+	var missing []string
+	if !b.hasId {
+		missing = append(missing, "id")
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("%T is missing required properties %q", b.value, missing)
+	}
+	value := b.value
+	return &value, nil
+}
+
+type Foo struct {
+	Id string `json:"id"`
+
+	_rawJSON json.RawMessage
+}
+
+func (f *Foo) GetId() string {
+	if f == nil {
+		return ""
+	}
+	return f.Id
+}
+
+func (f *Foo) UnmarshalJSON(data []byte) error {
+	type unmarshaler Foo
+	var value unmarshaler
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*f = Foo(value)
+	f._rawJSON = json.RawMessage(data)
+	return nil
+}
+
+func (f *Foo) String() string {
+	if len(f._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(f._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(f); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", f)
+}
+
+// FooBuilder builds the *Foo returned by Build.
+type FooBuilder struct {
+	value Foo
+
+	hasId bool
+}
+
+// NewFooBuilder returns a new *FooBuilder.
+func NewFooBuilder() *FooBuilder {
+	return &FooBuilder{}
+}
+
+// WithId sets the Id field.
+func (f *FooBuilder) WithId(id string) *FooBuilder {
+	f.value.Id = id
+	f.hasId = true
+	return f
+}
+
+// Build returns the *Foo, or an error if any of its required
+// properties aren't set.
+func (f *FooBuilder) Build() (*Foo, error) {
+	var missing []string
+	if !f.hasId {
+		missing = append(missing, "id")
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("%T is missing required properties %q", f.value, missing)
+	}
+	value := f.value
+	return &value, nil
+}
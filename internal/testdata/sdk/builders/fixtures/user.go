@@ -0,0 +1,835 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package api
+
+import (
+	json "encoding/json"
+	fmt "fmt"
+	core "github.com/fern-api/fern-go/internal/testdata/sdk/builders/fixtures/core"
+)
+
+type SetNameRequest struct {
+	UserName string `json:"userName"`
+}
+
+func (s *SetNameRequest) GetUserName() string {
+	if s == nil {
+		return ""
+	}
+	return s.UserName
+}
+
+// SetNameRequestBuilder builds the *SetNameRequest returned by Build.
+type SetNameRequestBuilder struct {
+	value SetNameRequest
+
+	hasUserName bool
+}
+
+// NewSetNameRequestBuilder returns a new *SetNameRequestBuilder.
+func NewSetNameRequestBuilder() *SetNameRequestBuilder {
+	return &SetNameRequestBuilder{}
+}
+
+// WithUserName sets the UserName field.
+func (s *SetNameRequestBuilder) WithUserName(userName string) *SetNameRequestBuilder {
+	s.value.UserName = userName
+	s.hasUserName = true
+	return s
+}
+
+// Build returns the *SetNameRequest, or an error if any of its required
+// properties aren't set.
+func (s *SetNameRequestBuilder) Build() (*SetNameRequest, error) {
+	var missing []string
+	if !s.hasUserName {
+		missing = append(missing, "userName")
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("%T is missing required properties %q", s.value, missing)
+	}
+	value := s.value
+	return &value, nil
+}
+
+type SetNameRequestV3 struct {
+	XEndpointHeader string                `json:"-"`
+	Body            *SetNameRequestV3Body `json:"-"`
+}
+
+func (s *SetNameRequestV3) GetXEndpointHeader() string {
+	if s == nil {
+		return ""
+	}
+	return s.XEndpointHeader
+}
+
+func (s *SetNameRequestV3) GetBody() *SetNameRequestV3Body {
+	if s == nil {
+		return nil
+	}
+	return s.Body
+}
+
+func (s *SetNameRequestV3) UnmarshalJSON(data []byte) error {
+	body := new(SetNameRequestV3Body)
+	if err := json.Unmarshal(data, &body); err != nil {
+		return err
+	}
+	s.Body = body
+	return nil
+}
+
+func (s *SetNameRequestV3) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.Body)
+}
+
+// SetNameRequestV3Builder builds the *SetNameRequestV3 returned by Build.
+type SetNameRequestV3Builder struct {
+	value SetNameRequestV3
+
+	hasXEndpointHeader bool
+	hasBody            bool
+}
+
+// NewSetNameRequestV3Builder returns a new *SetNameRequestV3Builder.
+func NewSetNameRequestV3Builder() *SetNameRequestV3Builder {
+	return &SetNameRequestV3Builder{}
+}
+
+// WithXEndpointHeader sets the XEndpointHeader field.
+func (s *SetNameRequestV3Builder) WithXEndpointHeader(xEndpointHeader string) *SetNameRequestV3Builder {
+	s.value.XEndpointHeader = xEndpointHeader
+	s.hasXEndpointHeader = true
+	return s
+}
+
+// WithBody sets the Body field.
+func (s *SetNameRequestV3Builder) WithBody(body *SetNameRequestV3Body) *SetNameRequestV3Builder {
+	s.value.Body = body
+	s.hasBody = true
+	return s
+}
+
+// Build returns the *SetNameRequestV3, or an error if any of its required
+// properties aren't set.
+func (s *SetNameRequestV3Builder) Build() (*SetNameRequestV3, error) {
+	var missing []string
+	if !s.hasXEndpointHeader {
+		missing = append(missing, "X-Endpoint-Header")
+	}
+	if !s.hasBody {
+		missing = append(missing, "body")
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("%T is missing required properties %q", s.value, missing)
+	}
+	value := s.value
+	return &value, nil
+}
+
+type SetNameRequestV3Optional struct {
+	XEndpointHeader string                `json:"-"`
+	Body            *SetNameRequestV3Body `json:"-"`
+}
+
+func (s *SetNameRequestV3Optional) GetXEndpointHeader() string {
+	if s == nil {
+		return ""
+	}
+	return s.XEndpointHeader
+}
+
+func (s *SetNameRequestV3Optional) GetBody() *SetNameRequestV3Body {
+	if s == nil {
+		return nil
+	}
+	return s.Body
+}
+
+func (s *SetNameRequestV3Optional) UnmarshalJSON(data []byte) error {
+	body := new(SetNameRequestV3Body)
+	if err := json.Unmarshal(data, &body); err != nil {
+		return err
+	}
+	s.Body = body
+	return nil
+}
+
+func (s *SetNameRequestV3Optional) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.Body)
+}
+
+// SetNameRequestV3OptionalBuilder builds the *SetNameRequestV3Optional returned by Build.
+type SetNameRequestV3OptionalBuilder struct {
+	value SetNameRequestV3Optional
+
+	hasXEndpointHeader bool
+	hasBody            bool
+}
+
+// NewSetNameRequestV3OptionalBuilder returns a new *SetNameRequestV3OptionalBuilder.
+func NewSetNameRequestV3OptionalBuilder() *SetNameRequestV3OptionalBuilder {
+	return &SetNameRequestV3OptionalBuilder{}
+}
+
+// WithXEndpointHeader sets the XEndpointHeader field.
+func (s *SetNameRequestV3OptionalBuilder) WithXEndpointHeader(xEndpointHeader string) *SetNameRequestV3OptionalBuilder {
+	s.value.XEndpointHeader = xEndpointHeader
+	s.hasXEndpointHeader = true
+	return s
+}
+
+// WithBody sets the Body field.
+func (s *SetNameRequestV3OptionalBuilder) WithBody(body *SetNameRequestV3Body) *SetNameRequestV3OptionalBuilder {
+	s.value.Body = body
+	s.hasBody = true
+	return s
+}
+
+// Build returns the *SetNameRequestV3Optional, or an error if any of its required
+// properties aren't set.
+func (s *SetNameRequestV3OptionalBuilder) Build() (*SetNameRequestV3Optional, error) {
+	var missing []string
+	if !s.hasXEndpointHeader {
+		missing = append(missing, "X-Endpoint-Header")
+	}
+	if !s.hasBody {
+		missing = append(missing, "body")
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("%T is missing required properties %q", s.value, missing)
+	}
+	value := s.value
+	return &value, nil
+}
+
+type SetNameRequestV4 struct {
+	XEndpointHeader string   `json:"-"`
+	Body            []string `json:"-"`
+}
+
+func (s *SetNameRequestV4) GetXEndpointHeader() string {
+	if s == nil {
+		return ""
+	}
+	return s.XEndpointHeader
+}
+
+func (s *SetNameRequestV4) GetBody() []string {
+	if s == nil {
+		return nil
+	}
+	return s.Body
+}
+
+func (s *SetNameRequestV4) UnmarshalJSON(data []byte) error {
+	var body []string
+	if err := json.Unmarshal(data, &body); err != nil {
+		return err
+	}
+	s.Body = body
+	return nil
+}
+
+func (s *SetNameRequestV4) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.Body)
+}
+
+// SetNameRequestV4Builder builds the *SetNameRequestV4 returned by Build.
+type SetNameRequestV4Builder struct {
+	value SetNameRequestV4
+
+	hasXEndpointHeader bool
+	hasBody            bool
+}
+
+// NewSetNameRequestV4Builder returns a new *SetNameRequestV4Builder.
+func NewSetNameRequestV4Builder() *SetNameRequestV4Builder {
+	return &SetNameRequestV4Builder{}
+}
+
+// WithXEndpointHeader sets the XEndpointHeader field.
+func (s *SetNameRequestV4Builder) WithXEndpointHeader(xEndpointHeader string) *SetNameRequestV4Builder {
+	s.value.XEndpointHeader = xEndpointHeader
+	s.hasXEndpointHeader = true
+	return s
+}
+
+// WithBody sets the Body field.
+func (s *SetNameRequestV4Builder) WithBody(body []string) *SetNameRequestV4Builder {
+	s.value.Body = body
+	s.hasBody = true
+	return s
+}
+
+// Build returns the *SetNameRequestV4, or an error if any of its required
+// properties aren't set.
+func (s *SetNameRequestV4Builder) Build() (*SetNameRequestV4, error) {
+	var missing []string
+	if !s.hasXEndpointHeader {
+		missing = append(missing, "X-Endpoint-Header")
+	}
+	if !s.hasBody {
+		missing = append(missing, "body")
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("%T is missing required properties %q", s.value, missing)
+	}
+	value := s.value
+	return &value, nil
+}
+
+type SetNameRequestV5 struct {
+	XEndpointHeader string `json:"-"`
+	Body            string `json:"-"`
+}
+
+func (s *SetNameRequestV5) GetXEndpointHeader() string {
+	if s == nil {
+		return ""
+	}
+	return s.XEndpointHeader
+}
+
+func (s *SetNameRequestV5) GetBody() string {
+	if s == nil {
+		return ""
+	}
+	return s.Body
+}
+
+func (s *SetNameRequestV5) UnmarshalJSON(data []byte) error {
+	var body string
+	if err := json.Unmarshal(data, &body); err != nil {
+		return err
+	}
+	if body != "fern" {
+		return fmt.Errorf("expected literal %q, but found %q", "fern", body)
+	}
+	s.Body = body
+	return nil
+}
+
+func (s *SetNameRequestV5) MarshalJSON() ([]byte, error) {
+	return json.Marshal("fern")
+}
+
+// SetNameRequestV5Builder builds the *SetNameRequestV5 returned by Build.
+type SetNameRequestV5Builder struct {
+	value SetNameRequestV5
+
+	hasXEndpointHeader bool
+}
+
+// NewSetNameRequestV5Builder returns a new *SetNameRequestV5Builder.
+func NewSetNameRequestV5Builder() *SetNameRequestV5Builder {
+	return &SetNameRequestV5Builder{}
+}
+
+// WithXEndpointHeader sets the XEndpointHeader field.
+func (s *SetNameRequestV5Builder) WithXEndpointHeader(xEndpointHeader string) *SetNameRequestV5Builder {
+	s.value.XEndpointHeader = xEndpointHeader
+	s.hasXEndpointHeader = true
+	return s
+}
+
+// Build returns the *SetNameRequestV5, or an error if any of its required
+// properties aren't set.
+func (s *SetNameRequestV5Builder) Build() (*SetNameRequestV5, error) {
+	var missing []string
+	if !s.hasXEndpointHeader {
+		missing = append(missing, "X-Endpoint-Header")
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("%T is missing required properties %q", s.value, missing)
+	}
+	value := s.value
+	value.Body = "fern"
+	return &value, nil
+}
+
+type Filter struct {
+	Tag   string `json:"tag"`
+	Limit *int   `json:"limit,omitempty"`
+	kind  string
+
+	_rawJSON json.RawMessage
+}
+
+func (f *Filter) Kind() string {
+	return f.kind
+}
+
+func (f *Filter) GetTag() string {
+	if f == nil {
+		return ""
+	}
+	return f.Tag
+}
+
+func (f *Filter) GetLimit() int {
+	if f == nil || f.Limit == nil {
+		return 0
+	}
+	return *f.Limit
+}
+
+func (f *Filter) UnmarshalJSON(data []byte) error {
+	type unmarshaler Filter
+	var value unmarshaler
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*f = Filter(value)
+	f.kind = "filter"
+	f._rawJSON = json.RawMessage(data)
+	return nil
+}
+
+func (f *Filter) MarshalJSON() ([]byte, error) {
+	type embed Filter
+	var marshaler = struct {
+		embed
+		Kind string `json:"kind"`
+	}{
+		embed: embed(*f),
+		Kind:  "filter",
+	}
+	return json.Marshal(marshaler)
+}
+
+func (f *Filter) String() string {
+	if len(f._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(f._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(f); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", f)
+}
+
+// FilterBuilder builds the *Filter returned by Build.
+type FilterBuilder struct {
+	value Filter
+
+	hasTag bool
+}
+
+// NewFilterBuilder returns a new *FilterBuilder.
+func NewFilterBuilder() *FilterBuilder {
+	return &FilterBuilder{}
+}
+
+// WithTag sets the Tag field.
+func (f *FilterBuilder) WithTag(tag string) *FilterBuilder {
+	f.value.Tag = tag
+	f.hasTag = true
+	return f
+}
+
+// WithLimit sets the Limit field.
+func (f *FilterBuilder) WithLimit(limit int) *FilterBuilder {
+	f.value.Limit = &limit
+	return f
+}
+
+// Build returns the *Filter, or an error if any of its required
+// properties aren't set.
+func (f *FilterBuilder) Build() (*Filter, error) {
+	var missing []string
+	if !f.hasTag {
+		missing = append(missing, "tag")
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("%T is missing required properties %q", f.value, missing)
+	}
+	value := f.value
+	value.kind = "filter"
+	return &value, nil
+}
+
+type SetNameRequestV3Body struct {
+	UserName string `json:"userName"`
+
+	_rawJSON json.RawMessage
+}
+
+func (s *SetNameRequestV3Body) GetUserName() string {
+	if s == nil {
+		return ""
+	}
+	return s.UserName
+}
+
+func (s *SetNameRequestV3Body) UnmarshalJSON(data []byte) error {
+	type unmarshaler SetNameRequestV3Body
+	var value unmarshaler
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*s = SetNameRequestV3Body(value)
+	s._rawJSON = json.RawMessage(data)
+	return nil
+}
+
+func (s *SetNameRequestV3Body) String() string {
+	if len(s._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(s._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(s); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", s)
+}
+
+// SetNameRequestV3BodyBuilder builds the *SetNameRequestV3Body returned by Build.
+type SetNameRequestV3BodyBuilder struct {
+	value SetNameRequestV3Body
+
+	hasUserName bool
+}
+
+// NewSetNameRequestV3BodyBuilder returns a new *SetNameRequestV3BodyBuilder.
+func NewSetNameRequestV3BodyBuilder() *SetNameRequestV3BodyBuilder {
+	return &SetNameRequestV3BodyBuilder{}
+}
+
+// WithUserName sets the UserName field.
+func (s *SetNameRequestV3BodyBuilder) WithUserName(userName string) *SetNameRequestV3BodyBuilder {
+	s.value.UserName = userName
+	s.hasUserName = true
+	return s
+}
+
+// Build returns the *SetNameRequestV3Body, or an error if any of its required
+// properties aren't set.
+func (s *SetNameRequestV3BodyBuilder) Build() (*SetNameRequestV3Body, error) {
+	var missing []string
+	if !s.hasUserName {
+		missing = append(missing, "userName")
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("%T is missing required properties %q", s.value, missing)
+	}
+	value := s.value
+	return &value, nil
+}
+
+type Union struct {
+	Type string
+	Foo  *Foo
+	Bar  *Bar
+}
+
+func NewUnionFromFoo(value *Foo) *Union {
+	return &Union{Type: "foo", Foo: value}
+}
+
+func NewUnionFromBar(value *Bar) *Union {
+	return &Union{Type: "bar", Bar: value}
+}
+
+func (u *Union) GetType() string {
+	if u == nil {
+		return ""
+	}
+	return u.Type
+}
+
+func (u *Union) GetFoo() *Foo {
+	if u == nil {
+		return nil
+	}
+	return u.Foo
+}
+
+func (u *Union) GetBar() *Bar {
+	if u == nil {
+		return nil
+	}
+	return u.Bar
+}
+
+func (u *Union) UnmarshalJSON(data []byte) error {
+	var unmarshaler struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return err
+	}
+	u.Type = unmarshaler.Type
+	switch unmarshaler.Type {
+	case "foo":
+		value := new(Foo)
+		if err := json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+		u.Foo = value
+	case "bar":
+		value := new(Bar)
+		if err := json.Unmarshal(data, &value); err != nil {
+			return err
+		}
+		u.Bar = value
+	}
+	return nil
+}
+
+func (u Union) MarshalJSON() ([]byte, error) {
+	switch u.Type {
+	default:
+		return nil, fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "foo":
+		var marshaler = struct {
+			Type string `json:"type"`
+			*Foo
+		}{
+			Type: u.Type,
+			Foo:  u.Foo,
+		}
+		return json.Marshal(marshaler)
+	case "bar":
+		var marshaler = struct {
+			Type string `json:"type"`
+			*Bar
+		}{
+			Type: u.Type,
+			Bar:  u.Bar,
+		}
+		return json.Marshal(marshaler)
+	}
+}
+
+type UnionVisitor interface {
+	VisitFoo(*Foo) error
+	VisitBar(*Bar) error
+}
+
+func (u *Union) Accept(visitor UnionVisitor) error {
+	switch u.Type {
+	default:
+		return fmt.Errorf("invalid type %s in %T", u.Type, u)
+	case "foo":
+		return visitor.VisitFoo(u.Foo)
+	case "bar":
+		return visitor.VisitBar(u.Bar)
+	}
+}
+
+type UpdateRequest struct {
+	XTraceId       *string                  `json:"-"`
+	Tag            string                   `json:"-"`
+	Extra          *string                  `json:"-"`
+	Labels         []string                 `json:"-"`
+	Union          *Union                   `json:"union,omitempty"`
+	Filter         *Filter                  `json:"filter,omitempty"`
+	OptionalUnion  *core.Optional[Union]    `json:"optionalUnion,omitempty"`
+	OptionalFilter *core.Optional[Filter]   `json:"optionalFilter,omitempty"`
+	OptionalTags   *core.Optional[[]string] `json:"optionalTags,omitempty"`
+	xVersion       string
+}
+
+func (u *UpdateRequest) XVersion() string {
+	return u.xVersion
+}
+
+func (u *UpdateRequest) GetXTraceId() string {
+	if u == nil || u.XTraceId == nil {
+		return ""
+	}
+	return *u.XTraceId
+}
+
+func (u *UpdateRequest) GetTag() string {
+	if u == nil {
+		return ""
+	}
+	return u.Tag
+}
+
+func (u *UpdateRequest) GetExtra() string {
+	if u == nil || u.Extra == nil {
+		return ""
+	}
+	return *u.Extra
+}
+
+func (u *UpdateRequest) GetLabels() []string {
+	if u == nil {
+		return nil
+	}
+	return u.Labels
+}
+
+func (u *UpdateRequest) GetUnion() *Union {
+	if u == nil {
+		return nil
+	}
+	return u.Union
+}
+
+func (u *UpdateRequest) GetFilter() *Filter {
+	if u == nil {
+		return nil
+	}
+	return u.Filter
+}
+
+func (u *UpdateRequest) GetOptionalUnion() *Union {
+	if u == nil || u.OptionalUnion == nil {
+		return nil
+	}
+	return &u.OptionalUnion.Value
+}
+
+func (u *UpdateRequest) GetOptionalFilter() *Filter {
+	if u == nil || u.OptionalFilter == nil {
+		return nil
+	}
+	return &u.OptionalFilter.Value
+}
+
+func (u *UpdateRequest) GetOptionalTags() []string {
+	if u == nil || u.OptionalTags == nil {
+		return nil
+	}
+	return u.OptionalTags.Value
+}
+
+func (u *UpdateRequest) UnmarshalJSON(data []byte) error {
+	type unmarshaler UpdateRequest
+	var body unmarshaler
+	if err := json.Unmarshal(data, &body); err != nil {
+		return err
+	}
+	*u = UpdateRequest(body)
+	u.xVersion = "2024-01-01"
+	return nil
+}
+
+func (u *UpdateRequest) MarshalJSON() ([]byte, error) {
+	type embed UpdateRequest
+	var marshaler = struct {
+		embed
+		XVersion string `json:"X-Version"`
+	}{
+		embed:    embed(*u),
+		XVersion: "2024-01-01",
+	}
+	return json.Marshal(marshaler)
+}
+
+// UpdateRequestBuilder builds the *UpdateRequest returned by Build.
+type UpdateRequestBuilder struct {
+	value UpdateRequest
+
+	hasTag    bool
+	hasUnion  bool
+	hasFilter bool
+}
+
+// NewUpdateRequestBuilder returns a new *UpdateRequestBuilder.
+func NewUpdateRequestBuilder() *UpdateRequestBuilder {
+	return &UpdateRequestBuilder{}
+}
+
+// WithXTraceId sets the XTraceId field.
+func (u *UpdateRequestBuilder) WithXTraceId(xTraceId string) *UpdateRequestBuilder {
+	u.value.XTraceId = &xTraceId
+	return u
+}
+
+// WithTag sets the Tag field.
+func (u *UpdateRequestBuilder) WithTag(tag string) *UpdateRequestBuilder {
+	u.value.Tag = tag
+	u.hasTag = true
+	return u
+}
+
+// WithExtra sets the Extra field.
+func (u *UpdateRequestBuilder) WithExtra(extra string) *UpdateRequestBuilder {
+	u.value.Extra = &extra
+	return u
+}
+
+// WithLabels sets the Labels field.
+func (u *UpdateRequestBuilder) WithLabels(labels []string) *UpdateRequestBuilder {
+	u.value.Labels = labels
+	return u
+}
+
+// WithUnion sets the Union field.
+func (u *UpdateRequestBuilder) WithUnion(union *Union) *UpdateRequestBuilder {
+	u.value.Union = union
+	u.hasUnion = true
+	return u
+}
+
+// WithFilter sets the Filter field.
+func (u *UpdateRequestBuilder) WithFilter(filter *Filter) *UpdateRequestBuilder {
+	u.value.Filter = filter
+	u.hasFilter = true
+	return u
+}
+
+// WithOptionalUnion sets the OptionalUnion field.
+func (u *UpdateRequestBuilder) WithOptionalUnion(optionalUnion Union) *UpdateRequestBuilder {
+	u.value.OptionalUnion = &core.Optional[Union]{Value: optionalUnion}
+	return u
+}
+
+// WithOptionalUnionNull sets the OptionalUnion field to an explicit null.
+func (u *UpdateRequestBuilder) WithOptionalUnionNull() *UpdateRequestBuilder {
+	u.value.OptionalUnion = &core.Optional[Union]{Null: true}
+	return u
+}
+
+// WithOptionalFilter sets the OptionalFilter field.
+func (u *UpdateRequestBuilder) WithOptionalFilter(optionalFilter Filter) *UpdateRequestBuilder {
+	u.value.OptionalFilter = &core.Optional[Filter]{Value: optionalFilter}
+	return u
+}
+
+// WithOptionalFilterNull sets the OptionalFilter field to an explicit null.
+func (u *UpdateRequestBuilder) WithOptionalFilterNull() *UpdateRequestBuilder {
+	u.value.OptionalFilter = &core.Optional[Filter]{Null: true}
+	return u
+}
+
+// WithOptionalTags sets the OptionalTags field.
+func (u *UpdateRequestBuilder) WithOptionalTags(optionalTags []string) *UpdateRequestBuilder {
+	u.value.OptionalTags = &core.Optional[[]string]{Value: optionalTags}
+	return u
+}
+
+// WithOptionalTagsNull sets the OptionalTags field to an explicit null.
+func (u *UpdateRequestBuilder) WithOptionalTagsNull() *UpdateRequestBuilder {
+	u.value.OptionalTags = &core.Optional[[]string]{Null: true}
+	return u
+}
+
+// Build returns the *UpdateRequest, or an error if any of its required
+// properties aren't set.
+func (u *UpdateRequestBuilder) Build() (*UpdateRequest, error) {
+	var missing []string
+	if !u.hasTag {
+		missing = append(missing, "tag")
+	}
+	if !u.hasUnion {
+		missing = append(missing, "union")
+	}
+	if !u.hasFilter {
+		missing = append(missing, "filter")
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("%T is missing required properties %q", u.value, missing)
+	}
+	value := u.value
+	value.xVersion = "2024-01-01"
+	return &value, nil
+}
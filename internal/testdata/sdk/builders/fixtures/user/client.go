@@ -0,0 +1,222 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package user
+
+import (
+	context "context"
+	fmt "fmt"
+	fixtures "github.com/fern-api/fern-go/internal/testdata/sdk/builders/fixtures"
+	core "github.com/fern-api/fern-go/internal/testdata/sdk/builders/fixtures/core"
+	http "net/http"
+	url "net/url"
+)
+
+type Client struct {
+	baseURL string
+	caller  *core.Caller
+	header  http.Header
+}
+
+func NewClient(opts ...core.ClientOption) *Client {
+	options := core.NewClientOptions()
+	for _, opt := range opts {
+		opt(options)
+	}
+	return &Client{
+		baseURL: options.BaseURL,
+		caller:  core.NewCaller(options.HTTPClient),
+		header:  options.ToHeader(),
+	}
+}
+
+func (c *Client) SetName(ctx context.Context, userId string, request string) (string, error) {
+	baseURL := ""
+	if c.baseURL != "" {
+		baseURL = c.baseURL
+	}
+	endpointURL := fmt.Sprintf(baseURL+"/"+"users/%v/set-name", userId)
+
+	var response string
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:      endpointURL,
+			Method:   http.MethodPost,
+			Headers:  c.header,
+			Request:  request,
+			Response: &response,
+		},
+	); err != nil {
+		return "", err
+	}
+	return response, nil
+}
+
+func (c *Client) SetNameV2(ctx context.Context, userId string, request *fixtures.SetNameRequest) (string, error) {
+	baseURL := ""
+	if c.baseURL != "" {
+		baseURL = c.baseURL
+	}
+	endpointURL := fmt.Sprintf(baseURL+"/"+"users/%v/set-name-v2", userId)
+
+	var response string
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:      endpointURL,
+			Method:   http.MethodPost,
+			Headers:  c.header,
+			Request:  request,
+			Response: &response,
+		},
+	); err != nil {
+		return "", err
+	}
+	return response, nil
+}
+
+func (c *Client) SetNameV3(ctx context.Context, userId string, request *fixtures.SetNameRequestV3) (*fixtures.SetNameRequestV3Body, error) {
+	baseURL := ""
+	if c.baseURL != "" {
+		baseURL = c.baseURL
+	}
+	endpointURL := fmt.Sprintf(baseURL+"/"+"users/%v/set-name-v3", userId)
+
+	headers := c.header.Clone()
+	headers.Add("X-Endpoint-Header", fmt.Sprintf("%v", request.XEndpointHeader))
+
+	var response *fixtures.SetNameRequestV3Body
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:      endpointURL,
+			Method:   http.MethodPost,
+			Headers:  headers,
+			Request:  request,
+			Response: &response,
+		},
+	); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *Client) SetNameV3Optional(ctx context.Context, userId string, request *fixtures.SetNameRequestV3Optional) (*fixtures.SetNameRequestV3Body, error) {
+	baseURL := ""
+	if c.baseURL != "" {
+		baseURL = c.baseURL
+	}
+	endpointURL := fmt.Sprintf(baseURL+"/"+"users/%v/set-name-v3-optional", userId)
+
+	headers := c.header.Clone()
+	headers.Add("X-Endpoint-Header", fmt.Sprintf("%v", request.XEndpointHeader))
+
+	var response *fixtures.SetNameRequestV3Body
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:                endpointURL,
+			Method:             http.MethodPost,
+			Headers:            headers,
+			Request:            request,
+			Response:           &response,
+			ResponseIsOptional: true,
+		},
+	); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *Client) SetNameV4(ctx context.Context, userId string, request *fixtures.SetNameRequestV4) (string, error) {
+	baseURL := ""
+	if c.baseURL != "" {
+		baseURL = c.baseURL
+	}
+	endpointURL := fmt.Sprintf(baseURL+"/"+"users/%v/set-name-v4", userId)
+
+	headers := c.header.Clone()
+	headers.Add("X-Endpoint-Header", fmt.Sprintf("%v", request.XEndpointHeader))
+
+	var response string
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:      endpointURL,
+			Method:   http.MethodPost,
+			Headers:  headers,
+			Request:  request,
+			Response: &response,
+		},
+	); err != nil {
+		return "", err
+	}
+	return response, nil
+}
+
+func (c *Client) SetNameV5(ctx context.Context, userId string, request *fixtures.SetNameRequestV5) (string, error) {
+	baseURL := ""
+	if c.baseURL != "" {
+		baseURL = c.baseURL
+	}
+	endpointURL := fmt.Sprintf(baseURL+"/"+"users/%v/set-name-v5", userId)
+
+	headers := c.header.Clone()
+	headers.Add("X-Endpoint-Header", fmt.Sprintf("%v", request.XEndpointHeader))
+
+	var response string
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:      endpointURL,
+			Method:   http.MethodPost,
+			Headers:  headers,
+			Request:  request,
+			Response: &response,
+		},
+	); err != nil {
+		return "", err
+	}
+	return response, nil
+}
+
+func (c *Client) Update(ctx context.Context, userId string, request *fixtures.UpdateRequest) (string, error) {
+	baseURL := ""
+	if c.baseURL != "" {
+		baseURL = c.baseURL
+	}
+	endpointURL := fmt.Sprintf(baseURL+"/"+"users/%v/update", userId)
+
+	queryParams := make(url.Values)
+	queryParams.Add("tag", fmt.Sprintf("%v", request.Tag))
+	if request.Extra != nil {
+		queryParams.Add("extra", fmt.Sprintf("%v", *request.Extra))
+	}
+	for _, value := range request.Labels {
+		queryParams.Add("labels", fmt.Sprintf("%v", value))
+	}
+	if len(queryParams) > 0 {
+		endpointURL += "?" + queryParams.Encode()
+	}
+
+	headers := c.header.Clone()
+	headers.Add("X-Version", fmt.Sprintf("%v", "2024-01-01"))
+	if request.XTraceId != nil {
+		headers.Add("X-Trace-Id", fmt.Sprintf("%v", *request.XTraceId))
+	}
+
+	var response string
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:      endpointURL,
+			Method:   http.MethodPost,
+			Headers:  headers,
+			Request:  request,
+			Response: &response,
+		},
+	); err != nil {
+		return "", err
+	}
+	return response, nil
+}
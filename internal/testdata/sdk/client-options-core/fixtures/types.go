@@ -14,6 +14,13 @@ type WithAuthToken struct {
 	_rawJSON json.RawMessage
 }
 
+func (w *WithAuthToken) GetValue() string {
+	if w == nil {
+		return ""
+	}
+	return w.Value
+}
+
 func (w *WithAuthToken) UnmarshalJSON(data []byte) error {
 	type unmarshaler WithAuthToken
 	var value unmarshaler
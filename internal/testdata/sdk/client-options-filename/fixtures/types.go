@@ -14,6 +14,13 @@ type ClientOptions struct {
 	_rawJSON json.RawMessage
 }
 
+func (c *ClientOptions) GetValue() string {
+	if c == nil {
+		return ""
+	}
+	return c.Value
+}
+
 func (c *ClientOptions) UnmarshalJSON(data []byte) error {
 	type unmarshaler ClientOptions
 	var value unmarshaler
@@ -14,6 +14,13 @@ type Username struct {
 	_rawJSON json.RawMessage
 }
 
+func (u *Username) GetValue() string {
+	if u == nil {
+		return ""
+	}
+	return u.Value
+}
+
 func (u *Username) UnmarshalJSON(data []byte) error {
 	type unmarshaler Username
 	var value unmarshaler
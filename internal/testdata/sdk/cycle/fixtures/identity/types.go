@@ -15,6 +15,13 @@ type Token struct {
 	_rawJSON json.RawMessage
 }
 
+func (t *Token) GetUsername() *user.Username {
+	if t == nil {
+		return nil
+	}
+	return t.Username
+}
+
 func (t *Token) UnmarshalJSON(data []byte) error {
 	type unmarshaler Token
 	var value unmarshaler
@@ -17,6 +17,20 @@ type User struct {
 	_rawJSON json.RawMessage
 }
 
+func (u *User) GetId() identity.Id {
+	if u == nil {
+		return ""
+	}
+	return u.Id
+}
+
+func (u *User) GetUsername() *user.Username {
+	if u == nil {
+		return nil
+	}
+	return u.Username
+}
+
 func (u *User) UnmarshalJSON(data []byte) error {
 	type unmarshaler User
 	var value unmarshaler
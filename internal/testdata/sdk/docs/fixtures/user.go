@@ -8,3 +8,17 @@ type GetNameRequest struct {
 	// Filters the username.
 	Filter string `json:"-"`
 }
+
+func (g *GetNameRequest) GetXEndpointHeader() string {
+	if g == nil {
+		return ""
+	}
+	return g.XEndpointHeader
+}
+
+func (g *GetNameRequest) GetFilter() string {
+	if g == nil {
+		return ""
+	}
+	return g.Filter
+}
@@ -0,0 +1,158 @@
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Int64String is an int64 that's serialized as a JSON string (e.g. "42") so that
+// large values aren't truncated by clients that represent numbers as doubles.
+//
+// Both JSON strings and numbers are accepted when deserializing.
+type Int64String int64
+
+func (i Int64String) Ptr() *Int64String {
+	return &i
+}
+
+func (i Int64String) String() string {
+	return strconv.FormatInt(int64(i), 10)
+}
+
+func (i Int64String) MarshalText() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+func (i *Int64String) UnmarshalText(data []byte) error {
+	value, err := strconv.ParseInt(string(data), 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid int64 %q: %w", data, err)
+	}
+	*i = Int64String(value)
+	return nil
+}
+
+func (i Int64String) MarshalJSON() ([]byte, error) {
+	return json.Marshal(i.String())
+}
+
+func (i *Int64String) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var number json.Number
+	if err := json.Unmarshal(data, &number); err != nil {
+		return err
+	}
+	return i.UnmarshalText([]byte(number))
+}
+
+// UnixSeconds wraps time.Time so that it's serialized as the number
+// of seconds elapsed since the Unix epoch (e.g. 1136214245).
+type UnixSeconds struct {
+	time.Time
+}
+
+// NewUnixSeconds returns a UnixSeconds for the given time.Time.
+func NewUnixSeconds(t time.Time) UnixSeconds {
+	return UnixSeconds{Time: t}
+}
+
+func (u UnixSeconds) Ptr() *UnixSeconds {
+	return &u
+}
+
+func (u UnixSeconds) String() string {
+	return strconv.FormatInt(u.Unix(), 10)
+}
+
+func (u UnixSeconds) MarshalText() ([]byte, error) {
+	return []byte(u.String()), nil
+}
+
+func (u *UnixSeconds) UnmarshalText(data []byte) error {
+	t, err := parseUnixTimestamp(string(data), time.Second)
+	if err != nil {
+		return err
+	}
+	u.Time = t
+	return nil
+}
+
+func (u UnixSeconds) MarshalJSON() ([]byte, error) {
+	return []byte(u.String()), nil
+}
+
+func (u *UnixSeconds) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var number json.Number
+	if err := json.Unmarshal(data, &number); err != nil {
+		return err
+	}
+	return u.UnmarshalText([]byte(number))
+}
+
+// UnixMillis wraps time.Time so that it's serialized as the number
+// of milliseconds elapsed since the Unix epoch (e.g. 1136214245000).
+type UnixMillis struct {
+	time.Time
+}
+
+// NewUnixMillis returns a UnixMillis for the given time.Time.
+func NewUnixMillis(t time.Time) UnixMillis {
+	return UnixMillis{Time: t}
+}
+
+func (u UnixMillis) Ptr() *UnixMillis {
+	return &u
+}
+
+func (u UnixMillis) String() string {
+	return strconv.FormatInt(u.UnixMilli(), 10)
+}
+
+func (u UnixMillis) MarshalText() ([]byte, error) {
+	return []byte(u.String()), nil
+}
+
+func (u *UnixMillis) UnmarshalText(data []byte) error {
+	t, err := parseUnixTimestamp(string(data), time.Millisecond)
+	if err != nil {
+		return err
+	}
+	u.Time = t
+	return nil
+}
+
+func (u UnixMillis) MarshalJSON() ([]byte, error) {
+	return []byte(u.String()), nil
+}
+
+func (u *UnixMillis) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	var number json.Number
+	if err := json.Unmarshal(data, &number); err != nil {
+		return err
+	}
+	return u.UnmarshalText([]byte(number))
+}
+
+// parseUnixTimestamp parses the given Unix timestamp in terms of the given unit
+// (e.g. time.Second). Timestamps may include a fractional component.
+func parseUnixTimestamp(s string, unit time.Duration) (time.Time, error) {
+	unitsPerSecond := int64(time.Second / unit)
+	if value, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.Unix(value/unitsPerSecond, value%unitsPerSecond*int64(unit)), nil
+	}
+	value, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid unix timestamp %q: %w", s, err)
+	}
+	return time.Unix(0, 0).Add(time.Duration(value * float64(unit))), nil
+}
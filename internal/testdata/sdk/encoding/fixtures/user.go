@@ -0,0 +1,136 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package api
+
+import (
+	json "encoding/json"
+	fmt "fmt"
+	core "github.com/fern-api/fern-go/internal/testdata/sdk/encoding/fixtures/core"
+)
+
+type GetUsersRequest struct {
+	Id               core.Int64String  `json:"-"`
+	Score            json.Number       `json:"-"`
+	Deadline         core.UnixMillis   `json:"-"`
+	OptionalId       *core.Int64String `json:"-"`
+	OptionalScore    *json.Number      `json:"-"`
+	OptionalDeadline *core.UnixMillis  `json:"-"`
+}
+
+func (g *GetUsersRequest) GetId() core.Int64String {
+	if g == nil {
+		return 0
+	}
+	return g.Id
+}
+
+func (g *GetUsersRequest) GetScore() json.Number {
+	if g == nil {
+		return ""
+	}
+	return g.Score
+}
+
+func (g *GetUsersRequest) GetDeadline() core.UnixMillis {
+	if g == nil {
+		return core.UnixMillis{}
+	}
+	return g.Deadline
+}
+
+func (g *GetUsersRequest) GetOptionalId() core.Int64String {
+	if g == nil || g.OptionalId == nil {
+		return 0
+	}
+	return *g.OptionalId
+}
+
+func (g *GetUsersRequest) GetOptionalScore() json.Number {
+	if g == nil || g.OptionalScore == nil {
+		return ""
+	}
+	return *g.OptionalScore
+}
+
+func (g *GetUsersRequest) GetOptionalDeadline() core.UnixMillis {
+	if g == nil || g.OptionalDeadline == nil {
+		return core.UnixMillis{}
+	}
+	return *g.OptionalDeadline
+}
+
+type User struct {
+	Name      string           `json:"name"`
+	Tags      []string         `json:"tags,omitempty"`
+	Id        core.Int64String `json:"id"`
+	Score     json.Number      `json:"score"`
+	CreatedAt core.UnixMillis  `json:"createdAt"`
+	UpdatedAt *core.UnixMillis `json:"updatedAt,omitempty"`
+
+	_rawJSON json.RawMessage
+}
+
+func (u *User) GetName() string {
+	if u == nil {
+		return ""
+	}
+	return u.Name
+}
+
+func (u *User) GetTags() []string {
+	if u == nil {
+		return nil
+	}
+	return u.Tags
+}
+
+func (u *User) GetId() core.Int64String {
+	if u == nil {
+		return 0
+	}
+	return u.Id
+}
+
+func (u *User) GetScore() json.Number {
+	if u == nil {
+		return ""
+	}
+	return u.Score
+}
+
+func (u *User) GetCreatedAt() core.UnixMillis {
+	if u == nil {
+		return core.UnixMillis{}
+	}
+	return u.CreatedAt
+}
+
+func (u *User) GetUpdatedAt() core.UnixMillis {
+	if u == nil || u.UpdatedAt == nil {
+		return core.UnixMillis{}
+	}
+	return *u.UpdatedAt
+}
+
+func (u *User) UnmarshalJSON(data []byte) error {
+	type unmarshaler User
+	var value unmarshaler
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*u = User(value)
+	u._rawJSON = json.RawMessage(data)
+	return nil
+}
+
+func (u *User) String() string {
+	if len(u._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(u._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(u); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", u)
+}
@@ -0,0 +1,69 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package user
+
+import (
+	context "context"
+	fmt "fmt"
+	fixtures "github.com/fern-api/fern-go/internal/testdata/sdk/encoding/fixtures"
+	core "github.com/fern-api/fern-go/internal/testdata/sdk/encoding/fixtures/core"
+	http "net/http"
+	url "net/url"
+)
+
+type Client struct {
+	baseURL string
+	caller  *core.Caller
+	header  http.Header
+}
+
+func NewClient(opts ...core.ClientOption) *Client {
+	options := core.NewClientOptions()
+	for _, opt := range opts {
+		opt(options)
+	}
+	return &Client{
+		baseURL: options.BaseURL,
+		caller:  core.NewCaller(options.HTTPClient),
+		header:  options.ToHeader(),
+	}
+}
+
+func (c *Client) GetUsername(ctx context.Context, request *fixtures.GetUsersRequest) (*fixtures.User, error) {
+	baseURL := ""
+	if c.baseURL != "" {
+		baseURL = c.baseURL
+	}
+	endpointURL := baseURL + "/" + "user"
+
+	queryParams := make(url.Values)
+	queryParams.Add("id", fmt.Sprintf("%v", request.Id))
+	queryParams.Add("score", fmt.Sprintf("%v", request.Score))
+	queryParams.Add("deadline", fmt.Sprintf("%v", request.Deadline.String()))
+	if request.OptionalId != nil {
+		queryParams.Add("optionalId", fmt.Sprintf("%v", *request.OptionalId))
+	}
+	if request.OptionalScore != nil {
+		queryParams.Add("optionalScore", fmt.Sprintf("%v", *request.OptionalScore))
+	}
+	if request.OptionalDeadline != nil {
+		queryParams.Add("optionalDeadline", fmt.Sprintf("%v", request.OptionalDeadline.String()))
+	}
+	if len(queryParams) > 0 {
+		endpointURL += "?" + queryParams.Encode()
+	}
+
+	var response *fixtures.User
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:      endpointURL,
+			Method:   http.MethodGet,
+			Headers:  c.header,
+			Response: &response,
+		},
+	); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
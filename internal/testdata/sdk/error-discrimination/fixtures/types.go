@@ -14,6 +14,13 @@ type OrganizationNotFoundErrorBody struct {
 	_rawJSON json.RawMessage
 }
 
+func (o *OrganizationNotFoundErrorBody) GetRequestedOrganizationId() string {
+	if o == nil {
+		return ""
+	}
+	return o.RequestedOrganizationId
+}
+
 func (o *OrganizationNotFoundErrorBody) UnmarshalJSON(data []byte) error {
 	type unmarshaler OrganizationNotFoundErrorBody
 	var value unmarshaler
@@ -43,6 +50,13 @@ type UserNotFoundErrorBody struct {
 	_rawJSON json.RawMessage
 }
 
+func (u *UserNotFoundErrorBody) GetRequestedUserId() string {
+	if u == nil {
+		return ""
+	}
+	return u.RequestedUserId
+}
+
 func (u *UserNotFoundErrorBody) UnmarshalJSON(data []byte) error {
 	type unmarshaler UserNotFoundErrorBody
 	var value unmarshaler
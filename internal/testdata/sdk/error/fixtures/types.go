@@ -14,6 +14,13 @@ type UserNotFoundErrorBody struct {
 	_rawJSON json.RawMessage
 }
 
+func (u *UserNotFoundErrorBody) GetRequestedUserId() string {
+	if u == nil {
+		return ""
+	}
+	return u.RequestedUserId
+}
+
 func (u *UserNotFoundErrorBody) UnmarshalJSON(data []byte) error {
 	type unmarshaler UserNotFoundErrorBody
 	var value unmarshaler
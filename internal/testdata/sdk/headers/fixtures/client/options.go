@@ -62,7 +62,7 @@ func WithXApiDatetime(xApiDatetime time.Time) core.ClientOption {
 }
 
 // WithXApiDate sets the xApiDate header on every request.
-func WithXApiDate(xApiDate time.Time) core.ClientOption {
+func WithXApiDate(xApiDate core.Date) core.ClientOption {
 	return func(opts *core.ClientOptions) {
 		opts.XApiDate = xApiDate
 	}
@@ -97,7 +97,7 @@ func WithXApiOptionalDatetime(xApiOptionalDatetime *time.Time) core.ClientOption
 }
 
 // WithXApiOptionalDate sets the xApiOptionalDate header on every request.
-func WithXApiOptionalDate(xApiOptionalDate *time.Time) core.ClientOption {
+func WithXApiOptionalDate(xApiOptionalDate *core.Date) core.ClientOption {
 	return func(opts *core.ClientOptions) {
 		opts.XApiOptionalDate = xApiOptionalDate
 	}
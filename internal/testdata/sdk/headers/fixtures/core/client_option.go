@@ -24,12 +24,12 @@ type ClientOptions struct {
 	XApiName             string
 	XApiId               uuid.UUID
 	XApiDatetime         time.Time
-	XApiDate             time.Time
+	XApiDate             Date
 	XApiBytes            []byte
 	XApiOptionalName     *string
 	XApiOptionalId       *uuid.UUID
 	XApiOptionalDatetime *time.Time
-	XApiOptionalDate     *time.Time
+	XApiOptionalDate     *Date
 	XApiOptionalBytes    *[]byte
 }
 
@@ -53,7 +53,7 @@ func (c *ClientOptions) ToHeader() http.Header {
 	header.Set("X-API-Name", fmt.Sprintf("%v", c.XApiName))
 	header.Set("X-API-ID", fmt.Sprintf("%v", c.XApiId))
 	header.Set("X-API-Datetime", fmt.Sprintf("%v", c.XApiDatetime.Format(time.RFC3339)))
-	header.Set("X-API-Date", fmt.Sprintf("%v", c.XApiDate.Format("2006-01-02")))
+	header.Set("X-API-Date", fmt.Sprintf("%v", c.XApiDate.String()))
 	header.Set("X-API-Bytes", fmt.Sprintf("%v", base64.StdEncoding.EncodeToString(c.XApiBytes)))
 	if c.XApiOptionalName != nil {
 		header.Set("X-API-Optional-Name", fmt.Sprintf("%v", *c.XApiOptionalName))
@@ -65,7 +65,7 @@ func (c *ClientOptions) ToHeader() http.Header {
 		header.Set("X-API-Optional-Datetime", fmt.Sprintf("%v", c.XApiOptionalDatetime.Format(time.RFC3339)))
 	}
 	if c.XApiOptionalDate != nil {
-		header.Set("X-API-Optional-Date", fmt.Sprintf("%v", c.XApiOptionalDate.Format("2006-01-02")))
+		header.Set("X-API-Optional-Date", fmt.Sprintf("%v", c.XApiOptionalDate.String()))
 	}
 	if c.XApiOptionalBytes != nil {
 		header.Set("X-API-Optional-Bytes", fmt.Sprintf("%v", base64.StdEncoding.EncodeToString(*c.XApiOptionalBytes)))
@@ -3,6 +3,7 @@
 package api
 
 import (
+	core "github.com/fern-api/fern-go/internal/testdata/sdk/headers/fixtures/core"
 	uuid "github.com/google/uuid"
 	time "time"
 )
@@ -10,12 +11,12 @@ import (
 type SetNameRequest struct {
 	XEndpointHeader                 string     `json:"-"`
 	XEndpointIdHeader               uuid.UUID  `json:"-"`
-	XEndpointDateHeader             time.Time  `json:"-"`
+	XEndpointDateHeader             core.Date  `json:"-"`
 	XEndpointDatetimeHeader         time.Time  `json:"-"`
 	XEndpointBytesHeader            []byte     `json:"-"`
 	XEndpointOptionalHeader         *string    `json:"-"`
 	XEndpointOptionalIdHeader       *uuid.UUID `json:"-"`
-	XEndpointOptionalDateHeader     *time.Time `json:"-"`
+	XEndpointOptionalDateHeader     *core.Date `json:"-"`
 	XEndpointOptionalDatetimeHeader *time.Time `json:"-"`
 	XEndpointOptionalBytesHeader    *[]byte    `json:"-"`
 	xEndpointFernHeader             string
@@ -24,3 +25,73 @@ type SetNameRequest struct {
 func (s *SetNameRequest) XEndpointFernHeader() string {
 	return s.xEndpointFernHeader
 }
+
+func (s *SetNameRequest) GetXEndpointHeader() string {
+	if s == nil {
+		return ""
+	}
+	return s.XEndpointHeader
+}
+
+func (s *SetNameRequest) GetXEndpointIdHeader() uuid.UUID {
+	if s == nil {
+		return uuid.Nil
+	}
+	return s.XEndpointIdHeader
+}
+
+func (s *SetNameRequest) GetXEndpointDateHeader() core.Date {
+	if s == nil {
+		return core.Date{}
+	}
+	return s.XEndpointDateHeader
+}
+
+func (s *SetNameRequest) GetXEndpointDatetimeHeader() time.Time {
+	if s == nil {
+		return time.Time{}
+	}
+	return s.XEndpointDatetimeHeader
+}
+
+func (s *SetNameRequest) GetXEndpointBytesHeader() []byte {
+	if s == nil {
+		return nil
+	}
+	return s.XEndpointBytesHeader
+}
+
+func (s *SetNameRequest) GetXEndpointOptionalHeader() string {
+	if s == nil || s.XEndpointOptionalHeader == nil {
+		return ""
+	}
+	return *s.XEndpointOptionalHeader
+}
+
+func (s *SetNameRequest) GetXEndpointOptionalIdHeader() uuid.UUID {
+	if s == nil || s.XEndpointOptionalIdHeader == nil {
+		return uuid.Nil
+	}
+	return *s.XEndpointOptionalIdHeader
+}
+
+func (s *SetNameRequest) GetXEndpointOptionalDateHeader() core.Date {
+	if s == nil || s.XEndpointOptionalDateHeader == nil {
+		return core.Date{}
+	}
+	return *s.XEndpointOptionalDateHeader
+}
+
+func (s *SetNameRequest) GetXEndpointOptionalDatetimeHeader() time.Time {
+	if s == nil || s.XEndpointOptionalDatetimeHeader == nil {
+		return time.Time{}
+	}
+	return *s.XEndpointOptionalDatetimeHeader
+}
+
+func (s *SetNameRequest) GetXEndpointOptionalBytesHeader() []byte {
+	if s == nil || s.XEndpointOptionalBytesHeader == nil {
+		return nil
+	}
+	return *s.XEndpointOptionalBytesHeader
+}
@@ -40,7 +40,7 @@ func (c *Client) SetName(ctx context.Context, userId string, request *fixtures.S
 	headers := c.header.Clone()
 	headers.Add("X-Endpoint-Header", fmt.Sprintf("%v", request.XEndpointHeader))
 	headers.Add("X-Endpoint-ID-Header", fmt.Sprintf("%v", request.XEndpointIdHeader))
-	headers.Add("X-Endpoint-Date-Header", fmt.Sprintf("%v", request.XEndpointDateHeader.Format("2006-01-02")))
+	headers.Add("X-Endpoint-Date-Header", fmt.Sprintf("%v", request.XEndpointDateHeader.String()))
 	headers.Add("X-Endpoint-Datetime-Header", fmt.Sprintf("%v", request.XEndpointDatetimeHeader.Format(time.RFC3339)))
 	headers.Add("X-Endpoint-Bytes-Header", fmt.Sprintf("%v", base64.StdEncoding.EncodeToString(request.XEndpointBytesHeader)))
 	if request.XEndpointOptionalHeader != nil {
@@ -50,7 +50,7 @@ func (c *Client) SetName(ctx context.Context, userId string, request *fixtures.S
 		headers.Add("X-Endpoint-Optional-ID-Header", fmt.Sprintf("%v", *request.XEndpointOptionalIdHeader))
 	}
 	if request.XEndpointOptionalDateHeader != nil {
-		headers.Add("X-Endpoint-Optional-Date-Header", fmt.Sprintf("%v", request.XEndpointOptionalDateHeader.Format("2006-01-02")))
+		headers.Add("X-Endpoint-Optional-Date-Header", fmt.Sprintf("%v", request.XEndpointOptionalDateHeader.String()))
 	}
 	if request.XEndpointOptionalDatetimeHeader != nil {
 		headers.Add("X-Endpoint-Optional-Datetime-Header", fmt.Sprintf("%v", request.XEndpointOptionalDatetimeHeader.Format(time.RFC3339)))
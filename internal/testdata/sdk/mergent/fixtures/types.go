@@ -32,6 +32,76 @@ type ScheduleNew struct {
 	CreatedAt *CreatedAt `json:"created_at,omitempty"`
 }
 
+func (s *ScheduleNew) GetId() Id {
+	if s == nil || s.Id == nil {
+		return ""
+	}
+	return *s.Id
+}
+
+func (s *ScheduleNew) GetName() string {
+	if s == nil || s.Name == nil {
+		return ""
+	}
+	return *s.Name
+}
+
+func (s *ScheduleNew) GetQueue() string {
+	if s == nil || s.Queue == nil {
+		return ""
+	}
+	return *s.Queue
+}
+
+func (s *ScheduleNew) GetDescription() string {
+	if s == nil || s.Description == nil {
+		return ""
+	}
+	return *s.Description
+}
+
+func (s *ScheduleNew) GetCron() string {
+	if s == nil || s.Cron == nil {
+		return ""
+	}
+	return *s.Cron
+}
+
+func (s *ScheduleNew) GetRrule() string {
+	if s == nil || s.Rrule == nil {
+		return ""
+	}
+	return *s.Rrule
+}
+
+func (s *ScheduleNew) GetDtstart() string {
+	if s == nil || s.Dtstart == nil {
+		return ""
+	}
+	return *s.Dtstart
+}
+
+func (s *ScheduleNew) GetPaused() bool {
+	if s == nil || s.Paused == nil {
+		return false
+	}
+	return *s.Paused
+}
+
+func (s *ScheduleNew) GetRequest() *Request {
+	if s == nil {
+		return nil
+	}
+	return s.Request
+}
+
+func (s *ScheduleNew) GetCreatedAt() CreatedAt {
+	if s == nil || s.CreatedAt == nil {
+		return ""
+	}
+	return *s.CreatedAt
+}
+
 type Error struct {
 	// A human-readable message providing more details about the error(s).
 	Message *string `json:"message,omitempty"`
@@ -43,6 +113,27 @@ type Error struct {
 	_rawJSON json.RawMessage
 }
 
+func (e *Error) GetMessage() string {
+	if e == nil || e.Message == nil {
+		return ""
+	}
+	return *e.Message
+}
+
+func (e *Error) GetParam() string {
+	if e == nil || e.Param == nil {
+		return ""
+	}
+	return *e.Param
+}
+
+func (e *Error) GetErrors() []*Error {
+	if e == nil {
+		return nil
+	}
+	return e.Errors
+}
+
 func (e *Error) UnmarshalJSON(data []byte) error {
 	type unmarshaler Error
 	var value unmarshaler
@@ -82,6 +173,27 @@ type Request struct {
 	_rawJSON json.RawMessage
 }
 
+func (r *Request) GetUrl() string {
+	if r == nil {
+		return ""
+	}
+	return r.Url
+}
+
+func (r *Request) GetHeaders() map[string]interface{} {
+	if r == nil {
+		return nil
+	}
+	return r.Headers
+}
+
+func (r *Request) GetBody() string {
+	if r == nil || r.Body == nil {
+		return ""
+	}
+	return *r.Body
+}
+
 func (r *Request) UnmarshalJSON(data []byte) error {
 	type unmarshaler Request
 	var value unmarshaler
@@ -131,6 +243,76 @@ type Schedule struct {
 	_rawJSON json.RawMessage
 }
 
+func (s *Schedule) GetId() Id {
+	if s == nil || s.Id == nil {
+		return ""
+	}
+	return *s.Id
+}
+
+func (s *Schedule) GetName() string {
+	if s == nil || s.Name == nil {
+		return ""
+	}
+	return *s.Name
+}
+
+func (s *Schedule) GetQueue() string {
+	if s == nil || s.Queue == nil {
+		return ""
+	}
+	return *s.Queue
+}
+
+func (s *Schedule) GetDescription() string {
+	if s == nil || s.Description == nil {
+		return ""
+	}
+	return *s.Description
+}
+
+func (s *Schedule) GetCron() string {
+	if s == nil || s.Cron == nil {
+		return ""
+	}
+	return *s.Cron
+}
+
+func (s *Schedule) GetRrule() string {
+	if s == nil || s.Rrule == nil {
+		return ""
+	}
+	return *s.Rrule
+}
+
+func (s *Schedule) GetDtstart() string {
+	if s == nil || s.Dtstart == nil {
+		return ""
+	}
+	return *s.Dtstart
+}
+
+func (s *Schedule) GetPaused() bool {
+	if s == nil || s.Paused == nil {
+		return false
+	}
+	return *s.Paused
+}
+
+func (s *Schedule) GetRequest() *Request {
+	if s == nil {
+		return nil
+	}
+	return s.Request
+}
+
+func (s *Schedule) GetCreatedAt() CreatedAt {
+	if s == nil || s.CreatedAt == nil {
+		return ""
+	}
+	return *s.CreatedAt
+}
+
 func (s *Schedule) UnmarshalJSON(data []byte) error {
 	type unmarshaler Schedule
 	var value unmarshaler
@@ -172,6 +354,62 @@ type Task struct {
 	_rawJSON json.RawMessage
 }
 
+func (t *Task) GetId() Id {
+	if t == nil || t.Id == nil {
+		return ""
+	}
+	return *t.Id
+}
+
+func (t *Task) GetName() string {
+	if t == nil || t.Name == nil {
+		return ""
+	}
+	return *t.Name
+}
+
+func (t *Task) GetQueue() string {
+	if t == nil || t.Queue == nil {
+		return ""
+	}
+	return *t.Queue
+}
+
+func (t *Task) GetStatus() TaskStatus {
+	if t == nil || t.Status == nil {
+		return ""
+	}
+	return *t.Status
+}
+
+func (t *Task) GetRequest() *Request {
+	if t == nil {
+		return nil
+	}
+	return t.Request
+}
+
+func (t *Task) GetScheduledFor() string {
+	if t == nil || t.ScheduledFor == nil {
+		return ""
+	}
+	return *t.ScheduledFor
+}
+
+func (t *Task) GetDelay() string {
+	if t == nil || t.Delay == nil {
+		return ""
+	}
+	return *t.Delay
+}
+
+func (t *Task) GetCreatedAt() CreatedAt {
+	if t == nil || t.CreatedAt == nil {
+		return ""
+	}
+	return *t.CreatedAt
+}
+
 func (t *Task) UnmarshalJSON(data []byte) error {
 	type unmarshaler Task
 	var value unmarshaler
@@ -213,6 +451,62 @@ type TaskNew struct {
 	_rawJSON json.RawMessage
 }
 
+func (t *TaskNew) GetId() Id {
+	if t == nil || t.Id == nil {
+		return ""
+	}
+	return *t.Id
+}
+
+func (t *TaskNew) GetName() string {
+	if t == nil || t.Name == nil {
+		return ""
+	}
+	return *t.Name
+}
+
+func (t *TaskNew) GetQueue() string {
+	if t == nil || t.Queue == nil {
+		return ""
+	}
+	return *t.Queue
+}
+
+func (t *TaskNew) GetStatus() TaskStatus {
+	if t == nil || t.Status == nil {
+		return ""
+	}
+	return *t.Status
+}
+
+func (t *TaskNew) GetRequest() *Request {
+	if t == nil {
+		return nil
+	}
+	return t.Request
+}
+
+func (t *TaskNew) GetScheduledFor() string {
+	if t == nil || t.ScheduledFor == nil {
+		return ""
+	}
+	return *t.ScheduledFor
+}
+
+func (t *TaskNew) GetDelay() string {
+	if t == nil || t.Delay == nil {
+		return ""
+	}
+	return *t.Delay
+}
+
+func (t *TaskNew) GetCreatedAt() CreatedAt {
+	if t == nil || t.CreatedAt == nil {
+		return ""
+	}
+	return *t.CreatedAt
+}
+
 func (t *TaskNew) UnmarshalJSON(data []byte) error {
 	type unmarshaler TaskNew
 	var value unmarshaler
@@ -14,6 +14,13 @@ type Optional struct {
 	_rawJSON json.RawMessage
 }
 
+func (o *Optional) GetValue() string {
+	if o == nil {
+		return ""
+	}
+	return o.Value
+}
+
 func (o *Optional) UnmarshalJSON(data []byte) error {
 	type unmarshaler Optional
 	var value unmarshaler
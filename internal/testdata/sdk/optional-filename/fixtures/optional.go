@@ -14,6 +14,13 @@ type Request struct {
 	_rawJSON json.RawMessage
 }
 
+func (r *Request) GetValue() string {
+	if r == nil {
+		return ""
+	}
+	return r.Value
+}
+
 func (r *Request) UnmarshalJSON(data []byte) error {
 	type unmarshaler Request
 	var value unmarshaler
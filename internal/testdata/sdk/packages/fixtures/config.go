@@ -5,3 +5,10 @@ package api
 type CreateConfigRequest struct {
 	Id string `json:"id"`
 }
+
+func (c *CreateConfigRequest) GetId() string {
+	if c == nil {
+		return ""
+	}
+	return c.Id
+}
@@ -14,6 +14,13 @@ type Config struct {
 	_rawJSON json.RawMessage
 }
 
+func (c *Config) GetId() string {
+	if c == nil {
+		return ""
+	}
+	return c.Id
+}
+
 func (c *Config) UnmarshalJSON(data []byte) error {
 	type unmarshaler Config
 	var value unmarshaler
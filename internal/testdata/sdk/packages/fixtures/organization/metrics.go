@@ -7,3 +7,24 @@ type CreateMetricsTagRequest struct {
 	String  *string `json:"string,omitempty"`
 	Boolean *bool   `json:"boolean,omitempty"`
 }
+
+func (c *CreateMetricsTagRequest) GetNumber() int {
+	if c == nil || c.Number == nil {
+		return 0
+	}
+	return *c.Number
+}
+
+func (c *CreateMetricsTagRequest) GetString() string {
+	if c == nil || c.String == nil {
+		return ""
+	}
+	return *c.String
+}
+
+func (c *CreateMetricsTagRequest) GetBoolean() bool {
+	if c == nil || c.Boolean == nil {
+		return false
+	}
+	return *c.Boolean
+}
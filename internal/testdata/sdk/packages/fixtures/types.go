@@ -14,6 +14,13 @@ type Error struct {
 	_rawJSON json.RawMessage
 }
 
+func (e *Error) GetMessage() string {
+	if e == nil {
+		return ""
+	}
+	return e.Message
+}
+
 func (e *Error) UnmarshalJSON(data []byte) error {
 	type unmarshaler Error
 	var value unmarshaler
@@ -44,6 +51,20 @@ type Foo struct {
 	_rawJSON json.RawMessage
 }
 
+func (f *Foo) GetId() string {
+	if f == nil {
+		return ""
+	}
+	return f.Id
+}
+
+func (f *Foo) GetName() string {
+	if f == nil {
+		return ""
+	}
+	return f.Name
+}
+
 func (f *Foo) UnmarshalJSON(data []byte) error {
 	type unmarshaler Foo
 	var value unmarshaler
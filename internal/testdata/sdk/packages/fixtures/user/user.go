@@ -5,3 +5,10 @@ package user
 type CreateUserRequest struct {
 	Name string `json:"name"`
 }
+
+func (c *CreateUserRequest) GetName() string {
+	if c == nil {
+		return ""
+	}
+	return c.Name
+}
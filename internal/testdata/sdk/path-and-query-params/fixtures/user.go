@@ -5,3 +5,10 @@ package api
 type GetUserRequest struct {
 	Shallow *bool `json:"-"`
 }
+
+func (g *GetUserRequest) GetShallow() bool {
+	if g == nil || g.Shallow == nil {
+		return false
+	}
+	return *g.Shallow
+}
@@ -14,6 +14,13 @@ type String struct {
 	_rawJSON json.RawMessage
 }
 
+func (s *String) GetValue() string {
+	if s == nil {
+		return ""
+	}
+	return s.Value
+}
+
 func (s *String) UnmarshalJSON(data []byte) error {
 	type unmarshaler String
 	var value unmarshaler
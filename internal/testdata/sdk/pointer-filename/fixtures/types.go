@@ -14,6 +14,13 @@ type Pointer struct {
 	_rawJSON json.RawMessage
 }
 
+func (p *Pointer) GetName() string {
+	if p == nil {
+		return ""
+	}
+	return p.Name
+}
+
 func (p *Pointer) UnmarshalJSON(data []byte) error {
 	type unmarshaler Pointer
 	var value unmarshaler
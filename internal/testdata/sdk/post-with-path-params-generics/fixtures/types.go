@@ -14,6 +14,13 @@ type Bar struct {
 	_rawJSON json.RawMessage
 }
 
+func (b *Bar) GetId() string {
+	if b == nil {
+		return ""
+	}
+	return b.Id
+}
+
 func (b *Bar) UnmarshalJSON(data []byte) error {
 	type unmarshaler Bar
 	var value unmarshaler
@@ -43,6 +50,13 @@ type Foo struct {
 	_rawJSON json.RawMessage
 }
 
+func (f *Foo) GetId() string {
+	if f == nil {
+		return ""
+	}
+	return f.Id
+}
+
 func (f *Foo) UnmarshalJSON(data []byte) error {
 	type unmarshaler Foo
 	var value unmarshaler
@@ -12,11 +12,32 @@ type SetNameRequest struct {
 	UserName string `json:"userName"`
 }
 
+func (s *SetNameRequest) GetUserName() string {
+	if s == nil {
+		return ""
+	}
+	return s.UserName
+}
+
 type SetNameRequestV3 struct {
 	XEndpointHeader string                `json:"-"`
 	Body            *SetNameRequestV3Body `json:"-"`
 }
 
+func (s *SetNameRequestV3) GetXEndpointHeader() string {
+	if s == nil {
+		return ""
+	}
+	return s.XEndpointHeader
+}
+
+func (s *SetNameRequestV3) GetBody() *SetNameRequestV3Body {
+	if s == nil {
+		return nil
+	}
+	return s.Body
+}
+
 func (s *SetNameRequestV3) UnmarshalJSON(data []byte) error {
 	body := new(SetNameRequestV3Body)
 	if err := json.Unmarshal(data, &body); err != nil {
@@ -35,6 +56,20 @@ type SetNameRequestV3Optional struct {
 	Body            *SetNameRequestV3Body `json:"-"`
 }
 
+func (s *SetNameRequestV3Optional) GetXEndpointHeader() string {
+	if s == nil {
+		return ""
+	}
+	return s.XEndpointHeader
+}
+
+func (s *SetNameRequestV3Optional) GetBody() *SetNameRequestV3Body {
+	if s == nil {
+		return nil
+	}
+	return s.Body
+}
+
 func (s *SetNameRequestV3Optional) UnmarshalJSON(data []byte) error {
 	body := new(SetNameRequestV3Body)
 	if err := json.Unmarshal(data, &body); err != nil {
@@ -53,6 +88,20 @@ type SetNameRequestV4 struct {
 	Body            []string `json:"-"`
 }
 
+func (s *SetNameRequestV4) GetXEndpointHeader() string {
+	if s == nil {
+		return ""
+	}
+	return s.XEndpointHeader
+}
+
+func (s *SetNameRequestV4) GetBody() []string {
+	if s == nil {
+		return nil
+	}
+	return s.Body
+}
+
 func (s *SetNameRequestV4) UnmarshalJSON(data []byte) error {
 	var body []string
 	if err := json.Unmarshal(data, &body); err != nil {
@@ -71,6 +120,20 @@ type SetNameRequestV5 struct {
 	Body            string `json:"-"`
 }
 
+func (s *SetNameRequestV5) GetXEndpointHeader() string {
+	if s == nil {
+		return ""
+	}
+	return s.XEndpointHeader
+}
+
+func (s *SetNameRequestV5) GetBody() string {
+	if s == nil {
+		return ""
+	}
+	return s.Body
+}
+
 func (s *SetNameRequestV5) UnmarshalJSON(data []byte) error {
 	var body string
 	if err := json.Unmarshal(data, &body); err != nil {
@@ -93,6 +156,13 @@ type Filter struct {
 	_rawJSON json.RawMessage
 }
 
+func (f *Filter) GetTag() string {
+	if f == nil {
+		return ""
+	}
+	return f.Tag
+}
+
 func (f *Filter) UnmarshalJSON(data []byte) error {
 	type unmarshaler Filter
 	var value unmarshaler
@@ -122,6 +192,13 @@ type SetNameRequestV3Body struct {
 	_rawJSON json.RawMessage
 }
 
+func (s *SetNameRequestV3Body) GetUserName() string {
+	if s == nil {
+		return ""
+	}
+	return s.UserName
+}
+
 func (s *SetNameRequestV3Body) UnmarshalJSON(data []byte) error {
 	type unmarshaler SetNameRequestV3Body
 	var value unmarshaler
@@ -159,6 +236,27 @@ func NewUnionFromBar(value *Bar) *Union {
 	return &Union{Type: "bar", Bar: value}
 }
 
+func (u *Union) GetType() string {
+	if u == nil {
+		return ""
+	}
+	return u.Type
+}
+
+func (u *Union) GetFoo() *Foo {
+	if u == nil {
+		return nil
+	}
+	return u.Foo
+}
+
+func (u *Union) GetBar() *Bar {
+	if u == nil {
+		return nil
+	}
+	return u.Bar
+}
+
 func (u *Union) UnmarshalJSON(data []byte) error {
 	var unmarshaler struct {
 		Type string `json:"type"`
@@ -234,3 +332,52 @@ type UpdateRequest struct {
 	OptionalFilter *core.Optional[Filter]   `json:"optionalFilter,omitempty"`
 	OptionalTags   *core.Optional[[]string] `json:"optionalTags,omitempty"`
 }
+
+func (u *UpdateRequest) GetTag() string {
+	if u == nil {
+		return ""
+	}
+	return u.Tag
+}
+
+func (u *UpdateRequest) GetExtra() string {
+	if u == nil || u.Extra == nil {
+		return ""
+	}
+	return *u.Extra
+}
+
+func (u *UpdateRequest) GetUnion() *Union {
+	if u == nil {
+		return nil
+	}
+	return u.Union
+}
+
+func (u *UpdateRequest) GetFilter() *Filter {
+	if u == nil {
+		return nil
+	}
+	return u.Filter
+}
+
+func (u *UpdateRequest) GetOptionalUnion() *Union {
+	if u == nil || u.OptionalUnion == nil {
+		return nil
+	}
+	return &u.OptionalUnion.Value
+}
+
+func (u *UpdateRequest) GetOptionalFilter() *Filter {
+	if u == nil || u.OptionalFilter == nil {
+		return nil
+	}
+	return &u.OptionalFilter.Value
+}
+
+func (u *UpdateRequest) GetOptionalTags() []string {
+	if u == nil || u.OptionalTags == nil {
+		return nil
+	}
+	return u.OptionalTags.Value
+}
@@ -12,15 +12,71 @@ import (
 
 type GetUsersRequest struct {
 	Id               uuid.UUID  `json:"-"`
-	Date             time.Time  `json:"-"`
+	Date             core.Date  `json:"-"`
 	Deadline         time.Time  `json:"-"`
 	Bytes            []byte     `json:"-"`
 	OptionalId       *uuid.UUID `json:"-"`
-	OptionalDate     *time.Time `json:"-"`
+	OptionalDate     *core.Date `json:"-"`
 	OptionalDeadline *time.Time `json:"-"`
 	OptionalBytes    *[]byte    `json:"-"`
 }
 
+func (g *GetUsersRequest) GetId() uuid.UUID {
+	if g == nil {
+		return uuid.Nil
+	}
+	return g.Id
+}
+
+func (g *GetUsersRequest) GetDate() core.Date {
+	if g == nil {
+		return core.Date{}
+	}
+	return g.Date
+}
+
+func (g *GetUsersRequest) GetDeadline() time.Time {
+	if g == nil {
+		return time.Time{}
+	}
+	return g.Deadline
+}
+
+func (g *GetUsersRequest) GetBytes() []byte {
+	if g == nil {
+		return nil
+	}
+	return g.Bytes
+}
+
+func (g *GetUsersRequest) GetOptionalId() uuid.UUID {
+	if g == nil || g.OptionalId == nil {
+		return uuid.Nil
+	}
+	return *g.OptionalId
+}
+
+func (g *GetUsersRequest) GetOptionalDate() core.Date {
+	if g == nil || g.OptionalDate == nil {
+		return core.Date{}
+	}
+	return *g.OptionalDate
+}
+
+func (g *GetUsersRequest) GetOptionalDeadline() time.Time {
+	if g == nil || g.OptionalDeadline == nil {
+		return time.Time{}
+	}
+	return *g.OptionalDeadline
+}
+
+func (g *GetUsersRequest) GetOptionalBytes() []byte {
+	if g == nil || g.OptionalBytes == nil {
+		return nil
+	}
+	return *g.OptionalBytes
+}
+
 type User struct {
 	Name string   `json:"name"`
 	Tags []string `json:"tags,omitempty"`
@@ -28,6 +84,20 @@ type User struct {
 	_rawJSON json.RawMessage
 }
 
+func (u *User) GetName() string {
+	if u == nil {
+		return ""
+	}
+	return u.Name
+}
+
+func (u *User) GetTags() []string {
+	if u == nil {
+		return nil
+	}
+	return u.Tags
+}
+
 func (u *User) UnmarshalJSON(data []byte) error {
 	type unmarshaler User
 	var value unmarshaler
@@ -40,14 +40,14 @@ func (c *Client) GetUsername(ctx context.Context, request *fixtures.GetUsersRequ
 
 	queryParams := make(url.Values)
 	queryParams.Add("id", fmt.Sprintf("%v", request.Id))
-	queryParams.Add("date", fmt.Sprintf("%v", request.Date.Format("2006-01-02")))
+	queryParams.Add("date", fmt.Sprintf("%v", request.Date.String()))
 	queryParams.Add("deadline", fmt.Sprintf("%v", request.Deadline.Format(time.RFC3339)))
 	queryParams.Add("bytes", fmt.Sprintf("%v", base64.StdEncoding.EncodeToString(request.Bytes)))
 	if request.OptionalId != nil {
 		queryParams.Add("optionalId", fmt.Sprintf("%v", *request.OptionalId))
 	}
 	if request.OptionalDate != nil {
-		queryParams.Add("optionalDate", fmt.Sprintf("%v", request.OptionalDate.Format("2006-01-02")))
+		queryParams.Add("optionalDate", fmt.Sprintf("%v", request.OptionalDate.String()))
 	}
 	if request.OptionalDeadline != nil {
 		queryParams.Add("optionalDeadline", fmt.Sprintf("%v", request.OptionalDeadline.Format(time.RFC3339)))
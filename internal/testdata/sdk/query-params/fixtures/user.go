@@ -11,3 +11,17 @@ type GetAllUsersRequest struct {
 func (g *GetAllUsersRequest) Key() string {
 	return g.key
 }
+
+func (g *GetAllUsersRequest) GetXEndpointHeader() string {
+	if g == nil {
+		return ""
+	}
+	return g.XEndpointHeader
+}
+
+func (g *GetAllUsersRequest) GetLimit() int {
+	if g == nil || g.Limit == nil {
+		return 0
+	}
+	return *g.Limit
+}
@@ -5,3 +5,10 @@ package nested
 type GetNestedRequest struct {
 	Name string `json:"name"`
 }
+
+func (g *GetNestedRequest) GetName() string {
+	if g == nil {
+		return ""
+	}
+	return g.Name
+}
@@ -19,6 +19,48 @@ type Bar struct {
 	_rawJSON json.RawMessage
 }
 
+func (b *Bar) GetId() Id {
+	if b == nil || b.Id == nil {
+		return ""
+	}
+	return *b.Id
+}
+
+func (b *Bar) GetName() string {
+	if b == nil || b.Name == nil {
+		return ""
+	}
+	return *b.Name
+}
+
+func (b *Bar) GetList() string {
+	if b == nil || b.List == nil {
+		return ""
+	}
+	return *b.List
+}
+
+func (b *Bar) GetType() FooType {
+	if b == nil || b.Type == nil {
+		return ""
+	}
+	return *b.Type
+}
+
+func (b *Bar) GetRequest() *Request {
+	if b == nil {
+		return nil
+	}
+	return b.Request
+}
+
+func (b *Bar) GetDelay() string {
+	if b == nil || b.Delay == nil {
+		return ""
+	}
+	return *b.Delay
+}
+
 func (b *Bar) UnmarshalJSON(data []byte) error {
 	type unmarshaler Bar
 	var value unmarshaler
@@ -55,6 +97,41 @@ type Baz struct {
 	_rawJSON json.RawMessage
 }
 
+func (b *Baz) GetId() Id {
+	if b == nil || b.Id == nil {
+		return ""
+	}
+	return *b.Id
+}
+
+func (b *Baz) GetName() string {
+	if b == nil || b.Name == nil {
+		return ""
+	}
+	return *b.Name
+}
+
+func (b *Baz) GetList() string {
+	if b == nil || b.List == nil {
+		return ""
+	}
+	return *b.List
+}
+
+func (b *Baz) GetDescription() string {
+	if b == nil || b.Description == nil {
+		return ""
+	}
+	return *b.Description
+}
+
+func (b *Baz) GetHasDocs() string {
+	if b == nil || b.HasDocs == nil {
+		return ""
+	}
+	return *b.HasDocs
+}
+
 func (b *Baz) UnmarshalJSON(data []byte) error {
 	type unmarshaler Baz
 	var value unmarshaler
@@ -85,6 +162,20 @@ type Error struct {
 	_rawJSON json.RawMessage
 }
 
+func (e *Error) GetMessage() string {
+	if e == nil || e.Message == nil {
+		return ""
+	}
+	return *e.Message
+}
+
+func (e *Error) GetRecursive() []*Error {
+	if e == nil {
+		return nil
+	}
+	return e.Recursive
+}
+
 func (e *Error) UnmarshalJSON(data []byte) error {
 	type unmarshaler Error
 	var value unmarshaler
@@ -119,6 +210,48 @@ type Foo struct {
 	_rawJSON json.RawMessage
 }
 
+func (f *Foo) GetId() Id {
+	if f == nil || f.Id == nil {
+		return ""
+	}
+	return *f.Id
+}
+
+func (f *Foo) GetName() string {
+	if f == nil || f.Name == nil {
+		return ""
+	}
+	return *f.Name
+}
+
+func (f *Foo) GetList() string {
+	if f == nil || f.List == nil {
+		return ""
+	}
+	return *f.List
+}
+
+func (f *Foo) GetType() FooType {
+	if f == nil || f.Type == nil {
+		return ""
+	}
+	return *f.Type
+}
+
+func (f *Foo) GetRequest() *Request {
+	if f == nil {
+		return nil
+	}
+	return f.Request
+}
+
+func (f *Foo) GetDelay() string {
+	if f == nil || f.Delay == nil {
+		return ""
+	}
+	return *f.Delay
+}
+
 func (f *Foo) UnmarshalJSON(data []byte) error {
 	type unmarshaler Foo
 	var value unmarshaler
@@ -180,6 +313,41 @@ type Request struct {
 	_rawJSON json.RawMessage
 }
 
+func (r *Request) GetUrl() string {
+	if r == nil {
+		return ""
+	}
+	return r.Url
+}
+
+func (r *Request) GetHeaders() map[string]interface{} {
+	if r == nil {
+		return nil
+	}
+	return r.Headers
+}
+
+func (r *Request) GetBody() string {
+	if r == nil || r.Body == nil {
+		return ""
+	}
+	return *r.Body
+}
+
+func (r *Request) GetPlatform() string {
+	if r == nil || r.Platform == nil {
+		return ""
+	}
+	return *r.Platform
+}
+
+func (r *Request) GetUnknown() interface{} {
+	if r == nil {
+		return nil
+	}
+	return r.Unknown
+}
+
 func (r *Request) UnmarshalJSON(data []byte) error {
 	type unmarshaler Request
 	var value unmarshaler
@@ -0,0 +1,162 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package api
+
+import (
+	json "encoding/json"
+	fmt "fmt"
+	core "github.com/fern-api/fern-go/internal/testdata/sdk/set/fixtures/core"
+)
+
+type GetUsersRequest struct {
+	Tags *core.Set[string] `json:"-"`
+	Ids  *core.Set[int]    `json:"-"`
+}
+
+func (g *GetUsersRequest) GetTags() *core.Set[string] {
+	if g == nil {
+		return nil
+	}
+	return g.Tags
+}
+
+func (g *GetUsersRequest) GetIds() *core.Set[int] {
+	if g == nil {
+		return nil
+	}
+	return g.Ids
+}
+
+type Friend struct {
+	Name string `json:"name"`
+
+	_rawJSON json.RawMessage
+}
+
+func (f *Friend) GetName() string {
+	if f == nil {
+		return ""
+	}
+	return f.Name
+}
+
+func (f *Friend) UnmarshalJSON(data []byte) error {
+	type unmarshaler Friend
+	var value unmarshaler
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*f = Friend(value)
+	f._rawJSON = json.RawMessage(data)
+	return nil
+}
+
+func (f *Friend) String() string {
+	if len(f._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(f._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(f); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", f)
+}
+
+type Role string
+
+const (
+	RoleAdmin  Role = "ADMIN"
+	RoleMember Role = "MEMBER"
+)
+
+func NewRoleFromString(s string) (Role, error) {
+	switch s {
+	case "ADMIN":
+		return RoleAdmin, nil
+	case "MEMBER":
+		return RoleMember, nil
+	}
+	var t Role
+	return "", fmt.Errorf("%s is not a valid %T", s, t)
+}
+
+func (r Role) Ptr() *Role {
+	return &r
+}
+
+type User struct {
+	Name      string            `json:"name"`
+	Tags      *core.Set[string] `json:"tags,omitempty"`
+	Roles     *core.Set[Role]   `json:"roles,omitempty"`
+	Nicknames *core.Set[string] `json:"nicknames,omitempty"`
+	Scores    []*core.Set[int]  `json:"scores,omitempty"`
+	Friends   []*Friend         `json:"friends,omitempty"`
+
+	_rawJSON json.RawMessage
+}
+
+func (u *User) GetName() string {
+	if u == nil {
+		return ""
+	}
+	return u.Name
+}
+
+func (u *User) GetTags() *core.Set[string] {
+	if u == nil {
+		return nil
+	}
+	return u.Tags
+}
+
+func (u *User) GetRoles() *core.Set[Role] {
+	if u == nil {
+		return nil
+	}
+	return u.Roles
+}
+
+func (u *User) GetNicknames() *core.Set[string] {
+	if u == nil {
+		return nil
+	}
+	return u.Nicknames
+}
+
+func (u *User) GetScores() []*core.Set[int] {
+	if u == nil {
+		return nil
+	}
+	return u.Scores
+}
+
+func (u *User) GetFriends() []*Friend {
+	if u == nil {
+		return nil
+	}
+	return u.Friends
+}
+
+func (u *User) UnmarshalJSON(data []byte) error {
+	type unmarshaler User
+	var value unmarshaler
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*u = User(value)
+	u._rawJSON = json.RawMessage(data)
+	return nil
+}
+
+func (u *User) String() string {
+	if len(u._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(u._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(u); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", u)
+}
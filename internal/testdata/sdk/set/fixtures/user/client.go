@@ -0,0 +1,61 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package user
+
+import (
+	context "context"
+	fmt "fmt"
+	fixtures "github.com/fern-api/fern-go/internal/testdata/sdk/set/fixtures"
+	core "github.com/fern-api/fern-go/internal/testdata/sdk/set/fixtures/core"
+	http "net/http"
+	url "net/url"
+)
+
+type Client struct {
+	baseURL string
+	caller  *core.Caller
+	header  http.Header
+}
+
+func NewClient(opts ...core.ClientOption) *Client {
+	options := core.NewClientOptions()
+	for _, opt := range opts {
+		opt(options)
+	}
+	return &Client{
+		baseURL: options.BaseURL,
+		caller:  core.NewCaller(options.HTTPClient),
+		header:  options.ToHeader(),
+	}
+}
+
+func (c *Client) GetUsername(ctx context.Context, request *fixtures.GetUsersRequest) (*fixtures.User, error) {
+	baseURL := ""
+	if c.baseURL != "" {
+		baseURL = c.baseURL
+	}
+	endpointURL := baseURL + "/" + "user"
+
+	queryParams := make(url.Values)
+	queryParams.Add("tags", fmt.Sprintf("%v", request.Tags))
+	if request.Ids != nil {
+		queryParams.Add("ids", fmt.Sprintf("%v", request.Ids))
+	}
+	if len(queryParams) > 0 {
+		endpointURL += "?" + queryParams.Encode()
+	}
+
+	var response *fixtures.User
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:      endpointURL,
+			Method:   http.MethodGet,
+			Headers:  c.header,
+			Response: &response,
+		},
+	); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
@@ -0,0 +1,33 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package api
+
+import (
+	json "encoding/json"
+	core "github.com/fern-api/fern-go/internal/testdata/sdk/union-interface/fixtures/core"
+)
+
+type ConflictError struct {
+	*core.APIError
+	Body Union
+}
+
+func (c *ConflictError) UnmarshalJSON(data []byte) error {
+	var body Union
+	value0, err := UnmarshalUnion(data)
+	if err != nil {
+		return err
+	}
+	body = value0
+	c.StatusCode = 409
+	c.Body = body
+	return nil
+}
+
+func (c *ConflictError) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.Body)
+}
+
+func (c *ConflictError) Unwrap() error {
+	return c.APIError
+}
@@ -0,0 +1,250 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package api
+
+import (
+	json "encoding/json"
+	fmt "fmt"
+	core "github.com/fern-api/fern-go/internal/testdata/sdk/union-interface/fixtures/core"
+)
+
+type Bar struct {
+	Id string `json:"id"`
+
+	_rawJSON json.RawMessage
+}
+
+func (b *Bar) GetId() string {
+	if b == nil {
+		return ""
+	}
+	return b.Id
+}
+
+func (b *Bar) UnmarshalJSON(data []byte) error {
+	type unmarshaler Bar
+	var value unmarshaler
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*b = Bar(value)
+	b._rawJSON = json.RawMessage(data)
+	return nil
+}
+
+func (b *Bar) String() string {
+	if len(b._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(b._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(b); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", b)
+}
+
+type Filter struct {
+	Tag string `json:"tag"`
+
+	_rawJSON json.RawMessage
+}
+
+func (f *Filter) GetTag() string {
+	if f == nil {
+		return ""
+	}
+	return f.Tag
+}
+
+func (f *Filter) UnmarshalJSON(data []byte) error {
+	type unmarshaler Filter
+	var value unmarshaler
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*f = Filter(value)
+	f._rawJSON = json.RawMessage(data)
+	return nil
+}
+
+func (f *Filter) String() string {
+	if len(f._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(f._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(f); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", f)
+}
+
+type Foo struct {
+	Id string `json:"id"`
+
+	_rawJSON json.RawMessage
+}
+
+func (f *Foo) GetId() string {
+	if f == nil {
+		return ""
+	}
+	return f.Id
+}
+
+func (f *Foo) UnmarshalJSON(data []byte) error {
+	type unmarshaler Foo
+	var value unmarshaler
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*f = Foo(value)
+	f._rawJSON = json.RawMessage(data)
+	return nil
+}
+
+func (f *Foo) String() string {
+	if len(f._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(f._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(f); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", f)
+}
+
+type SetNameRequestV3Body struct {
+	UserName string `json:"userName"`
+
+	_rawJSON json.RawMessage
+}
+
+func (s *SetNameRequestV3Body) GetUserName() string {
+	if s == nil {
+		return ""
+	}
+	return s.UserName
+}
+
+func (s *SetNameRequestV3Body) UnmarshalJSON(data []byte) error {
+	type unmarshaler SetNameRequestV3Body
+	var value unmarshaler
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*s = SetNameRequestV3Body(value)
+	s._rawJSON = json.RawMessage(data)
+	return nil
+}
+
+func (s *SetNameRequestV3Body) String() string {
+	if len(s._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(s._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(s); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", s)
+}
+
+type Union interface {
+	isUnion()
+}
+
+type UnionFoo struct {
+	Value *Foo
+}
+
+func (*UnionFoo) isUnion() {}
+
+func (u *UnionFoo) GetValue() *Foo {
+	if u == nil {
+		return nil
+	}
+	return u.Value
+}
+
+func (u *UnionFoo) UnmarshalJSON(data []byte) error {
+	value := new(Foo)
+	if err := json.Unmarshal(data, value); err != nil {
+		return err
+	}
+	u.Value = value
+	return nil
+}
+
+func (u *UnionFoo) MarshalJSON() ([]byte, error) {
+	var marshaler = struct {
+		Type string `json:"type"`
+		*Foo
+	}{
+		Type: "foo",
+		Foo:  u.Value,
+	}
+	return json.Marshal(marshaler)
+}
+
+type UnionBar struct {
+	Value *Bar
+}
+
+func (*UnionBar) isUnion() {}
+
+func (u *UnionBar) GetValue() *Bar {
+	if u == nil {
+		return nil
+	}
+	return u.Value
+}
+
+func (u *UnionBar) UnmarshalJSON(data []byte) error {
+	value := new(Bar)
+	if err := json.Unmarshal(data, value); err != nil {
+		return err
+	}
+	u.Value = value
+	return nil
+}
+
+func (u *UnionBar) MarshalJSON() ([]byte, error) {
+	var marshaler = struct {
+		Type string `json:"type"`
+		*Bar
+	}{
+		Type: "bar",
+		Bar:  u.Value,
+	}
+	return json.Marshal(marshaler)
+}
+
+// UnmarshalUnion deserializes the given JSON into the Union variant
+// identified by its "type" discriminant.
+func UnmarshalUnion(data []byte) (Union, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return nil, nil
+	}
+	var unmarshaler struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &unmarshaler); err != nil {
+		return nil, err
+	}
+	var value Union
+	switch unmarshaler.Type {
+	case "foo":
+		value = new(UnionFoo)
+	case "bar":
+		value = new(UnionBar)
+	default:
+		return nil, fmt.Errorf("invalid type %s in Union", unmarshaler.Type)
+	}
+	if err := json.Unmarshal(data, value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
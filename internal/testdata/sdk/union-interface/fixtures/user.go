@@ -0,0 +1,231 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package api
+
+import (
+	json "encoding/json"
+	fmt "fmt"
+)
+
+type SetNameRequest struct {
+	UserName string `json:"userName"`
+}
+
+func (s *SetNameRequest) GetUserName() string {
+	if s == nil {
+		return ""
+	}
+	return s.UserName
+}
+
+type SetNameRequestV3 struct {
+	XEndpointHeader string                `json:"-"`
+	Body            *SetNameRequestV3Body `json:"-"`
+}
+
+func (s *SetNameRequestV3) GetXEndpointHeader() string {
+	if s == nil {
+		return ""
+	}
+	return s.XEndpointHeader
+}
+
+func (s *SetNameRequestV3) GetBody() *SetNameRequestV3Body {
+	if s == nil {
+		return nil
+	}
+	return s.Body
+}
+
+func (s *SetNameRequestV3) UnmarshalJSON(data []byte) error {
+	body := new(SetNameRequestV3Body)
+	if err := json.Unmarshal(data, &body); err != nil {
+		return err
+	}
+	s.Body = body
+	return nil
+}
+
+func (s *SetNameRequestV3) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.Body)
+}
+
+type SetNameRequestV3Optional struct {
+	XEndpointHeader string                `json:"-"`
+	Body            *SetNameRequestV3Body `json:"-"`
+}
+
+func (s *SetNameRequestV3Optional) GetXEndpointHeader() string {
+	if s == nil {
+		return ""
+	}
+	return s.XEndpointHeader
+}
+
+func (s *SetNameRequestV3Optional) GetBody() *SetNameRequestV3Body {
+	if s == nil {
+		return nil
+	}
+	return s.Body
+}
+
+func (s *SetNameRequestV3Optional) UnmarshalJSON(data []byte) error {
+	body := new(SetNameRequestV3Body)
+	if err := json.Unmarshal(data, &body); err != nil {
+		return err
+	}
+	s.Body = body
+	return nil
+}
+
+func (s *SetNameRequestV3Optional) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.Body)
+}
+
+type SetNameRequestV4 struct {
+	XEndpointHeader string `json:"-"`
+	Body            Union  `json:"-"`
+}
+
+func (s *SetNameRequestV4) GetXEndpointHeader() string {
+	if s == nil {
+		return ""
+	}
+	return s.XEndpointHeader
+}
+
+func (s *SetNameRequestV4) GetBody() Union {
+	if s == nil {
+		return nil
+	}
+	return s.Body
+}
+
+func (s *SetNameRequestV4) UnmarshalJSON(data []byte) error {
+	var body Union
+	value0, err := UnmarshalUnion(data)
+	if err != nil {
+		return err
+	}
+	body = value0
+	s.Body = body
+	return nil
+}
+
+func (s *SetNameRequestV4) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.Body)
+}
+
+type SetNameRequestV5 struct {
+	XEndpointHeader string `json:"-"`
+	Body            string `json:"-"`
+}
+
+func (s *SetNameRequestV5) GetXEndpointHeader() string {
+	if s == nil {
+		return ""
+	}
+	return s.XEndpointHeader
+}
+
+func (s *SetNameRequestV5) GetBody() string {
+	if s == nil {
+		return ""
+	}
+	return s.Body
+}
+
+func (s *SetNameRequestV5) UnmarshalJSON(data []byte) error {
+	var body string
+	if err := json.Unmarshal(data, &body); err != nil {
+		return err
+	}
+	if body != "fern" {
+		return fmt.Errorf("expected literal %q, but found %q", "fern", body)
+	}
+	s.Body = body
+	return nil
+}
+
+func (s *SetNameRequestV5) MarshalJSON() ([]byte, error) {
+	return json.Marshal("fern")
+}
+
+type UpdateRequest struct {
+	Tag            string  `json:"-"`
+	Extra          *string `json:"-"`
+	Union          Union   `json:"union,omitempty"`
+	Filter         *Filter `json:"filter,omitempty"`
+	OptionalUnion  Union   `json:"optionalUnion,omitempty"`
+	OptionalFilter *Filter `json:"optionalFilter,omitempty"`
+}
+
+func (u *UpdateRequest) GetTag() string {
+	if u == nil {
+		return ""
+	}
+	return u.Tag
+}
+
+func (u *UpdateRequest) GetExtra() string {
+	if u == nil || u.Extra == nil {
+		return ""
+	}
+	return *u.Extra
+}
+
+func (u *UpdateRequest) GetUnion() Union {
+	if u == nil {
+		return nil
+	}
+	return u.Union
+}
+
+func (u *UpdateRequest) GetFilter() *Filter {
+	if u == nil {
+		return nil
+	}
+	return u.Filter
+}
+
+func (u *UpdateRequest) GetOptionalUnion() Union {
+	if u == nil {
+		return nil
+	}
+	return u.OptionalUnion
+}
+
+func (u *UpdateRequest) GetOptionalFilter() *Filter {
+	if u == nil {
+		return nil
+	}
+	return u.OptionalFilter
+}
+
+func (u *UpdateRequest) UnmarshalJSON(data []byte) error {
+	type unmarshaler UpdateRequest
+	var body = struct {
+		unmarshaler
+		Union         json.RawMessage `json:"union"`
+		OptionalUnion json.RawMessage `json:"optionalUnion"`
+	}{}
+	if err := json.Unmarshal(data, &body); err != nil {
+		return err
+	}
+	*u = UpdateRequest(body.unmarshaler)
+	if body.Union != nil {
+		value0, err := UnmarshalUnion(body.Union)
+		if err != nil {
+			return err
+		}
+		u.Union = value0
+	}
+	if body.OptionalUnion != nil {
+		value0, err := UnmarshalUnion(body.OptionalUnion)
+		if err != nil {
+			return err
+		}
+		u.OptionalUnion = value0
+	}
+	return nil
+}
@@ -0,0 +1,271 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package user
+
+import (
+	bytes "bytes"
+	context "context"
+	json "encoding/json"
+	errors "errors"
+	fmt "fmt"
+	fixtures "github.com/fern-api/fern-go/internal/testdata/sdk/union-interface/fixtures"
+	core "github.com/fern-api/fern-go/internal/testdata/sdk/union-interface/fixtures/core"
+	io "io"
+	http "net/http"
+	url "net/url"
+)
+
+type Client struct {
+	baseURL string
+	caller  *core.Caller
+	header  http.Header
+}
+
+func NewClient(opts ...core.ClientOption) *Client {
+	options := core.NewClientOptions()
+	for _, opt := range opts {
+		opt(options)
+	}
+	return &Client{
+		baseURL: options.BaseURL,
+		caller:  core.NewCaller(options.HTTPClient),
+		header:  options.ToHeader(),
+	}
+}
+
+func (c *Client) SetName(ctx context.Context, userId string, request string) (fixtures.Union, error) {
+	baseURL := ""
+	if c.baseURL != "" {
+		baseURL = c.baseURL
+	}
+	endpointURL := fmt.Sprintf(baseURL+"/"+"users/%v/set-name", userId)
+
+	var response fixtures.Union
+	var responseJSON json.RawMessage
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:      endpointURL,
+			Method:   http.MethodPost,
+			Headers:  c.header,
+			Request:  request,
+			Response: &responseJSON,
+		},
+	); err != nil {
+		return nil, err
+	}
+	if len(responseJSON) > 0 {
+		value0, err := fixtures.UnmarshalUnion(responseJSON)
+		if err != nil {
+			return nil, err
+		}
+		response = value0
+	}
+	return response, nil
+}
+
+func (c *Client) SetNameV2(ctx context.Context, userId string, request *fixtures.SetNameRequest) (fixtures.Union, error) {
+	baseURL := ""
+	if c.baseURL != "" {
+		baseURL = c.baseURL
+	}
+	endpointURL := fmt.Sprintf(baseURL+"/"+"users/%v/set-name-v2", userId)
+
+	var response fixtures.Union
+	var responseJSON json.RawMessage
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:                endpointURL,
+			Method:             http.MethodPost,
+			Headers:            c.header,
+			Request:            request,
+			Response:           &responseJSON,
+			ResponseIsOptional: true,
+		},
+	); err != nil {
+		return nil, err
+	}
+	if len(responseJSON) > 0 {
+		value0, err := fixtures.UnmarshalUnion(responseJSON)
+		if err != nil {
+			return nil, err
+		}
+		response = value0
+	}
+	return response, nil
+}
+
+func (c *Client) SetNameV3(ctx context.Context, userId string, request *fixtures.SetNameRequestV3) (*fixtures.SetNameRequestV3Body, error) {
+	baseURL := ""
+	if c.baseURL != "" {
+		baseURL = c.baseURL
+	}
+	endpointURL := fmt.Sprintf(baseURL+"/"+"users/%v/set-name-v3", userId)
+
+	headers := c.header.Clone()
+	headers.Add("X-Endpoint-Header", fmt.Sprintf("%v", request.XEndpointHeader))
+
+	var response *fixtures.SetNameRequestV3Body
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:      endpointURL,
+			Method:   http.MethodPost,
+			Headers:  headers,
+			Request:  request,
+			Response: &response,
+		},
+	); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *Client) SetNameV3Optional(ctx context.Context, userId string, request *fixtures.SetNameRequestV3Optional) (*fixtures.SetNameRequestV3Body, error) {
+	baseURL := ""
+	if c.baseURL != "" {
+		baseURL = c.baseURL
+	}
+	endpointURL := fmt.Sprintf(baseURL+"/"+"users/%v/set-name-v3-optional", userId)
+
+	headers := c.header.Clone()
+	headers.Add("X-Endpoint-Header", fmt.Sprintf("%v", request.XEndpointHeader))
+
+	var response *fixtures.SetNameRequestV3Body
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:                endpointURL,
+			Method:             http.MethodPost,
+			Headers:            headers,
+			Request:            request,
+			Response:           &response,
+			ResponseIsOptional: true,
+		},
+	); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *Client) SetNameV4(ctx context.Context, userId string, request *fixtures.SetNameRequestV4) ([]fixtures.Union, error) {
+	baseURL := ""
+	if c.baseURL != "" {
+		baseURL = c.baseURL
+	}
+	endpointURL := fmt.Sprintf(baseURL+"/"+"users/%v/set-name-v4", userId)
+
+	headers := c.header.Clone()
+	headers.Add("X-Endpoint-Header", fmt.Sprintf("%v", request.XEndpointHeader))
+
+	errorDecoder := func(statusCode int, body io.Reader) error {
+		raw, err := io.ReadAll(body)
+		if err != nil {
+			return err
+		}
+		apiError := core.NewAPIError(statusCode, errors.New(string(raw)))
+		decoder := json.NewDecoder(bytes.NewReader(raw))
+		switch statusCode {
+		case 409:
+			value := new(fixtures.ConflictError)
+			value.APIError = apiError
+			if err := decoder.Decode(value); err != nil {
+				return apiError
+			}
+			return value
+		}
+		return apiError
+	}
+
+	var response []fixtures.Union
+	var responseJSON json.RawMessage
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:          endpointURL,
+			Method:       http.MethodPost,
+			Headers:      headers,
+			Request:      request,
+			Response:     &responseJSON,
+			ErrorDecoder: errorDecoder,
+		},
+	); err != nil {
+		return nil, err
+	}
+	if len(responseJSON) > 0 {
+		var raws0 []json.RawMessage
+		if err := json.Unmarshal(responseJSON, &raws0); err != nil {
+			return nil, err
+		}
+		if raws0 != nil {
+			response = make([]fixtures.Union, len(raws0))
+			for i0, raw0 := range raws0 {
+				value1, err := fixtures.UnmarshalUnion(raw0)
+				if err != nil {
+					return nil, err
+				}
+				response[i0] = value1
+			}
+		}
+	}
+	return response, nil
+}
+
+func (c *Client) SetNameV5(ctx context.Context, userId string, request *fixtures.SetNameRequestV5) (string, error) {
+	baseURL := ""
+	if c.baseURL != "" {
+		baseURL = c.baseURL
+	}
+	endpointURL := fmt.Sprintf(baseURL+"/"+"users/%v/set-name-v5", userId)
+
+	headers := c.header.Clone()
+	headers.Add("X-Endpoint-Header", fmt.Sprintf("%v", request.XEndpointHeader))
+
+	var response string
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:      endpointURL,
+			Method:   http.MethodPost,
+			Headers:  headers,
+			Request:  request,
+			Response: &response,
+		},
+	); err != nil {
+		return "", err
+	}
+	return response, nil
+}
+
+func (c *Client) Update(ctx context.Context, userId string, request *fixtures.UpdateRequest) (string, error) {
+	baseURL := ""
+	if c.baseURL != "" {
+		baseURL = c.baseURL
+	}
+	endpointURL := fmt.Sprintf(baseURL+"/"+"users/%v/update", userId)
+
+	queryParams := make(url.Values)
+	queryParams.Add("tag", fmt.Sprintf("%v", request.Tag))
+	if request.Extra != nil {
+		queryParams.Add("extra", fmt.Sprintf("%v", *request.Extra))
+	}
+	if len(queryParams) > 0 {
+		endpointURL += "?" + queryParams.Encode()
+	}
+
+	var response string
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:      endpointURL,
+			Method:   http.MethodPost,
+			Headers:  c.header,
+			Request:  request,
+			Response: &response,
+		},
+	); err != nil {
+		return "", err
+	}
+	return response, nil
+}
@@ -15,6 +15,13 @@ func (u *UploadRequest) Fern() string {
 	return u.fern
 }
 
+func (u *UploadRequest) GetStatus() string {
+	if u == nil {
+		return ""
+	}
+	return u.Status
+}
+
 func (u *UploadRequest) UnmarshalJSON(data []byte) error {
 	type unmarshaler UploadRequest
 	var body unmarshaler
@@ -41,3 +48,10 @@ func (u *UploadRequest) MarshalJSON() ([]byte, error) {
 type UploadMultiRequest struct {
 	Status string `json:"status"`
 }
+
+func (u *UploadMultiRequest) GetStatus() string {
+	if u == nil {
+		return ""
+	}
+	return u.Status
+}
@@ -0,0 +1,140 @@
+package writer
+
+import (
+	"bytes"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/fern-api/fern-go/internal/fernignore"
+	"github.com/fern-api/fern-go/internal/generator"
+)
+
+// changeSet is the set of changes made to the root directory by writeFiles.
+type changeSet struct {
+	// manifest is the manifest written after the changes are made.
+	manifest *manifest
+
+	// files are the added, modified, and deleted files, sorted by path.
+	files []*fileChange
+
+	// ignored are the paths of the generated files that match
+	// a pattern in the .fernignore file.
+	ignored []string
+
+	// kept are the paths of the stale files that aren't removed because
+	// they were modified since they were generated.
+	kept []string
+
+	// unchanged is the number of generated files that are
+	// already up to date.
+	unchanged int
+}
+
+// fileChange is a single file that's added, modified, or deleted.
+type fileChange struct {
+	path string
+
+	// before is the content of the file on disk, which is nil
+	// if the file is added.
+	before []byte
+
+	// after is the generated content of the file, which is nil
+	// if the file is deleted.
+	after []byte
+}
+
+// newChangeSet returns the changes needed to write the given files to the root
+// directory, which include removing the stale files listed in its manifest.
+func newChangeSet(root string, files []*generator.File) (*changeSet, error) {
+	ignore, err := fernignore.Read(root)
+	if err != nil {
+		return nil, err
+	}
+	previous, err := readManifest(root)
+	if err != nil {
+		return nil, err
+	}
+	changes := &changeSet{
+		manifest: newManifest(),
+	}
+	for _, file := range files {
+		path := filepath.ToSlash(file.Path)
+		if ignore.Match(path) {
+			changes.ignored = append(changes.ignored, path)
+			continue
+		}
+		changes.manifest.Files[path] = hashContent(file.Content)
+
+		before, err := readFile(root, path)
+		if err != nil {
+			return nil, err
+		}
+		if before != nil && bytes.Equal(before, file.Content) {
+			changes.unchanged++
+			continue
+		}
+		changes.files = append(changes.files, &fileChange{path: path, before: before, after: file.Content})
+	}
+
+	// Remove the files that were generated by the previous run, but weren't
+	// generated this time (e.g. the type was removed from the API definition).
+	for path := range previous.Files {
+		if _, ok := changes.manifest.Files[path]; ok || !isLocalPath(path) || ignore.Match(path) {
+			continue
+		}
+		before, err := readFile(root, path)
+		if err != nil {
+			return nil, err
+		}
+		if before == nil {
+			// The file has already been removed.
+			continue
+		}
+		if hashContent(before) != previous.Files[path] {
+			// The file was edited by hand, so it's no longer ours to remove.
+			changes.kept = append(changes.kept, path)
+			continue
+		}
+		changes.files = append(changes.files, &fileChange{path: path, before: before})
+	}
+	sort.Strings(changes.kept)
+	sort.Slice(changes.files, func(i, j int) bool {
+		return changes.files[i].path < changes.files[j].path
+	})
+	return changes, nil
+}
+
+// count returns the number of added, modified, and deleted files.
+func (c *changeSet) count() (added int, modified int, deleted int) {
+	for _, file := range c.files {
+		switch {
+		case file.before == nil:
+			added++
+		case file.after == nil:
+			deleted++
+		default:
+			modified++
+		}
+	}
+	return added, modified, deleted
+}
+
+// readFile returns the content of the given file in the root directory,
+// or nil if it doesn't exist.
+func readFile(root string, path string) ([]byte, error) {
+	content, err := os.ReadFile(filepath.Join(root, filepath.FromSlash(path)))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if content == nil {
+		// Distinguish an empty file from one that doesn't exist.
+		content = []byte{}
+	}
+	return content, nil
+}
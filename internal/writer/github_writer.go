@@ -2,8 +2,6 @@ package writer
 
 import (
 	_ "embed"
-	"os"
-	"path/filepath"
 
 	"github.com/fern-api/fern-go/internal/coordinator"
 	"github.com/fern-api/fern-go/internal/generator"
@@ -54,19 +52,15 @@ func (g *githubWriter) WriteFiles(files []*generator.File) error {
 	// If we're writing to a GitHub repository, we want to include a GitHub Actions
 	// workflow that verifies the generated code compiles.
 	files = append(files, newGitHubActionsFile(g.coordinator))
-	if err := os.MkdirAll(g.config.Path, 0755); err != nil {
-		return err
-	}
-	for _, file := range files {
-		filename := filepath.Join(g.config.Path, file.Path)
-		if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
-			return err
-		}
-		if err := os.WriteFile(filename, file.Content, 0644); err != nil {
-			return err
-		}
+	return writeFiles(g.coordinator, g.config.Path, files)
+}
+
+func (g *githubWriter) Diff(files []*generator.File) (string, error) {
+	if len(files) == 0 {
+		return "", nil
 	}
-	return nil
+	files = append(files, newGitHubActionsFile(g.coordinator))
+	return diffFiles(g.config.Path, files)
 }
 
 // newCIWorkflowFile returns a new Github Actions
@@ -1,9 +1,7 @@
 package writer
 
 import (
-	"os"
-	"path/filepath"
-
+	"github.com/fern-api/fern-go/internal/coordinator"
 	"github.com/fern-api/fern-go/internal/generator"
 )
 
@@ -22,12 +20,14 @@ func NewLocalConfig(path string) (*LocalConfig, error) {
 }
 
 type localWriter struct {
-	config *LocalConfig
+	coordinator *coordinator.Client
+	config      *LocalConfig
 }
 
-func newLocalWriter(config *LocalConfig) (*localWriter, error) {
+func newLocalWriter(coordinator *coordinator.Client, config *LocalConfig) (*localWriter, error) {
 	return &localWriter{
-		config: config,
+		coordinator: coordinator,
+		config:      config,
 	}, nil
 }
 
@@ -39,17 +39,12 @@ func (l *localWriter) WriteFiles(files []*generator.File) error {
 	if len(files) == 0 {
 		return nil
 	}
-	if err := os.MkdirAll(l.config.Path, 0755); err != nil {
-		return err
-	}
-	for _, file := range files {
-		filename := filepath.Join(l.config.Path, file.Path)
-		if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
-			return err
-		}
-		if err := os.WriteFile(filename, file.Content, 0644); err != nil {
-			return err
-		}
+	return writeFiles(l.coordinator, l.config.Path, files)
+}
+
+func (l *localWriter) Diff(files []*generator.File) (string, error) {
+	if len(files) == 0 {
+		return "", nil
 	}
-	return nil
+	return diffFiles(l.config.Path, files)
 }
@@ -0,0 +1,102 @@
+package writer
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// manifestFilename is the name of the manifest file, which records every
+// file written by the previous run so that the stale files can be removed.
+var manifestFilename = filepath.Join(".fern", "manifest.json")
+
+// manifest is the content of the manifest file.
+type manifest struct {
+	// Files maps the path of every generated file to the SHA-256
+	// hash of its content.
+	Files map[string]string `json:"files"`
+}
+
+// readManifest reads the manifest file in the given root directory. If the
+// directory doesn't have a manifest (e.g. nothing has been generated yet),
+// an empty manifest is returned.
+func readManifest(root string) (*manifest, error) {
+	content, err := os.ReadFile(filepath.Join(root, manifestFilename))
+	if errors.Is(err, fs.ErrNotExist) {
+		return newManifest(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	manifest := newManifest()
+	if err := json.Unmarshal(content, manifest); err != nil {
+		return nil, fmt.Errorf("failed to read %s: %v", manifestFilename, err)
+	}
+	if manifest.Files == nil {
+		manifest.Files = make(map[string]string)
+	}
+	return manifest, nil
+}
+
+// newManifest returns a new, empty manifest.
+func newManifest() *manifest {
+	return &manifest{
+		Files: make(map[string]string),
+	}
+}
+
+// write writes the manifest file to the given root directory.
+func (m *manifest) write(root string) error {
+	content, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	filename := filepath.Join(root, manifestFilename)
+	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(filename, append(content, '\n'), 0644)
+}
+
+// hashContent returns the hex-encoded SHA-256 hash of the given content.
+func hashContent(content []byte) string {
+	hash := sha256.Sum256(content)
+	return hex.EncodeToString(hash[:])
+}
+
+// isLocalPath returns true if the given path is relative and doesn't escape
+// the directory it's relative to, so that a modified manifest can't be used
+// to remove files outside of the root directory.
+func isLocalPath(path string) bool {
+	if path == "" || filepath.IsAbs(path) {
+		return false
+	}
+	path = filepath.Clean(path)
+	return path != ".." && !strings.HasPrefix(path, ".."+string(filepath.Separator))
+}
+
+// removeFile removes the given file from the root directory, along with
+// any of its parent directories that are left empty.
+func removeFile(root string, path string) error {
+	filename := filepath.Join(root, path)
+	if err := os.Remove(filename); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	root = filepath.Clean(root)
+	for dir := filepath.Dir(filename); dir != root && dir != "."; dir = filepath.Dir(dir) {
+		entries, err := os.ReadDir(dir)
+		if err != nil || len(entries) > 0 {
+			return nil
+		}
+		if err := os.Remove(dir); err != nil {
+			return nil
+		}
+	}
+	return nil
+}
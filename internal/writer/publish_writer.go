@@ -0,0 +1,320 @@
+package writer
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/fern-api/fern-go/internal/coordinator"
+	"github.com/fern-api/fern-go/internal/diff"
+	"github.com/fern-api/fern-go/internal/generator"
+	generatorexec "github.com/fern-api/generator-exec-go"
+	"golang.org/x/mod/modfile"
+	"golang.org/x/mod/module"
+	"golang.org/x/mod/semver"
+	modzip "golang.org/x/mod/zip"
+)
+
+// PublishConfig is the publish output mode configuration, which
+// writes the module to a directory laid out like a GOPROXY, e.g.
+//
+//	github.com/acme/sdk/@v/list
+//	github.com/acme/sdk/@v/v1.2.3.info
+//	github.com/acme/sdk/@v/v1.2.3.mod
+//	github.com/acme/sdk/@v/v1.2.3.zip
+//
+// Published versions are immutable, so an existing version is only
+// replaced if Overwrite is set.
+type PublishConfig struct {
+	Path      string
+	Version   string
+	Overwrite bool
+}
+
+func (p *PublishConfig) isOutputMode() {}
+
+// NewPublishConfig returns a new publish writer configuration. The version
+// must be a valid semantic version, and the 'v' prefix is optional.
+func NewPublishConfig(path string, version string, overwrite bool) (*PublishConfig, error) {
+	if version == "" {
+		return nil, errors.New("the publish output mode requires a version")
+	}
+	if !strings.HasPrefix(version, "v") {
+		version = "v" + version
+	}
+	if canonical := semver.Canonical(version); canonical != version {
+		return nil, fmt.Errorf("the publish output mode requires a canonical semantic version, but found %q", version)
+	}
+	return &PublishConfig{
+		Path:      path,
+		Version:   version,
+		Overwrite: overwrite,
+	}, nil
+}
+
+type publishWriter struct {
+	coordinator *coordinator.Client
+	config      *PublishConfig
+}
+
+func newPublishWriter(coordinator *coordinator.Client, config *PublishConfig) (*publishWriter, error) {
+	return &publishWriter{
+		coordinator: coordinator,
+		config:      config,
+	}, nil
+}
+
+func (p *publishWriter) Root() string {
+	return p.config.Path
+}
+
+func (p *publishWriter) WriteFiles(files []*generator.File) error {
+	if len(files) == 0 {
+		return nil
+	}
+	version, goMod, err := p.moduleVersion(files)
+	if err != nil {
+		return err
+	}
+	dir, err := p.versionDir(version)
+	if err != nil {
+		return err
+	}
+	escapedVersion, err := module.EscapeVersion(version.Version)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return err
+	}
+
+	zipFilename := filepath.Join(dir, escapedVersion+".zip")
+	if _, err := os.Stat(zipFilename); err == nil {
+		if !p.config.Overwrite {
+			return fmt.Errorf(
+				"%s@%s has already been published; published versions are immutable, so please publish a new version",
+				version.Path,
+				version.Version,
+			)
+		}
+		// It's OK if we fail to send the warning - it's purely informational.
+		_ = p.coordinator.Log(
+			generatorexec.LogLevelWarn,
+			fmt.Sprintf(
+				"Replacing %s@%s; published versions should be immutable, so any cached copies won't be updated.",
+				version.Path,
+				version.Version,
+			),
+		)
+	}
+	zipContent := bytes.NewBuffer(nil)
+	if err := modzip.Create(zipContent, version, newZipFiles(files)); err != nil {
+		return err
+	}
+	info, err := json.Marshal(
+		&moduleInfo{
+			Version: version.Version,
+			Time:    time.Now().UTC().Format(time.RFC3339),
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(zipFilename, zipContent.Bytes(), 0644); err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(dir, escapedVersion+".mod"), goMod, 0644); err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(dir, escapedVersion+".info"), info, 0644); err != nil {
+		return err
+	}
+	return writeVersionList(filepath.Join(dir, "list"), version.Version)
+}
+
+// Diff returns the unified diff between the given files and the files in the
+// module zip that was previously published for the same version, if any.
+func (p *publishWriter) Diff(files []*generator.File) (string, error) {
+	if len(files) == 0 {
+		return "", nil
+	}
+	version, _, err := p.moduleVersion(files)
+	if err != nil {
+		return "", err
+	}
+	dir, err := p.versionDir(version)
+	if err != nil {
+		return "", err
+	}
+	escapedVersion, err := module.EscapeVersion(version.Version)
+	if err != nil {
+		return "", err
+	}
+	published, err := readZipFiles(filepath.Join(dir, escapedVersion+".zip"), version)
+	if err != nil {
+		return "", err
+	}
+
+	generated := make(map[string][]byte, len(files))
+	for _, file := range files {
+		generated[filepath.ToSlash(file.Path)] = file.Content
+	}
+	paths := make([]string, 0, len(generated)+len(published))
+	for path := range generated {
+		paths = append(paths, path)
+	}
+	for path := range published {
+		if _, ok := generated[path]; !ok {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+
+	var b strings.Builder
+	for _, path := range paths {
+		b.WriteString(diff.Unified("a/"+path, "b/"+path, published[path], generated[path]))
+	}
+	return b.String(), nil
+}
+
+// moduleVersion returns the module version published for the given files,
+// along with the content of its go.mod.
+func (p *publishWriter) moduleVersion(files []*generator.File) (module.Version, []byte, error) {
+	for _, file := range files {
+		if file.Path != "go.mod" {
+			continue
+		}
+		modulePath := modfile.ModulePath(file.Content)
+		if modulePath == "" {
+			return module.Version{}, nil, errors.New("the generated go.mod doesn't specify a module path")
+		}
+		version := module.Version{
+			Path:    modulePath,
+			Version: p.config.Version,
+		}
+		if err := module.Check(version.Path, version.Version); err != nil {
+			return module.Version{}, nil, fmt.Errorf("the generated module can't be published: %v", err)
+		}
+		return version, file.Content, nil
+	}
+	return module.Version{}, nil, errors.New("the publish output mode requires the module configuration")
+}
+
+// versionDir returns the directory that contains the files of the
+// given module version (i.e. the @v directory).
+func (p *publishWriter) versionDir(version module.Version) (string, error) {
+	escapedPath, err := module.EscapePath(version.Path)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(p.config.Path, filepath.FromSlash(escapedPath), "@v"), nil
+}
+
+// moduleInfo is the content of the .info file served by a GOPROXY.
+type moduleInfo struct {
+	Version string
+	Time    string
+}
+
+// writeVersionList adds the given version to the list of versions
+// served by the GOPROXY, which is sorted in semantic version order.
+func writeVersionList(filename string, version string) error {
+	content, err := os.ReadFile(filename)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	versions := []string{version}
+	for _, line := range strings.Split(string(content), "\n") {
+		if line = strings.TrimSpace(line); line != "" && line != version {
+			versions = append(versions, line)
+		}
+	}
+	semver.Sort(versions)
+	return os.WriteFile(filename, []byte(strings.Join(versions, "\n")+"\n"), 0644)
+}
+
+// readZipFiles returns the content of every file in the given module zip,
+// keyed by its path relative to the module root. If the zip doesn't exist,
+// an empty map is returned.
+func readZipFiles(filename string, version module.Version) (map[string][]byte, error) {
+	files := make(map[string][]byte)
+	reader, err := zip.OpenReader(filename)
+	if errors.Is(err, fs.ErrNotExist) {
+		return files, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	prefix := version.Path + "@" + version.Version + "/"
+	for _, file := range reader.File {
+		if !strings.HasPrefix(file.Name, prefix) || strings.HasSuffix(file.Name, "/") {
+			continue
+		}
+		content, err := readZipFile(file)
+		if err != nil {
+			return nil, err
+		}
+		files[strings.TrimPrefix(file.Name, prefix)] = content
+	}
+	return files, nil
+}
+
+// readZipFile returns the content of the given file in a zip.
+func readZipFile(file *zip.File) ([]byte, error) {
+	reader, err := file.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+	return io.ReadAll(reader)
+}
+
+// zipFile adapts a *generator.File to the modzip.File interface.
+type zipFile struct {
+	file *generator.File
+}
+
+// newZipFiles returns the modzip.File for each of the given files.
+func newZipFiles(files []*generator.File) []modzip.File {
+	zipFiles := make([]modzip.File, 0, len(files))
+	for _, file := range files {
+		zipFiles = append(zipFiles, &zipFile{file: file})
+	}
+	return zipFiles
+}
+
+func (z *zipFile) Path() string {
+	return path.Clean(filepath.ToSlash(z.file.Path))
+}
+
+func (z *zipFile) Lstat() (os.FileInfo, error) {
+	return &zipFileInfo{file: z.file}, nil
+}
+
+func (z *zipFile) Open() (io.ReadCloser, error) {
+	return io.NopCloser(bytes.NewReader(z.file.Content)), nil
+}
+
+// zipFileInfo describes a *generator.File, which is always a regular file.
+type zipFileInfo struct {
+	file *generator.File
+}
+
+func (z *zipFileInfo) Name() string       { return path.Base(filepath.ToSlash(z.file.Path)) }
+func (z *zipFileInfo) Size() int64        { return int64(len(z.file.Content)) }
+func (z *zipFileInfo) Mode() fs.FileMode  { return 0644 }
+func (z *zipFileInfo) ModTime() time.Time { return time.Time{} }
+func (z *zipFileInfo) IsDir() bool        { return false }
+func (z *zipFileInfo) Sys() any           { return nil }
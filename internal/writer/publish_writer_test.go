@@ -0,0 +1,144 @@
+package writer
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/fern-api/fern-go/internal/coordinator"
+	"github.com/fern-api/fern-go/internal/generator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/mod/module"
+	modzip "golang.org/x/mod/zip"
+)
+
+func TestNewPublishConfig(t *testing.T) {
+	config, err := NewPublishConfig("output", "1.2.3", false)
+	require.NoError(t, err)
+	assert.Equal(t, "v1.2.3", config.Version)
+
+	config, err = NewPublishConfig("output", "v2.0.0-rc.1", false)
+	require.NoError(t, err)
+	assert.Equal(t, "v2.0.0-rc.1", config.Version)
+
+	_, err = NewPublishConfig("output", "", false)
+	assert.EqualError(t, err, "the publish output mode requires a version")
+
+	_, err = NewPublishConfig("output", "1.2", false)
+	assert.EqualError(t, err, `the publish output mode requires a canonical semantic version, but found "v1.2"`)
+}
+
+func TestPublishWriter(t *testing.T) {
+	var (
+		root        = t.TempDir()
+		coordinator = coordinator.NewClient("", "")
+		dir         = filepath.Join(root, "github.com", "!acme", "sdk", "@v")
+	)
+	files := []*generator.File{
+		generator.NewFile(coordinator, "go.mod", []byte("module github.com/Acme/sdk\n\ngo 1.19\n")),
+		generator.NewFile(coordinator, "types.go", []byte("package sdk\n")),
+		generator.NewFile(coordinator, "core/core.go", []byte("package core\n")),
+	}
+	for _, version := range []string{"1.10.0", "1.2.3"} {
+		config, err := NewPublishConfig(root, version, false)
+		require.NoError(t, err)
+		writer, err := New(coordinator, &Config{Mode: config})
+		require.NoError(t, err)
+		require.NoError(t, writer.WriteFiles(files))
+	}
+
+	list, err := os.ReadFile(filepath.Join(dir, "list"))
+	require.NoError(t, err)
+	assert.Equal(t, "v1.2.3\nv1.10.0\n", string(list))
+
+	mod, err := os.ReadFile(filepath.Join(dir, "v1.2.3.mod"))
+	require.NoError(t, err)
+	assert.Equal(t, "module github.com/Acme/sdk\n\ngo 1.19\n", string(mod))
+
+	content, err := os.ReadFile(filepath.Join(dir, "v1.2.3.info"))
+	require.NoError(t, err)
+	var info moduleInfo
+	require.NoError(t, json.Unmarshal(content, &info))
+	assert.Equal(t, "v1.2.3", info.Version)
+	assert.NotEmpty(t, info.Time)
+
+	version := module.Version{Path: "github.com/Acme/sdk", Version: "v1.2.3"}
+	checked, err := modzip.CheckZip(version, filepath.Join(dir, "v1.2.3.zip"))
+	require.NoError(t, err)
+	assert.ElementsMatch(
+		t,
+		[]string{
+			"github.com/Acme/sdk@v1.2.3/go.mod",
+			"github.com/Acme/sdk@v1.2.3/types.go",
+			"github.com/Acme/sdk@v1.2.3/core/core.go",
+		},
+		checked.Valid,
+	)
+
+	// The dry run compares the files with the published zip.
+	config, err := NewPublishConfig(root, "1.2.3", false)
+	require.NoError(t, err)
+	writer, err := New(coordinator, &Config{Mode: config})
+	require.NoError(t, err)
+	diff, err := writer.Diff(files)
+	require.NoError(t, err)
+	assert.Empty(t, diff)
+
+	diff, err = writer.Diff(files[:2])
+	require.NoError(t, err)
+	assert.Equal(
+		t,
+		`--- a/core/core.go
++++ /dev/null
+@@ -1 +0,0 @@
+-package core
+`,
+		diff,
+	)
+
+	// Published versions are immutable unless they're explicitly overwritten.
+	err = writer.WriteFiles(files[:2])
+	assert.EqualError(t, err, "github.com/Acme/sdk@v1.2.3 has already been published; published versions are immutable, so please publish a new version")
+	_, err = modzip.CheckZip(version, filepath.Join(dir, "v1.2.3.zip"))
+	require.NoError(t, err)
+
+	config, err = NewPublishConfig(root, "1.2.3", true)
+	require.NoError(t, err)
+	writer, err = New(coordinator, &Config{Mode: config})
+	require.NoError(t, err)
+	require.NoError(t, writer.WriteFiles(files[:2]))
+	checked, err = modzip.CheckZip(version, filepath.Join(dir, "v1.2.3.zip"))
+	require.NoError(t, err)
+	assert.ElementsMatch(
+		t,
+		[]string{
+			"github.com/Acme/sdk@v1.2.3/go.mod",
+			"github.com/Acme/sdk@v1.2.3/types.go",
+		},
+		checked.Valid,
+	)
+}
+
+func TestPublishWriterRequiresModule(t *testing.T) {
+	var (
+		root        = t.TempDir()
+		coordinator = coordinator.NewClient("", "")
+	)
+	config, err := NewPublishConfig(root, "1.2.3", false)
+	require.NoError(t, err)
+	writer, err := New(coordinator, &Config{Mode: config})
+	require.NoError(t, err)
+
+	err = writer.WriteFiles([]*generator.File{generator.NewFile(coordinator, "types.go", []byte("package sdk\n"))})
+	assert.EqualError(t, err, "the publish output mode requires the module configuration")
+
+	// The major version suffix must match the version.
+	config, err = NewPublishConfig(root, "2.0.0", false)
+	require.NoError(t, err)
+	writer, err = New(coordinator, &Config{Mode: config})
+	require.NoError(t, err)
+	err = writer.WriteFiles([]*generator.File{generator.NewFile(coordinator, "go.mod", []byte("module github.com/acme/sdk\n"))})
+	assert.EqualError(t, err, "the generated module can't be published: github.com/acme/sdk@v2.0.0: invalid version: should be v0 or v1, not v2")
+}
@@ -2,15 +2,26 @@ package writer
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
 
 	"github.com/fern-api/fern-go/internal/coordinator"
+	"github.com/fern-api/fern-go/internal/diff"
+	"github.com/fern-api/fern-go/internal/fernignore"
 	"github.com/fern-api/fern-go/internal/generator"
+	generatorexec "github.com/fern-api/generator-exec-go"
 )
 
 // Writer writes files to their configured location.
 type Writer interface {
 	Root() string
 	WriteFiles([]*generator.File) error
+
+	// Diff returns the unified diff of the changes that WriteFiles would make
+	// for the given files, without writing anything. An empty string is returned
+	// if the files are up to date.
+	Diff([]*generator.File) (string, error)
 }
 
 // New returns a new Writer.
@@ -19,7 +30,84 @@ func New(coordinator *coordinator.Client, config *Config) (Writer, error) {
 	case *GithubConfig:
 		return newGithubWriter(coordinator, mode)
 	case *LocalConfig:
-		return newLocalWriter(mode)
+		return newLocalWriter(coordinator, mode)
+	case *PublishConfig:
+		return newPublishWriter(coordinator, mode)
 	}
 	return nil, fmt.Errorf("unrecognized output mode %T", config.Mode)
 }
+
+// writeFiles writes the given files to the root directory. Any file that matches
+// a pattern in the root's .fernignore file is left as-is.
+//
+// The files written to the root directory are recorded in its manifest, so that the
+// files that are no longer generated are removed by the next run, unless they were
+// modified since. Files that haven't changed aren't rewritten.
+func writeFiles(coordinator *coordinator.Client, root string, files []*generator.File) error {
+	if err := os.MkdirAll(root, 0755); err != nil {
+		return err
+	}
+	changes, err := newChangeSet(root, files)
+	if err != nil {
+		return err
+	}
+	for _, path := range changes.ignored {
+		// It's OK if we fail to send the log - it's purely informational.
+		_ = coordinator.Log(
+			generatorexec.LogLevelInfo,
+			fmt.Sprintf("Skipping %s because it matches a pattern in %s.", path, fernignore.Filename),
+		)
+	}
+	for _, path := range changes.kept {
+		_ = coordinator.Log(
+			generatorexec.LogLevelWarn,
+			fmt.Sprintf("Keeping %s, which is no longer generated but was modified since the last run.", path),
+		)
+	}
+	for _, change := range changes.files {
+		if change.after == nil {
+			if err := removeFile(root, filepath.FromSlash(change.path)); err != nil {
+				return err
+			}
+			continue
+		}
+		filename := filepath.Join(root, filepath.FromSlash(change.path))
+		if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+			return err
+		}
+		if err := os.WriteFile(filename, change.after, 0644); err != nil {
+			return err
+		}
+	}
+	if err := changes.manifest.write(root); err != nil {
+		return err
+	}
+
+	added, modified, deleted := changes.count()
+	_ = coordinator.Log(
+		generatorexec.LogLevelInfo,
+		fmt.Sprintf(
+			"Generated %d files (%d added, %d modified, %d deleted, %d unchanged).",
+			len(changes.manifest.Files),
+			added,
+			modified,
+			deleted,
+			changes.unchanged,
+		),
+	)
+	return nil
+}
+
+// diffFiles returns the unified diff of the changes that writeFiles would make to
+// the root directory, or an empty string if there aren't any.
+func diffFiles(root string, files []*generator.File) (string, error) {
+	changes, err := newChangeSet(root, files)
+	if err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	for _, change := range changes.files {
+		b.WriteString(diff.Unified("a/"+change.path, "b/"+change.path, change.before, change.after))
+	}
+	return b.String(), nil
+}
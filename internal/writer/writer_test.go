@@ -0,0 +1,230 @@
+package writer
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/fern-api/fern-go/internal/coordinator"
+	"github.com/fern-api/fern-go/internal/generator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteFiles(t *testing.T) {
+	var (
+		root        = t.TempDir()
+		coordinator = coordinator.NewClient("", "")
+	)
+	writer, err := New(coordinator, &Config{Mode: &LocalConfig{Path: root}})
+	require.NoError(t, err)
+
+	// The first run writes every file.
+	require.NoError(
+		t,
+		writer.WriteFiles(
+			[]*generator.File{
+				generator.NewFile(coordinator, "types.go", []byte("package api")),
+				generator.NewFile(coordinator, "user.go", []byte("package api")),
+				generator.NewFile(coordinator, "user/client.go", []byte("package user")),
+				generator.NewFile(coordinator, "custom.go", []byte("package api")),
+			},
+		),
+	)
+	assert.Equal(
+		t,
+		map[string]string{
+			"custom.go":      hashContent([]byte("package api")),
+			"types.go":       hashContent([]byte("package api")),
+			"user.go":        hashContent([]byte("package api")),
+			"user/client.go": hashContent([]byte("package user")),
+		},
+		readManifestFiles(t, root),
+	)
+
+	// The second run removes the stale files, except for the ones that are ignored.
+	require.NoError(t, os.WriteFile(filepath.Join(root, ".fernignore"), []byte("custom.go\n"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "custom.go"), []byte("package api // edited"), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "user", "custom.go"), []byte("package user"), 0644))
+	require.NoError(
+		t,
+		writer.WriteFiles(
+			[]*generator.File{
+				generator.NewFile(coordinator, "types.go", []byte("package api // updated")),
+				generator.NewFile(coordinator, "user/client.go", []byte("package user")),
+			},
+		),
+	)
+	assertFileContent(t, root, "types.go", "package api // updated")
+	assertFileContent(t, root, "user/client.go", "package user")
+	assertFileContent(t, root, "custom.go", "package api // edited")
+	assertFileContent(t, root, "user/custom.go", "package user")
+	assert.NoFileExists(t, filepath.Join(root, "user.go"))
+	assert.Equal(
+		t,
+		map[string]string{
+			"types.go":       hashContent([]byte("package api // updated")),
+			"user/client.go": hashContent([]byte("package user")),
+		},
+		readManifestFiles(t, root),
+	)
+
+	// Directories that are left empty are removed.
+	require.NoError(t, os.Remove(filepath.Join(root, "user", "custom.go")))
+	require.NoError(
+		t,
+		writer.WriteFiles(
+			[]*generator.File{
+				generator.NewFile(coordinator, "types.go", []byte("package api // updated")),
+			},
+		),
+	)
+	assert.NoDirExists(t, filepath.Join(root, "user"))
+	assertFileContent(t, root, "types.go", "package api // updated")
+}
+
+func TestWriteFilesUnchanged(t *testing.T) {
+	var (
+		root        = t.TempDir()
+		coordinator = coordinator.NewClient("", "")
+		filename    = filepath.Join(root, "types.go")
+	)
+	writer, err := New(coordinator, &Config{Mode: &LocalConfig{Path: root}})
+	require.NoError(t, err)
+
+	files := []*generator.File{generator.NewFile(coordinator, "types.go", []byte("package api"))}
+	require.NoError(t, writer.WriteFiles(files))
+
+	// The modification time is preserved if the file isn't rewritten.
+	modTime := time.Date(2006, time.January, 2, 15, 4, 5, 0, time.UTC)
+	require.NoError(t, os.Chtimes(filename, modTime, modTime))
+	require.NoError(t, writer.WriteFiles(files))
+	info, err := os.Stat(filename)
+	require.NoError(t, err)
+	assert.True(t, modTime.Equal(info.ModTime()))
+}
+
+func TestWriteFilesKeepsModified(t *testing.T) {
+	var (
+		root        = t.TempDir()
+		coordinator = coordinator.NewClient("", "")
+	)
+	writer, err := New(coordinator, &Config{Mode: &LocalConfig{Path: root}})
+	require.NoError(t, err)
+
+	require.NoError(
+		t,
+		writer.WriteFiles(
+			[]*generator.File{
+				generator.NewFile(coordinator, "types.go", []byte("package api")),
+				generator.NewFile(coordinator, "user.go", []byte("package api")),
+			},
+		),
+	)
+
+	// A stale file that was edited since it was generated isn't removed,
+	// and it's no longer recorded in the manifest.
+	require.NoError(t, os.WriteFile(filepath.Join(root, "user.go"), []byte("package api // edited"), 0644))
+	require.NoError(
+		t,
+		writer.WriteFiles(
+			[]*generator.File{
+				generator.NewFile(coordinator, "types.go", []byte("package api")),
+			},
+		),
+	)
+	assertFileContent(t, root, "user.go", "package api // edited")
+	assert.Equal(
+		t,
+		map[string]string{
+			"types.go": hashContent([]byte("package api")),
+		},
+		readManifestFiles(t, root),
+	)
+}
+
+func TestIsLocalPath(t *testing.T) {
+	assert.True(t, isLocalPath("types.go"))
+	assert.True(t, isLocalPath("user/client.go"))
+	assert.True(t, isLocalPath("..types.go"))
+	assert.False(t, isLocalPath(""))
+	assert.False(t, isLocalPath(".."))
+	assert.False(t, isLocalPath("../types.go"))
+	assert.False(t, isLocalPath("user/../../types.go"))
+	assert.False(t, isLocalPath("/etc/passwd"))
+}
+
+func assertFileContent(t *testing.T, root string, path string, content string) {
+	bytes, err := os.ReadFile(filepath.Join(root, path))
+	require.NoError(t, err)
+	assert.Equal(t, content, string(bytes))
+}
+
+func readManifestFiles(t *testing.T, root string) map[string]string {
+	content, err := os.ReadFile(filepath.Join(root, manifestFilename))
+	require.NoError(t, err)
+	var manifest manifest
+	require.NoError(t, json.Unmarshal(content, &manifest))
+	return manifest.Files
+}
+
+func TestDiff(t *testing.T) {
+	var (
+		root        = t.TempDir()
+		coordinator = coordinator.NewClient("", "")
+	)
+	writer, err := New(coordinator, &Config{Mode: &LocalConfig{Path: root}})
+	require.NoError(t, err)
+
+	files := []*generator.File{
+		generator.NewFile(coordinator, "types.go", []byte("package api\n")),
+		generator.NewFile(coordinator, "user.go", []byte("package api\n")),
+	}
+	diff, err := writer.Diff(files)
+	require.NoError(t, err)
+	assert.Equal(
+		t,
+		`--- /dev/null
++++ b/types.go
+@@ -0,0 +1 @@
++package api
+--- /dev/null
++++ b/user.go
+@@ -0,0 +1 @@
++package api
+`,
+		diff,
+	)
+	assert.NoFileExists(t, filepath.Join(root, "types.go"))
+
+	require.NoError(t, writer.WriteFiles(files))
+	diff, err = writer.Diff(files)
+	require.NoError(t, err)
+	assert.Empty(t, diff)
+
+	// Stale files are reported as deleted.
+	diff, err = writer.Diff(
+		[]*generator.File{
+			generator.NewFile(coordinator, "types.go", []byte("package api\n\ntype User struct{}\n")),
+		},
+	)
+	require.NoError(t, err)
+	assert.Equal(
+		t,
+		`--- a/types.go
++++ b/types.go
+@@ -1 +1,3 @@
+ package api
++
++type User struct{}
+--- a/user.go
++++ /dev/null
+@@ -1 +0,0 @@
+-package api
+`,
+		diff,
+	)
+	assertFileContent(t, root, "user.go", "package api\n")
+}
@@ -7,7 +7,7 @@ import (
 	fmt "fmt"
 	time "time"
 
-	core "github.com/fern-api/fern-go/internal/fern/ir/core"
+	core "github.com/fern-api/fern-go/ir/core"
 	uuid "github.com/google/uuid"
 )
 